@@ -0,0 +1,21 @@
+package dto
+
+import "time"
+
+// ActivityEntryDTO represents a state change or comment in a task's activity log
+type ActivityEntryDTO struct {
+	ID        string            `json:"id"`
+	Type      string            `json:"type"`
+	Timestamp time.Time         `json:"timestamp"`
+	Author    string            `json:"author,omitempty"`
+	Message   string            `json:"message"`
+	Details   map[string]string `json:"details,omitempty"`
+}
+
+// TaskActivityDTO represents the activity timeline of a task, oldest entry first
+type TaskActivityDTO struct {
+	TaskID  string             `json:"task_id"`
+	BoardID string             `json:"board_id"`
+	Column  string             `json:"column"`
+	Entries []ActivityEntryDTO `json:"entries"`
+}
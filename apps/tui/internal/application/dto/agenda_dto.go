@@ -0,0 +1,51 @@
+package dto
+
+import "time"
+
+// AgendaDTO represents the agenda of a range of days
+type AgendaDTO struct {
+	ProjectID string          `json:"project_id,omitempty"`
+	From      time.Time       `json:"from"`
+	To        time.Time       `json:"to"` // last day of the range
+	Days      []AgendaDayDTO  `json:"days"`
+	Overdue   []AgendaItemDTO `json:"overdue"` // unfinished tasks due before now, oldest first
+}
+
+// AgendaDayDTO represents the agenda of a day with its busy and free
+// working time
+type AgendaDayDTO struct {
+	Date         time.Time       `json:"date"`
+	Weekday      string          `json:"weekday"`
+	WorkingStart *time.Time      `json:"working_start,omitempty"` // nil on days off
+	WorkingEnd   *time.Time      `json:"working_end,omitempty"`
+	Items        []AgendaItemDTO `json:"items"`
+	Busy         []TimeSlotDTO   `json:"busy"`
+	Free         []TimeSlotDTO   `json:"free"`
+	BusyMinutes  int             `json:"busy_minutes"`
+	FreeMinutes  int             `json:"free_minutes"`
+}
+
+// AgendaItemDTO represents a task on a day of the agenda
+type AgendaItemDTO struct {
+	Kind       string      `json:"kind"` // meeting, block, scheduled or due
+	TaskID     string      `json:"task_id"`
+	ShortID    string      `json:"short_id"`
+	ProjectID  string      `json:"project_id"`
+	BoardID    string      `json:"board_id"`
+	ColumnName string      `json:"column_name"`
+	Title      string      `json:"title"`
+	Priority   string      `json:"priority"`
+	Start      time.Time   `json:"start"`
+	End        time.Time   `json:"end"`
+	AllDay     bool        `json:"all_day"`
+	Occurrence bool        `json:"occurrence,omitempty"` // a later occurrence of a recurring task
+	Recurrence string      `json:"recurrence,omitempty"`
+	Done       bool        `json:"done"`
+	Meeting    *MeetingDTO `json:"meeting,omitempty"`
+}
+
+// TimeSlotDTO represents a span of time on the agenda
+type TimeSlotDTO struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
@@ -0,0 +1,22 @@
+package dto
+
+import "time"
+
+// BackupDTO represents a point-in-time backup archive of the data directory
+type BackupDTO struct {
+	Name          string    `json:"name"`
+	Path          string    `json:"path"`
+	Created       time.Time `json:"created"`
+	Reason        string    `json:"reason"`
+	SchemaVersion int       `json:"schema_version"`
+	Size          int64     `json:"size"`
+}
+
+// BackupRestoreDTO represents the result of restoring a backup archive
+type BackupRestoreDTO struct {
+	Backup         BackupDTO           `json:"backup"`
+	SafetyBackup   string              `json:"safety_backup"`
+	Files          int                 `json:"files"`
+	ConfigRestored bool                `json:"config_restored"`
+	Migration      *MigrationReportDTO `json:"migration,omitempty"`
+}
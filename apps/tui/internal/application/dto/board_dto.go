@@ -4,14 +4,24 @@ import "time"
 
 // BoardDTO represents a board data transfer object
 type BoardDTO struct {
-	ID          string      `json:"id"`
-	ProjectID   string      `json:"project_id,omitempty"`
-	Name        string      `json:"name"`
-	Prefix      string      `json:"prefix"`
-	Description string      `json:"description"`
-	Columns     []ColumnDTO `json:"columns"`
-	CreatedAt   time.Time   `json:"created_at"`
-	ModifiedAt  time.Time   `json:"modified_at"`
+	ID          string               `json:"id"`
+	ProjectID   string               `json:"project_id,omitempty"`
+	Name        string               `json:"name"`
+	Prefix      string               `json:"prefix"`
+	Description string               `json:"description"`
+	Columns     []ColumnDTO          `json:"columns"`
+	Fields      []FieldDefinitionDTO `json:"fields,omitempty"`
+	CreatedAt   time.Time            `json:"created_at"`
+	ModifiedAt  time.Time            `json:"modified_at"`
+}
+
+// FieldDefinitionDTO represents a custom field in a board's field schema
+type FieldDefinitionDTO struct {
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Description string   `json:"description,omitempty"`
+	Options     []string `json:"options,omitempty"`
+	Required    bool     `json:"required,omitempty"`
 }
 
 // CreateBoardRequest represents a request to create a board
@@ -19,6 +29,7 @@ type CreateBoardRequest struct {
 	ProjectID   string `json:"project_id"`
 	Name        string `json:"name"`
 	Description string `json:"description"`
+	Template    string `json:"template,omitempty"` // board template for the columns, fields, tasks and actions
 }
 
 // UpdateBoardRequest represents a request to update a board
@@ -0,0 +1,59 @@
+package dto
+
+import "time"
+
+// CalendarSyncResultDTO represents the outcome of a calendar sync run
+type CalendarSyncResultDTO struct {
+	SyncedAt      time.Time                 `json:"synced_at"`
+	EventsCreated int                       `json:"events_created"`
+	EventsUpdated int                       `json:"events_updated"`
+	EventsDeleted int                       `json:"events_deleted"`
+	TasksCreated  int                       `json:"tasks_created"`
+	TasksUpdated  int                       `json:"tasks_updated"`
+	Conflicts     []CalendarSyncConflictDTO `json:"conflicts"`
+	Errors        []string                  `json:"errors"`
+}
+
+// CalendarSyncConflictDTO represents a calendar event and task that both
+// changed, with both versions so the user can choose between them
+type CalendarSyncConflictDTO struct {
+	EventID     string                  `json:"event_id"`
+	TaskID      string                  `json:"task_id"`
+	BoardID     string                  `json:"board_id"`
+	EventTitle  string                  `json:"event_title"`
+	TaskTitle   string                  `json:"task_title"`
+	Description string                  `json:"description"`
+	Fields      []string                `json:"fields"` // fields the versions disagree on
+	DetectedAt  time.Time               `json:"detected_at"`
+	Event       CalendarEventVersionDTO `json:"event"`
+	Task        CalendarEventVersionDTO `json:"task"`
+}
+
+// CalendarEventVersionDTO represents one version of a conflicting meeting
+type CalendarEventVersionDTO struct {
+	Title       string    `json:"title"`
+	Description string    `json:"description,omitempty"`
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+	AllDay      bool      `json:"all_day,omitempty"`
+	Location    string    `json:"location,omitempty"`
+}
+
+// CalendarSyncStatusDTO represents the calendar sync configuration and the
+// state of the last sync
+type CalendarSyncStatusDTO struct {
+	Enabled        bool                   `json:"enabled"`
+	Provider       string                 `json:"provider,omitempty"`
+	AutoSync       bool                   `json:"auto_sync"`
+	SyncInterval   int                    `json:"sync_interval"` // seconds
+	PullEnabled    bool                   `json:"pull_enabled"`
+	PushEnabled    bool                   `json:"push_enabled"`
+	ConflictPolicy string                 `json:"conflict_policy"`
+	Syncing        bool                   `json:"syncing"`
+	LastSync       *time.Time             `json:"last_sync,omitempty"`
+	NextSync       *time.Time             `json:"next_sync,omitempty"`
+	SyncedEvents   int                    `json:"synced_events"`
+	Conflicts      int                    `json:"conflicts"` // awaiting a resolution
+	LastResult     *CalendarSyncResultDTO `json:"last_result,omitempty"`
+	LastError      string                 `json:"last_error,omitempty"`
+}
@@ -0,0 +1,49 @@
+package dto
+
+import "time"
+
+// FlowMetricsDTO represents the Kanban flow metrics of a board over a date range
+type FlowMetricsDTO struct {
+	BoardID        string                 `json:"board_id"`
+	From           time.Time              `json:"from"`
+	To             time.Time              `json:"to"`
+	Columns        []string               `json:"columns"` // board order, for the cumulative flow diagram
+	LeadTime       DurationStatsDTO       `json:"lead_time"`
+	CycleTime      DurationStatsDTO       `json:"cycle_time"`
+	Throughput     []WeeklyThroughputDTO  `json:"throughput"`
+	CumulativeFlow []CumulativeFlowDayDTO `json:"cumulative_flow"`
+	Tasks          []TaskFlowDTO          `json:"tasks"` // tasks completed within the range
+}
+
+// DurationStatsDTO summarizes lead or cycle times
+type DurationStatsDTO struct {
+	Count   int           `json:"count"`
+	Average time.Duration `json:"average"`
+	P50     time.Duration `json:"p50"`
+	P85     time.Duration `json:"p85"`
+	P95     time.Duration `json:"p95"`
+	Max     time.Duration `json:"max"`
+}
+
+// WeeklyThroughputDTO represents the tasks completed in a week
+type WeeklyThroughputDTO struct {
+	WeekStart time.Time `json:"week_start"`
+	Completed int       `json:"completed"`
+}
+
+// CumulativeFlowDayDTO represents the tasks in each column at the end of a day
+type CumulativeFlowDayDTO struct {
+	Date    time.Time      `json:"date"`
+	Columns map[string]int `json:"columns"`
+}
+
+// TaskFlowDTO represents the flow of a completed task
+type TaskFlowDTO struct {
+	TaskID      string         `json:"task_id"`
+	Title       string         `json:"title"`
+	CreatedAt   time.Time      `json:"created_at"`
+	StartedAt   *time.Time     `json:"started_at,omitempty"`
+	CompletedAt time.Time      `json:"completed_at"`
+	LeadTime    time.Duration  `json:"lead_time"`
+	CycleTime   *time.Duration `json:"cycle_time,omitempty"`
+}
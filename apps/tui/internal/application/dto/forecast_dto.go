@@ -0,0 +1,27 @@
+package dto
+
+import "time"
+
+// CompletionForecastDTO represents when a number of tasks will be done, at
+// 50, 85 and 95 percent confidence
+type CompletionForecastDTO struct {
+	BoardIDs     []string   `json:"board_ids"`
+	Tasks        int        `json:"tasks"`
+	HistoryWeeks int        `json:"history_weeks"`
+	Trials       int        `json:"trials"`
+	P50          *time.Time `json:"p50,omitempty"` // nil when too few simulations finished
+	P85          *time.Time `json:"p85,omitempty"`
+	P95          *time.Time `json:"p95,omitempty"`
+}
+
+// ThroughputForecastDTO represents how many tasks will be done by a date, at
+// 50, 85 and 95 percent confidence
+type ThroughputForecastDTO struct {
+	BoardIDs     []string  `json:"board_ids"`
+	Date         time.Time `json:"date"`
+	HistoryWeeks int       `json:"history_weeks"`
+	Trials       int       `json:"trials"`
+	P50          int       `json:"p50"`
+	P85          int       `json:"p85"`
+	P95          int       `json:"p95"`
+}
@@ -0,0 +1,32 @@
+package dto
+
+import "time"
+
+// HistoryEntryDTO represents one recorded revision of a task or board.
+// For task history the task's files at that revision are included.
+type HistoryEntryDTO struct {
+	Revision string    `json:"revision"`
+	Date     time.Time `json:"date"`
+	Author   string    `json:"author"`
+	Message  string    `json:"message"`
+	Files    []string  `json:"files,omitempty"`
+	Column   string    `json:"column,omitempty"`
+	Metadata string    `json:"metadata,omitempty"`
+	Content  string    `json:"content,omitempty"`
+	Deleted  bool      `json:"deleted,omitempty"`
+}
+
+// HistoryDTO represents the history of a task or board, newest first
+type HistoryDTO struct {
+	TaskID  string            `json:"task_id,omitempty"`
+	BoardID string            `json:"board_id,omitempty"`
+	Entries []HistoryEntryDTO `json:"entries"`
+}
+
+// RestoreResultDTO represents the outcome of restoring a task or board from history
+type RestoreResultDTO struct {
+	BoardID  string `json:"board_id"`
+	TaskID   string `json:"task_id,omitempty"`
+	Revision string `json:"revision"`
+	Commit   string `json:"commit"`
+}
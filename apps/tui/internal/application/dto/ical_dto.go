@@ -0,0 +1,22 @@
+package dto
+
+// ICalExportDTO represents an iCalendar feed of scheduled tasks, meetings and
+// due dates
+type ICalExportDTO struct {
+	Name    string `json:"name"`
+	Events  int    `json:"events"`
+	Content string `json:"content,omitempty"` // left out when written to Path
+	Path    string `json:"path,omitempty"`
+}
+
+// ICalImportResultDTO represents the outcome of importing an iCalendar file
+// into a board
+type ICalImportResultDTO struct {
+	BoardID   string   `json:"board_id"`
+	Created   int      `json:"created"`
+	Updated   int      `json:"updated"`
+	Unchanged int      `json:"unchanged"`
+	Skipped   int      `json:"skipped"` // own exports, overridden occurrences and events without a UID
+	TaskIDs   []string `json:"task_ids"`
+	Backup    string   `json:"backup,omitempty"` // backup taken before the import
+}
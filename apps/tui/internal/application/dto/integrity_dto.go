@@ -0,0 +1,20 @@
+package dto
+
+// IntegrityIssueDTO represents a single data directory inconsistency
+type IntegrityIssueDTO struct {
+	Severity string `json:"severity"`
+	Code     string `json:"code"`
+	Path     string `json:"path"`
+	Message  string `json:"message"`
+	Fixable  bool   `json:"fixable"`
+	Fixed    bool   `json:"fixed"`
+}
+
+// IntegrityReportDTO represents the result of a data directory integrity check
+type IntegrityReportDTO struct {
+	Fix      bool                `json:"fix"`
+	Errors   int                 `json:"errors"`
+	Warnings int                 `json:"warnings"`
+	Fixed    int                 `json:"fixed"`
+	Issues   []IntegrityIssueDTO `json:"issues"`
+}
@@ -2,13 +2,31 @@ package dto
 
 import (
 	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/valueobject"
+	"time"
 )
 
 // BoardToDTO converts a Board entity to BoardDTO
 func BoardToDTO(board *entity.Board) BoardDTO {
+	return BoardToDTOWithTrackedTime(board, nil)
+}
+
+// BoardToDTOWithTrackedTime converts a Board entity to BoardDTO, with the
+// tracked time of its tasks keyed by short task ID
+func BoardToDTOWithTrackedTime(board *entity.Board, trackedTimes map[string]time.Duration) BoardDTO {
+	hierarchy := board.Hierarchy()
 	columns := make([]ColumnDTO, 0, len(board.Columns()))
 	for _, col := range board.Columns() {
-		columns = append(columns, ColumnToDTO(col))
+		columnDTO := ColumnToDTO(col)
+		for i, task := range col.Tasks() {
+			columnDTO.Tasks[i] = hierarchyTaskToDTO(hierarchy, task, trackedTimes)
+		}
+		columns = append(columns, columnDTO)
+	}
+
+	fields := make([]FieldDefinitionDTO, 0, len(board.Fields()))
+	for _, field := range board.Fields() {
+		fields = append(fields, FieldDefinitionToDTO(field))
 	}
 
 	return BoardDTO{
@@ -18,11 +36,34 @@ func BoardToDTO(board *entity.Board) BoardDTO {
 		Prefix:      board.Prefix(),
 		Description: board.Description(),
 		Columns:     columns,
+		Fields:      fields,
 		CreatedAt:   board.CreatedAt(),
 		ModifiedAt:  board.ModifiedAt(),
 	}
 }
 
+// FieldDefinitionToDTO converts a FieldDefinition to FieldDefinitionDTO
+func FieldDefinitionToDTO(field entity.FieldDefinition) FieldDefinitionDTO {
+	return FieldDefinitionDTO{
+		Name:        field.Name,
+		Type:        string(field.Type),
+		Description: field.Description,
+		Options:     field.Options,
+		Required:    field.Required,
+	}
+}
+
+// FieldDefinitionFromDTO converts a FieldDefinitionDTO to a FieldDefinition
+func FieldDefinitionFromDTO(field FieldDefinitionDTO) entity.FieldDefinition {
+	return entity.FieldDefinition{
+		Name:        field.Name,
+		Type:        entity.FieldType(field.Type),
+		Description: field.Description,
+		Options:     field.Options,
+		Required:    field.Required,
+	}
+}
+
 // BoardToListDTO converts a Board entity to BoardListDTO
 func BoardToListDTO(board *entity.Board) BoardListDTO {
 	return BoardListDTO{
@@ -80,9 +121,106 @@ func TaskToDTO(task *entity.Task) TaskDTO {
 		TimeBlock:     task.TimeBlock(),
 		TaskType:      string(task.TaskType()),
 	}
+	for _, attachment := range task.Attachments() {
+		dto.Attachments = append(dto.Attachments, AttachmentToDTO(attachment))
+	}
+	dto.AttachmentCount = len(dto.Attachments)
+	if fields := task.Fields(); len(fields) > 0 {
+		dto.Fields = fields
+	}
+	if notes := task.LinkedNotes(); len(notes) > 0 {
+		dto.LinkedNotes = notes
+	}
+	dto.EstimatedTime = task.EstimatedTime()
+	if rule := task.Recurrence(); rule != nil {
+		dto.Recurrence = rule.String()
+	}
+	if meeting := task.MeetingData(); meeting != nil {
+		dto.MeetingData = &MeetingDTO{
+			Attendees:     meeting.Attendees,
+			Location:      meeting.Location,
+			MeetingURL:    meeting.MeetingURL,
+			GoogleEventID: meeting.GoogleEventID,
+			ICalUID:       meeting.ICalUID,
+		}
+	}
+	if task.ParentID() != nil {
+		dto.ParentID = task.ParentID().String()
+	}
+	for _, visit := range task.ColumnHistory() {
+		dto.ColumnHistory = append(dto.ColumnHistory, ColumnVisitDTO{
+			Column:    visit.Column,
+			EnteredAt: visit.EnteredAt,
+			ExitedAt:  visit.ExitedAt,
+		})
+	}
+	return dto
+}
+
+// BoardTaskToDTO converts a Task entity of a board to TaskDTO, with its
+// tracked time and the rollup of its subtasks
+func BoardTaskToDTO(board *entity.Board, task *entity.Task, trackedTimes map[string]time.Duration) TaskDTO {
+	return hierarchyTaskToDTO(board.Hierarchy(), task, trackedTimes)
+}
+
+// hierarchyTaskToDTO converts a Task entity of a board to TaskDTO, rolling up
+// its subtasks from the board's hierarchy
+func hierarchyTaskToDTO(hierarchy *entity.TaskHierarchy, task *entity.Task, trackedTimes map[string]time.Duration) TaskDTO {
+	dto := TaskToDTO(task)
+	dto.TrackedTime = trackedTimes[task.ID().ShortID()]
+	dto.SubtaskCount = len(hierarchy.Subtasks(task.ID()))
+	if dto.SubtaskCount == 0 {
+		return dto
+	}
+
+	rollup := hierarchy.Rollup(task, trackedTimes)
+	dto.Rollup = &TaskRollupDTO{
+		Subtasks:        rollup.Subtasks,
+		DoneSubtasks:    rollup.DoneSubtasks,
+		PercentComplete: rollup.PercentComplete,
+		EstimatedTime:   rollup.EstimatedTime,
+		TrackedTime:     rollup.TrackedTime,
+		EarliestDueDate: rollup.EarliestDueDate,
+	}
 	return dto
 }
 
+// TaskTreeToDTO converts a task of a board and its subtasks, at any depth,
+// to TaskTreeDTO
+func TaskTreeToDTO(board *entity.Board, task *entity.Task, trackedTimes map[string]time.Duration) TaskTreeDTO {
+	return taskTreeToDTO(board.Hierarchy(), task, trackedTimes, map[string]bool{task.ID().ShortID(): true})
+}
+
+// taskTreeToDTO builds the tree below a task; visited guards against cycles
+// in hand-edited metadata
+func taskTreeToDTO(hierarchy *entity.TaskHierarchy, task *entity.Task, trackedTimes map[string]time.Duration, visited map[string]bool) TaskTreeDTO {
+	tree := TaskTreeDTO{
+		Task:     hierarchyTaskToDTO(hierarchy, task, trackedTimes),
+		Subtasks: make([]TaskTreeDTO, 0),
+	}
+	if column := hierarchy.Column(task.ID()); column != nil {
+		tree.Task.ColumnName = column.DisplayName()
+	}
+	for _, subtask := range hierarchy.Subtasks(task.ID()) {
+		if visited[subtask.ID().ShortID()] {
+			continue
+		}
+		visited[subtask.ID().ShortID()] = true
+		tree.Subtasks = append(tree.Subtasks, taskTreeToDTO(hierarchy, subtask, trackedTimes, visited))
+	}
+	return tree
+}
+
+// AttachmentToDTO converts a task Attachment to AttachmentDTO
+func AttachmentToDTO(attachment entity.Attachment) AttachmentDTO {
+	return AttachmentDTO{
+		Name:     attachment.Name,
+		Size:     attachment.Size,
+		MimeType: attachment.MimeType,
+		AddedAt:  attachment.AddedAt,
+	}
+}
+
 // TaskToDTOWithPath converts a Task entity to TaskDTO with file path and column name
 func TaskToDTOWithPath(task *entity.Task, filePath string, columnName string) TaskDTO {
 	dto := TaskToDTO(task)
@@ -90,3 +228,184 @@ func TaskToDTOWithPath(task *entity.Task, filePath string, columnName string) Ta
 	dto.ColumnName = columnName
 	return dto
 }
+
+// TrashItemToDTO converts a TrashItem entity to TrashItemDTO; a positive retention sets ExpiresAt
+func TrashItemToDTO(item *entity.TrashItem, retention time.Duration) TrashItemDTO {
+	dto := TrashItemDTO{
+		ID:         item.ID(),
+		Type:       string(item.Type()),
+		Title:      item.Title(),
+		OriginalID: item.OriginalID(),
+		Project:    item.ProjectSlug(),
+		BoardID:    item.BoardID(),
+		Column:     item.ColumnName(),
+		DeletedAt:  item.DeletedAt(),
+	}
+	for _, task := range item.Tasks() {
+		dto.Tasks = append(dto.Tasks, TrashedTaskDTO{ID: task.TaskID, Column: task.Column})
+	}
+	if retention > 0 {
+		expiresAt := item.DeletedAt().Add(retention)
+		dto.ExpiresAt = &expiresAt
+	}
+	return dto
+}
+
+// ActivityEntryToDTO converts an ActivityEntry entity to ActivityEntryDTO
+func ActivityEntryToDTO(entry *entity.ActivityEntry) ActivityEntryDTO {
+	dto := ActivityEntryDTO{
+		ID:        entry.ID(),
+		Type:      string(entry.Type()),
+		Timestamp: entry.Timestamp(),
+		Author:    entry.Author(),
+		Message:   entry.Message(),
+	}
+	if details := entry.Details(); len(details) > 0 {
+		dto.Details = details
+	}
+	return dto
+}
+
+// TaskTemplateToDTO converts a TaskTemplate entity to TaskTemplateDTO
+func TaskTemplateToDTO(template *entity.TaskTemplate) TaskTemplateDTO {
+	dto := TaskTemplateDTO{
+		Name:        template.Name(),
+		ProjectID:   template.ProjectID(),
+		Title:       template.Title(),
+		Description: template.Description(),
+		Column:      template.Column(),
+		Tags:        template.Tags(),
+	}
+	if template.Priority() != valueobject.PriorityNone {
+		dto.Priority = template.Priority().String()
+	}
+	if fields := template.Fields(); len(fields) > 0 {
+		dto.Fields = fields
+	}
+	if variables := template.Variables(); len(variables) > 0 {
+		dto.Variables = variables
+	}
+	return dto
+}
+
+// BoardTemplateToDTO converts a BoardTemplate entity to BoardTemplateDTO
+func BoardTemplateToDTO(template *entity.BoardTemplate) BoardTemplateDTO {
+	dto := BoardTemplateDTO{
+		Name:        template.Name(),
+		Description: template.Description(),
+		Columns:     make([]ColumnBlueprintDTO, 0),
+	}
+
+	for _, column := range template.Columns() {
+		dto.Columns = append(dto.Columns, ColumnBlueprintDTO{
+			Name:        column.Name,
+			Description: column.Description,
+			Order:       column.Order,
+			WIPLimit:    column.WIPLimit,
+			Color:       column.Color,
+		})
+	}
+
+	for _, field := range template.Fields() {
+		dto.Fields = append(dto.Fields, FieldDefinitionToDTO(field))
+	}
+
+	for _, task := range template.Tasks() {
+		taskDTO := TaskBlueprintDTO{
+			Title:       task.Title,
+			Description: task.Description,
+			Column:      task.Column,
+			Tags:        task.Tags,
+			Fields:      task.Fields,
+		}
+		if task.Priority != valueobject.PriorityNone {
+			taskDTO.Priority = task.Priority.String()
+		}
+		dto.Tasks = append(dto.Tasks, taskDTO)
+	}
+
+	for _, action := range template.Actions() {
+		actionDTO := ActionBlueprintDTO{
+			Name:        action.Name,
+			Description: action.Description,
+			Event:       string(action.Event),
+			Schedule:    string(action.ScheduleType),
+			Cron:        action.Cron,
+			Type:        string(action.Type),
+			Title:       action.Title,
+			Message:     action.Message,
+			ScriptPath:  action.ScriptPath,
+			Column:      action.Column,
+			AddTags:     action.AddTags,
+			RemoveTags:  action.RemoveTags,
+		}
+		if action.ScheduleType == valueobject.ScheduleTypeRelativeDueDate ||
+			action.ScheduleType == valueobject.ScheduleTypeRelativeCreation {
+			actionDTO.Offset = action.Offset.String()
+		}
+		dto.Actions = append(dto.Actions, actionDTO)
+	}
+
+	return dto
+}
+
+// ProjectTemplateToDTO converts a ProjectTemplate entity to ProjectTemplateDTO
+func ProjectTemplateToDTO(template *entity.ProjectTemplate) ProjectTemplateDTO {
+	dto := ProjectTemplateDTO{
+		Name:        template.Name(),
+		Description: template.Description(),
+		Boards:      make([]BoardBlueprintDTO, 0),
+	}
+
+	for _, board := range template.Boards() {
+		dto.Boards = append(dto.Boards, BoardBlueprintDTO{
+			Name:        board.Name,
+			Description: board.Description,
+			Template:    board.Template,
+		})
+	}
+
+	for _, note := range template.Notes() {
+		dto.Notes = append(dto.Notes, NoteBlueprintDTO{
+			Title:   note.Title,
+			Type:    string(note.Type),
+			Content: note.Content,
+			Tags:    note.Tags,
+		})
+	}
+
+	return dto
+}
+
+// TagToDTO converts a registered Tag entity to TagDTO
+func TagToDTO(tag *entity.Tag) TagDTO {
+	tagDTO := TagDTO{
+		Name:        tag.Name(),
+		Description: tag.Description(),
+		Aliases:     tag.Aliases(),
+		Registered:  true,
+	}
+	if tag.Color() != nil {
+		tagDTO.Color = tag.Color().String()
+	}
+	return tagDTO
+}
+
+// NoteToDTO converts Note entity to NoteDTO
+func NoteToDTO(note *entity.Note) NoteDTO {
+	noteDTO := NoteDTO{
+		ID:         note.ID(),
+		ProjectID:  note.ProjectID(),
+		Title:      note.Title(),
+		Type:       string(note.NoteType()),
+		Content:    note.Content(),
+		Tags:       note.Tags(),
+		Date:       note.Date(),
+		CreatedAt:  note.CreatedAt(),
+		ModifiedAt: note.ModifiedAt(),
+	}
+	for _, taskID := range note.LinkedTasks() {
+		noteDTO.LinkedTasks = append(noteDTO.LinkedTasks, taskID.String())
+	}
+	return noteDTO
+}
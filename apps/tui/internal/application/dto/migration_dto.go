@@ -0,0 +1,18 @@
+package dto
+
+// MigrationStepDTO represents a single storage migration step in a report
+type MigrationStepDTO struct {
+	Version     int      `json:"version"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Changes     []string `json:"changes"`
+}
+
+// MigrationReportDTO represents the result of a storage migration run or dry run
+type MigrationReportDTO struct {
+	DryRun      bool               `json:"dry_run"`
+	FromVersion int                `json:"from_version"`
+	ToVersion   int                `json:"to_version"`
+	BackupPath  string             `json:"backup_path,omitempty"`
+	Steps       []MigrationStepDTO `json:"steps"`
+}
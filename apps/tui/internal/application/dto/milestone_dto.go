@@ -0,0 +1,35 @@
+package dto
+
+import "time"
+
+// MilestoneDTO represents a milestone task with the tasks it tracks
+type MilestoneDTO struct {
+	BoardID       string                 `json:"board_id"`
+	Task          TaskDTO                `json:"task"`
+	Members       []MilestoneMemberDTO   `json:"members"`
+	Progress      MilestoneProgressDTO   `json:"progress"`
+	Forecast      *CompletionForecastDTO `json:"forecast,omitempty"`       // shown next to the task's due date
+	ForecastError string                 `json:"forecast_error,omitempty"` // why there is no forecast, when it failed
+}
+
+// MilestoneMemberDTO represents a task tracked by a milestone
+type MilestoneMemberDTO struct {
+	BoardID    string `json:"board_id,omitempty"` // the milestone's board when empty
+	TaskID     string `json:"task_id"`
+	Title      string `json:"title,omitempty"`
+	ColumnName string `json:"column_name,omitempty"`
+	Done       bool   `json:"done"`
+	Missing    bool   `json:"missing,omitempty"` // the task no longer exists
+}
+
+// MilestoneProgressDTO reports the progress of a milestone
+type MilestoneProgressDTO struct {
+	Members             int           `json:"members"`
+	DoneMembers         int           `json:"done_members"`
+	MissingMembers      int           `json:"missing_members,omitempty"`
+	PercentComplete     float64       `json:"percent_complete"`
+	RemainingEstimate   time.Duration `json:"remaining_estimate"`
+	Throughput          float64       `json:"throughput"` // tasks completed per day over the last two weeks
+	ProjectedCompletion *time.Time    `json:"projected_completion,omitempty"`
+	AtRisk              bool          `json:"at_risk"`
+}
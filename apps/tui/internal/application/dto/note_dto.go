@@ -0,0 +1,33 @@
+package dto
+
+import "time"
+
+// NoteDTO represents a note
+type NoteDTO struct {
+	ID          string    `json:"id"`
+	ProjectID   string    `json:"project_id,omitempty"`
+	Title       string    `json:"title"`
+	Type        string    `json:"type"`
+	Content     string    `json:"content"`
+	Tags        []string  `json:"tags,omitempty"`
+	LinkedTasks []string  `json:"linked_tasks,omitempty"`
+	Date        time.Time `json:"date"`
+	CreatedAt   time.Time `json:"created_at"`
+	ModifiedAt  time.Time `json:"modified_at"`
+}
+
+// MeetingNoteDTO represents the note of a meeting task
+type MeetingNoteDTO struct {
+	Note    NoteDTO `json:"note"`
+	BoardID string  `json:"board_id"`
+	TaskID  string  `json:"task_id"`
+	Created bool    `json:"created"` // false when the meeting already had a note
+}
+
+// ActionItemTasksDTO represents the tasks created from the action items of a
+// meeting note
+type ActionItemTasksDTO struct {
+	NoteID  string    `json:"note_id"`
+	BoardID string    `json:"board_id"`
+	Tasks   []TaskDTO `json:"tasks"`
+}
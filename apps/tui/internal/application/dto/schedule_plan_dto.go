@@ -0,0 +1,37 @@
+package dto
+
+import "time"
+
+// SchedulePlanDTO represents proposed time blocks for tasks
+type SchedulePlanDTO struct {
+	From      time.Time          `json:"from"`
+	Until     time.Time          `json:"until"`
+	Blocks    []PlannedBlockDTO  `json:"blocks"`
+	Unplanned []UnplannedTaskDTO `json:"unplanned"`
+}
+
+// PlannedBlockDTO represents a time block proposed for a task. Date, Time and
+// Duration are in the form the schedule_task request takes them.
+type PlannedBlockDTO struct {
+	TaskID     string     `json:"task_id"`
+	BoardID    string     `json:"board_id"`
+	ColumnName string     `json:"column_name"`
+	Title      string     `json:"title"`
+	Priority   string     `json:"priority"`
+	DueDate    *time.Time `json:"due_date,omitempty"`
+	Start      time.Time  `json:"start"`
+	End        time.Time  `json:"end"`
+	Date       string     `json:"date"`
+	Time       string     `json:"time"`
+	Duration   string     `json:"duration"`
+	MissedAt   *time.Time `json:"missed_at,omitempty"` // start of the missed block this replaces
+	Late       bool       `json:"late"`
+}
+
+// UnplannedTaskDTO represents a task no time block was found for
+type UnplannedTaskDTO struct {
+	TaskID  string `json:"task_id"`
+	BoardID string `json:"board_id"`
+	Title   string `json:"title"`
+	Reason  string `json:"reason"`
+}
@@ -0,0 +1,51 @@
+package dto
+
+import "time"
+
+// SprintDTO represents a sprint with its tasks
+type SprintDTO struct {
+	ID            string                 `json:"id"`
+	ProjectID     string                 `json:"project_id"`
+	Name          string                 `json:"name"`
+	Goal          string                 `json:"goal,omitempty"`
+	StartDate     time.Time              `json:"start_date"`
+	EndDate       time.Time              `json:"end_date"`
+	Closed        bool                   `json:"closed"`
+	Tasks         []SprintTaskDTO        `json:"tasks"`
+	Summary       *SprintSummaryDTO      `json:"summary,omitempty"`        // set once the sprint is closed
+	Forecast      *CompletionForecastDTO `json:"forecast,omitempty"`       // shown next to the end date while open
+	ForecastError string                 `json:"forecast_error,omitempty"` // why there is no forecast, when it failed
+}
+
+// SprintTaskDTO represents a task planned in a sprint
+type SprintTaskDTO struct {
+	BoardID        string    `json:"board_id"`
+	TaskID         string    `json:"task_id"`
+	Title          string    `json:"title,omitempty"`
+	ColumnName     string    `json:"column_name,omitempty"`
+	AddedAt        time.Time `json:"added_at"`
+	AddedMidSprint bool      `json:"added_mid_sprint"`
+	Done           bool      `json:"done"`
+	Missing        bool      `json:"missing,omitempty"` // the task no longer exists
+}
+
+// SprintSummaryDTO represents the outcome of a closed sprint
+type SprintSummaryDTO struct {
+	ClosedAt      time.Time       `json:"closed_at"`
+	Committed     int             `json:"committed"`
+	CommittedDone int             `json:"committed_done"`
+	Added         int             `json:"added"` // scope added mid-sprint
+	AddedDone     int             `json:"added_done"`
+	RolledOver    []SprintTaskDTO `json:"rolled_over"`
+	RolledOverTo  string          `json:"rolled_over_to,omitempty"`
+	TrackedTime   time.Duration   `json:"tracked_time"`
+}
+
+// PlanSprintRequest represents a request to plan a sprint
+type PlanSprintRequest struct {
+	ProjectID string    `json:"project_id"`
+	Name      string    `json:"name"`
+	Goal      string    `json:"goal,omitempty"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+}
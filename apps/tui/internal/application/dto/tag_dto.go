@@ -0,0 +1,20 @@
+package dto
+
+// TagDTO represents a tag of a project: a registered tag, or a tag that is
+// only used on tasks or notes
+type TagDTO struct {
+	Name        string   `json:"name"`
+	Color       string   `json:"color,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Aliases     []string `json:"aliases,omitempty"`
+	Registered  bool     `json:"registered"`
+	TaskCount   int      `json:"task_count"`
+	NoteCount   int      `json:"note_count"`
+}
+
+// TagRewriteDTO reports the tasks and notes changed by a tag operation
+type TagRewriteDTO struct {
+	TasksUpdated int      `json:"tasks_updated"`
+	NotesUpdated int      `json:"notes_updated"`
+	BoardIDs     []string `json:"board_ids,omitempty"`
+}
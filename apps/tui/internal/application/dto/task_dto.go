@@ -27,9 +27,53 @@ type TaskDTO struct {
 	ScheduledDate *time.Time     `json:"scheduled_date,omitempty"`
 	ScheduledTime *time.Time     `json:"scheduled_time,omitempty"`
 	TimeBlock     *time.Duration `json:"time_block,omitempty"`
+	Recurrence    string         `json:"recurrence,omitempty"`
 
 	TaskType    string       `json:"task_type,omitempty"`
 	MeetingData *MeetingDTO  `json:"meeting_data,omitempty"`
+
+	AttachmentCount int             `json:"attachment_count"`
+	Attachments     []AttachmentDTO `json:"attachments,omitempty"`
+
+	Fields map[string]string `json:"fields,omitempty"`
+
+	ParentID     string         `json:"parent_id,omitempty"`
+	SubtaskCount int            `json:"subtask_count,omitempty"`
+	Rollup       *TaskRollupDTO `json:"rollup,omitempty"` // set on tasks with subtasks
+
+	ColumnHistory []ColumnVisitDTO `json:"column_history,omitempty"` // empty until the task is first moved
+}
+
+// ColumnVisitDTO represents a stay of a task in a column
+type ColumnVisitDTO struct {
+	Column    string     `json:"column"`
+	EnteredAt time.Time  `json:"entered_at"`
+	ExitedAt  *time.Time `json:"exited_at,omitempty"`
+}
+
+// TaskRollupDTO summarizes the subtasks of a task at any depth
+type TaskRollupDTO struct {
+	Subtasks        int           `json:"subtasks"`
+	DoneSubtasks    int           `json:"done_subtasks"`
+	PercentComplete float64       `json:"percent_complete"`
+	EstimatedTime   time.Duration `json:"estimated_time"`
+	TrackedTime     time.Duration `json:"tracked_time"`
+	EarliestDueDate *time.Time    `json:"earliest_due_date,omitempty"`
+}
+
+// TaskTreeDTO represents a task with its subtasks, nested to any depth
+type TaskTreeDTO struct {
+	Task     TaskDTO       `json:"task"`
+	Subtasks []TaskTreeDTO `json:"subtasks"`
+}
+
+// AttachmentDTO represents a file attached to a task
+type AttachmentDTO struct {
+	Name     string    `json:"name"`
+	Size     int64     `json:"size"`
+	MimeType string    `json:"mime_type,omitempty"`
+	AddedAt  time.Time `json:"added_at"`
+	Path     string    `json:"path,omitempty"`
 }
 
 type MeetingDTO struct {
@@ -37,6 +81,7 @@ type MeetingDTO struct {
 	Location      string   `json:"location,omitempty"`
 	MeetingURL    string   `json:"meeting_url,omitempty"`
 	GoogleEventID string   `json:"google_event_id,omitempty"`
+	ICalUID       string   `json:"ical_uid,omitempty"`
 }
 
 // CreateTaskRequest represents a request to create a task
@@ -47,6 +92,7 @@ type CreateTaskRequest struct {
 	ColumnName  string    `json:"column_name"`
 	DueDate     *time.Time `json:"due_date,omitempty"`
 	Tags        []string  `json:"tags,omitempty"`
+	Fields      map[string]string `json:"fields,omitempty"`
 }
 
 // UpdateTaskRequest represents a request to update a task
@@ -56,7 +102,23 @@ type UpdateTaskRequest struct {
 	Priority    *string   `json:"priority,omitempty"`
 	Status      *string   `json:"status,omitempty"`
 	DueDate     *time.Time `json:"due_date,omitempty"`
+	EstimatedTime *time.Duration `json:"estimated_time,omitempty"`
 	Tags        []string  `json:"tags,omitempty"`
+	Fields      map[string]string `json:"fields,omitempty"` // custom fields to change; an empty value clears one
+}
+
+// TaskQuery selects the tasks of a board that match conditions
+type TaskQuery struct {
+	Conditions []ConditionDTO `json:"conditions,omitempty"`
+	MatchAny   bool           `json:"match_any,omitempty"` // match any condition instead of all
+}
+
+// ConditionDTO represents a condition on a task property or custom field,
+// e.g. {"field": "story_points", "operator": "gt", "value": 3}
+type ConditionDTO struct {
+	Field    string      `json:"field"`
+	Operator string      `json:"operator"`
+	Value    interface{} `json:"value"`
 }
 
 // MoveTaskRequest represents a request to move a task
@@ -0,0 +1,100 @@
+package dto
+
+// TaskTemplateDTO represents a task template. Title, description and field
+// values may contain {{name}} placeholders.
+type TaskTemplateDTO struct {
+	Name        string            `json:"name"`
+	ProjectID   string            `json:"project_id,omitempty"` // empty for a global template
+	Title       string            `json:"title"`
+	Description string            `json:"description,omitempty"`
+	Priority    string            `json:"priority,omitempty"`
+	Column      string            `json:"column,omitempty"`
+	Tags        []string          `json:"tags,omitempty"`
+	Fields      map[string]string `json:"fields,omitempty"`
+	Variables   map[string]string `json:"variables,omitempty"` // declared variables and their defaults
+}
+
+// CreateTaskFromTemplateRequest represents a request to create a task from a template
+type CreateTaskFromTemplateRequest struct {
+	Template   string            `json:"template"`
+	ColumnName string            `json:"column_name,omitempty"` // overrides the template column
+	Variables  map[string]string `json:"variables,omitempty"`
+}
+
+// BoardTemplateDTO represents a board template
+type BoardTemplateDTO struct {
+	Name        string               `json:"name"`
+	Description string               `json:"description,omitempty"`
+	Columns     []ColumnBlueprintDTO `json:"columns"`
+	Fields      []FieldDefinitionDTO `json:"fields,omitempty"`
+	Tasks       []TaskBlueprintDTO   `json:"tasks,omitempty"`
+	Actions     []ActionBlueprintDTO `json:"actions,omitempty"`
+}
+
+// ColumnBlueprintDTO represents a column of a board template
+type ColumnBlueprintDTO struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Order       int    `json:"order"`
+	WIPLimit    int    `json:"wip_limit,omitempty"`
+	Color       string `json:"color,omitempty"`
+}
+
+// TaskBlueprintDTO represents a seed task of a board template
+type TaskBlueprintDTO struct {
+	Title       string            `json:"title"`
+	Description string            `json:"description,omitempty"`
+	Column      string            `json:"column,omitempty"` // empty for the first column
+	Priority    string            `json:"priority,omitempty"`
+	Tags        []string          `json:"tags,omitempty"`
+	Fields      map[string]string `json:"fields,omitempty"`
+}
+
+// ActionBlueprintDTO represents an action of a board template. It is triggered
+// by Event, or else by Schedule (relative_due_date, relative_creation or recurring).
+type ActionBlueprintDTO struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Event       string   `json:"event,omitempty"`
+	Schedule    string   `json:"schedule,omitempty"`
+	Offset      string   `json:"offset,omitempty"` // duration string like "-24h"
+	Cron        string   `json:"cron,omitempty"`
+	Type        string   `json:"type"`
+	Title       string   `json:"title,omitempty"`
+	Message     string   `json:"message,omitempty"`
+	ScriptPath  string   `json:"script_path,omitempty"`
+	Column      string   `json:"column,omitempty"`
+	AddTags     []string `json:"add_tags,omitempty"`
+	RemoveTags  []string `json:"remove_tags,omitempty"`
+}
+
+// ExportBoardTemplateRequest represents a request to save a board as a template
+type ExportBoardTemplateRequest struct {
+	BoardID      string `json:"board_id"`
+	Name         string `json:"name"`
+	Description  string `json:"description,omitempty"`
+	IncludeTasks bool   `json:"include_tasks,omitempty"`
+}
+
+// ProjectTemplateDTO represents a project template
+type ProjectTemplateDTO struct {
+	Name        string              `json:"name"`
+	Description string              `json:"description,omitempty"`
+	Boards      []BoardBlueprintDTO `json:"boards"`
+	Notes       []NoteBlueprintDTO  `json:"notes,omitempty"`
+}
+
+// BoardBlueprintDTO represents a board of a project template
+type BoardBlueprintDTO struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Template    string `json:"template,omitempty"` // empty for the default board template
+}
+
+// NoteBlueprintDTO represents a note of a project template
+type NoteBlueprintDTO struct {
+	Title   string   `json:"title"`
+	Type    string   `json:"type,omitempty"`
+	Content string   `json:"content,omitempty"`
+	Tags    []string `json:"tags,omitempty"`
+}
@@ -0,0 +1,36 @@
+package dto
+
+import "time"
+
+// TrashItemDTO represents a deleted task, column or note held in the trash
+type TrashItemDTO struct {
+	ID         string           `json:"id"`
+	Type       string           `json:"type"`
+	Title      string           `json:"title"`
+	OriginalID string           `json:"original_id"`
+	Project    string           `json:"project,omitempty"`
+	BoardID    string           `json:"board_id,omitempty"`
+	Column     string           `json:"column,omitempty"`
+	Tasks      []TrashedTaskDTO `json:"tasks,omitempty"`
+	DeletedAt  time.Time        `json:"deleted_at"`
+	ExpiresAt  *time.Time       `json:"expires_at,omitempty"`
+}
+
+// TrashedTaskDTO represents a task held by a trash item and the column it was deleted from
+type TrashedTaskDTO struct {
+	ID     string `json:"id"`
+	Column string `json:"column"`
+}
+
+// TrashRestoreDTO represents the result of restoring a trash item
+type TrashRestoreDTO struct {
+	Item    TrashItemDTO      `json:"item"`
+	BoardID string            `json:"board_id,omitempty"`
+	Column  string            `json:"column,omitempty"`
+	Renamed map[string]string `json:"renamed,omitempty"`
+}
+
+// TrashPurgeDTO represents the trash items permanently removed by a purge
+type TrashPurgeDTO struct {
+	Purged []TrashItemDTO `json:"purged"`
+}
@@ -0,0 +1,55 @@
+package dto
+
+import "time"
+
+// WorkScheduleDTO represents the working hours of a project, or the default
+// working hours
+type WorkScheduleDTO struct {
+	ProjectID  string                 `json:"project_id,omitempty"` // empty for the default schedule
+	Inherited  bool                   `json:"inherited"`            // the project uses the default schedule
+	Name       string                 `json:"name"`
+	Timezone   string                 `json:"timezone"`
+	Days       []DayScheduleDTO       `json:"days"` // Monday first
+	Exceptions []ScheduleExceptionDTO `json:"exceptions"`
+}
+
+// DayScheduleDTO represents the working hours of a weekday; times are HH:MM
+type DayScheduleDTO struct {
+	Day            string `json:"day"` // lower-case weekday name
+	Enabled        bool   `json:"enabled"`
+	Start          string `json:"start,omitempty"`
+	End            string `json:"end,omitempty"`
+	BreakStart     string `json:"break_start,omitempty"`
+	BreakEnd       string `json:"break_end,omitempty"`
+	WorkingMinutes int    `json:"working_minutes"`
+}
+
+// ScheduleExceptionDTO represents a holiday, time off or changed working
+// hours ("override") on one date
+type ScheduleExceptionDTO struct {
+	Date        time.Time `json:"date"`
+	Type        string    `json:"type"`
+	Description string    `json:"description,omitempty"`
+	Start       string    `json:"start,omitempty"` // HH:MM, overrides only
+	End         string    `json:"end,omitempty"`
+}
+
+// UpdateWorkScheduleRequest represents a change to a work schedule; only the
+// fields and days given are changed
+type UpdateWorkScheduleRequest struct {
+	ProjectID string           `json:"project_id,omitempty"` // empty for the default schedule
+	Name      *string          `json:"name,omitempty"`
+	Timezone  *string          `json:"timezone,omitempty"`
+	Days      []DayScheduleDTO `json:"days,omitempty"`
+}
+
+// WorkingTimeDTO reports whether a moment falls within working hours
+type WorkingTimeDTO struct {
+	ProjectID        string    `json:"project_id,omitempty"`
+	Time             time.Time `json:"time"`
+	Working          bool      `json:"working"`
+	WorkingDay       bool      `json:"working_day"`
+	Start            string    `json:"start,omitempty"` // working hours of the day, HH:MM
+	End              string    `json:"end,omitempty"`
+	AvailableMinutes int       `json:"available_minutes"`
+}
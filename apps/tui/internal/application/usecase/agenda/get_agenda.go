@@ -0,0 +1,108 @@
+package agenda
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/service"
+)
+
+// GetAgendaUseCase handles reading the agenda of a range of days
+type GetAgendaUseCase struct {
+	agendaService *service.AgendaService
+}
+
+// NewGetAgendaUseCase creates a new GetAgendaUseCase
+func NewGetAgendaUseCase(agendaService *service.AgendaService) *GetAgendaUseCase {
+	return &GetAgendaUseCase{
+		agendaService: agendaService,
+	}
+}
+
+// Execute returns the scheduled tasks, meetings, due tasks and recurring
+// occurrences of a project, or of every project if projectID is empty, for
+// the days from from to to, grouped by day with free and busy time, along with
+// the tasks that are overdue
+func (uc *GetAgendaUseCase) Execute(ctx context.Context, projectID string, from, to time.Time) (*dto.AgendaDTO, error) {
+	agenda, err := uc.agendaService.Agenda(ctx, projectID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	agendaDTO := &dto.AgendaDTO{
+		ProjectID: agenda.ProjectID,
+		From:      agenda.From,
+		To:        agenda.To,
+		Days:      make([]dto.AgendaDayDTO, 0, len(agenda.Days)),
+		Overdue:   make([]dto.AgendaItemDTO, 0, len(agenda.Overdue)),
+	}
+	for _, day := range agenda.Days {
+		agendaDTO.Days = append(agendaDTO.Days, dayToDTO(day))
+	}
+	for _, item := range agenda.Overdue {
+		agendaDTO.Overdue = append(agendaDTO.Overdue, itemToDTO(item))
+	}
+	return agendaDTO, nil
+}
+
+// dayToDTO converts an agenda day to a DTO
+func dayToDTO(day service.AgendaDay) dto.AgendaDayDTO {
+	dayDTO := dto.AgendaDayDTO{
+		Date:         day.Date,
+		Weekday:      strings.ToLower(day.Date.Weekday().String()),
+		WorkingStart: day.WorkingStart,
+		WorkingEnd:   day.WorkingEnd,
+		Items:        make([]dto.AgendaItemDTO, 0, len(day.Items)),
+		Busy:         make([]dto.TimeSlotDTO, 0, len(day.Busy)),
+		Free:         make([]dto.TimeSlotDTO, 0, len(day.Free)),
+	}
+
+	for _, item := range day.Items {
+		dayDTO.Items = append(dayDTO.Items, itemToDTO(item))
+	}
+
+	for _, slot := range day.Busy {
+		dayDTO.Busy = append(dayDTO.Busy, dto.TimeSlotDTO{Start: slot.Start, End: slot.End})
+		dayDTO.BusyMinutes += int(slot.End.Sub(slot.Start).Minutes())
+	}
+	for _, slot := range day.Free {
+		dayDTO.Free = append(dayDTO.Free, dto.TimeSlotDTO{Start: slot.Start, End: slot.End})
+		dayDTO.FreeMinutes += int(slot.End.Sub(slot.Start).Minutes())
+	}
+	return dayDTO
+}
+
+// itemToDTO converts an agenda item to a DTO
+func itemToDTO(item service.AgendaItem) dto.AgendaItemDTO {
+	task := item.Task
+	itemDTO := dto.AgendaItemDTO{
+		Kind:       string(item.Kind),
+		TaskID:     task.ID().String(),
+		ShortID:    task.ID().ShortID(),
+		ProjectID:  item.ProjectID,
+		BoardID:    item.BoardID,
+		ColumnName: item.ColumnName,
+		Title:      task.Title(),
+		Priority:   task.Priority().String(),
+		Start:      item.Start,
+		End:        item.End(),
+		AllDay:     item.AllDay,
+		Occurrence: item.Occurrence,
+		Done:       item.Done,
+	}
+	if rule := task.Recurrence(); rule != nil {
+		itemDTO.Recurrence = rule.String()
+	}
+	if meeting := task.MeetingData(); meeting != nil {
+		itemDTO.Meeting = &dto.MeetingDTO{
+			Attendees:     meeting.Attendees,
+			Location:      meeting.Location,
+			MeetingURL:    meeting.MeetingURL,
+			GoogleEventID: meeting.GoogleEventID,
+			ICalUID:       meeting.ICalUID,
+		}
+	}
+	return itemDTO
+}
@@ -3,24 +3,33 @@ package board
 import (
 	"context"
 	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
 	"mkanban/internal/domain/service"
 )
 
 // CreateBoardUseCase handles board creation
 type CreateBoardUseCase struct {
-	boardService *service.BoardService
+	boardService    *service.BoardService
+	templateService *service.BoardTemplateService
 }
 
 // NewCreateBoardUseCase creates a new CreateBoardUseCase
-func NewCreateBoardUseCase(boardService *service.BoardService) *CreateBoardUseCase {
+func NewCreateBoardUseCase(boardService *service.BoardService, templateService *service.BoardTemplateService) *CreateBoardUseCase {
 	return &CreateBoardUseCase{
-		boardService: boardService,
+		boardService:    boardService,
+		templateService: templateService,
 	}
 }
 
-// Execute creates a new board
+// Execute creates a new board, laid out by a board template if one is named
 func (uc *CreateBoardUseCase) Execute(ctx context.Context, req dto.CreateBoardRequest) (*dto.BoardDTO, error) {
-	board, err := uc.boardService.CreateBoard(ctx, req.ProjectID, req.Name, req.Description)
+	var board *entity.Board
+	var err error
+	if req.Template != "" {
+		board, err = uc.templateService.CreateBoard(ctx, req.ProjectID, req.Name, req.Description, req.Template)
+	} else {
+		board, err = uc.boardService.CreateBoard(ctx, req.ProjectID, req.Name, req.Description)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -3,18 +3,21 @@ package board
 import (
 	"context"
 	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
 	"mkanban/internal/domain/repository"
 )
 
 // GetBoardUseCase handles retrieving a single board
 type GetBoardUseCase struct {
-	boardRepo repository.BoardRepository
+	boardRepo   repository.BoardRepository
+	timeLogRepo repository.TimeLogRepository
 }
 
 // NewGetBoardUseCase creates a new GetBoardUseCase
-func NewGetBoardUseCase(boardRepo repository.BoardRepository) *GetBoardUseCase {
+func NewGetBoardUseCase(boardRepo repository.BoardRepository, timeLogRepo repository.TimeLogRepository) *GetBoardUseCase {
 	return &GetBoardUseCase{
-		boardRepo: boardRepo,
+		boardRepo:   boardRepo,
+		timeLogRepo: timeLogRepo,
 	}
 }
 
@@ -25,6 +28,9 @@ func (uc *GetBoardUseCase) Execute(ctx context.Context, boardID string) (*dto.Bo
 		return nil, err
 	}
 
-	boardDTO := dto.BoardToDTO(board)
+	// Tracked time is informational, a board without time logs still loads
+	logs, _ := uc.timeLogRepo.FindByProject(ctx, board.ProjectID())
+
+	boardDTO := dto.BoardToDTOWithTrackedTime(board, entity.TrackedTimeByTask(logs))
 	return &boardDTO, nil
 }
@@ -0,0 +1,95 @@
+package board
+
+import (
+	"context"
+	"time"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/service"
+)
+
+// defaultFlowMetricsRange is the range flow metrics cover when no start is given
+const defaultFlowMetricsRange = 12 * 7 * 24 * time.Hour
+
+// GetFlowMetricsUseCase handles computing the flow metrics of a board
+type GetFlowMetricsUseCase struct {
+	flowMetricsService *service.FlowMetricsService
+}
+
+// NewGetFlowMetricsUseCase creates a new GetFlowMetricsUseCase
+func NewGetFlowMetricsUseCase(flowMetricsService *service.FlowMetricsService) *GetFlowMetricsUseCase {
+	return &GetFlowMetricsUseCase{
+		flowMetricsService: flowMetricsService,
+	}
+}
+
+// Execute computes lead and cycle times, weekly throughput and cumulative flow
+// of a board for the days from and to, both included. A nil to means today and
+// a nil from the twelve weeks up to to.
+func (uc *GetFlowMetricsUseCase) Execute(ctx context.Context, boardID string, from, to *time.Time) (*dto.FlowMetricsDTO, error) {
+	end := time.Now()
+	if to != nil {
+		end = *to
+	}
+	start := end.Add(-defaultFlowMetricsRange)
+	if from != nil {
+		start = *from
+	}
+
+	metrics, err := uc.flowMetricsService.Calculate(ctx, boardID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	metricsDTO := &dto.FlowMetricsDTO{
+		BoardID:        metrics.BoardID,
+		From:           metrics.From,
+		To:             metrics.To,
+		Columns:        metrics.Columns,
+		LeadTime:       durationStatsToDTO(metrics.LeadTime),
+		CycleTime:      durationStatsToDTO(metrics.CycleTime),
+		Throughput:     make([]dto.WeeklyThroughputDTO, 0, len(metrics.Throughput)),
+		CumulativeFlow: make([]dto.CumulativeFlowDayDTO, 0, len(metrics.CumulativeFlow)),
+		Tasks:          make([]dto.TaskFlowDTO, 0, len(metrics.Completed)),
+	}
+
+	for _, week := range metrics.Throughput {
+		metricsDTO.Throughput = append(metricsDTO.Throughput, dto.WeeklyThroughputDTO{
+			WeekStart: week.WeekStart,
+			Completed: week.Completed,
+		})
+	}
+
+	for _, day := range metrics.CumulativeFlow {
+		metricsDTO.CumulativeFlow = append(metricsDTO.CumulativeFlow, dto.CumulativeFlowDayDTO{
+			Date:    day.Date,
+			Columns: day.Columns,
+		})
+	}
+
+	for _, flow := range metrics.Completed {
+		metricsDTO.Tasks = append(metricsDTO.Tasks, dto.TaskFlowDTO{
+			TaskID:      flow.Task.ID().String(),
+			Title:       flow.Task.Title(),
+			CreatedAt:   flow.CreatedAt,
+			StartedAt:   flow.StartedAt,
+			CompletedAt: flow.CompletedAt,
+			LeadTime:    flow.LeadTime,
+			CycleTime:   flow.CycleTime,
+		})
+	}
+
+	return metricsDTO, nil
+}
+
+// durationStatsToDTO converts duration stats to DurationStatsDTO
+func durationStatsToDTO(stats service.DurationStats) dto.DurationStatsDTO {
+	return dto.DurationStatsDTO{
+		Count:   stats.Count,
+		Average: stats.Average,
+		P50:     stats.P50,
+		P85:     stats.P85,
+		P95:     stats.P95,
+		Max:     stats.Max,
+	}
+}
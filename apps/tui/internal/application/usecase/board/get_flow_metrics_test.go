@@ -0,0 +1,129 @@
+package board
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/service"
+	"mkanban/internal/domain/valueobject"
+	"mkanban/internal/infrastructure/persistence/filesystem"
+)
+
+// flowTestDay returns 09:00 on a day of March 2026
+func flowTestDay(day int) time.Time {
+	return time.Date(2026, 3, day, 9, 0, 0, 0, time.Local)
+}
+
+// addFlowTestTask adds a task created at created to a column, with the moves
+// it made to get there from To Do. Moves are recorded by column name.
+func addFlowTestTask(t *testing.T, board *entity.Board, columnName, slug string, created time.Time, moves ...entity.ColumnVisit) {
+	t.Helper()
+	taskID, err := board.GenerateNextTaskID(slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	task, err := entity.NewTask(taskID, slug, "", valueobject.PriorityMedium, valueobject.StatusTodo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	task.RestoreDates(created, created, nil, nil)
+
+	from := "to-do"
+	for _, move := range moves {
+		task.RecordMove(from, move.Column, move.EnteredAt)
+		from = move.Column
+	}
+
+	column, err := board.GetColumn(columnName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := column.AddTask(task); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetFlowMetrics(t *testing.T) {
+	ctx := context.Background()
+	boardRepo := filesystem.NewBoardRepository(t.TempDir())
+
+	board, err := entity.NewBoard("work/tracker", "Tracker", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, name := range []string{"To Do", "Doing", "Done"} {
+		column, err := entity.NewColumn(name, "", i, 0, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := board.AddColumn(column); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Done in the first week: 3 days lead time, 2 days cycle time
+	addFlowTestTask(t, board, "Done", "first", flowTestDay(2),
+		entity.ColumnVisit{Column: "doing", EnteredAt: flowTestDay(3)},
+		entity.ColumnVisit{Column: "done", EnteredAt: flowTestDay(5)})
+	// Done in the second week: 11 days lead time, 4 days cycle time
+	addFlowTestTask(t, board, "Done", "second", flowTestDay(2),
+		entity.ColumnVisit{Column: "doing", EnteredAt: flowTestDay(9)},
+		entity.ColumnVisit{Column: "done", EnteredAt: flowTestDay(13)})
+	// Done before the range: counted in the cumulative flow only
+	addFlowTestTask(t, board, "Done", "earlier", time.Date(2026, 2, 20, 9, 0, 0, 0, time.Local),
+		entity.ColumnVisit{Column: "done", EnteredAt: time.Date(2026, 2, 25, 9, 0, 0, 0, time.Local)})
+	// Never moved
+	addFlowTestTask(t, board, "To Do", "open", flowTestDay(4))
+
+	// The column history has to survive a round trip through storage
+	if err := boardRepo.Save(ctx, board); err != nil {
+		t.Fatal(err)
+	}
+
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.Local)
+	to := time.Date(2026, 3, 15, 0, 0, 0, 0, time.Local)
+	metrics, err := NewGetFlowMetricsUseCase(service.NewFlowMetricsService(boardRepo)).Execute(ctx, board.ID(), &from, &to)
+	if err != nil {
+		t.Fatalf("flow metrics failed: %v", err)
+	}
+
+	if len(metrics.Tasks) != 2 {
+		t.Fatalf("expected the 2 tasks completed within the range, got %d", len(metrics.Tasks))
+	}
+	if metrics.Tasks[0].Title != "first" || metrics.Tasks[0].CycleTime == nil || *metrics.Tasks[0].CycleTime != 48*time.Hour {
+		t.Errorf("expected the first task with a 2 day cycle time first, got %+v", metrics.Tasks[0])
+	}
+
+	if metrics.LeadTime.Count != 2 || metrics.LeadTime.P50 != 72*time.Hour || metrics.LeadTime.P95 != 264*time.Hour {
+		t.Errorf("expected lead times of 3 and 11 days, got %+v", metrics.LeadTime)
+	}
+	if metrics.CycleTime.Count != 2 || metrics.CycleTime.Average != 72*time.Hour || metrics.CycleTime.Max != 96*time.Hour {
+		t.Errorf("expected cycle times of 2 and 4 days, got %+v", metrics.CycleTime)
+	}
+
+	if len(metrics.Throughput) != 2 || metrics.Throughput[0].Completed != 1 || metrics.Throughput[1].Completed != 1 {
+		t.Errorf("expected one task completed in each of the 2 weeks, got %+v", metrics.Throughput)
+	}
+
+	if len(metrics.CumulativeFlow) != 14 {
+		t.Fatalf("expected a cumulative flow point per day, got %d", len(metrics.CumulativeFlow))
+	}
+	tests := []struct {
+		day               int
+		todo, doing, done int
+	}{
+		{2, 2, 0, 1},
+		{4, 2, 1, 1},
+		{5, 2, 0, 2},
+		{9, 1, 1, 2},
+		{15, 1, 0, 3},
+	}
+	for _, tt := range tests {
+		counts := metrics.CumulativeFlow[tt.day-2].Columns
+		if counts["to-do"] != tt.todo || counts["doing"] != tt.doing || counts["done"] != tt.done {
+			t.Errorf("March %d: expected %d/%d/%d tasks in To Do/Doing/Done, got %v", tt.day, tt.todo, tt.doing, tt.done, counts)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package board
+
+import (
+	"context"
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/service"
+)
+
+// SetBoardFieldsUseCase handles replacing the custom field schema of a board
+type SetBoardFieldsUseCase struct {
+	boardService *service.BoardService
+}
+
+// NewSetBoardFieldsUseCase creates a new SetBoardFieldsUseCase
+func NewSetBoardFieldsUseCase(boardService *service.BoardService) *SetBoardFieldsUseCase {
+	return &SetBoardFieldsUseCase{
+		boardService: boardService,
+	}
+}
+
+// Execute replaces the board's field schema with fields
+func (uc *SetBoardFieldsUseCase) Execute(ctx context.Context, boardID string, fields []dto.FieldDefinitionDTO) (*dto.BoardDTO, error) {
+	definitions := make([]entity.FieldDefinition, 0, len(fields))
+	for _, field := range fields {
+		definitions = append(definitions, dto.FieldDefinitionFromDTO(field))
+	}
+
+	board, err := uc.boardService.SetBoardFields(ctx, boardID, definitions)
+	if err != nil {
+		return nil, err
+	}
+
+	boardDTO := dto.BoardToDTO(board)
+	return &boardDTO, nil
+}
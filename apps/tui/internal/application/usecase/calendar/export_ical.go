@@ -0,0 +1,197 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/service"
+	"mkanban/pkg/filesystem"
+)
+
+const (
+	// icalUIDSuffix ends the UIDs of events exported from tasks
+	icalUIDSuffix = "@mkanban"
+	// icalDueUIDSuffix ends the UIDs of the due date events of tasks
+	icalDueUIDSuffix = "/due" + icalUIDSuffix
+	// icalDefaultDuration is the length of timed events of tasks with neither
+	// a time block nor an estimate
+	icalDefaultDuration = time.Hour
+)
+
+// ExportICalUseCase handles exporting scheduled tasks, meetings, due dates and
+// recurring tasks as an iCalendar feed
+type ExportICalUseCase struct {
+	boardRepo repository.BoardRepository
+	codec     service.CalendarCodec
+}
+
+// NewExportICalUseCase creates a new ExportICalUseCase
+func NewExportICalUseCase(
+	boardRepo repository.BoardRepository,
+	codec service.CalendarCodec,
+) *ExportICalUseCase {
+	return &ExportICalUseCase{
+		boardRepo: boardRepo,
+		codec:     codec,
+	}
+}
+
+// Execute returns the feed of a board, of every board of a project if boardID
+// is empty, or of every board if both are empty. Each task keeps its UID
+// across exports so subscribed calendars update events instead of
+// duplicating them. When path is set the feed is written to that file
+// instead of being returned.
+func (uc *ExportICalUseCase) Execute(ctx context.Context, projectID, boardID, path string) (*dto.ICalExportDTO, error) {
+	var boards []*entity.Board
+	name := "mkanban"
+	if boardID != "" {
+		board, err := uc.boardRepo.FindByID(ctx, boardID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load board: %w", err)
+		}
+		boards = []*entity.Board{board}
+		name = board.Name()
+	} else {
+		all, err := uc.boardRepo.FindAll(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load boards: %w", err)
+		}
+		for _, board := range all {
+			if projectID == "" || board.ProjectID() == projectID {
+				boards = append(boards, board)
+			}
+		}
+		if projectID != "" {
+			name = projectID
+		}
+	}
+
+	events := make([]service.CalendarEvent, 0)
+	for _, board := range boards {
+		for _, column := range board.Columns() {
+			for _, task := range column.Tasks() {
+				events = append(events, taskEvents(board, task)...)
+			}
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].StartTime.Equal(events[j].StartTime) {
+			return events[i].StartTime.Before(events[j].StartTime)
+		}
+		return events[i].UID < events[j].UID
+	})
+
+	data, err := uc.codec.Encode(name, events)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode calendar: %w", err)
+	}
+
+	export := &dto.ICalExportDTO{
+		Name:   name,
+		Events: len(events),
+	}
+	if path != "" {
+		if err := filesystem.SafeWrite(path, data, 0644); err != nil {
+			return nil, fmt.Errorf("failed to write calendar: %w", err)
+		}
+		export.Path = path
+	} else {
+		export.Content = string(data)
+	}
+	return export, nil
+}
+
+// taskEvents returns the events of a task: one when it is scheduled, repeating
+// when it recurs, and one on the due date of an unfinished task that is not a
+// meeting. A recurring task without a schedule repeats its due date instead.
+func taskEvents(board *entity.Board, task *entity.Task) []service.CalendarEvent {
+	events := make([]service.CalendarEvent, 0, 2)
+	base := service.CalendarEvent{
+		Title:       task.Title(),
+		Description: task.Description(),
+		Updated:     task.ModifiedAt(),
+	}
+	if meeting := task.MeetingData(); meeting != nil {
+		base.Attendees = meeting.Attendees
+		base.Location = meeting.Location
+		base.MeetingLink = meeting.MeetingURL
+	}
+	if base.Location == "" {
+		base.Location, _ = task.GetMetadata("location")
+	}
+	if base.MeetingLink == "" {
+		base.MeetingLink, _ = task.GetMetadata("meeting_link")
+	}
+
+	scheduled := false
+	switch {
+	case task.ScheduledTime() != nil:
+		event := base
+		event.UID = taskUID(board, task)
+		event.StartTime = *task.ScheduledTime()
+		event.EndTime = event.StartTime.Add(eventDuration(task))
+		event.Recurrence = task.Recurrence()
+		events = append(events, event)
+		scheduled = true
+	case task.ScheduledDate() != nil:
+		event := base
+		event.UID = taskUID(board, task)
+		event.StartTime = midnight(*task.ScheduledDate())
+		event.EndTime = event.StartTime.AddDate(0, 0, 1)
+		event.IsAllDay = true
+		event.Recurrence = task.Recurrence()
+		events = append(events, event)
+		scheduled = true
+	}
+
+	if due := task.DueDate(); due != nil && !task.IsMeeting() && !board.IsTaskDone(task.ID()) {
+		event := base
+		event.UID = board.ID() + "/" + task.ID().String() + icalDueUIDSuffix
+		event.Title = "Due: " + task.Title()
+		event.StartTime = midnight(*due)
+		event.EndTime = event.StartTime.AddDate(0, 0, 1)
+		event.IsAllDay = true
+		if !scheduled {
+			event.Recurrence = task.Recurrence()
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+// taskUID returns the UID of the event of a task, the UID of the event it was
+// imported from if any
+func taskUID(board *entity.Board, task *entity.Task) string {
+	if meeting := task.MeetingData(); meeting != nil && meeting.ICalUID != "" {
+		return meeting.ICalUID
+	}
+	return board.ID() + "/" + task.ID().String() + icalUIDSuffix
+}
+
+// isExportedUID reports whether uid is the UID of an event exported from
+// a task of the board, including its due date
+func isExportedUID(boardID, uid string) bool {
+	return strings.HasPrefix(uid, boardID+"/") && strings.HasSuffix(uid, icalUIDSuffix)
+}
+
+// eventDuration returns the length of the timed event of a task
+func eventDuration(task *entity.Task) time.Duration {
+	if block := task.TimeBlock(); block != nil && *block > 0 {
+		return *block
+	}
+	if estimate := task.EstimatedTime(); estimate != nil && *estimate > 0 {
+		return *estimate
+	}
+	return icalDefaultDuration
+}
+
+// midnight returns the start of the day of t in its time zone
+func midnight(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,233 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/service"
+	"mkanban/internal/domain/valueobject"
+)
+
+// ImportICalUseCase handles importing the events of an iCalendar file into a
+// board as meeting tasks
+type ImportICalUseCase struct {
+	boardRepo repository.BoardRepository
+	codec     service.CalendarCodec
+}
+
+// NewImportICalUseCase creates a new ImportICalUseCase
+func NewImportICalUseCase(
+	boardRepo repository.BoardRepository,
+	codec service.CalendarCodec,
+) *ImportICalUseCase {
+	return &ImportICalUseCase{
+		boardRepo: boardRepo,
+		codec:     codec,
+	}
+}
+
+// Execute imports the events of data into a board. Events are matched to the
+// tasks they created on their UID, so importing a file again updates those
+// tasks instead of duplicating them. New tasks go to the Meetings or Calendar
+// column, or the first column. Events exported from the board itself and
+// overridden occurrences of recurring events, as tasks recur by rule only,
+// are skipped.
+func (uc *ImportICalUseCase) Execute(ctx context.Context, boardID string, data []byte) (*dto.ICalImportResultDTO, error) {
+	events, err := uc.codec.Decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidCalendarData, err)
+	}
+
+	board, err := uc.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load board: %w", err)
+	}
+	column := meetingColumn(board)
+	if column == nil {
+		return nil, fmt.Errorf("%w: board %s has no columns", entity.ErrColumnNotFound, boardID)
+	}
+
+	tasks := make(map[string]*entity.Task)
+	for _, col := range board.Columns() {
+		for _, task := range col.Tasks() {
+			if meeting := task.MeetingData(); meeting != nil && meeting.ICalUID != "" {
+				tasks[meeting.ICalUID] = task
+			}
+		}
+	}
+
+	result := &dto.ICalImportResultDTO{
+		BoardID: board.ID(),
+		TaskIDs: make([]string, 0),
+	}
+	for _, event := range events {
+		// Events exported from the board come from its own tasks
+		if event.UID == "" || event.RecurringID != "" || isExportedUID(board.ID(), event.UID) {
+			result.Skipped++
+			continue
+		}
+
+		task, ok := tasks[event.UID]
+		if !ok {
+			title := event.Title
+			if strings.TrimSpace(title) == "" {
+				title = "Untitled event"
+			}
+			taskID, err := board.GenerateNextTaskID(valueobject.GenerateSlug(title))
+			if err != nil {
+				return nil, err
+			}
+			task, err = entity.NewTask(taskID, title, "", valueobject.PriorityMedium, valueobject.StatusTodo)
+			if err != nil {
+				return nil, err
+			}
+			applyEvent(task, event)
+			if err := column.AddTask(task); err != nil {
+				return nil, err
+			}
+
+			tasks[event.UID] = task
+			result.Created++
+			result.TaskIDs = append(result.TaskIDs, task.ID().String())
+			continue
+		}
+
+		if applyEvent(task, event) {
+			result.Updated++
+			result.TaskIDs = append(result.TaskIDs, task.ID().String())
+		} else {
+			result.Unchanged++
+		}
+	}
+
+	if result.Created > 0 || result.Updated > 0 {
+		if err := uc.boardRepo.Save(ctx, board); err != nil {
+			return nil, fmt.Errorf("failed to save board: %w", err)
+		}
+	}
+	return result, nil
+}
+
+// meetingColumn returns the column new meeting tasks go to
+func meetingColumn(board *entity.Board) *entity.Column {
+	columns := board.Columns()
+	if len(columns) == 0 {
+		return nil
+	}
+	for _, column := range columns {
+		if name := column.Name(); strings.EqualFold(name, "Meetings") || strings.EqualFold(name, "Calendar") {
+			return column
+		}
+	}
+	return columns[0]
+}
+
+// applyEvent makes task a meeting matching event and reports whether
+// anything changed
+func applyEvent(task *entity.Task, event service.CalendarEvent) bool {
+	changed := false
+
+	if event.Title != "" && task.Title() != event.Title {
+		if err := task.UpdateTitle(event.Title); err == nil {
+			changed = true
+		}
+	}
+	if task.Description() != event.Description {
+		task.UpdateDescription(event.Description)
+		changed = true
+	}
+	if !task.IsMeeting() {
+		task.SetTaskType(entity.TaskTypeMeeting)
+		changed = true
+	}
+
+	start := event.StartTime.In(time.Local)
+	date := midnight(start)
+	if !sameTime(task.ScheduledDate(), &date) {
+		task.SetScheduledDate(date)
+		changed = true
+	}
+	if event.IsAllDay {
+		if task.ScheduledTime() != nil {
+			task.ClearScheduledTime()
+			changed = true
+		}
+		if task.TimeBlock() != nil {
+			task.ClearTimeBlock()
+			changed = true
+		}
+	} else {
+		if !sameTime(task.ScheduledTime(), &start) {
+			task.SetScheduledTime(start)
+			changed = true
+		}
+		if duration := event.EndTime.Sub(event.StartTime); duration > 0 {
+			if task.TimeBlock() == nil || *task.TimeBlock() != duration {
+				task.SetTimeBlock(duration)
+				changed = true
+			}
+		}
+	}
+
+	if !sameRecurrence(task.Recurrence(), event.Recurrence) {
+		if event.Recurrence != nil {
+			task.SetRecurrence(event.Recurrence)
+		} else {
+			task.ClearRecurrence()
+		}
+		changed = true
+	}
+
+	meeting := entity.MeetingData{
+		Attendees:  event.Attendees,
+		Location:   event.Location,
+		MeetingURL: event.MeetingLink,
+		ICalUID:    event.UID,
+	}
+	if current := task.MeetingData(); current != nil {
+		meeting.GoogleEventID = current.GoogleEventID
+		if current.Location == meeting.Location && current.MeetingURL == meeting.MeetingURL &&
+			current.ICalUID == meeting.ICalUID &&
+			strings.Join(current.Attendees, "\n") == strings.Join(meeting.Attendees, "\n") {
+			return changed
+		}
+	}
+	task.SetMeetingData(&meeting)
+	return true
+}
+
+// sameTime reports whether two optional times are both unset or equal
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+// sameRecurrence reports whether two optional rules are both unset or repeat
+// the same way
+func sameRecurrence(a, b *valueobject.RecurrenceRule) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if a.Frequency() != b.Frequency() || a.Interval() != b.Interval() ||
+		a.DayOfMonth() != b.DayOfMonth() || a.Count() != b.Count() ||
+		!sameTime(a.EndDate(), b.EndDate()) {
+		return false
+	}
+	aDays, bDays := a.DaysOfWeek(), b.DaysOfWeek()
+	if len(aDays) != len(bDays) {
+		return false
+	}
+	for i := range aDays {
+		if aDays[i] != bDays[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,58 @@
+package column
+
+import (
+	"context"
+	"fmt"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/repository"
+)
+
+// DeleteColumnUseCase handles moving a column and its tasks to the trash
+type DeleteColumnUseCase struct {
+	boardRepo repository.BoardRepository
+	trashRepo repository.TrashRepository
+}
+
+// NewDeleteColumnUseCase creates a new DeleteColumnUseCase
+func NewDeleteColumnUseCase(boardRepo repository.BoardRepository, trashRepo repository.TrashRepository) *DeleteColumnUseCase {
+	return &DeleteColumnUseCase{
+		boardRepo: boardRepo,
+		trashRepo: trashRepo,
+	}
+}
+
+// Execute moves a column, including every task in it, into the trash
+func (uc *DeleteColumnUseCase) Execute(ctx context.Context, boardID string, columnName string) (*dto.TrashItemDTO, error) {
+	board, err := uc.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	column, err := board.GetColumn(columnName)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := uc.trashRepo.TrashColumn(ctx, boardID, column)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move column to trash: %w", err)
+	}
+
+	// The board only drops empty columns
+	for _, task := range column.Tasks() {
+		if _, err := column.RemoveTask(task.ID()); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := board.RemoveColumn(column.Name()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.boardRepo.Save(ctx, board); err != nil {
+		return nil, fmt.Errorf("failed to save board: %w", err)
+	}
+
+	itemDTO := dto.TrashItemToDTO(item, 0)
+	return &itemDTO, nil
+}
@@ -0,0 +1,40 @@
+package forecast
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/service"
+)
+
+// ForecastCompletionUseCase handles forecasting when tasks will be done
+type ForecastCompletionUseCase struct {
+	forecastService *service.ForecastService
+}
+
+// NewForecastCompletionUseCase creates a new ForecastCompletionUseCase
+func NewForecastCompletionUseCase(forecastService *service.ForecastService) *ForecastCompletionUseCase {
+	return &ForecastCompletionUseCase{
+		forecastService: forecastService,
+	}
+}
+
+// Execute forecasts when a number of tasks on boards will be done, sampling
+// the past weekly throughput of the boards. A tasks count of zero forecasts
+// the unfinished tasks of the boards.
+func (uc *ForecastCompletionUseCase) Execute(ctx context.Context, boardIDs []string, tasks int) (*dto.CompletionForecastDTO, error) {
+	forecast, err := uc.forecastService.ForecastCompletion(ctx, boardIDs, tasks, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.CompletionForecastDTO{
+		BoardIDs:     forecast.BoardIDs,
+		Tasks:        forecast.Tasks,
+		HistoryWeeks: forecast.HistoryWeeks,
+		Trials:       forecast.Trials,
+		P50:          forecast.P50,
+		P85:          forecast.P85,
+		P95:          forecast.P95,
+	}, nil
+}
@@ -0,0 +1,40 @@
+package forecast
+
+import (
+	"context"
+	"time"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/service"
+)
+
+// ForecastThroughputUseCase handles forecasting how many tasks will be done by a date
+type ForecastThroughputUseCase struct {
+	forecastService *service.ForecastService
+}
+
+// NewForecastThroughputUseCase creates a new ForecastThroughputUseCase
+func NewForecastThroughputUseCase(forecastService *service.ForecastService) *ForecastThroughputUseCase {
+	return &ForecastThroughputUseCase{
+		forecastService: forecastService,
+	}
+}
+
+// Execute forecasts how many tasks of boards will be done from today up to
+// and including date
+func (uc *ForecastThroughputUseCase) Execute(ctx context.Context, boardIDs []string, date time.Time) (*dto.ThroughputForecastDTO, error) {
+	forecast, err := uc.forecastService.ForecastThroughput(ctx, boardIDs, date, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ThroughputForecastDTO{
+		BoardIDs:     forecast.BoardIDs,
+		Date:         forecast.Date,
+		HistoryWeeks: forecast.HistoryWeeks,
+		Trials:       forecast.Trials,
+		P50:          forecast.P50,
+		P85:          forecast.P85,
+		P95:          forecast.P95,
+	}, nil
+}
@@ -0,0 +1,36 @@
+package milestone
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/service"
+	"mkanban/internal/domain/valueobject"
+)
+
+// AddMilestoneTasksUseCase handles adding tasks to a milestone
+type AddMilestoneTasksUseCase struct {
+	milestoneService *service.MilestoneService
+}
+
+// NewAddMilestoneTasksUseCase creates a new AddMilestoneTasksUseCase
+func NewAddMilestoneTasksUseCase(milestoneService *service.MilestoneService) *AddMilestoneTasksUseCase {
+	return &AddMilestoneTasksUseCase{
+		milestoneService: milestoneService,
+	}
+}
+
+// Execute adds tasks, from any board, to a milestone; a regular task becomes
+// a milestone when tasks are added to it
+func (uc *AddMilestoneTasksUseCase) Execute(ctx context.Context, boardID string, milestoneIDStr string, members []dto.MilestoneMemberDTO) (*dto.MilestoneDTO, error) {
+	milestoneID, err := valueobject.ParseTaskID(milestoneIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := uc.milestoneService.AddMembers(ctx, boardID, milestoneID, membersFromDTO(members))
+	if err != nil {
+		return nil, err
+	}
+	return reportToDTO(report), nil
+}
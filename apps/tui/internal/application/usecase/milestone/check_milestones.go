@@ -0,0 +1,51 @@
+package milestone
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/service"
+	"mkanban/internal/domain/valueobject"
+)
+
+// CheckMilestonesUseCase handles re-evaluating which milestones are at risk
+type CheckMilestonesUseCase struct {
+	milestoneService *service.MilestoneService
+}
+
+// NewCheckMilestonesUseCase creates a new CheckMilestonesUseCase
+func NewCheckMilestonesUseCase(milestoneService *service.MilestoneService) *CheckMilestonesUseCase {
+	return &CheckMilestonesUseCase{
+		milestoneService: milestoneService,
+	}
+}
+
+// Execute re-evaluates every milestone and returns the ones that have become
+// at risk since the last check
+func (uc *CheckMilestonesUseCase) Execute(ctx context.Context) ([]dto.MilestoneDTO, error) {
+	reports, err := uc.milestoneService.CheckRisk(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	milestones := make([]dto.MilestoneDTO, 0, len(reports))
+	for _, report := range reports {
+		milestones = append(milestones, *reportToDTO(report))
+	}
+	return milestones, nil
+}
+
+// ExecuteForMilestone re-evaluates a single milestone and returns it if it
+// has become at risk since the last check
+func (uc *CheckMilestonesUseCase) ExecuteForMilestone(ctx context.Context, boardID string, milestoneIDStr string) ([]dto.MilestoneDTO, error) {
+	milestoneID, err := valueobject.ParseTaskID(milestoneIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := uc.milestoneService.CheckMilestoneRisk(ctx, boardID, milestoneID)
+	if err != nil || report == nil {
+		return nil, err
+	}
+	return []dto.MilestoneDTO{*reportToDTO(report)}, nil
+}
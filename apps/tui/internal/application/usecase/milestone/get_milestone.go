@@ -0,0 +1,107 @@
+package milestone
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/service"
+	"mkanban/internal/domain/valueobject"
+)
+
+// GetMilestoneUseCase handles retrieving a milestone with its progress
+type GetMilestoneUseCase struct {
+	milestoneService *service.MilestoneService
+}
+
+// NewGetMilestoneUseCase creates a new GetMilestoneUseCase
+func NewGetMilestoneUseCase(milestoneService *service.MilestoneService) *GetMilestoneUseCase {
+	return &GetMilestoneUseCase{
+		milestoneService: milestoneService,
+	}
+}
+
+// Execute retrieves a milestone, its member tasks and its progress
+func (uc *GetMilestoneUseCase) Execute(ctx context.Context, boardID string, milestoneIDStr string) (*dto.MilestoneDTO, error) {
+	milestoneID, err := valueobject.ParseTaskID(milestoneIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := uc.milestoneService.Report(ctx, boardID, milestoneID)
+	if err != nil {
+		return nil, err
+	}
+	return reportToDTO(report), nil
+}
+
+// reportToDTO converts a milestone report to MilestoneDTO
+func reportToDTO(report *service.MilestoneReport) *dto.MilestoneDTO {
+	taskDTO := dto.TaskToDTO(report.Milestone)
+	taskDTO.ColumnName = report.Column.Name()
+
+	members := make([]dto.MilestoneMemberDTO, 0, len(report.Members))
+	for _, member := range report.Members {
+		memberDTO := dto.MilestoneMemberDTO{
+			BoardID: member.Member.BoardID,
+			TaskID:  member.Member.TaskID,
+			Done:    member.Done,
+			Missing: member.Task == nil,
+		}
+		if member.Task != nil {
+			memberDTO.Title = member.Task.Title()
+			memberDTO.ColumnName = member.Column.Name()
+		}
+		members = append(members, memberDTO)
+	}
+
+	progress := report.Progress
+	milestoneDTO := &dto.MilestoneDTO{
+		BoardID: report.BoardID,
+		Task:    taskDTO,
+		Members: members,
+		Progress: dto.MilestoneProgressDTO{
+			Members:             progress.Members,
+			DoneMembers:         progress.DoneMembers,
+			MissingMembers:      progress.MissingMembers,
+			PercentComplete:     progress.PercentComplete,
+			RemainingEstimate:   progress.RemainingEstimate,
+			Throughput:          progress.Throughput,
+			ProjectedCompletion: progress.ProjectedCompletion,
+			AtRisk:              progress.AtRisk,
+		},
+		Forecast: forecastToDTO(report.Forecast),
+	}
+	if report.ForecastErr != nil {
+		milestoneDTO.ForecastError = report.ForecastErr.Error()
+	}
+	return milestoneDTO
+}
+
+// forecastToDTO converts a completion forecast to CompletionForecastDTO
+func forecastToDTO(forecast *service.CompletionForecast) *dto.CompletionForecastDTO {
+	if forecast == nil {
+		return nil
+	}
+	return &dto.CompletionForecastDTO{
+		BoardIDs:     forecast.BoardIDs,
+		Tasks:        forecast.Tasks,
+		HistoryWeeks: forecast.HistoryWeeks,
+		Trials:       forecast.Trials,
+		P50:          forecast.P50,
+		P85:          forecast.P85,
+		P95:          forecast.P95,
+	}
+}
+
+// membersFromDTO converts member references to milestone members
+func membersFromDTO(members []dto.MilestoneMemberDTO) []entity.MilestoneMember {
+	result := make([]entity.MilestoneMember, 0, len(members))
+	for _, member := range members {
+		result = append(result, entity.MilestoneMember{
+			BoardID: member.BoardID,
+			TaskID:  member.TaskID,
+		})
+	}
+	return result
+}
@@ -0,0 +1,35 @@
+package milestone
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/service"
+)
+
+// ListMilestonesUseCase handles listing the milestones of a project
+type ListMilestonesUseCase struct {
+	milestoneService *service.MilestoneService
+}
+
+// NewListMilestonesUseCase creates a new ListMilestonesUseCase
+func NewListMilestonesUseCase(milestoneService *service.MilestoneService) *ListMilestonesUseCase {
+	return &ListMilestonesUseCase{
+		milestoneService: milestoneService,
+	}
+}
+
+// Execute lists the milestones of a project, or of all projects if projectID
+// is empty, with their progress
+func (uc *ListMilestonesUseCase) Execute(ctx context.Context, projectID string) ([]dto.MilestoneDTO, error) {
+	reports, err := uc.milestoneService.FindMilestones(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	milestones := make([]dto.MilestoneDTO, 0, len(reports))
+	for _, report := range reports {
+		milestones = append(milestones, *reportToDTO(report))
+	}
+	return milestones, nil
+}
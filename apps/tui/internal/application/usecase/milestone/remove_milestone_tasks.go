@@ -0,0 +1,35 @@
+package milestone
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/service"
+	"mkanban/internal/domain/valueobject"
+)
+
+// RemoveMilestoneTasksUseCase handles removing tasks from a milestone
+type RemoveMilestoneTasksUseCase struct {
+	milestoneService *service.MilestoneService
+}
+
+// NewRemoveMilestoneTasksUseCase creates a new RemoveMilestoneTasksUseCase
+func NewRemoveMilestoneTasksUseCase(milestoneService *service.MilestoneService) *RemoveMilestoneTasksUseCase {
+	return &RemoveMilestoneTasksUseCase{
+		milestoneService: milestoneService,
+	}
+}
+
+// Execute removes tasks from a milestone; the tasks themselves are kept
+func (uc *RemoveMilestoneTasksUseCase) Execute(ctx context.Context, boardID string, milestoneIDStr string, members []dto.MilestoneMemberDTO) (*dto.MilestoneDTO, error) {
+	milestoneID, err := valueobject.ParseTaskID(milestoneIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := uc.milestoneService.RemoveMembers(ctx, boardID, milestoneID, membersFromDTO(members))
+	if err != nil {
+		return nil, err
+	}
+	return reportToDTO(report), nil
+}
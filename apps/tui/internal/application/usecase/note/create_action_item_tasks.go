@@ -0,0 +1,105 @@
+package note
+
+import (
+	"context"
+	"fmt"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/service"
+	"mkanban/internal/domain/valueobject"
+)
+
+// CreateActionItemTasksUseCase handles turning the action items of a meeting
+// note into tasks
+type CreateActionItemTasksUseCase struct {
+	boardService *service.BoardService
+	boardRepo    repository.BoardRepository
+	noteRepo     repository.NoteRepository
+}
+
+// NewCreateActionItemTasksUseCase creates a new CreateActionItemTasksUseCase
+func NewCreateActionItemTasksUseCase(
+	boardService *service.BoardService,
+	boardRepo repository.BoardRepository,
+	noteRepo repository.NoteRepository,
+) *CreateActionItemTasksUseCase {
+	return &CreateActionItemTasksUseCase{
+		boardService: boardService,
+		boardRepo:    boardRepo,
+		noteRepo:     noteRepo,
+	}
+}
+
+// Execute creates a task in the first column of the meeting's board for each
+// checked item of the note's action items section that has no task yet. The
+// items are replaced with links to their tasks, and the tasks and the note
+// are linked both ways.
+func (uc *CreateActionItemTasksUseCase) Execute(ctx context.Context, noteID string) (*dto.ActionItemTasksDTO, error) {
+	note, err := uc.noteRepo.FindByID(ctx, noteID)
+	if err != nil {
+		return nil, err
+	}
+
+	boardID, ok := note.GetMetadata(meetingBoardKey)
+	if !note.IsMeeting() || !ok || boardID == "" {
+		return nil, fmt.Errorf("%w: %s", entity.ErrNotMeetingNote, note.Title())
+	}
+
+	result := &dto.ActionItemTasksDTO{
+		NoteID:  note.ID(),
+		BoardID: boardID,
+		Tasks:   make([]dto.TaskDTO, 0),
+	}
+
+	items := service.ParseActionItems(note.Content())
+	if len(items) == 0 {
+		return result, nil
+	}
+
+	board, err := uc.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	column, err := board.GetColumnByIndex(0)
+	if err != nil {
+		return nil, err
+	}
+
+	content := note.Content()
+	var createErr error
+	for _, item := range items {
+		_, task, err := uc.boardService.CreateTask(ctx, boardID, column.Name(), item, "", valueobject.PriorityNone, nil)
+		if err != nil {
+			createErr = fmt.Errorf("failed to create task %q: %w", item, err)
+			break
+		}
+
+		task.AddLinkedNote(note.ID())
+		if err := uc.boardRepo.SaveTask(ctx, boardID, column.Name(), task); err != nil {
+			createErr = fmt.Errorf("failed to save task %q: %w", item, err)
+			break
+		}
+
+		content = service.LinkActionItem(content, item, task.ID().String())
+		note.LinkTask(task.ID())
+
+		taskDTO := dto.TaskToDTO(task)
+		taskDTO.ColumnName = column.Name()
+		result.Tasks = append(result.Tasks, taskDTO)
+	}
+
+	// Keep the links of the tasks that were created, even after a failure
+	if len(result.Tasks) > 0 {
+		note.SetContent(content)
+		if err := uc.noteRepo.Save(ctx, note); err != nil {
+			return nil, fmt.Errorf("failed to save note: %w", err)
+		}
+	}
+	if createErr != nil {
+		return nil, createErr
+	}
+
+	return result, nil
+}
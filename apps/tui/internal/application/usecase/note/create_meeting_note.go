@@ -0,0 +1,215 @@
+package note
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+)
+
+const (
+	// meetingBoardKey is the note metadata key holding the board of the meeting
+	meetingBoardKey = "meeting_board"
+	// meetingTaskKey is the note metadata key holding the ID of the meeting task
+	meetingTaskKey = "meeting_task"
+
+	// defaultMeetingNoteTemplate names the built-in template
+	defaultMeetingNoteTemplate = "meeting-note"
+	// defaultMeetingNoteTitle and defaultMeetingNoteContent make up the
+	// built-in template, used when no task template of the configured name
+	// exists
+	defaultMeetingNoteTitle   = "{{title}}"
+	defaultMeetingNoteContent = `# {{title}}
+
+- **When:** {{date}} {{time}}
+- **Where:** {{location}}
+- **Link:** {{meeting_url}}
+
+## Attendees
+
+{{attendees}}
+
+## Agenda
+
+{{agenda}}
+
+## Notes
+
+## Action items
+
+<!-- Check the items to turn into tasks on the {{board}} board -->
+`
+)
+
+// CreateMeetingNoteUseCase handles creating the note of a meeting task
+type CreateMeetingNoteUseCase struct {
+	boardRepo    repository.BoardRepository
+	noteRepo     repository.NoteRepository
+	templateRepo repository.TaskTemplateRepository
+}
+
+// NewCreateMeetingNoteUseCase creates a new CreateMeetingNoteUseCase
+func NewCreateMeetingNoteUseCase(
+	boardRepo repository.BoardRepository,
+	noteRepo repository.NoteRepository,
+	templateRepo repository.TaskTemplateRepository,
+) *CreateMeetingNoteUseCase {
+	return &CreateMeetingNoteUseCase{
+		boardRepo:    boardRepo,
+		noteRepo:     noteRepo,
+		templateRepo: templateRepo,
+	}
+}
+
+// Execute creates a meeting note for a meeting task, accepted as a full or
+// short ID, and links the two both ways. The note is rendered from the task
+// template named templateName, of the board's project or a global one, or
+// from a built-in template when there is none; the template's title and
+// description become the note's title and content, and its tags the note's
+// tags. Placeholders are {{title}}, {{date}}, {{time}}, {{attendees}},
+// {{location}}, {{meeting_url}}, {{agenda}} (the task's description) and
+// {{board}}. A meeting that already has a meeting note keeps it.
+func (uc *CreateMeetingNoteUseCase) Execute(ctx context.Context, boardID, taskID, templateName string) (*dto.MeetingNoteDTO, error) {
+	board, err := uc.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	task, column := findTask(board, taskID)
+	if task == nil {
+		return nil, entity.ErrTaskNotFound
+	}
+	if !task.IsMeeting() {
+		return nil, fmt.Errorf("%w: %s", entity.ErrTaskNotAMeeting, task.ID().String())
+	}
+
+	for _, noteID := range task.LinkedNotes() {
+		existing, err := uc.noteRepo.FindByID(ctx, noteID)
+		if err == nil && existing.IsMeeting() {
+			return &dto.MeetingNoteDTO{
+				Note:    dto.NoteToDTO(existing),
+				BoardID: board.ID(),
+				TaskID:  task.ID().String(),
+			}, nil
+		}
+	}
+
+	template, err := uc.template(ctx, board.ProjectID(), templateName)
+	if err != nil {
+		return nil, err
+	}
+
+	date := time.Now()
+	if task.ScheduledTime() != nil {
+		date = *task.ScheduledTime()
+	} else if task.ScheduledDate() != nil {
+		date = *task.ScheduledDate()
+	}
+
+	rendered, err := template.Render(meetingNoteValues(board, task, date), date)
+	if err != nil {
+		return nil, err
+	}
+
+	note, err := entity.NewNote(uuid.New().String(), strings.TrimSpace(rendered.Title), entity.NoteTypeMeeting)
+	if err != nil {
+		return nil, err
+	}
+	note.SetProjectID(board.ProjectID())
+	note.SetDate(date)
+	note.SetContent(rendered.Description)
+	for _, tag := range template.Tags() {
+		note.AddTag(tag)
+	}
+	note.LinkTask(task.ID())
+	note.SetMetadata(meetingBoardKey, board.ID())
+	note.SetMetadata(meetingTaskKey, task.ID().String())
+
+	if err := uc.noteRepo.Save(ctx, note); err != nil {
+		return nil, fmt.Errorf("failed to save note: %w", err)
+	}
+
+	task.AddLinkedNote(note.ID())
+	if err := uc.boardRepo.SaveTask(ctx, board.ID(), column.Name(), task); err != nil {
+		return nil, fmt.Errorf("failed to save task: %w", err)
+	}
+
+	return &dto.MeetingNoteDTO{
+		Note:    dto.NoteToDTO(note),
+		BoardID: board.ID(),
+		TaskID:  task.ID().String(),
+		Created: true,
+	}, nil
+}
+
+// template returns the meeting note template, falling back to the built-in one
+func (uc *CreateMeetingNoteUseCase) template(ctx context.Context, projectID, name string) (*entity.TaskTemplate, error) {
+	if name != "" {
+		template, err := uc.templateRepo.FindByName(ctx, projectID, name)
+		if err == nil {
+			return template, nil
+		}
+		if !errors.Is(err, entity.ErrTemplateNotFound) {
+			return nil, err
+		}
+	}
+	return entity.NewTaskTemplate(defaultMeetingNoteTemplate, defaultMeetingNoteTitle, defaultMeetingNoteContent)
+}
+
+// meetingNoteValues returns the values of the placeholders of a meeting note
+// template. Values the meeting lacks are a dash, as templates treat empty
+// values as missing.
+func meetingNoteValues(board *entity.Board, task *entity.Task, date time.Time) map[string]string {
+	values := map[string]string{
+		"title":       task.Title(),
+		"date":        date.Format("2006-01-02"),
+		"time":        "(all day)",
+		"attendees":   "-",
+		"location":    "-",
+		"meeting_url": "-",
+		"agenda":      "-",
+		"board":       board.Name(),
+	}
+
+	if task.ScheduledTime() != nil {
+		start := *task.ScheduledTime()
+		values["time"] = start.Format("15:04")
+		if block := task.TimeBlock(); block != nil && *block > 0 {
+			values["time"] += "–" + start.Add(*block).Format("15:04")
+		}
+	}
+	if meeting := task.MeetingData(); meeting != nil {
+		if len(meeting.Attendees) > 0 {
+			values["attendees"] = "- " + strings.Join(meeting.Attendees, "\n- ")
+		}
+		if meeting.Location != "" {
+			values["location"] = meeting.Location
+		}
+		if meeting.MeetingURL != "" {
+			values["meeting_url"] = meeting.MeetingURL
+		}
+	}
+	if agenda := strings.TrimSpace(task.Description()); agenda != "" {
+		values["agenda"] = agenda
+	}
+	return values
+}
+
+// findTask returns a task of the board, accepted as a full or short ID, and
+// its column
+func findTask(board *entity.Board, taskID string) (*entity.Task, *entity.Column) {
+	for _, column := range board.Columns() {
+		for _, task := range column.Tasks() {
+			if task.ID().String() == taskID || task.ID().ShortID() == taskID {
+				return task, column
+			}
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,39 @@
+package note
+
+import (
+	"context"
+	"fmt"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/repository"
+)
+
+// DeleteNoteUseCase handles moving a note to the trash
+type DeleteNoteUseCase struct {
+	noteRepo  repository.NoteRepository
+	trashRepo repository.TrashRepository
+}
+
+// NewDeleteNoteUseCase creates a new DeleteNoteUseCase
+func NewDeleteNoteUseCase(noteRepo repository.NoteRepository, trashRepo repository.TrashRepository) *DeleteNoteUseCase {
+	return &DeleteNoteUseCase{
+		noteRepo:  noteRepo,
+		trashRepo: trashRepo,
+	}
+}
+
+// Execute moves a note into the trash of its project
+func (uc *DeleteNoteUseCase) Execute(ctx context.Context, noteID string) (*dto.TrashItemDTO, error) {
+	note, err := uc.noteRepo.FindByID(ctx, noteID)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := uc.trashRepo.TrashNote(ctx, note)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move note to trash: %w", err)
+	}
+
+	itemDTO := dto.TrashItemToDTO(item, 0)
+	return &itemDTO, nil
+}
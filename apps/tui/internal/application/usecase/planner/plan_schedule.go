@@ -0,0 +1,71 @@
+package planner
+
+import (
+	"context"
+	"time"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/service"
+)
+
+// PlanScheduleUseCase handles proposing time blocks for unscheduled tasks
+type PlanScheduleUseCase struct {
+	autoSchedulerService *service.AutoSchedulerService
+}
+
+// NewPlanScheduleUseCase creates a new PlanScheduleUseCase
+func NewPlanScheduleUseCase(autoSchedulerService *service.AutoSchedulerService) *PlanScheduleUseCase {
+	return &PlanScheduleUseCase{
+		autoSchedulerService: autoSchedulerService,
+	}
+}
+
+// Execute proposes time blocks for the unscheduled tasks of boards, or of
+// every board if boardIDs is empty, over the days starting at from. The plan
+// is only a preview; its blocks are applied with schedule_task.
+func (uc *PlanScheduleUseCase) Execute(ctx context.Context, boardIDs []string, from time.Time, days int) (*dto.SchedulePlanDTO, error) {
+	plan, err := uc.autoSchedulerService.Plan(ctx, boardIDs, from, days)
+	if err != nil {
+		return nil, err
+	}
+	return planToDTO(plan), nil
+}
+
+// planToDTO converts a schedule plan to a DTO
+func planToDTO(plan *service.SchedulePlan) *dto.SchedulePlanDTO {
+	planDTO := &dto.SchedulePlanDTO{
+		From:      plan.From,
+		Until:     plan.Until,
+		Blocks:    make([]dto.PlannedBlockDTO, 0, len(plan.Blocks)),
+		Unplanned: make([]dto.UnplannedTaskDTO, 0, len(plan.Unplanned)),
+	}
+
+	for _, block := range plan.Blocks {
+		planDTO.Blocks = append(planDTO.Blocks, dto.PlannedBlockDTO{
+			TaskID:     block.Task.ID().String(),
+			BoardID:    block.BoardID,
+			ColumnName: block.ColumnName,
+			Title:      block.Task.Title(),
+			Priority:   block.Task.Priority().String(),
+			DueDate:    block.Task.DueDate(),
+			Start:      block.Start,
+			End:        block.Start.Add(block.Duration),
+			Date:       block.Start.Format("2006-01-02"),
+			Time:       block.Start.Format("15:04"),
+			Duration:   block.Duration.String(),
+			MissedAt:   block.MissedAt,
+			Late:       block.Late,
+		})
+	}
+
+	for _, unplanned := range plan.Unplanned {
+		planDTO.Unplanned = append(planDTO.Unplanned, dto.UnplannedTaskDTO{
+			TaskID:  unplanned.Task.ID().String(),
+			BoardID: unplanned.BoardID,
+			Title:   unplanned.Task.Title(),
+			Reason:  unplanned.Reason,
+		})
+	}
+
+	return planDTO
+}
@@ -0,0 +1,30 @@
+package planner
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/service"
+)
+
+// ReplanMissedBlocksUseCase handles moving missed time blocks
+type ReplanMissedBlocksUseCase struct {
+	autoSchedulerService *service.AutoSchedulerService
+}
+
+// NewReplanMissedBlocksUseCase creates a new ReplanMissedBlocksUseCase
+func NewReplanMissedBlocksUseCase(autoSchedulerService *service.AutoSchedulerService) *ReplanMissedBlocksUseCase {
+	return &ReplanMissedBlocksUseCase{
+		autoSchedulerService: autoSchedulerService,
+	}
+}
+
+// Execute moves every time block that passed without its task being done to
+// the next free slot, and returns the moved blocks
+func (uc *ReplanMissedBlocksUseCase) Execute(ctx context.Context) (*dto.SchedulePlanDTO, error) {
+	plan, err := uc.autoSchedulerService.ReplanMissed(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return planToDTO(plan), nil
+}
@@ -0,0 +1,61 @@
+package schedule
+
+import (
+	"context"
+	"time"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/service"
+)
+
+// AddScheduleExceptionUseCase handles adding holidays, time off and changed
+// working hours to a schedule
+type AddScheduleExceptionUseCase struct {
+	workScheduleService *service.WorkScheduleService
+}
+
+// NewAddScheduleExceptionUseCase creates a new AddScheduleExceptionUseCase
+func NewAddScheduleExceptionUseCase(workScheduleService *service.WorkScheduleService) *AddScheduleExceptionUseCase {
+	return &AddScheduleExceptionUseCase{
+		workScheduleService: workScheduleService,
+	}
+}
+
+// Execute adds an exception to the schedule of a project, or to the default
+// schedule if projectID is empty. It replaces any exception on the same date.
+func (uc *AddScheduleExceptionUseCase) Execute(ctx context.Context, projectID string, exceptionDTO dto.ScheduleExceptionDTO) (*dto.WorkScheduleDTO, error) {
+	exception := entity.ScheduleException{
+		Type:        entity.ExceptionType(exceptionDTO.Type),
+		Description: exceptionDTO.Description,
+	}
+
+	var err error
+	if exception.StartTime, err = optionalTimeOfDay(exceptionDTO.Start); err != nil {
+		return nil, err
+	}
+	if exception.EndTime, err = optionalTimeOfDay(exceptionDTO.End); err != nil {
+		return nil, err
+	}
+
+	schedule, err := uc.workScheduleService.Update(ctx, projectID, func(schedule *entity.WorkSchedule) error {
+		date := exceptionDTO.Date
+		if !date.IsZero() {
+			// Exceptions are whole dates in the schedule's time zone
+			date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, schedule.Location())
+		}
+		exception.Date = date
+		if err := exception.Validate(); err != nil {
+			return err
+		}
+
+		schedule.RemoveException(date)
+		schedule.AddException(exception)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduleToDTO(projectID, schedule), nil
+}
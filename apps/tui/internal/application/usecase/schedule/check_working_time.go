@@ -0,0 +1,45 @@
+package schedule
+
+import (
+	"context"
+	"time"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/service"
+)
+
+// CheckWorkingTimeUseCase handles checking whether a moment falls within
+// working hours
+type CheckWorkingTimeUseCase struct {
+	workScheduleService *service.WorkScheduleService
+}
+
+// NewCheckWorkingTimeUseCase creates a new CheckWorkingTimeUseCase
+func NewCheckWorkingTimeUseCase(workScheduleService *service.WorkScheduleService) *CheckWorkingTimeUseCase {
+	return &CheckWorkingTimeUseCase{
+		workScheduleService: workScheduleService,
+	}
+}
+
+// Execute checks a moment against the schedule of a project, or the default
+// schedule if projectID is empty, and reports the working hours of its day
+func (uc *CheckWorkingTimeUseCase) Execute(ctx context.Context, projectID string, t time.Time) (*dto.WorkingTimeDTO, error) {
+	schedule, err := uc.workScheduleService.Schedule(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	local := t.In(schedule.Location())
+	workingTime := &dto.WorkingTimeDTO{
+		ProjectID:        projectID,
+		Time:             local,
+		Working:          schedule.IsWithinWorkingHours(local),
+		WorkingDay:       schedule.IsWorkingDay(local),
+		AvailableMinutes: schedule.GetAvailableMinutes(local),
+	}
+	if start, end := schedule.GetWorkingHours(local); start != nil && end != nil {
+		workingTime.Start = start.String()
+		workingTime.End = end.String()
+	}
+	return workingTime, nil
+}
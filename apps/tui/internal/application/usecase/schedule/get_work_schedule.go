@@ -0,0 +1,92 @@
+package schedule
+
+import (
+	"context"
+	"strings"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/service"
+)
+
+// GetWorkScheduleUseCase handles retrieving the work schedule of a project
+type GetWorkScheduleUseCase struct {
+	workScheduleService *service.WorkScheduleService
+}
+
+// NewGetWorkScheduleUseCase creates a new GetWorkScheduleUseCase
+func NewGetWorkScheduleUseCase(workScheduleService *service.WorkScheduleService) *GetWorkScheduleUseCase {
+	return &GetWorkScheduleUseCase{
+		workScheduleService: workScheduleService,
+	}
+}
+
+// Execute retrieves the schedule that applies to a project, or the default
+// schedule if projectID is empty
+func (uc *GetWorkScheduleUseCase) Execute(ctx context.Context, projectID string) (*dto.WorkScheduleDTO, error) {
+	schedule, err := uc.workScheduleService.Schedule(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return scheduleToDTO(projectID, schedule), nil
+}
+
+// scheduleToDTO converts the schedule that applies to a project to WorkScheduleDTO
+func scheduleToDTO(projectID string, schedule *entity.WorkSchedule) *dto.WorkScheduleDTO {
+	scheduleDTO := &dto.WorkScheduleDTO{
+		ProjectID:  projectID,
+		Inherited:  projectID != "" && schedule.ID() != projectID,
+		Name:       schedule.Name(),
+		Timezone:   schedule.Timezone(),
+		Days:       make([]dto.DayScheduleDTO, 0, 7),
+		Exceptions: make([]dto.ScheduleExceptionDTO, 0),
+	}
+
+	for i := 1; i <= 7; i++ {
+		day := entity.Weekday(i % 7)
+		daySchedule := schedule.GetDaySchedule(day)
+		dayDTO := dto.DayScheduleDTO{
+			Day:            strings.ToLower(day.String()),
+			Enabled:        daySchedule.Enabled(),
+			WorkingMinutes: daySchedule.WorkingMinutes(),
+		}
+		if daySchedule.Enabled() {
+			dayDTO.Start = daySchedule.StartTime().String()
+			dayDTO.End = daySchedule.EndTime().String()
+			if daySchedule.BreakStart() != nil && daySchedule.BreakEnd() != nil {
+				dayDTO.BreakStart = daySchedule.BreakStart().String()
+				dayDTO.BreakEnd = daySchedule.BreakEnd().String()
+			}
+		}
+		scheduleDTO.Days = append(scheduleDTO.Days, dayDTO)
+	}
+
+	for _, exception := range schedule.GetExceptions() {
+		exceptionDTO := dto.ScheduleExceptionDTO{
+			Date:        exception.Date,
+			Type:        string(exception.Type),
+			Description: exception.Description,
+		}
+		if exception.StartTime != nil {
+			exceptionDTO.Start = exception.StartTime.String()
+		}
+		if exception.EndTime != nil {
+			exceptionDTO.End = exception.EndTime.String()
+		}
+		scheduleDTO.Exceptions = append(scheduleDTO.Exceptions, exceptionDTO)
+	}
+
+	return scheduleDTO
+}
+
+// optionalTimeOfDay parses an HH:MM time that may be empty
+func optionalTimeOfDay(value string) (*entity.TimeOfDay, error) {
+	if value == "" {
+		return nil, nil
+	}
+	timeOfDay, err := entity.ParseTimeOfDay(value)
+	if err != nil {
+		return nil, err
+	}
+	return &timeOfDay, nil
+}
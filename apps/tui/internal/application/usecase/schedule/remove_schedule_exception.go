@@ -0,0 +1,42 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/service"
+)
+
+// RemoveScheduleExceptionUseCase handles removing an exception from a schedule
+type RemoveScheduleExceptionUseCase struct {
+	workScheduleService *service.WorkScheduleService
+}
+
+// NewRemoveScheduleExceptionUseCase creates a new RemoveScheduleExceptionUseCase
+func NewRemoveScheduleExceptionUseCase(workScheduleService *service.WorkScheduleService) *RemoveScheduleExceptionUseCase {
+	return &RemoveScheduleExceptionUseCase{
+		workScheduleService: workScheduleService,
+	}
+}
+
+// Execute removes the exception on a date from the schedule of a project, or
+// from the default schedule if projectID is empty
+func (uc *RemoveScheduleExceptionUseCase) Execute(ctx context.Context, projectID string, date time.Time) (*dto.WorkScheduleDTO, error) {
+	schedule, err := uc.workScheduleService.Update(ctx, projectID, func(schedule *entity.WorkSchedule) error {
+		for _, exception := range schedule.GetExceptions() {
+			if exception.Date.Format("2006-01-02") == date.Format("2006-01-02") {
+				schedule.RemoveException(date)
+				return nil
+			}
+		}
+		return fmt.Errorf("no exception on %s: %w", date.Format("2006-01-02"), entity.ErrScheduleExceptionNotFound)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduleToDTO(projectID, schedule), nil
+}
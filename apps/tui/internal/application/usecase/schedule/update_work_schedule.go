@@ -0,0 +1,93 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/service"
+)
+
+// UpdateWorkScheduleUseCase handles changing the working hours of a project
+// or the default working hours
+type UpdateWorkScheduleUseCase struct {
+	workScheduleService *service.WorkScheduleService
+}
+
+// NewUpdateWorkScheduleUseCase creates a new UpdateWorkScheduleUseCase
+func NewUpdateWorkScheduleUseCase(workScheduleService *service.WorkScheduleService) *UpdateWorkScheduleUseCase {
+	return &UpdateWorkScheduleUseCase{
+		workScheduleService: workScheduleService,
+	}
+}
+
+// Execute changes the name, time zone and the given weekdays of a schedule.
+// Updating a project without its own schedule gives it a copy of the default
+// schedule with the changes applied.
+func (uc *UpdateWorkScheduleUseCase) Execute(ctx context.Context, req dto.UpdateWorkScheduleRequest) (*dto.WorkScheduleDTO, error) {
+	days := make(map[entity.Weekday]*entity.DaySchedule)
+	for _, dayDTO := range req.Days {
+		day, err := entity.ParseWeekday(dayDTO.Day)
+		if err != nil {
+			return nil, err
+		}
+		daySchedule, err := dayScheduleFromDTO(dayDTO)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", day, err)
+		}
+		days[day] = daySchedule
+	}
+
+	if req.Timezone != nil && *req.Timezone != "" {
+		if _, err := time.LoadLocation(*req.Timezone); err != nil {
+			return nil, fmt.Errorf("%w: %q", entity.ErrInvalidTimezone, *req.Timezone)
+		}
+	}
+
+	schedule, err := uc.workScheduleService.Update(ctx, req.ProjectID, func(schedule *entity.WorkSchedule) error {
+		if req.Name != nil {
+			if err := schedule.SetName(*req.Name); err != nil {
+				return err
+			}
+		}
+		if req.Timezone != nil {
+			schedule.SetTimezone(*req.Timezone)
+		}
+		for day, daySchedule := range days {
+			schedule.SetDaySchedule(day, daySchedule)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduleToDTO(req.ProjectID, schedule), nil
+}
+
+// dayScheduleFromDTO converts the working hours of a weekday to a DaySchedule
+func dayScheduleFromDTO(dayDTO dto.DayScheduleDTO) (*entity.DaySchedule, error) {
+	if !dayDTO.Enabled {
+		return entity.NewDayOff(), nil
+	}
+
+	start, err := entity.ParseTimeOfDay(dayDTO.Start)
+	if err != nil {
+		return nil, err
+	}
+	end, err := entity.ParseTimeOfDay(dayDTO.End)
+	if err != nil {
+		return nil, err
+	}
+	breakStart, err := optionalTimeOfDay(dayDTO.BreakStart)
+	if err != nil {
+		return nil, err
+	}
+	breakEnd, err := optionalTimeOfDay(dayDTO.BreakEnd)
+	if err != nil {
+		return nil, err
+	}
+	return entity.NewDaySchedule(start, end, breakStart, breakEnd)
+}
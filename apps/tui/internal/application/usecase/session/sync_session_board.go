@@ -8,7 +8,11 @@ import (
 	"mkanban/internal/domain/repository"
 	"mkanban/internal/domain/service"
 	"mkanban/internal/domain/valueobject"
+	"mkanban/internal/infrastructure/config"
 	"mkanban/pkg/slug"
+	"os"
+	"path/filepath"
+	"strings"
 )
 
 // SyncSessionBoardUseCase synchronizes a session's board with its current state
@@ -18,6 +22,8 @@ type SyncSessionBoardUseCase struct {
 	boardService      *service.BoardService
 	strategies        []strategy.BoardSyncStrategy
 	boardPlanner      *SessionBoardPlanner
+	templateService   *service.BoardTemplateService
+	config            *config.Config
 }
 
 // NewSyncSessionBoardUseCase creates a new SyncSessionBoardUseCase
@@ -27,6 +33,8 @@ func NewSyncSessionBoardUseCase(
 	boardService *service.BoardService,
 	strategies []strategy.BoardSyncStrategy,
 	boardPlanner *SessionBoardPlanner,
+	templateService *service.BoardTemplateService,
+	cfg *config.Config,
 ) *SyncSessionBoardUseCase {
 	return &SyncSessionBoardUseCase{
 		boardRepo:         boardRepo,
@@ -34,6 +42,8 @@ func NewSyncSessionBoardUseCase(
 		boardService:      boardService,
 		strategies:        strategies,
 		boardPlanner:      boardPlanner,
+		templateService:   templateService,
+		config:            cfg,
 	}
 }
 
@@ -106,6 +116,12 @@ func (uc *SyncSessionBoardUseCase) getOrCreateBoard(
 		return nil, fmt.Errorf("failed to check for existing board: %w", err)
 	}
 
+	// Resolve the template first so a bad name does not leave an empty board behind
+	template, err := uc.templateService.FindTemplate(ctx, uc.boardTemplateFor(session.WorkingDir()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load board template: %w", err)
+	}
+
 	description := fmt.Sprintf("Session: %s\nWorking Directory: %s",
 		session.Name(), session.WorkingDir())
 
@@ -114,43 +130,37 @@ func (uc *SyncSessionBoardUseCase) getOrCreateBoard(
 		return nil, fmt.Errorf("failed to create board: %w", err)
 	}
 
-	if err := uc.addDefaultColumns(board); err != nil {
-		return nil, fmt.Errorf("failed to add default columns: %w", err)
-	}
-
-	if err := uc.boardRepo.Save(ctx, board); err != nil {
-		return nil, fmt.Errorf("failed to save board with columns: %w", err)
+	board, err = uc.templateService.ApplyTemplate(ctx, board, template)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply board template %s: %w", template.Name(), err)
 	}
 
 	return board, nil
 }
 
-// addDefaultColumns adds the default kanban columns to a new board
-func (uc *SyncSessionBoardUseCase) addDefaultColumns(board *entity.Board) error {
-	// Default columns for a kanban board
-	defaultColumns := []struct {
-		name        string
-		description string
-		order       int
-		wipLimit    int
-	}{
-		{"To Do", "Tasks to be started", 0, 0},
-		{"In Progress", "Tasks currently being worked on", 1, 3},
-		{"Done", "Completed tasks", 2, 0},
-	}
-
-	for _, col := range defaultColumns {
-		column, err := entity.NewColumn(col.name, col.description, col.order, col.wipLimit, nil)
-		if err != nil {
-			return fmt.Errorf("failed to create column %s: %w", col.name, err)
+// boardTemplateFor returns the board template configured for a working
+// directory: the first entry whose path matches it or one of its parents
+func (uc *SyncSessionBoardUseCase) boardTemplateFor(workingDir string) string {
+	homeDir, _ := os.UserHomeDir()
+
+	for _, rule := range uc.config.SessionTracking.BoardTemplates {
+		pattern := rule.Path
+		if homeDir != "" && (pattern == "~" || strings.HasPrefix(pattern, "~/")) {
+			pattern = filepath.Join(homeDir, strings.TrimPrefix(pattern, "~"))
 		}
+		pattern = filepath.Clean(pattern)
 
-		if err := board.AddColumn(column); err != nil {
-			return fmt.Errorf("failed to add column %s to board: %w", col.name, err)
+		for dir := filepath.Clean(workingDir); ; dir = filepath.Dir(dir) {
+			if matched, _ := filepath.Match(pattern, dir); matched {
+				return rule.Template
+			}
+			if dir == filepath.Dir(dir) {
+				break
+			}
 		}
 	}
 
-	return nil
+	return entity.DefaultBoardTemplateName
 }
 
 func (uc *SyncSessionBoardUseCase) getOrCreateProject(
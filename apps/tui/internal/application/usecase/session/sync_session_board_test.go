@@ -0,0 +1,46 @@
+package session
+
+import (
+	"path/filepath"
+	"testing"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/infrastructure/config"
+)
+
+func TestBoardTemplateFor(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	cfg := &config.Config{}
+	cfg.SessionTracking.BoardTemplates = []config.SessionBoardTemplate{
+		{Path: "/work/legacy", Template: "legacy"},
+		{Path: "/work/*", Template: "work"},
+		{Path: "~/projects/*/api", Template: "api"},
+		{Path: "/work/legacy/new", Template: "shadowed"},
+	}
+	uc := &SyncSessionBoardUseCase{config: cfg}
+
+	tests := []struct {
+		name       string
+		workingDir string
+		want       string
+	}{
+		{"exact path", "/work/legacy", "legacy"},
+		{"parent of the directory", "/work/legacy/new/cmd", "legacy"},
+		{"glob", "/work/shop", "work"},
+		{"glob on a parent", "/work/shop/internal/api", "work"},
+		{"trailing slash", "/work/shop/", "work"},
+		{"glob needs a directory below", "/work", entity.DefaultBoardTemplateName},
+		{"home directory", filepath.Join(home, "projects", "shop", "api", "cmd"), "api"},
+		{"home directory without a match", filepath.Join(home, "projects", "shop"), entity.DefaultBoardTemplateName},
+		{"no match", "/tmp/scratch", entity.DefaultBoardTemplateName},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := uc.boardTemplateFor(tt.workingDir); got != tt.want {
+				t.Errorf("expected %s for %s, got %s", tt.want, tt.workingDir, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package sprint
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/service"
+)
+
+// AddSprintTasksUseCase handles planning tasks in a sprint
+type AddSprintTasksUseCase struct {
+	sprintService *service.SprintService
+}
+
+// NewAddSprintTasksUseCase creates a new AddSprintTasksUseCase
+func NewAddSprintTasksUseCase(sprintService *service.SprintService) *AddSprintTasksUseCase {
+	return &AddSprintTasksUseCase{
+		sprintService: sprintService,
+	}
+}
+
+// Execute adds tasks to a sprint; tasks added after the sprint started count
+// as scope added mid-sprint
+func (uc *AddSprintTasksUseCase) Execute(ctx context.Context, projectID, sprintID string, tasks []dto.SprintTaskDTO) (*dto.SprintDTO, error) {
+	report, err := uc.sprintService.AddTasks(ctx, projectID, sprintID, tasksFromDTO(tasks))
+	if err != nil {
+		return nil, err
+	}
+	return reportToDTO(report), nil
+}
@@ -0,0 +1,30 @@
+package sprint
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/service"
+)
+
+// CloseSprintUseCase handles closing a sprint
+type CloseSprintUseCase struct {
+	sprintService *service.SprintService
+}
+
+// NewCloseSprintUseCase creates a new CloseSprintUseCase
+func NewCloseSprintUseCase(sprintService *service.SprintService) *CloseSprintUseCase {
+	return &CloseSprintUseCase{
+		sprintService: sprintService,
+	}
+}
+
+// Execute closes a sprint and stores its summary. Unfinished tasks roll over
+// to nextSprintID, or to the next open sprint of the project if it is empty.
+func (uc *CloseSprintUseCase) Execute(ctx context.Context, projectID, sprintID, nextSprintID string) (*dto.SprintDTO, error) {
+	report, err := uc.sprintService.Close(ctx, projectID, sprintID, nextSprintID)
+	if err != nil {
+		return nil, err
+	}
+	return reportToDTO(report), nil
+}
@@ -0,0 +1,29 @@
+package sprint
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/service"
+)
+
+// GetSprintUseCase handles retrieving a sprint with its tasks
+type GetSprintUseCase struct {
+	sprintService *service.SprintService
+}
+
+// NewGetSprintUseCase creates a new GetSprintUseCase
+func NewGetSprintUseCase(sprintService *service.SprintService) *GetSprintUseCase {
+	return &GetSprintUseCase{
+		sprintService: sprintService,
+	}
+}
+
+// Execute retrieves a sprint of a project
+func (uc *GetSprintUseCase) Execute(ctx context.Context, projectID, sprintID string) (*dto.SprintDTO, error) {
+	report, err := uc.sprintService.Report(ctx, projectID, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	return reportToDTO(report), nil
+}
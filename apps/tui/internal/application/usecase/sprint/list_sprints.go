@@ -0,0 +1,34 @@
+package sprint
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/service"
+)
+
+// ListSprintsUseCase handles listing the sprints of a project
+type ListSprintsUseCase struct {
+	sprintService *service.SprintService
+}
+
+// NewListSprintsUseCase creates a new ListSprintsUseCase
+func NewListSprintsUseCase(sprintService *service.SprintService) *ListSprintsUseCase {
+	return &ListSprintsUseCase{
+		sprintService: sprintService,
+	}
+}
+
+// Execute lists the sprints of a project, sorted by start date
+func (uc *ListSprintsUseCase) Execute(ctx context.Context, projectID string) ([]dto.SprintDTO, error) {
+	reports, err := uc.sprintService.FindSprints(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	sprints := make([]dto.SprintDTO, 0, len(reports))
+	for _, report := range reports {
+		sprints = append(sprints, *reportToDTO(report))
+	}
+	return sprints, nil
+}
@@ -0,0 +1,117 @@
+package sprint
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/service"
+)
+
+// PlanSprintUseCase handles planning a new sprint
+type PlanSprintUseCase struct {
+	sprintService *service.SprintService
+}
+
+// NewPlanSprintUseCase creates a new PlanSprintUseCase
+func NewPlanSprintUseCase(sprintService *service.SprintService) *PlanSprintUseCase {
+	return &PlanSprintUseCase{
+		sprintService: sprintService,
+	}
+}
+
+// Execute plans a sprint in a project; tasks are added to it afterwards
+func (uc *PlanSprintUseCase) Execute(ctx context.Context, req dto.PlanSprintRequest) (*dto.SprintDTO, error) {
+	report, err := uc.sprintService.Plan(ctx, req.ProjectID, req.Name, req.Goal, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	return reportToDTO(report), nil
+}
+
+// reportToDTO converts a sprint report to SprintDTO
+func reportToDTO(report *service.SprintReport) *dto.SprintDTO {
+	sprint := report.Sprint
+	sprintDTO := &dto.SprintDTO{
+		ID:        sprint.ID(),
+		ProjectID: sprint.ProjectID(),
+		Name:      sprint.Name(),
+		Goal:      sprint.Goal(),
+		StartDate: sprint.StartDate(),
+		EndDate:   sprint.EndDate(),
+		Closed:    sprint.IsClosed(),
+		Tasks:     make([]dto.SprintTaskDTO, 0, len(report.Tasks)),
+		Forecast:  forecastToDTO(report.Forecast),
+	}
+	if report.ForecastErr != nil {
+		sprintDTO.ForecastError = report.ForecastErr.Error()
+	}
+
+	for _, status := range report.Tasks {
+		taskDTO := sprintTaskToDTO(sprint, status.SprintTask)
+		taskDTO.Done = status.Done
+		taskDTO.Missing = status.Task == nil
+		if status.Task != nil {
+			taskDTO.Title = status.Task.Title()
+			taskDTO.ColumnName = status.Column.Name()
+		}
+		sprintDTO.Tasks = append(sprintDTO.Tasks, taskDTO)
+	}
+
+	if summary := sprint.Summary(); summary != nil {
+		rolledOver := make([]dto.SprintTaskDTO, 0, len(summary.RolledOver))
+		for _, task := range summary.RolledOver {
+			rolledOver = append(rolledOver, sprintTaskToDTO(sprint, task))
+		}
+		sprintDTO.Summary = &dto.SprintSummaryDTO{
+			ClosedAt:      summary.ClosedAt,
+			Committed:     summary.Committed,
+			CommittedDone: summary.CommittedDone,
+			Added:         summary.Added,
+			AddedDone:     summary.AddedDone,
+			RolledOver:    rolledOver,
+			RolledOverTo:  summary.RolledOverTo,
+			TrackedTime:   summary.TrackedTime,
+		}
+	}
+
+	return sprintDTO
+}
+
+// forecastToDTO converts a completion forecast to CompletionForecastDTO
+func forecastToDTO(forecast *service.CompletionForecast) *dto.CompletionForecastDTO {
+	if forecast == nil {
+		return nil
+	}
+	return &dto.CompletionForecastDTO{
+		BoardIDs:     forecast.BoardIDs,
+		Tasks:        forecast.Tasks,
+		HistoryWeeks: forecast.HistoryWeeks,
+		Trials:       forecast.Trials,
+		P50:          forecast.P50,
+		P85:          forecast.P85,
+		P95:          forecast.P95,
+	}
+}
+
+// sprintTaskToDTO converts a sprint task reference to SprintTaskDTO
+func sprintTaskToDTO(sprint *entity.Sprint, task entity.SprintTask) dto.SprintTaskDTO {
+	return dto.SprintTaskDTO{
+		BoardID:        task.BoardID,
+		TaskID:         task.TaskID,
+		AddedAt:        task.AddedAt,
+		AddedMidSprint: sprint.IsAddedMidSprint(task),
+	}
+}
+
+// tasksFromDTO converts task references to sprint tasks
+func tasksFromDTO(tasks []dto.SprintTaskDTO) []entity.SprintTask {
+	result := make([]entity.SprintTask, 0, len(tasks))
+	for _, task := range tasks {
+		result = append(result, entity.SprintTask{
+			BoardID: task.BoardID,
+			TaskID:  task.TaskID,
+		})
+	}
+	return result
+}
@@ -0,0 +1,29 @@
+package sprint
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/service"
+)
+
+// RemoveSprintTasksUseCase handles taking tasks out of a sprint
+type RemoveSprintTasksUseCase struct {
+	sprintService *service.SprintService
+}
+
+// NewRemoveSprintTasksUseCase creates a new RemoveSprintTasksUseCase
+func NewRemoveSprintTasksUseCase(sprintService *service.SprintService) *RemoveSprintTasksUseCase {
+	return &RemoveSprintTasksUseCase{
+		sprintService: sprintService,
+	}
+}
+
+// Execute removes tasks from a sprint; the tasks themselves are kept
+func (uc *RemoveSprintTasksUseCase) Execute(ctx context.Context, projectID, sprintID string, tasks []dto.SprintTaskDTO) (*dto.SprintDTO, error) {
+	report, err := uc.sprintService.RemoveTasks(ctx, projectID, sprintID, tasksFromDTO(tasks))
+	if err != nil {
+		return nil, err
+	}
+	return reportToDTO(report), nil
+}
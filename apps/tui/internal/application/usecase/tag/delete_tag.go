@@ -0,0 +1,29 @@
+package tag
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/service"
+)
+
+// DeleteTagUseCase handles deleting a tag from a project
+type DeleteTagUseCase struct {
+	tagService *service.TagService
+}
+
+// NewDeleteTagUseCase creates a new DeleteTagUseCase
+func NewDeleteTagUseCase(tagService *service.TagService) *DeleteTagUseCase {
+	return &DeleteTagUseCase{
+		tagService: tagService,
+	}
+}
+
+// Execute removes a tag from every task and note of a project and from its registry
+func (uc *DeleteTagUseCase) Execute(ctx context.Context, projectID, name string) (*dto.TagRewriteDTO, error) {
+	result, err := uc.tagService.DeleteTag(ctx, projectID, name)
+	if err != nil {
+		return nil, err
+	}
+	return rewriteToDTO(result), nil
+}
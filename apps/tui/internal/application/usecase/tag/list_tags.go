@@ -0,0 +1,68 @@
+package tag
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/service"
+)
+
+// ListTagsUseCase handles listing the tags of a project
+type ListTagsUseCase struct {
+	tagRepo    repository.TagRepository
+	tagService *service.TagService
+}
+
+// NewListTagsUseCase creates a new ListTagsUseCase
+func NewListTagsUseCase(tagRepo repository.TagRepository, tagService *service.TagService) *ListTagsUseCase {
+	return &ListTagsUseCase{
+		tagRepo:    tagRepo,
+		tagService: tagService,
+	}
+}
+
+// Execute lists the registered tags of a project, then the tags used on its
+// tasks and notes that are not registered, with how often each is used. Uses
+// of an alias count towards its registered tag.
+func (uc *ListTagsUseCase) Execute(ctx context.Context, projectID string) ([]dto.TagDTO, error) {
+	registry, err := uc.tagRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := uc.tagService.Usage(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	registered := registry.Tags()
+	tags := make([]dto.TagDTO, 0, len(registered))
+	indexByName := make(map[string]int, len(registered))
+	for _, tag := range registered {
+		indexByName[tag.Name()] = len(tags)
+		tags = append(tags, dto.TagToDTO(tag))
+	}
+
+	unregistered := make([]dto.TagDTO, 0)
+	for name, counts := range usage {
+		if tag, ok := registry.Find(name); ok {
+			tagDTO := &tags[indexByName[tag.Name()]]
+			tagDTO.TaskCount += counts.Tasks
+			tagDTO.NoteCount += counts.Notes
+			continue
+		}
+		unregistered = append(unregistered, dto.TagDTO{
+			Name:      name,
+			TaskCount: counts.Tasks,
+			NoteCount: counts.Notes,
+		})
+	}
+	sort.Slice(unregistered, func(i, j int) bool {
+		return strings.ToLower(unregistered[i].Name) < strings.ToLower(unregistered[j].Name)
+	})
+
+	return append(tags, unregistered...), nil
+}
@@ -0,0 +1,30 @@
+package tag
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/service"
+)
+
+// MergeTagsUseCase handles merging tags of a project into one
+type MergeTagsUseCase struct {
+	tagService *service.TagService
+}
+
+// NewMergeTagsUseCase creates a new MergeTagsUseCase
+func NewMergeTagsUseCase(tagService *service.TagService) *MergeTagsUseCase {
+	return &MergeTagsUseCase{
+		tagService: tagService,
+	}
+}
+
+// Execute replaces the source tags with the target tag on every task and note
+// of a project; the sources are kept as aliases of the target
+func (uc *MergeTagsUseCase) Execute(ctx context.Context, projectID string, sources []string, target string) (*dto.TagRewriteDTO, error) {
+	result, err := uc.tagService.MergeTags(ctx, projectID, sources, target)
+	if err != nil {
+		return nil, err
+	}
+	return rewriteToDTO(result), nil
+}
@@ -0,0 +1,29 @@
+package tag
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/service"
+)
+
+// RenameTagUseCase handles renaming a tag across a project
+type RenameTagUseCase struct {
+	tagService *service.TagService
+}
+
+// NewRenameTagUseCase creates a new RenameTagUseCase
+func NewRenameTagUseCase(tagService *service.TagService) *RenameTagUseCase {
+	return &RenameTagUseCase{
+		tagService: tagService,
+	}
+}
+
+// Execute renames a tag on every task and note of a project and in its registry
+func (uc *RenameTagUseCase) Execute(ctx context.Context, projectID, oldName, newName string) (*dto.TagRewriteDTO, error) {
+	result, err := uc.tagService.RenameTag(ctx, projectID, oldName, newName)
+	if err != nil {
+		return nil, err
+	}
+	return rewriteToDTO(result), nil
+}
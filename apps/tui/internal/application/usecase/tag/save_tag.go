@@ -0,0 +1,56 @@
+package tag
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/service"
+	"mkanban/internal/domain/valueobject"
+)
+
+// SaveTagUseCase handles registering and updating tags
+type SaveTagUseCase struct {
+	tagService *service.TagService
+}
+
+// NewSaveTagUseCase creates a new SaveTagUseCase
+func NewSaveTagUseCase(tagService *service.TagService) *SaveTagUseCase {
+	return &SaveTagUseCase{
+		tagService: tagService,
+	}
+}
+
+// Execute registers a tag with its color, description and aliases, and
+// rewrites tasks and notes tagged with one of its aliases to its name
+func (uc *SaveTagUseCase) Execute(ctx context.Context, projectID string, req dto.TagDTO) (*dto.TagRewriteDTO, error) {
+	var color *valueobject.Color
+	if req.Color != "" {
+		parsed, err := valueobject.NewColor(req.Color)
+		if err != nil {
+			return nil, err
+		}
+		color = parsed
+	}
+
+	tag, err := entity.NewTag(req.Name, req.Description, color)
+	if err != nil {
+		return nil, err
+	}
+	tag.SetAliases(req.Aliases)
+
+	result, err := uc.tagService.SaveTag(ctx, projectID, tag)
+	if err != nil {
+		return nil, err
+	}
+	return rewriteToDTO(result), nil
+}
+
+// rewriteToDTO converts the result of a tag rewrite to TagRewriteDTO
+func rewriteToDTO(result *service.TagRewriteResult) *dto.TagRewriteDTO {
+	return &dto.TagRewriteDTO{
+		TasksUpdated: result.TasksUpdated,
+		NotesUpdated: result.NotesUpdated,
+		BoardIDs:     result.BoardIDs,
+	}
+}
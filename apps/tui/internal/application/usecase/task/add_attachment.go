@@ -0,0 +1,73 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+)
+
+// AddAttachmentUseCase handles copying a file into a task folder
+type AddAttachmentUseCase struct {
+	boardRepo      repository.BoardRepository
+	attachmentRepo repository.AttachmentRepository
+}
+
+// NewAddAttachmentUseCase creates a new AddAttachmentUseCase
+func NewAddAttachmentUseCase(boardRepo repository.BoardRepository, attachmentRepo repository.AttachmentRepository) *AddAttachmentUseCase {
+	return &AddAttachmentUseCase{
+		boardRepo:      boardRepo,
+		attachmentRepo: attachmentRepo,
+	}
+}
+
+// Execute copies sourcePath into the task folder. The file keeps its base name
+// unless name is given; a name already used by the task gets a numeric suffix.
+func (uc *AddAttachmentUseCase) Execute(ctx context.Context, boardID string, taskIDStr string, sourcePath string, name string) (*dto.AttachmentDTO, error) {
+	board, err := uc.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	task, column := findTask(board, taskIDStr)
+	if task == nil {
+		return nil, entity.ErrTaskNotFound
+	}
+
+	if name == "" {
+		name = filepath.Base(sourcePath)
+	}
+	name = uniqueAttachmentName(task, strings.TrimLeft(name, "."))
+
+	attachment, err := uc.attachmentRepo.Store(ctx, boardID, column.Name(), task.ID(), sourcePath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	task.AddAttachment(attachment)
+	if err := uc.boardRepo.SaveTask(ctx, boardID, column.Name(), task); err != nil {
+		return nil, fmt.Errorf("failed to save task: %w", err)
+	}
+
+	attachmentDTO := dto.AttachmentToDTO(attachment)
+	attachmentDTO.Path, _ = uc.attachmentRepo.Path(boardID, column.Name(), task.ID(), attachment.Name)
+	return &attachmentDTO, nil
+}
+
+// uniqueAttachmentName appends -1, -2, ... before the extension until the name is free
+func uniqueAttachmentName(task *entity.Task, name string) string {
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+
+	candidate := name
+	for i := 1; ; i++ {
+		if _, err := task.GetAttachment(candidate); err != nil {
+			return candidate
+		}
+		candidate = fmt.Sprintf("%s-%d%s", base, i, ext)
+	}
+}
@@ -0,0 +1,104 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/valueobject"
+	"mkanban/internal/infrastructure/persistence/filesystem"
+)
+
+func TestAddAttachment(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	boardRepo := filesystem.NewBoardRepository(root)
+	uc := NewAddAttachmentUseCase(boardRepo, filesystem.NewAttachmentRepository(root))
+
+	board, err := entity.NewBoard("work/tracker", "Tracker", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	column, err := entity.NewColumn("To Do", "", 0, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := board.AddColumn(column); err != nil {
+		t.Fatal(err)
+	}
+	taskID, err := board.GenerateNextTaskID("report")
+	if err != nil {
+		t.Fatal(err)
+	}
+	task, err := entity.NewTask(taskID, "report", "", valueobject.PriorityMedium, valueobject.StatusTodo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := column.AddTask(task); err != nil {
+		t.Fatal(err)
+	}
+	if err := boardRepo.Save(ctx, board); err != nil {
+		t.Fatal(err)
+	}
+
+	source := filepath.Join(t.TempDir(), "draft.md")
+	if err := os.WriteFile(source, []byte("# Draft\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		given    string // the requested name
+		wantName string
+		wantErr  error
+	}{
+		{"source name", "", "draft.md", nil},
+		{"taken name gets a suffix", "", "draft-1.md", nil},
+		{"given name", "notes.md", "notes.md", nil},
+		{"leading dots dropped", ".env", "env", nil},
+		{"parent directory", "../escape.md", "", entity.ErrInvalidAttachmentName},
+		{"absolute name", filepath.Join(root, "escape.md"), "", entity.ErrInvalidAttachmentName},
+		{"nested name", "sub/escape.md", "", entity.ErrInvalidAttachmentName},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attachment, err := uc.Execute(ctx, "work/tracker", taskID.String(), source, tt.given)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v (%+v)", tt.wantErr, err, attachment)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("add failed: %v", err)
+			}
+			if attachment.Name != tt.wantName || filepath.Base(attachment.Path) != tt.wantName {
+				t.Errorf("expected %s, got %s at %s", tt.wantName, attachment.Name, attachment.Path)
+			}
+			if data, err := os.ReadFile(attachment.Path); err != nil || string(data) != "# Draft\n" {
+				t.Errorf("expected a copy of the source, got %q (%v)", data, err)
+			}
+		})
+	}
+
+	for _, escaped := range []string{filepath.Join(root, "escape.md"), filepath.Join(filepath.Dir(source), "escape.md")} {
+		if _, err := os.Stat(escaped); !os.IsNotExist(err) {
+			t.Errorf("expected nothing written to %s, got %v", escaped, err)
+		}
+	}
+
+	loaded, err := boardRepo.FindByID(ctx, "work/tracker")
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved, _, err := loaded.FindTask(taskID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(saved.Attachments()); got != 4 {
+		t.Errorf("expected the 4 attachments to be saved with the task, got %d", got)
+	}
+}
@@ -0,0 +1,49 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+)
+
+// AddCommentUseCase handles adding a free-form comment to a task's activity log
+type AddCommentUseCase struct {
+	boardRepo    repository.BoardRepository
+	activityRepo repository.ActivityRepository
+}
+
+// NewAddCommentUseCase creates a new AddCommentUseCase
+func NewAddCommentUseCase(boardRepo repository.BoardRepository, activityRepo repository.ActivityRepository) *AddCommentUseCase {
+	return &AddCommentUseCase{
+		boardRepo:    boardRepo,
+		activityRepo: activityRepo,
+	}
+}
+
+// Execute adds a comment to a task, accepted as a full or short ID, and returns the updated timeline
+func (uc *AddCommentUseCase) Execute(ctx context.Context, boardID string, taskIDStr string, author string, text string) (*dto.TaskActivityDTO, error) {
+	board, err := uc.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	task, column := findTask(board, taskIDStr)
+	if task == nil {
+		return nil, entity.ErrTaskNotFound
+	}
+
+	comment, err := entity.NewComment(uuid.New().String(), author, text)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.activityRepo.Append(ctx, boardID, column.Name(), task.ID(), comment); err != nil {
+		return nil, fmt.Errorf("failed to save comment: %w", err)
+	}
+
+	return loadTaskActivity(ctx, uc.activityRepo, boardID, column, task)
+}
@@ -3,6 +3,7 @@ package task
 import (
 	"context"
 	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
 	"mkanban/internal/domain/service"
 	"mkanban/internal/domain/valueobject"
 )
@@ -19,8 +20,24 @@ func NewCreateTaskUseCase(boardService *service.BoardService) *CreateTaskUseCase
 	}
 }
 
-// Execute creates a new task
+// Execute creates a new task for the user, who must set every required field
+// of the board
 func (uc *CreateTaskUseCase) Execute(ctx context.Context, boardID string, req dto.CreateTaskRequest) (*dto.TaskDTO, error) {
+	return uc.execute(ctx, boardID, req, uc.boardService.CreateTaskWithRequiredFields)
+}
+
+// ExecuteForAutomation creates a new task for a board action, which does not
+// know the board's required fields
+func (uc *CreateTaskUseCase) ExecuteForAutomation(ctx context.Context, boardID string, req dto.CreateTaskRequest) (*dto.TaskDTO, error) {
+	return uc.execute(ctx, boardID, req, uc.boardService.CreateTask)
+}
+
+func (uc *CreateTaskUseCase) execute(
+	ctx context.Context,
+	boardID string,
+	req dto.CreateTaskRequest,
+	create func(context.Context, string, string, string, string, valueobject.Priority, map[string]string) (*entity.Board, *entity.Task, error),
+) (*dto.TaskDTO, error) {
 	// Parse priority
 	priority, err := valueobject.ParsePriority(req.Priority)
 	if err != nil {
@@ -28,13 +45,14 @@ func (uc *CreateTaskUseCase) Execute(ctx context.Context, boardID string, req dt
 	}
 
 	// Create task
-	_, task, err := uc.boardService.CreateTask(
+	_, task, err := create(
 		ctx,
 		boardID,
 		req.ColumnName,
 		req.Title,
 		req.Description,
 		priority,
+		req.Fields,
 	)
 	if err != nil {
 		return nil, err
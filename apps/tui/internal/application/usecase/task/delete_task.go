@@ -0,0 +1,108 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+)
+
+// DeleteTaskUseCase handles moving a task and its subtasks to the trash
+type DeleteTaskUseCase struct {
+	boardRepo repository.BoardRepository
+	trashRepo repository.TrashRepository
+}
+
+// NewDeleteTaskUseCase creates a new DeleteTaskUseCase
+func NewDeleteTaskUseCase(boardRepo repository.BoardRepository, trashRepo repository.TrashRepository) *DeleteTaskUseCase {
+	return &DeleteTaskUseCase{
+		boardRepo: boardRepo,
+		trashRepo: trashRepo,
+	}
+}
+
+// Execute moves a task, accepted as a full or short ID, into the trash together with its subtasks
+func (uc *DeleteTaskUseCase) Execute(ctx context.Context, boardID string, taskIDStr string) (*dto.TrashItemDTO, error) {
+	board, err := uc.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	task, column := findTask(board, taskIDStr)
+	if task == nil {
+		return nil, entity.ErrTaskNotFound
+	}
+
+	// The task goes first so restoring the item recreates it before its subtasks
+	trashed := []entity.TrashedTask{{TaskID: task.ID().String(), Column: column.Name()}}
+	removals := map[*entity.Task]*entity.Column{task: column}
+	for _, sub := range collectSubtasks(board, task) {
+		trashed = append(trashed, entity.TrashedTask{TaskID: sub.task.ID().String(), Column: sub.column.Name()})
+		removals[sub.task] = sub.column
+	}
+
+	item, err := uc.trashRepo.TrashTasks(ctx, boardID, task.Title(), trashed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move task to trash: %w", err)
+	}
+
+	for t, c := range removals {
+		if _, err := c.RemoveTask(t.ID()); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.boardRepo.Save(ctx, board); err != nil {
+		return nil, fmt.Errorf("failed to save board: %w", err)
+	}
+
+	itemDTO := dto.TrashItemToDTO(item, 0)
+	return &itemDTO, nil
+}
+
+type taskInColumn struct {
+	task   *entity.Task
+	column *entity.Column
+}
+
+// findTask looks a task up by its full or short ID
+func findTask(board *entity.Board, taskIDStr string) (*entity.Task, *entity.Column) {
+	for _, column := range board.Columns() {
+		for _, task := range column.Tasks() {
+			if task.ID().String() == taskIDStr || task.ID().ShortID() == taskIDStr {
+				return task, column
+			}
+		}
+	}
+	return nil, nil
+}
+
+// collectSubtasks returns every descendant of parent on the board
+func collectSubtasks(board *entity.Board, parent *entity.Task) []taskInColumn {
+	var result []taskInColumn
+	queue := []string{parent.ID().ShortID()}
+	seen := map[string]bool{parent.ID().ShortID(): true}
+
+	for len(queue) > 0 {
+		parentID := queue[0]
+		queue = queue[1:]
+
+		for _, column := range board.Columns() {
+			for _, task := range column.Tasks() {
+				if task.ParentID() == nil || task.ParentID().ShortID() != parentID {
+					continue
+				}
+				if seen[task.ID().ShortID()] {
+					continue
+				}
+				seen[task.ID().ShortID()] = true
+				result = append(result, taskInColumn{task: task, column: column})
+				queue = append(queue, task.ID().ShortID())
+			}
+		}
+	}
+
+	return result
+}
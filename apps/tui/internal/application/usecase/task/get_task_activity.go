@@ -0,0 +1,64 @@
+package task
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+)
+
+// GetTaskActivityUseCase handles reading the activity timeline of a task
+type GetTaskActivityUseCase struct {
+	boardRepo    repository.BoardRepository
+	activityRepo repository.ActivityRepository
+}
+
+// NewGetTaskActivityUseCase creates a new GetTaskActivityUseCase
+func NewGetTaskActivityUseCase(boardRepo repository.BoardRepository, activityRepo repository.ActivityRepository) *GetTaskActivityUseCase {
+	return &GetTaskActivityUseCase{
+		boardRepo:    boardRepo,
+		activityRepo: activityRepo,
+	}
+}
+
+// Execute returns the activity timeline of a task, accepted as a full or short ID
+func (uc *GetTaskActivityUseCase) Execute(ctx context.Context, boardID string, taskIDStr string) (*dto.TaskActivityDTO, error) {
+	board, err := uc.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	task, column := findTask(board, taskIDStr)
+	if task == nil {
+		return nil, entity.ErrTaskNotFound
+	}
+
+	return loadTaskActivity(ctx, uc.activityRepo, boardID, column, task)
+}
+
+// loadTaskActivity reads a task's activity log into a TaskActivityDTO
+func loadTaskActivity(
+	ctx context.Context,
+	activityRepo repository.ActivityRepository,
+	boardID string,
+	column *entity.Column,
+	task *entity.Task,
+) (*dto.TaskActivityDTO, error) {
+	entries, err := activityRepo.FindByTask(ctx, boardID, column.Name(), task.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.TaskActivityDTO{
+		TaskID:  task.ID().String(),
+		BoardID: boardID,
+		Column:  column.Name(),
+		Entries: make([]dto.ActivityEntryDTO, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		result.Entries = append(result.Entries, dto.ActivityEntryToDTO(entry))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,48 @@
+package task
+
+import (
+	"context"
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/valueobject"
+)
+
+// GetTaskTreeUseCase handles retrieving a task with its subtasks at any depth
+type GetTaskTreeUseCase struct {
+	boardRepo   repository.BoardRepository
+	timeLogRepo repository.TimeLogRepository
+}
+
+// NewGetTaskTreeUseCase creates a new GetTaskTreeUseCase
+func NewGetTaskTreeUseCase(boardRepo repository.BoardRepository, timeLogRepo repository.TimeLogRepository) *GetTaskTreeUseCase {
+	return &GetTaskTreeUseCase{
+		boardRepo:   boardRepo,
+		timeLogRepo: timeLogRepo,
+	}
+}
+
+// Execute returns the subtree rooted at a task, each task with the rollup of
+// its own subtasks
+func (uc *GetTaskTreeUseCase) Execute(ctx context.Context, boardID string, taskIDStr string) (*dto.TaskTreeDTO, error) {
+	taskID, err := valueobject.ParseTaskID(taskIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := uc.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	task, _, err := board.FindTask(taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tracked time is informational, a tree without time logs still loads
+	logs, _ := uc.timeLogRepo.FindByProject(ctx, board.ProjectID())
+
+	tree := dto.TaskTreeToDTO(board, task, entity.TrackedTimeByTask(logs))
+	return &tree, nil
+}
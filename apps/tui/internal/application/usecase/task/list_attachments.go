@@ -0,0 +1,45 @@
+package task
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+)
+
+// ListAttachmentsUseCase handles listing the files attached to a task
+type ListAttachmentsUseCase struct {
+	boardRepo      repository.BoardRepository
+	attachmentRepo repository.AttachmentRepository
+}
+
+// NewListAttachmentsUseCase creates a new ListAttachmentsUseCase
+func NewListAttachmentsUseCase(boardRepo repository.BoardRepository, attachmentRepo repository.AttachmentRepository) *ListAttachmentsUseCase {
+	return &ListAttachmentsUseCase{
+		boardRepo:      boardRepo,
+		attachmentRepo: attachmentRepo,
+	}
+}
+
+// Execute lists the attachments of a task with the current path of each file
+func (uc *ListAttachmentsUseCase) Execute(ctx context.Context, boardID string, taskIDStr string) ([]dto.AttachmentDTO, error) {
+	board, err := uc.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	task, column := findTask(board, taskIDStr)
+	if task == nil {
+		return nil, entity.ErrTaskNotFound
+	}
+
+	result := make([]dto.AttachmentDTO, 0)
+	for _, attachment := range task.Attachments() {
+		attachmentDTO := dto.AttachmentToDTO(attachment)
+		attachmentDTO.Path, _ = uc.attachmentRepo.Path(boardID, column.Name(), task.ID(), attachment.Name)
+		result = append(result, attachmentDTO)
+	}
+
+	return result, nil
+}
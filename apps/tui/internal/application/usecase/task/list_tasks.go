@@ -2,16 +2,18 @@ package task
 
 import (
 	"context"
+	"fmt"
 	"path/filepath"
 
 	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
 	"mkanban/internal/domain/repository"
 	"mkanban/internal/domain/valueobject"
 	"mkanban/internal/infrastructure/config"
 	"mkanban/pkg/filesystem"
 )
 
-// ListTasksUseCase handles listing all tasks for a board
+// ListTasksUseCase handles listing and querying the tasks of a board
 type ListTasksUseCase struct {
 	boardRepo repository.BoardRepository
 	config    *config.Config
@@ -27,6 +29,17 @@ func NewListTasksUseCase(boardRepo repository.BoardRepository, cfg *config.Confi
 
 // Execute lists all tasks for a given board with their file paths
 func (uc *ListTasksUseCase) Execute(ctx context.Context, boardID string) ([]dto.TaskDTO, error) {
+	return uc.Query(ctx, boardID, dto.TaskQuery{})
+}
+
+// Query lists the tasks of a board that match the query conditions. Conditions
+// may name custom fields, which compare by their type.
+func (uc *ListTasksUseCase) Query(ctx context.Context, boardID string, query dto.TaskQuery) ([]dto.TaskDTO, error) {
+	conditions, err := conditionGroupFromQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
 	board, err := uc.boardRepo.FindByID(ctx, boardID)
 	if err != nil {
 		return nil, err
@@ -38,6 +51,10 @@ func (uc *ListTasksUseCase) Execute(ctx context.Context, boardID string) ([]dto.
 	// Iterate through all columns and their tasks
 	for _, column := range board.Columns() {
 		for _, task := range column.Tasks() {
+			if !conditions.Evaluate(task, column) {
+				continue
+			}
+
 			taskFolderName := task.ID().String()
 			filePath, err := buildTaskFilePath(dataPath, boardID, column.Name(), taskFolderName)
 			if err != nil {
@@ -53,6 +70,25 @@ func (uc *ListTasksUseCase) Execute(ctx context.Context, boardID string) ([]dto.
 	return result, nil
 }
 
+// conditionGroupFromQuery builds the condition group a query describes
+func conditionGroupFromQuery(query dto.TaskQuery) (*entity.ConditionGroup, error) {
+	operator := entity.LogicalAnd
+	if query.MatchAny {
+		operator = entity.LogicalOr
+	}
+
+	conditions := make([]*entity.Condition, 0, len(query.Conditions))
+	for _, c := range query.Conditions {
+		op := entity.ConditionOperator(c.Operator)
+		if !op.IsValid() {
+			return nil, fmt.Errorf("%w: %q", entity.ErrInvalidConditionOperator, c.Operator)
+		}
+		conditions = append(conditions, entity.NewCondition(c.Field, op, c.Value))
+	}
+
+	return entity.NewConditionGroup(operator, conditions...), nil
+}
+
 func buildTaskFilePath(dataPath, boardID, columnName, taskFolderName string) (string, error) {
 	projectSlug, boardSlug, err := valueobject.ParseBoardID(boardID)
 	if err != nil {
@@ -0,0 +1,211 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/valueobject"
+)
+
+// RecordActivityUseCase handles turning task domain events into entries in
+// the activity log of the task they concern
+type RecordActivityUseCase struct {
+	boardRepo    repository.BoardRepository
+	activityRepo repository.ActivityRepository
+}
+
+// NewRecordActivityUseCase creates a new RecordActivityUseCase
+func NewRecordActivityUseCase(boardRepo repository.BoardRepository, activityRepo repository.ActivityRepository) *RecordActivityUseCase {
+	return &RecordActivityUseCase{
+		boardRepo:    boardRepo,
+		activityRepo: activityRepo,
+	}
+}
+
+// Execute records an event in the task's activity log. Events that do not
+// describe a change to a task that still exists are ignored.
+func (uc *RecordActivityUseCase) Execute(ctx context.Context, event *entity.DomainEvent) error {
+	if event.TaskID == nil || event.BoardID == "" {
+		return nil
+	}
+
+	entry, err := activityFromEvent(event)
+	if err != nil || entry == nil {
+		return err
+	}
+
+	board, err := uc.boardRepo.FindByID(ctx, event.BoardID)
+	if err != nil {
+		return err
+	}
+
+	// Look the task up again since it may have moved since the event was published
+	task, column := findTask(board, event.TaskID.String())
+	if task == nil {
+		return nil
+	}
+
+	if err := uc.activityRepo.Append(ctx, event.BoardID, column.Name(), task.ID(), entry); err != nil {
+		return fmt.Errorf("failed to record activity for %s: %w", task.ID().ShortID(), err)
+	}
+
+	return nil
+}
+
+// activityFromEvent builds the activity entry for an event, or returns nil
+// when the event is not part of the task timeline
+func activityFromEvent(event *entity.DomainEvent) (*entity.ActivityEntry, error) {
+	var (
+		activityType entity.ActivityType
+		message      string
+		details      = make(map[string]string)
+	)
+
+	from := eventMetadata(event, "from")
+	to := eventMetadata(event, "to")
+
+	switch event.Type {
+	case valueobject.EventTaskCreated:
+		activityType = entity.ActivityCreated
+		message = "Created"
+		if event.ColumnID != "" {
+			message = fmt.Sprintf("Created in %s", event.ColumnID)
+			details["column"] = event.ColumnID
+		}
+
+	case valueobject.EventTaskMoved:
+		activityType = entity.ActivityMoved
+		fromColumn := eventMetadata(event, "from_column")
+		message = fmt.Sprintf("Moved to %s", event.ColumnID)
+		if fromColumn != "" {
+			message = fmt.Sprintf("Moved from %s to %s", fromColumn, event.ColumnID)
+		}
+		details["from"] = fromColumn
+		details["to"] = event.ColumnID
+
+	case valueobject.EventTaskStatusChanged:
+		activityType = entity.ActivityStatusChanged
+		message = fmt.Sprintf("Status changed from %s to %s", from, to)
+		details["from"], details["to"] = from, to
+
+	case valueobject.EventTaskPriorityChanged:
+		activityType = entity.ActivityPriorityChanged
+		message = fmt.Sprintf("Priority changed from %s to %s", from, to)
+		details["from"], details["to"] = from, to
+
+	case valueobject.EventTaskDueDateSet, valueobject.EventTaskDueDateChanged:
+		activityType = entity.ActivityDueDateChanged
+		switch {
+		case to == "":
+			message = "Due date cleared"
+		case from == "":
+			message = fmt.Sprintf("Due date set to %s", to)
+		default:
+			message = fmt.Sprintf("Due date changed from %s to %s", from, to)
+		}
+		details["from"], details["to"] = from, to
+
+	case valueobject.EventTaskTagsChanged:
+		activityType = entity.ActivityTagsChanged
+		added := eventMetadata(event, "added")
+		removed := eventMetadata(event, "removed")
+		var parts []string
+		if added != "" {
+			parts = append(parts, "added "+added)
+		}
+		if removed != "" {
+			parts = append(parts, "removed "+removed)
+		}
+		if len(parts) == 0 {
+			return nil, nil
+		}
+		message = "Tags " + strings.Join(parts, ", ")
+		details["added"], details["removed"] = added, removed
+
+	case valueobject.EventTaskCompleted:
+		activityType = entity.ActivityCompleted
+		message = "Completed"
+
+	case valueobject.EventTaskTimeLogged:
+		activityType = entity.ActivityTimeLogged
+		seconds := eventMetadata(event, "duration")
+		duration, err := time.ParseDuration(seconds + "s")
+		if err != nil {
+			return nil, nil
+		}
+		message = fmt.Sprintf("Logged %s", formatLoggedDuration(duration))
+		if description := eventMetadata(event, "description"); description != "" {
+			message += ": " + description
+		}
+		details["duration"] = seconds
+		details["time_log_id"] = eventMetadata(event, "time_log_id")
+
+	case valueobject.EventTaskCommitLinked:
+		activityType = entity.ActivityCommitLinked
+		hash := eventMetadata(event, "hash")
+		shortHash := hash
+		if len(shortHash) > 7 {
+			shortHash = shortHash[:7]
+		}
+		message = fmt.Sprintf("Linked commit %s", shortHash)
+		if subject := eventMetadata(event, "message"); subject != "" {
+			message += ": " + subject
+		}
+		details["hash"] = hash
+
+	case valueobject.EventTaskUpdated:
+		// Status, priority, due date and tag changes have events of their own
+		activityType = entity.ActivityUpdated
+		switch {
+		case eventMetadata(event, "attachment_added") != "":
+			message = fmt.Sprintf("Attached %s", eventMetadata(event, "attachment_added"))
+		case eventMetadata(event, "attachment_removed") != "":
+			message = fmt.Sprintf("Removed attachment %s", eventMetadata(event, "attachment_removed"))
+		case eventMetadata(event, "scheduled_date") != "":
+			message = fmt.Sprintf("Scheduled for %s", eventMetadata(event, "scheduled_date"))
+		case eventMetadata(event, "fields") != "":
+			message = fmt.Sprintf("Updated %s", strings.ReplaceAll(eventMetadata(event, "fields"), ",", ", "))
+			details["fields"] = eventMetadata(event, "fields")
+		default:
+			return nil, nil
+		}
+
+	default:
+		// Comments are written by AddCommentUseCase; other events are not part of the timeline
+		return nil, nil
+	}
+
+	entry, err := entity.NewActivityEntry(uuid.New().String(), activityType, message, event.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.SetAuthor(eventMetadata(event, "author"))
+	for key, value := range details {
+		entry.SetDetail(key, value)
+	}
+
+	return entry, nil
+}
+
+// formatLoggedDuration formats a logged duration to the minute, e.g. 1h30m
+func formatLoggedDuration(d time.Duration) string {
+	if d < time.Minute {
+		return d.Round(time.Second).String()
+	}
+	return strings.TrimSuffix(d.Round(time.Minute).String(), "0s")
+}
+
+// eventMetadata returns an event metadata value as a string
+func eventMetadata(event *entity.DomainEvent, key string) string {
+	value, ok := event.Metadata[key]
+	if !ok || value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
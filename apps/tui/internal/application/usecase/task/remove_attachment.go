@@ -0,0 +1,52 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+)
+
+// RemoveAttachmentUseCase handles deleting a file attached to a task
+type RemoveAttachmentUseCase struct {
+	boardRepo      repository.BoardRepository
+	attachmentRepo repository.AttachmentRepository
+}
+
+// NewRemoveAttachmentUseCase creates a new RemoveAttachmentUseCase
+func NewRemoveAttachmentUseCase(boardRepo repository.BoardRepository, attachmentRepo repository.AttachmentRepository) *RemoveAttachmentUseCase {
+	return &RemoveAttachmentUseCase{
+		boardRepo:      boardRepo,
+		attachmentRepo: attachmentRepo,
+	}
+}
+
+// Execute removes an attachment from a task and deletes its file
+func (uc *RemoveAttachmentUseCase) Execute(ctx context.Context, boardID string, taskIDStr string, name string) (*dto.TaskDTO, error) {
+	board, err := uc.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	task, column := findTask(board, taskIDStr)
+	if task == nil {
+		return nil, entity.ErrTaskNotFound
+	}
+
+	if err := task.RemoveAttachment(name); err != nil {
+		return nil, err
+	}
+
+	if err := uc.attachmentRepo.Remove(ctx, boardID, column.Name(), task.ID(), name); err != nil {
+		return nil, err
+	}
+
+	if err := uc.boardRepo.SaveTask(ctx, boardID, column.Name(), task); err != nil {
+		return nil, fmt.Errorf("failed to save task: %w", err)
+	}
+
+	taskDTO := dto.TaskToDTO(task)
+	return &taskDTO, nil
+}
@@ -0,0 +1,45 @@
+package task
+
+import (
+	"context"
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/service"
+	"mkanban/internal/domain/valueobject"
+)
+
+// SetTaskParentUseCase handles nesting a task under another task
+type SetTaskParentUseCase struct {
+	boardService *service.BoardService
+}
+
+// NewSetTaskParentUseCase creates a new SetTaskParentUseCase
+func NewSetTaskParentUseCase(boardService *service.BoardService) *SetTaskParentUseCase {
+	return &SetTaskParentUseCase{
+		boardService: boardService,
+	}
+}
+
+// Execute nests a task under the parent task, or makes it a top-level task
+// if parentIDStr is empty
+func (uc *SetTaskParentUseCase) Execute(ctx context.Context, boardID string, taskIDStr string, parentIDStr string) (*dto.TaskDTO, error) {
+	taskID, err := valueobject.ParseTaskID(taskIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var parentID *valueobject.TaskID
+	if parentIDStr != "" {
+		parentID, err = valueobject.ParseTaskID(parentIDStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	board, task, err := uc.boardService.SetTaskParent(ctx, boardID, taskID, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskDTO := dto.BoardTaskToDTO(board, task, nil)
+	return &taskDTO, nil
+}
@@ -54,6 +54,8 @@ func (uc *UpdateTaskUseCase) Execute(ctx context.Context, boardID string, taskID
 		req.Description,
 		priority,
 		status,
+		req.EstimatedTime,
+		req.Fields,
 	)
 	if err != nil {
 		return nil, err
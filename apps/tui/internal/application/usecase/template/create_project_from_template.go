@@ -0,0 +1,82 @@
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/service"
+)
+
+// CreateProjectFromTemplateUseCase handles creating a project with the boards
+// and notes of a project template
+type CreateProjectFromTemplateUseCase struct {
+	projectRepo          repository.ProjectRepository
+	noteRepo             repository.NoteRepository
+	templateRepo         repository.ProjectTemplateRepository
+	boardTemplateService *service.BoardTemplateService
+}
+
+// NewCreateProjectFromTemplateUseCase creates a new CreateProjectFromTemplateUseCase
+func NewCreateProjectFromTemplateUseCase(
+	projectRepo repository.ProjectRepository,
+	noteRepo repository.NoteRepository,
+	templateRepo repository.ProjectTemplateRepository,
+	boardTemplateService *service.BoardTemplateService,
+) *CreateProjectFromTemplateUseCase {
+	return &CreateProjectFromTemplateUseCase{
+		projectRepo:          projectRepo,
+		noteRepo:             noteRepo,
+		templateRepo:         templateRepo,
+		boardTemplateService: boardTemplateService,
+	}
+}
+
+// Execute saves a new project, then creates each board of the template from
+// its board template (the default one if unset) and the template notes.
+// It returns the created boards.
+func (uc *CreateProjectFromTemplateUseCase) Execute(ctx context.Context, project *entity.Project, templateName string) ([]dto.BoardDTO, error) {
+	template, err := uc.templateRepo.FindByName(ctx, templateName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.projectRepo.Save(ctx, project); err != nil {
+		return nil, err
+	}
+
+	boards := make([]dto.BoardDTO, 0, len(template.Boards()))
+	for _, blueprint := range template.Boards() {
+		boardTemplate := blueprint.Template
+		if boardTemplate == "" {
+			boardTemplate = entity.DefaultBoardTemplateName
+		}
+
+		board, err := uc.boardTemplateService.CreateBoard(ctx, project.Slug(), blueprint.Name, blueprint.Description, boardTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create board %s: %w", blueprint.Name, err)
+		}
+		boards = append(boards, dto.BoardToDTO(board))
+	}
+
+	for _, blueprint := range template.Notes() {
+		note, err := entity.NewNote(uuid.New().String(), blueprint.Title, blueprint.Type)
+		if err != nil {
+			return nil, err
+		}
+		note.SetProjectID(project.ID())
+		note.SetContent(blueprint.Content)
+		for _, tag := range blueprint.Tags {
+			note.AddTag(tag)
+		}
+
+		if err := uc.noteRepo.Save(ctx, note); err != nil {
+			return nil, fmt.Errorf("failed to create note %s: %w", blueprint.Title, err)
+		}
+	}
+
+	return boards, nil
+}
@@ -0,0 +1,93 @@
+package template
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/service"
+)
+
+// CreateTaskFromTemplateUseCase handles creating a task from a task template
+type CreateTaskFromTemplateUseCase struct {
+	boardService *service.BoardService
+	boardRepo    repository.BoardRepository
+	templateRepo repository.TaskTemplateRepository
+}
+
+// NewCreateTaskFromTemplateUseCase creates a new CreateTaskFromTemplateUseCase
+func NewCreateTaskFromTemplateUseCase(
+	boardService *service.BoardService,
+	boardRepo repository.BoardRepository,
+	templateRepo repository.TaskTemplateRepository,
+) *CreateTaskFromTemplateUseCase {
+	return &CreateTaskFromTemplateUseCase{
+		boardService: boardService,
+		boardRepo:    boardRepo,
+		templateRepo: templateRepo,
+	}
+}
+
+// Execute creates a task from a template of the board's project or a global one.
+// Checkboxes in the rendered description become subtasks like in any new task.
+func (uc *CreateTaskFromTemplateUseCase) Execute(ctx context.Context, boardID string, req dto.CreateTaskFromTemplateRequest) (*dto.TaskDTO, error) {
+	board, err := uc.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := uc.templateRepo.FindByName(ctx, board.ProjectID(), req.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered, err := template.Render(req.Variables, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	columnName := req.ColumnName
+	if columnName == "" {
+		columnName = template.Column()
+	}
+	if columnName == "" {
+		firstColumn, err := board.GetColumnByIndex(0)
+		if err != nil {
+			return nil, err
+		}
+		columnName = firstColumn.Name()
+	}
+
+	board, task, err := uc.boardService.CreateTaskWithRequiredFields(
+		ctx,
+		boardID,
+		columnName,
+		rendered.Title,
+		rendered.Description,
+		template.Priority(),
+		rendered.Fields,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, column, err := board.FindTask(task.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	if tags := template.Tags(); len(tags) > 0 {
+		for _, tag := range tags {
+			task.AddTag(tag)
+		}
+		if err := uc.boardRepo.SaveTask(ctx, boardID, column.Name(), task); err != nil {
+			return nil, fmt.Errorf("failed to save task tags: %w", err)
+		}
+	}
+
+	taskDTO := dto.TaskToDTO(task)
+	taskDTO.ColumnName = column.Name()
+	return &taskDTO, nil
+}
@@ -0,0 +1,139 @@
+package template
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/service"
+	"mkanban/internal/infrastructure/config"
+	"mkanban/internal/infrastructure/persistence/filesystem"
+)
+
+// newTemplateTestUseCase saves a work project with a tracker board, To Do
+// then Triage, and returns the use case with its template repository
+func newTemplateTestUseCase(t *testing.T) (*CreateTaskFromTemplateUseCase, repository.TaskTemplateRepository, repository.BoardRepository) {
+	t.Helper()
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "projects", "work"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	boardRepo := filesystem.NewBoardRepository(root)
+	templateRepo := filesystem.NewTaskTemplateRepository(root)
+
+	board, err := entity.NewBoard("work/tracker", "Tracker", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, name := range []string{"To Do", "Triage"} {
+		column, err := entity.NewColumn(name, "", i, 0, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := board.AddColumn(column); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := boardRepo.Save(context.Background(), board); err != nil {
+		t.Fatal(err)
+	}
+
+	boardService := service.NewBoardService(boardRepo, service.NewValidationService(boardRepo), &config.Config{})
+	return NewCreateTaskFromTemplateUseCase(boardService, boardRepo, templateRepo), templateRepo, boardRepo
+}
+
+// saveTemplate saves a template to a project, or globally for an empty projectID
+func saveTemplate(t *testing.T, templateRepo repository.TaskTemplateRepository, projectID, title string, configure func(*entity.TaskTemplate)) {
+	t.Helper()
+	template, err := entity.NewTaskTemplate("bug", title, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	template.SetProjectID(projectID)
+	if configure != nil {
+		configure(template)
+	}
+	if err := templateRepo.Save(context.Background(), template); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCreateTaskFromTemplate(t *testing.T) {
+	ctx := context.Background()
+	uc, templateRepo, _ := newTemplateTestUseCase(t)
+	saveTemplate(t, templateRepo, "", "Global {{component}} bug", nil)
+	saveTemplate(t, templateRepo, "work", "Fix {{component}} bug", func(template *entity.TaskTemplate) {
+		template.SetColumn("Triage")
+		template.SetTags([]string{"bug"})
+	})
+
+	task, err := uc.Execute(ctx, "work/tracker", dto.CreateTaskFromTemplateRequest{
+		Template:  "bug",
+		Variables: map[string]string{"component": "api"},
+	})
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if task.Title != "Fix api bug" {
+		t.Errorf("expected the project template to shadow the global one, got %q", task.Title)
+	}
+	if task.ColumnName != "triage" || len(task.Tags) != 1 || task.Tags[0] != "bug" {
+		t.Errorf("expected the template column and tags, got %s and %v", task.ColumnName, task.Tags)
+	}
+
+	task, err = uc.Execute(ctx, "work/tracker", dto.CreateTaskFromTemplateRequest{
+		Template:   "bug",
+		ColumnName: "To Do",
+		Variables:  map[string]string{"component": "ui"},
+	})
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if task.ColumnName != "to-do" {
+		t.Errorf("expected the requested column over the template's, got %s", task.ColumnName)
+	}
+}
+
+func TestCreateTaskFromGlobalTemplate(t *testing.T) {
+	uc, templateRepo, _ := newTemplateTestUseCase(t)
+	saveTemplate(t, templateRepo, "", "Global {{component}} bug", nil)
+
+	task, err := uc.Execute(context.Background(), "work/tracker", dto.CreateTaskFromTemplateRequest{
+		Template:  "bug",
+		Variables: map[string]string{"component": "api"},
+	})
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if task.Title != "Global api bug" || task.ColumnName != "to-do" {
+		t.Errorf("expected the global template in the first column, got %q in %s", task.Title, task.ColumnName)
+	}
+}
+
+func TestCreateTaskFromTemplateWithMissingVariables(t *testing.T) {
+	ctx := context.Background()
+	uc, templateRepo, boardRepo := newTemplateTestUseCase(t)
+	saveTemplate(t, templateRepo, "work", "Fix {{component}} bug", nil)
+
+	if _, err := uc.Execute(ctx, "work/tracker", dto.CreateTaskFromTemplateRequest{Template: "bug"}); !errors.Is(err, entity.ErrMissingTemplateVariable) {
+		t.Fatalf("expected the missing variable to be reported, got %v", err)
+	}
+	board, err := boardRepo.FindByID(ctx, "work/tracker")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, column := range board.Columns() {
+		if column.TaskCount() != 0 {
+			t.Errorf("expected no task to be created, got %d in %s", column.TaskCount(), column.Name())
+		}
+	}
+
+	if _, err := uc.Execute(ctx, "work/tracker", dto.CreateTaskFromTemplateRequest{Template: "missing"}); !errors.Is(err, entity.ErrTemplateNotFound) {
+		t.Errorf("expected an unknown template to be reported, got %v", err)
+	}
+}
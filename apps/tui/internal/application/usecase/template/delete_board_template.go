@@ -0,0 +1,25 @@
+package template
+
+import (
+	"context"
+
+	"mkanban/internal/domain/repository"
+)
+
+// DeleteBoardTemplateUseCase handles deleting board templates
+type DeleteBoardTemplateUseCase struct {
+	templateRepo repository.BoardTemplateRepository
+}
+
+// NewDeleteBoardTemplateUseCase creates a new DeleteBoardTemplateUseCase
+func NewDeleteBoardTemplateUseCase(templateRepo repository.BoardTemplateRepository) *DeleteBoardTemplateUseCase {
+	return &DeleteBoardTemplateUseCase{
+		templateRepo: templateRepo,
+	}
+}
+
+// Execute deletes a board template; deleting a saved default template brings
+// back the built-in one
+func (uc *DeleteBoardTemplateUseCase) Execute(ctx context.Context, name string) error {
+	return uc.templateRepo.Delete(ctx, name)
+}
@@ -0,0 +1,24 @@
+package template
+
+import (
+	"context"
+
+	"mkanban/internal/domain/repository"
+)
+
+// DeleteProjectTemplateUseCase handles deleting project templates
+type DeleteProjectTemplateUseCase struct {
+	templateRepo repository.ProjectTemplateRepository
+}
+
+// NewDeleteProjectTemplateUseCase creates a new DeleteProjectTemplateUseCase
+func NewDeleteProjectTemplateUseCase(templateRepo repository.ProjectTemplateRepository) *DeleteProjectTemplateUseCase {
+	return &DeleteProjectTemplateUseCase{
+		templateRepo: templateRepo,
+	}
+}
+
+// Execute deletes a project template
+func (uc *DeleteProjectTemplateUseCase) Execute(ctx context.Context, name string) error {
+	return uc.templateRepo.Delete(ctx, name)
+}
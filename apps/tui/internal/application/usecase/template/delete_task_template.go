@@ -0,0 +1,24 @@
+package template
+
+import (
+	"context"
+
+	"mkanban/internal/domain/repository"
+)
+
+// DeleteTaskTemplateUseCase handles deleting task templates
+type DeleteTaskTemplateUseCase struct {
+	templateRepo repository.TaskTemplateRepository
+}
+
+// NewDeleteTaskTemplateUseCase creates a new DeleteTaskTemplateUseCase
+func NewDeleteTaskTemplateUseCase(templateRepo repository.TaskTemplateRepository) *DeleteTaskTemplateUseCase {
+	return &DeleteTaskTemplateUseCase{
+		templateRepo: templateRepo,
+	}
+}
+
+// Execute deletes a template from a project, or a global template for an empty projectID
+func (uc *DeleteTaskTemplateUseCase) Execute(ctx context.Context, projectID string, name string) error {
+	return uc.templateRepo.Delete(ctx, projectID, name)
+}
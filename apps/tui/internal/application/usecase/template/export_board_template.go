@@ -0,0 +1,43 @@
+package template
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/service"
+	"mkanban/pkg/slug"
+)
+
+// ExportBoardTemplateUseCase handles saving an existing board as a board template
+type ExportBoardTemplateUseCase struct {
+	templateService *service.BoardTemplateService
+	templateRepo    repository.BoardTemplateRepository
+}
+
+// NewExportBoardTemplateUseCase creates a new ExportBoardTemplateUseCase
+func NewExportBoardTemplateUseCase(
+	templateService *service.BoardTemplateService,
+	templateRepo repository.BoardTemplateRepository,
+) *ExportBoardTemplateUseCase {
+	return &ExportBoardTemplateUseCase{
+		templateService: templateService,
+		templateRepo:    templateRepo,
+	}
+}
+
+// Execute saves the board's columns, field schema and board actions as a
+// template, and its top-level tasks as seed tasks if requested
+func (uc *ExportBoardTemplateUseCase) Execute(ctx context.Context, req dto.ExportBoardTemplateRequest) (*dto.BoardTemplateDTO, error) {
+	template, err := uc.templateService.ExportTemplate(ctx, req.BoardID, slug.Generate(req.Name), req.Description, req.IncludeTasks)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.templateRepo.Save(ctx, template); err != nil {
+		return nil, err
+	}
+
+	templateDTO := dto.BoardTemplateToDTO(template)
+	return &templateDTO, nil
+}
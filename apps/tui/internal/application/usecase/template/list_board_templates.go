@@ -0,0 +1,43 @@
+package template
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+)
+
+// ListBoardTemplatesUseCase handles listing board templates
+type ListBoardTemplatesUseCase struct {
+	templateRepo repository.BoardTemplateRepository
+}
+
+// NewListBoardTemplatesUseCase creates a new ListBoardTemplatesUseCase
+func NewListBoardTemplatesUseCase(templateRepo repository.BoardTemplateRepository) *ListBoardTemplatesUseCase {
+	return &ListBoardTemplatesUseCase{
+		templateRepo: templateRepo,
+	}
+}
+
+// Execute lists the saved board templates, plus the built-in default
+// template unless a saved one replaces it
+func (uc *ListBoardTemplatesUseCase) Execute(ctx context.Context) ([]dto.BoardTemplateDTO, error) {
+	templates, err := uc.templateRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dto.BoardTemplateDTO, 0, len(templates)+1)
+	hasDefault := false
+	for _, template := range templates {
+		if template.Name() == entity.DefaultBoardTemplateName {
+			hasDefault = true
+		}
+		result = append(result, dto.BoardTemplateToDTO(template))
+	}
+	if !hasDefault {
+		result = append([]dto.BoardTemplateDTO{dto.BoardTemplateToDTO(entity.NewDefaultBoardTemplate())}, result...)
+	}
+	return result, nil
+}
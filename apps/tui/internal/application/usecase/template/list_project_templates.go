@@ -0,0 +1,34 @@
+package template
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/repository"
+)
+
+// ListProjectTemplatesUseCase handles listing project templates
+type ListProjectTemplatesUseCase struct {
+	templateRepo repository.ProjectTemplateRepository
+}
+
+// NewListProjectTemplatesUseCase creates a new ListProjectTemplatesUseCase
+func NewListProjectTemplatesUseCase(templateRepo repository.ProjectTemplateRepository) *ListProjectTemplatesUseCase {
+	return &ListProjectTemplatesUseCase{
+		templateRepo: templateRepo,
+	}
+}
+
+// Execute lists all project templates
+func (uc *ListProjectTemplatesUseCase) Execute(ctx context.Context) ([]dto.ProjectTemplateDTO, error) {
+	templates, err := uc.templateRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dto.ProjectTemplateDTO, 0, len(templates))
+	for _, template := range templates {
+		result = append(result, dto.ProjectTemplateToDTO(template))
+	}
+	return result, nil
+}
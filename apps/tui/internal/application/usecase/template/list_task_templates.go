@@ -0,0 +1,35 @@
+package template
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/repository"
+)
+
+// ListTaskTemplatesUseCase handles listing the task templates available to a project
+type ListTaskTemplatesUseCase struct {
+	templateRepo repository.TaskTemplateRepository
+}
+
+// NewListTaskTemplatesUseCase creates a new ListTaskTemplatesUseCase
+func NewListTaskTemplatesUseCase(templateRepo repository.TaskTemplateRepository) *ListTaskTemplatesUseCase {
+	return &ListTaskTemplatesUseCase{
+		templateRepo: templateRepo,
+	}
+}
+
+// Execute lists the project's templates and the global templates they do not
+// override; an empty projectID lists only global templates
+func (uc *ListTaskTemplatesUseCase) Execute(ctx context.Context, projectID string) ([]dto.TaskTemplateDTO, error) {
+	templates, err := uc.templateRepo.FindAll(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dto.TaskTemplateDTO, 0, len(templates))
+	for _, template := range templates {
+		result = append(result, dto.TaskTemplateToDTO(template))
+	}
+	return result, nil
+}
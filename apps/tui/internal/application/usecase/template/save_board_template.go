@@ -0,0 +1,124 @@
+package template
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/valueobject"
+	"mkanban/pkg/slug"
+)
+
+// SaveBoardTemplateUseCase handles creating and replacing board templates
+type SaveBoardTemplateUseCase struct {
+	templateRepo repository.BoardTemplateRepository
+}
+
+// NewSaveBoardTemplateUseCase creates a new SaveBoardTemplateUseCase
+func NewSaveBoardTemplateUseCase(templateRepo repository.BoardTemplateRepository) *SaveBoardTemplateUseCase {
+	return &SaveBoardTemplateUseCase{
+		templateRepo: templateRepo,
+	}
+}
+
+// Execute validates and saves a board template. The name is turned into a
+// slug, so "Scrum Board" is saved as scrum-board.
+func (uc *SaveBoardTemplateUseCase) Execute(ctx context.Context, req dto.BoardTemplateDTO) (*dto.BoardTemplateDTO, error) {
+	template, err := boardTemplateFromDTO(slug.Generate(req.Name), req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.templateRepo.Save(ctx, template); err != nil {
+		return nil, err
+	}
+
+	templateDTO := dto.BoardTemplateToDTO(template)
+	return &templateDTO, nil
+}
+
+// boardTemplateFromDTO builds a BoardTemplate, validating every part of it
+func boardTemplateFromDTO(name string, req dto.BoardTemplateDTO) (*entity.BoardTemplate, error) {
+	template, err := entity.NewBoardTemplate(name, req.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]entity.ColumnBlueprint, 0, len(req.Columns))
+	for _, column := range req.Columns {
+		columns = append(columns, entity.ColumnBlueprint{
+			Name:        column.Name,
+			Description: column.Description,
+			Order:       column.Order,
+			WIPLimit:    column.WIPLimit,
+			Color:       column.Color,
+		})
+	}
+	if err := template.SetColumns(columns); err != nil {
+		return nil, err
+	}
+
+	fields := make([]entity.FieldDefinition, 0, len(req.Fields))
+	for _, field := range req.Fields {
+		fields = append(fields, dto.FieldDefinitionFromDTO(field))
+	}
+	if err := template.SetFields(fields); err != nil {
+		return nil, err
+	}
+
+	tasks := make([]entity.TaskBlueprint, 0, len(req.Tasks))
+	for _, task := range req.Tasks {
+		priority := valueobject.PriorityNone
+		if task.Priority != "" {
+			priority, err = valueobject.ParsePriority(task.Priority)
+			if err != nil {
+				return nil, err
+			}
+		}
+		tasks = append(tasks, entity.TaskBlueprint{
+			Title:       task.Title,
+			Description: task.Description,
+			Column:      task.Column,
+			Priority:    priority,
+			Tags:        task.Tags,
+			Fields:      task.Fields,
+		})
+	}
+	if err := template.SetTasks(tasks); err != nil {
+		return nil, err
+	}
+
+	actions := make([]entity.ActionBlueprint, 0, len(req.Actions))
+	for _, action := range req.Actions {
+		var offset time.Duration
+		if action.Offset != "" {
+			offset, err = time.ParseDuration(action.Offset)
+			if err != nil {
+				return nil, fmt.Errorf("action %q: invalid offset: %w", action.Name, err)
+			}
+		}
+		actions = append(actions, entity.ActionBlueprint{
+			Name:         action.Name,
+			Description:  action.Description,
+			Event:        valueobject.EventType(action.Event),
+			ScheduleType: valueobject.ScheduleType(action.Schedule),
+			Offset:       offset,
+			Cron:         action.Cron,
+			Type:         entity.ActionTypeEnum(action.Type),
+			Title:        action.Title,
+			Message:      action.Message,
+			ScriptPath:   action.ScriptPath,
+			Column:       action.Column,
+			AddTags:      action.AddTags,
+			RemoveTags:   action.RemoveTags,
+		})
+	}
+	if err := template.SetActions(actions); err != nil {
+		return nil, fmt.Errorf("invalid action: %w", err)
+	}
+
+	return template, nil
+}
@@ -0,0 +1,79 @@
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/service"
+	"mkanban/pkg/slug"
+)
+
+// SaveProjectTemplateUseCase handles creating and replacing project templates
+type SaveProjectTemplateUseCase struct {
+	templateRepo         repository.ProjectTemplateRepository
+	boardTemplateService *service.BoardTemplateService
+}
+
+// NewSaveProjectTemplateUseCase creates a new SaveProjectTemplateUseCase
+func NewSaveProjectTemplateUseCase(
+	templateRepo repository.ProjectTemplateRepository,
+	boardTemplateService *service.BoardTemplateService,
+) *SaveProjectTemplateUseCase {
+	return &SaveProjectTemplateUseCase{
+		templateRepo:         templateRepo,
+		boardTemplateService: boardTemplateService,
+	}
+}
+
+// Execute validates and saves a project template; the board templates it
+// refers to must exist
+func (uc *SaveProjectTemplateUseCase) Execute(ctx context.Context, req dto.ProjectTemplateDTO) (*dto.ProjectTemplateDTO, error) {
+	template, err := entity.NewProjectTemplate(slug.Generate(req.Name), req.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	boards := make([]entity.BoardBlueprint, 0, len(req.Boards))
+	for _, board := range req.Boards {
+		boards = append(boards, entity.BoardBlueprint{
+			Name:        board.Name,
+			Description: board.Description,
+			Template:    board.Template,
+		})
+	}
+	if err := template.SetBoards(boards); err != nil {
+		return nil, err
+	}
+
+	for _, board := range template.Boards() {
+		if board.Template == "" {
+			continue
+		}
+		if _, err := uc.boardTemplateService.FindTemplate(ctx, board.Template); err != nil {
+			return nil, fmt.Errorf("board %s: %w: %s", board.Name, err, board.Template)
+		}
+	}
+
+	notes := make([]entity.NoteBlueprint, 0, len(req.Notes))
+	for _, note := range req.Notes {
+		notes = append(notes, entity.NoteBlueprint{
+			Title:   note.Title,
+			Type:    entity.NoteType(note.Type),
+			Content: note.Content,
+			Tags:    note.Tags,
+		})
+	}
+	if err := template.SetNotes(notes); err != nil {
+		return nil, err
+	}
+
+	if err := uc.templateRepo.Save(ctx, template); err != nil {
+		return nil, err
+	}
+
+	templateDTO := dto.ProjectTemplateToDTO(template)
+	return &templateDTO, nil
+}
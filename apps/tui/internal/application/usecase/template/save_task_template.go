@@ -0,0 +1,64 @@
+package template
+
+import (
+	"context"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/valueobject"
+	"mkanban/pkg/slug"
+)
+
+// SaveTaskTemplateUseCase handles creating and replacing task templates
+type SaveTaskTemplateUseCase struct {
+	templateRepo repository.TaskTemplateRepository
+	projectRepo  repository.ProjectRepository
+}
+
+// NewSaveTaskTemplateUseCase creates a new SaveTaskTemplateUseCase
+func NewSaveTaskTemplateUseCase(templateRepo repository.TaskTemplateRepository, projectRepo repository.ProjectRepository) *SaveTaskTemplateUseCase {
+	return &SaveTaskTemplateUseCase{
+		templateRepo: templateRepo,
+		projectRepo:  projectRepo,
+	}
+}
+
+// Execute saves a template in its project, or globally when no project is set.
+// The name is turned into a slug, so "Bug Report" is saved as bug-report.
+func (uc *SaveTaskTemplateUseCase) Execute(ctx context.Context, req dto.TaskTemplateDTO) (*dto.TaskTemplateDTO, error) {
+	if req.ProjectID != "" {
+		if _, err := uc.projectRepo.FindBySlug(ctx, req.ProjectID); err != nil {
+			return nil, entity.ErrProjectNotFound
+		}
+	}
+
+	template, err := entity.NewTaskTemplate(slug.Generate(req.Name), req.Title, req.Description)
+	if err != nil {
+		return nil, err
+	}
+	template.SetProjectID(req.ProjectID)
+
+	if req.Priority != "" {
+		priority, err := valueobject.ParsePriority(req.Priority)
+		if err != nil {
+			return nil, err
+		}
+		if err := template.SetPriority(priority); err != nil {
+			return nil, err
+		}
+	}
+	template.SetColumn(req.Column)
+	template.SetTags(req.Tags)
+	template.SetFields(req.Fields)
+	for name, defaultValue := range req.Variables {
+		template.SetVariable(name, defaultValue)
+	}
+
+	if err := uc.templateRepo.Save(ctx, template); err != nil {
+		return nil, err
+	}
+
+	templateDTO := dto.TaskTemplateToDTO(template)
+	return &templateDTO, nil
+}
@@ -7,20 +7,24 @@ import (
 
 	"mkanban/internal/domain/entity"
 	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/service"
 )
 
 type TimeReportUseCase struct {
-	timeLogRepo repository.TimeLogRepository
-	projectRepo repository.ProjectRepository
+	timeLogRepo         repository.TimeLogRepository
+	projectRepo         repository.ProjectRepository
+	workScheduleService *service.WorkScheduleService
 }
 
 func NewTimeReportUseCase(
 	timeLogRepo repository.TimeLogRepository,
 	projectRepo repository.ProjectRepository,
+	workScheduleService *service.WorkScheduleService,
 ) *TimeReportUseCase {
 	return &TimeReportUseCase{
-		timeLogRepo: timeLogRepo,
-		projectRepo: projectRepo,
+		timeLogRepo:         timeLogRepo,
+		projectRepo:         projectRepo,
+		workScheduleService: workScheduleService,
 	}
 }
 
@@ -31,6 +35,11 @@ type TimeReport struct {
 	ByDay         []DayTimeEntry
 	ByTask        []TaskTimeEntry
 	BySource      map[entity.TimeLogSource]time.Duration
+	// WorkingTime is the time available in the default schedule over the period
+	WorkingTime time.Duration
+	// OutsideWorkingHours is the logged time that started outside the working
+	// hours of its project
+	OutsideWorkingHours time.Duration
 }
 
 type ReportPeriod struct {
@@ -48,9 +57,10 @@ type ProjectTimeEntry struct {
 }
 
 type DayTimeEntry struct {
-	Date     time.Time
-	Duration time.Duration
-	LogCount int
+	Date        time.Time
+	Duration    time.Duration
+	LogCount    int
+	WorkingTime time.Duration // available in the default schedule
 }
 
 type TaskTimeEntry struct {
@@ -127,6 +137,10 @@ func (u *TimeReportUseCase) generateReport(ctx context.Context, start, end time.
 		if err != nil {
 			continue
 		}
+		schedule, err := u.workScheduleService.Schedule(ctx, project.ID())
+		if err != nil {
+			return nil, err
+		}
 
 		for _, log := range logs {
 			duration := log.Duration()
@@ -140,6 +154,9 @@ func (u *TimeReportUseCase) generateReport(ctx context.Context, start, end time.
 			dayLogCounts[dayKey]++
 
 			report.BySource[log.Source()] += duration
+			if !schedule.IsWithinWorkingHours(log.StartTime()) {
+				report.OutsideWorkingHours += duration
+			}
 
 			if log.TaskID() != nil {
 				taskID := log.TaskID().String()
@@ -176,13 +193,21 @@ func (u *TimeReportUseCase) generateReport(ctx context.Context, start, end time.
 		return report.ByProject[i].Duration > report.ByProject[j].Duration
 	})
 
+	defaultSchedule, err := u.workScheduleService.Schedule(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
 	current := start
 	for !current.After(end) {
 		dayKey := current.Format("2006-01-02")
+		workingTime := time.Duration(defaultSchedule.GetAvailableMinutes(current)) * time.Minute
+		report.WorkingTime += workingTime
 		report.ByDay = append(report.ByDay, DayTimeEntry{
-			Date:     current,
-			Duration: dayDurations[dayKey],
-			LogCount: dayLogCounts[dayKey],
+			Date:        current,
+			Duration:    dayDurations[dayKey],
+			LogCount:    dayLogCounts[dayKey],
+			WorkingTime: workingTime,
 		})
 		current = current.AddDate(0, 0, 1)
 	}
@@ -0,0 +1,50 @@
+package trash
+
+import (
+	"context"
+	"time"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/infrastructure/config"
+)
+
+// ListTrashUseCase handles listing deleted items held in the trash
+type ListTrashUseCase struct {
+	trashRepo repository.TrashRepository
+	config    *config.Config
+}
+
+// NewListTrashUseCase creates a new ListTrashUseCase
+func NewListTrashUseCase(trashRepo repository.TrashRepository, cfg *config.Config) *ListTrashUseCase {
+	return &ListTrashUseCase{
+		trashRepo: trashRepo,
+		config:    cfg,
+	}
+}
+
+// Execute lists trash items, most recently deleted first, optionally limited to one board
+func (uc *ListTrashUseCase) Execute(ctx context.Context, boardID string) ([]dto.TrashItemDTO, error) {
+	items, err := uc.trashRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dto.TrashItemDTO, 0, len(items))
+	for _, item := range items {
+		if boardID != "" && item.BoardID() != boardID {
+			continue
+		}
+		result = append(result, dto.TrashItemToDTO(item, retention(uc.config)))
+	}
+
+	return result, nil
+}
+
+// retention returns how long items stay in the trash, zero meaning forever
+func retention(cfg *config.Config) time.Duration {
+	if cfg.Trash.RetentionDays <= 0 {
+		return 0
+	}
+	return time.Duration(cfg.Trash.RetentionDays) * 24 * time.Hour
+}
@@ -0,0 +1,60 @@
+package trash
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/infrastructure/config"
+)
+
+// PurgeTrashUseCase handles permanently removing items from the trash
+type PurgeTrashUseCase struct {
+	trashRepo repository.TrashRepository
+	config    *config.Config
+}
+
+// NewPurgeTrashUseCase creates a new PurgeTrashUseCase
+func NewPurgeTrashUseCase(trashRepo repository.TrashRepository, cfg *config.Config) *PurgeTrashUseCase {
+	return &PurgeTrashUseCase{
+		trashRepo: trashRepo,
+		config:    cfg,
+	}
+}
+
+// Execute purges a single item when id is set, every item when all is set,
+// and otherwise only the items older than the configured retention period
+func (uc *PurgeTrashUseCase) Execute(ctx context.Context, id string, all bool) (*dto.TrashPurgeDTO, error) {
+	var items []*entity.TrashItem
+	if id != "" {
+		item, err := uc.trashRepo.FindByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	} else {
+		found, err := uc.trashRepo.FindAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		now := time.Now()
+		for _, item := range found {
+			if all || item.IsExpired(retention(uc.config), now) {
+				items = append(items, item)
+			}
+		}
+	}
+
+	result := &dto.TrashPurgeDTO{Purged: make([]dto.TrashItemDTO, 0, len(items))}
+	for _, item := range items {
+		if err := uc.trashRepo.Delete(ctx, item.ID()); err != nil {
+			return result, fmt.Errorf("failed to purge %s: %w", item.ID(), err)
+		}
+		result.Purged = append(result.Purged, dto.TrashItemToDTO(item, retention(uc.config)))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,174 @@
+package trash
+
+import (
+	"context"
+	"fmt"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/valueobject"
+	"mkanban/internal/infrastructure/config"
+)
+
+// RestoreTrashUseCase handles putting trashed items back where they were deleted from
+type RestoreTrashUseCase struct {
+	trashRepo repository.TrashRepository
+	boardRepo repository.BoardRepository
+	config    *config.Config
+}
+
+// NewRestoreTrashUseCase creates a new RestoreTrashUseCase
+func NewRestoreTrashUseCase(
+	trashRepo repository.TrashRepository,
+	boardRepo repository.BoardRepository,
+	cfg *config.Config,
+) *RestoreTrashUseCase {
+	return &RestoreTrashUseCase{
+		trashRepo: trashRepo,
+		boardRepo: boardRepo,
+		config:    cfg,
+	}
+}
+
+// taskRestore is one trashed task with the column and ID it is restored under
+type taskRestore struct {
+	trashedID string
+	column    string
+	taskID    *valueobject.TaskID
+}
+
+// Execute restores a trash item. Tasks whose column no longer exists go to the
+// first column of the board, and tasks whose short ID was taken in the meantime
+// are renumbered with the board's next task number.
+func (uc *RestoreTrashUseCase) Execute(ctx context.Context, id string) (*dto.TrashRestoreDTO, error) {
+	item, err := uc.trashRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.TrashRestoreDTO{
+		Item:    dto.TrashItemToDTO(item, retention(uc.config)),
+		BoardID: item.BoardID(),
+	}
+
+	switch item.Type() {
+	case entity.TrashItemNote:
+		if err := uc.trashRepo.RestoreNote(ctx, item); err != nil {
+			return nil, err
+		}
+	case entity.TrashItemTask, entity.TrashItemColumn:
+		if err := uc.restoreBoardItem(ctx, item, result); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, entity.ErrInvalidTrashItemType
+	}
+
+	if err := uc.trashRepo.Delete(ctx, item.ID()); err != nil {
+		return nil, fmt.Errorf("failed to remove restored item from trash: %w", err)
+	}
+
+	return result, nil
+}
+
+func (uc *RestoreTrashUseCase) restoreBoardItem(ctx context.Context, item *entity.TrashItem, result *dto.TrashRestoreDTO) error {
+	board, err := uc.boardRepo.FindByID(ctx, item.BoardID())
+	if err != nil {
+		return fmt.Errorf("cannot restore into board %s: %w", item.BoardID(), err)
+	}
+
+	// A trashed column is recreated unless a column of the same name exists again,
+	// in which case its tasks are merged into that column
+	restoreColumn := false
+	columnOverride := ""
+	if item.Type() == entity.TrashItemColumn {
+		if existing, err := board.GetColumn(item.ColumnName()); err == nil {
+			columnOverride = existing.Name()
+		} else {
+			restoreColumn = true
+			columnOverride = item.ColumnName()
+		}
+		result.Column = columnOverride
+	}
+
+	usedIDs := make(map[string]bool)
+	for _, column := range board.Columns() {
+		for _, task := range column.Tasks() {
+			usedIDs[task.ID().ShortID()] = true
+		}
+	}
+
+	nextTaskNum := board.NextTaskNum()
+	renamed := make(map[string]string)
+	restores := make([]taskRestore, 0, len(item.Tasks()))
+	for _, trashed := range item.Tasks() {
+		taskID, err := valueobject.ParseTaskID(trashed.TaskID)
+		if err != nil {
+			return fmt.Errorf("invalid trashed task %s: %w", trashed.TaskID, err)
+		}
+
+		column, err := uc.targetColumn(board, trashed.Column, columnOverride)
+		if err != nil {
+			return err
+		}
+
+		if usedIDs[taskID.ShortID()] {
+			newID, err := board.GenerateNextTaskID(taskID.Slug())
+			if err != nil {
+				return err
+			}
+			renamed[taskID.ShortID()] = newID.ShortID()
+			taskID = newID
+		} else {
+			board.SetNextTaskNum(taskID.Number() + 1)
+		}
+		usedIDs[taskID.ShortID()] = true
+
+		restores = append(restores, taskRestore{trashedID: trashed.TaskID, column: column, taskID: taskID})
+		if result.Column == "" {
+			result.Column = column
+		}
+	}
+
+	// The board is saved before any folder moves back: saving rewrites the board
+	// from the loaded entity and would drop folders it does not know about
+	if board.NextTaskNum() != nextTaskNum {
+		if err := uc.boardRepo.Save(ctx, board); err != nil {
+			return fmt.Errorf("failed to save board: %w", err)
+		}
+	}
+
+	if restoreColumn {
+		if err := uc.trashRepo.RestoreColumn(ctx, item); err != nil {
+			return err
+		}
+	}
+
+	for _, restore := range restores {
+		if err := uc.trashRepo.RestoreTask(ctx, item, restore.trashedID, restore.column, restore.taskID, renamed); err != nil {
+			return err
+		}
+	}
+
+	if len(renamed) > 0 {
+		result.Renamed = renamed
+	}
+	return nil
+}
+
+// targetColumn picks the folder name of the column a task is restored into
+func (uc *RestoreTrashUseCase) targetColumn(board *entity.Board, original string, override string) (string, error) {
+	if override != "" {
+		return override, nil
+	}
+	if column, err := board.GetColumn(original); err == nil {
+		return column.Name(), nil
+	}
+
+	column, err := board.GetColumnByIndex(0)
+	if err != nil {
+		return "", fmt.Errorf("board %s has no column to restore into: %w", board.ID(), err)
+	}
+	return column.Name(), nil
+}
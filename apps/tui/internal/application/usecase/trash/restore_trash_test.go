@@ -0,0 +1,159 @@
+package trash
+
+import (
+	"context"
+	"testing"
+
+	"mkanban/internal/application/usecase/task"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/valueobject"
+	"mkanban/internal/infrastructure/config"
+	"mkanban/internal/infrastructure/persistence/filesystem"
+)
+
+// addTestTask adds a task to a column of the board
+func addTestTask(t *testing.T, board *entity.Board, columnName, slug string) *entity.Task {
+	t.Helper()
+	taskID, err := board.GenerateNextTaskID(slug)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newTask, err := entity.NewTask(taskID, slug, "", valueobject.PriorityMedium, valueobject.StatusTodo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	column, err := board.GetColumn(columnName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := column.AddTask(newTask); err != nil {
+		t.Fatal(err)
+	}
+	return newTask
+}
+
+func TestRestoreTaskIntoMissingColumnRenumbersTakenIDs(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	boardRepo := filesystem.NewBoardRepository(root)
+	trashRepo := filesystem.NewTrashRepository(root)
+
+	board, err := entity.NewBoard("work/tracker", "Tracker", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, name := range []string{"To Do", "Doing"} {
+		column, err := entity.NewColumn(name, "", i, 0, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := board.AddColumn(column); err != nil {
+			t.Fatal(err)
+		}
+	}
+	parent := addTestTask(t, board, "Doing", "parent")
+	subtask := addTestTask(t, board, "Doing", "subtask")
+	if err := board.SetTaskParent(subtask.ID(), parent.ID()); err != nil {
+		t.Fatal(err)
+	}
+	if err := boardRepo.Save(ctx, board); err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := task.NewDeleteTaskUseCase(boardRepo, trashRepo).Execute(ctx, board.ID(), parent.ID().ShortID())
+	if err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+
+	// Drop the column the tasks came from, and give the parent's short ID to a
+	// task that arrived while it was in the trash
+	board, err = boardRepo.FindByID(ctx, board.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := board.RemoveColumn("Doing"); err != nil {
+		t.Fatal(err)
+	}
+	takenID, err := valueobject.ParseTaskID(parent.ID().ShortID() + "-taken")
+	if err != nil {
+		t.Fatal(err)
+	}
+	taken, err := entity.NewTask(takenID, "taken", "", valueobject.PriorityMedium, valueobject.StatusTodo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	toDo, err := board.GetColumn("To Do")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := toDo.AddTask(taken); err != nil {
+		t.Fatal(err)
+	}
+	if err := boardRepo.Save(ctx, board); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := NewRestoreTrashUseCase(trashRepo, boardRepo, &config.Config{}).Execute(ctx, item.ID)
+	if err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+
+	board, err = boardRepo.FindByID(ctx, board.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	firstColumn, err := board.GetColumnByIndex(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Column != firstColumn.Name() {
+		t.Errorf("expected the tasks to go to the first column %s, got %s", firstColumn.Name(), result.Column)
+	}
+
+	// Only the parent is renumbered; the subtask keeps its free ID
+	newParentID := result.Renamed[parent.ID().ShortID()]
+	if newParentID == "" || newParentID == taken.ID().ShortID() || len(result.Renamed) != 1 {
+		t.Fatalf("expected only %s to be renumbered, got %v", parent.ID().ShortID(), result.Renamed)
+	}
+	newSubtaskID := subtask.ID().ShortID()
+
+	restoredParent, column, err := board.FindTask(findShortID(t, board, newParentID))
+	if err != nil {
+		t.Fatalf("restored parent %s not found: %v", newParentID, err)
+	}
+	if column.Name() != firstColumn.Name() {
+		t.Errorf("expected %s in %s, got %s", newParentID, firstColumn.Name(), column.Name())
+	}
+	if restoredParent.Title() != "parent" {
+		t.Errorf("expected the parent's title to survive, got %q", restoredParent.Title())
+	}
+
+	restoredSubtask, _, err := board.FindTask(findShortID(t, board, newSubtaskID))
+	if err != nil {
+		t.Fatalf("restored subtask %s not found: %v", newSubtaskID, err)
+	}
+	if restoredSubtask.ParentID() == nil || restoredSubtask.ParentID().ShortID() != newParentID {
+		t.Errorf("expected the subtask to follow its parent to %s, got %v", newParentID, restoredSubtask.ParentID())
+	}
+
+	if _, _, err := board.FindTask(taken.ID()); err != nil {
+		t.Errorf("expected %s to keep its ID: %v", taken.ID().ShortID(), err)
+	}
+	if _, err := trashRepo.FindByID(ctx, item.ID); err == nil {
+		t.Error("expected the restored item to be removed from the trash")
+	}
+}
+
+// findShortID returns the full ID of the board task with a short ID
+func findShortID(t *testing.T, board *entity.Board, shortID string) *valueobject.TaskID {
+	t.Helper()
+	for _, column := range board.Columns() {
+		for _, task := range column.Tasks() {
+			if task.ID().ShortID() == shortID {
+				return task.ID()
+			}
+		}
+	}
+	t.Fatalf("no task %s on board %s", shortID, board.ID())
+	return nil
+}
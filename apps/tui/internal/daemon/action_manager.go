@@ -9,6 +9,7 @@ import (
 	"mkanban/internal/application/usecase/action"
 	"mkanban/internal/domain/entity"
 	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/service"
 	"mkanban/internal/domain/valueobject"
 	"mkanban/internal/infrastructure/config"
 )
@@ -20,6 +21,7 @@ type ActionManager struct {
 	executeUseCase      *action.ExecuteActionUseCase
 	processEventUseCase *action.ProcessEventUseCase
 	actionRepo          repository.ActionRepository
+	workScheduleService *service.WorkScheduleService
 	eventBus            entity.EventBus
 
 	ctx        context.Context
@@ -34,6 +36,7 @@ func NewActionManager(
 	executeUseCase *action.ExecuteActionUseCase,
 	processEventUseCase *action.ProcessEventUseCase,
 	actionRepo repository.ActionRepository,
+	workScheduleService *service.WorkScheduleService,
 	eventBus entity.EventBus,
 ) *ActionManager {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -44,6 +47,7 @@ func NewActionManager(
 		executeUseCase:      executeUseCase,
 		processEventUseCase: processEventUseCase,
 		actionRepo:          actionRepo,
+		workScheduleService: workScheduleService,
 		eventBus:            eventBus,
 		ctx:                 ctx,
 		cancelFunc:          cancel,
@@ -117,6 +121,16 @@ func (m *ActionManager) checkTimeBasedActions() {
 		return
 	}
 
+	if m.config.Actions.WorkingHoursOnly {
+		// Time-based actions are only checked within working hours
+		working, err := m.workScheduleService.IsWorkingTime(ctx, "", time.Now())
+		if err != nil {
+			fmt.Printf("Failed to check working hours: %v\n", err)
+		} else if !working {
+			return
+		}
+	}
+
 	fmt.Printf("Checking %d time-based actions...\n", len(actions))
 
 	// Evaluate each action
@@ -196,6 +210,8 @@ func (m *ActionManager) subscribeToEvents() {
 	m.eventBus.Subscribe("column.created", handler)
 	m.eventBus.Subscribe("column.deleted", handler)
 	m.eventBus.Subscribe("column.wip_reached", handler)
+	m.eventBus.Subscribe("milestone.at_risk", handler)
+	m.eventBus.Subscribe("task.block_missed", handler)
 
 	fmt.Println("Subscribed to domain events")
 }
@@ -0,0 +1,101 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"mkanban/internal/application/usecase/task"
+	"mkanban/internal/domain/entity"
+)
+
+// activityEventBuffer is how many events may wait to be recorded
+const activityEventBuffer = 64
+
+// ActivityManager records task domain events in the activity log of the
+// task they concern
+type ActivityManager struct {
+	record   *task.RecordActivityUseCase
+	eventBus entity.EventBus
+	dataLock sync.Locker // held while an entry is written to a task folder
+
+	events chan *entity.DomainEvent
+
+	ctx        context.Context
+	cancelFunc context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+// NewActivityManager creates a new ActivityManager. dataLock must keep daemon
+// mutations, which may move task folders, out while an entry is written.
+func NewActivityManager(record *task.RecordActivityUseCase, eventBus entity.EventBus, dataLock sync.Locker) *ActivityManager {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &ActivityManager{
+		record:     record,
+		eventBus:   eventBus,
+		dataLock:   dataLock,
+		events:     make(chan *entity.DomainEvent, activityEventBuffer),
+		ctx:        ctx,
+		cancelFunc: cancel,
+	}
+}
+
+// Start begins recording task activity
+func (m *ActivityManager) Start() error {
+	m.eventBus.SubscribeAll(func(event *entity.DomainEvent) {
+		if event.TaskID == nil {
+			return
+		}
+		select {
+		case m.events <- event:
+		case <-m.ctx.Done():
+		}
+	})
+
+	m.wg.Add(1)
+	go m.run()
+
+	fmt.Println("[Activity] Recording task activity")
+	return nil
+}
+
+// Stop records events that are still queued and stops recording
+func (m *ActivityManager) Stop() error {
+	m.cancelFunc()
+	m.wg.Wait()
+	return nil
+}
+
+// run records events one at a time as they are received. The event bus
+// delivers each event on its own goroutine, so they may arrive out of order;
+// entries keep the event timestamp and the log is sorted by it when read.
+func (m *ActivityManager) run() {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case event := <-m.events:
+			m.recordEvent(event)
+		case <-m.ctx.Done():
+			// Drain what was queued before shutdown
+			for {
+				select {
+				case event := <-m.events:
+					m.recordEvent(event)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (m *ActivityManager) recordEvent(event *entity.DomainEvent) {
+	m.dataLock.Lock()
+	defer m.dataLock.Unlock()
+
+	if err := m.record.Execute(context.Background(), event); err != nil {
+		fmt.Printf("[Activity] Failed to record %s: %v\n", event.Type, err)
+	}
+}
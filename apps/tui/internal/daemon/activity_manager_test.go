@@ -0,0 +1,179 @@
+package daemon
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/application/usecase/task"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/valueobject"
+	"mkanban/internal/infrastructure/persistence/filesystem"
+	"mkanban/internal/infrastructure/service"
+)
+
+// newActivityTestBoard saves a board with To Do and Doing columns and one
+// task in Doing, and returns the task ID
+func newActivityTestBoard(t *testing.T, boardRepo repository.BoardRepository) *valueobject.TaskID {
+	t.Helper()
+	board, err := entity.NewBoard("work/tracker", "Tracker", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doing *entity.Column
+	for i, name := range []string{"To Do", "Doing"} {
+		column, err := entity.NewColumn(name, "", i, 0, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := board.AddColumn(column); err != nil {
+			t.Fatal(err)
+		}
+		doing = column
+	}
+	taskID, err := board.GenerateNextTaskID("fix-bug")
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, err := entity.NewTask(taskID, "fix-bug", "", valueobject.PriorityMedium, valueobject.StatusTodo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := doing.AddTask(created); err != nil {
+		t.Fatal(err)
+	}
+	if err := boardRepo.Save(context.Background(), board); err != nil {
+		t.Fatal(err)
+	}
+	return taskID
+}
+
+// waitForActivity reads a task's timeline until it has want entries
+func waitForActivity(t *testing.T, uc *task.GetTaskActivityUseCase, taskID string, want int) *dto.TaskActivityDTO {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		activity, err := uc.Execute(context.Background(), "work/tracker", taskID)
+		if err != nil {
+			t.Fatalf("read activity failed: %v", err)
+		}
+		if len(activity.Entries) >= want || time.Now().After(deadline) {
+			return activity
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestActivityManagerRecordsTimeline(t *testing.T) {
+	root := t.TempDir()
+	boardRepo := filesystem.NewBoardRepository(root)
+	activityRepo := filesystem.NewActivityRepository(root)
+	taskID := newActivityTestBoard(t, boardRepo)
+
+	bus := service.NewEventBus()
+	m := NewActivityManager(task.NewRecordActivityUseCase(boardRepo, activityRepo), bus, &sync.Mutex{})
+	if err := m.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		eventType   valueobject.EventType
+		column      string
+		metadata    map[string]interface{}
+		wantType    entity.ActivityType
+		wantMessage string // empty when the event is not part of the timeline
+		wantDetails map[string]string
+	}{
+		{valueobject.EventTaskCreated, "to-do", map[string]interface{}{"author": "ana"},
+			entity.ActivityCreated, "Created in to-do", map[string]string{"column": "to-do"}},
+		{valueobject.EventTaskMoved, "doing", map[string]interface{}{"from_column": "to-do"},
+			entity.ActivityMoved, "Moved from to-do to doing", map[string]string{"from": "to-do", "to": "doing"}},
+		{valueobject.EventTaskPriorityChanged, "doing", map[string]interface{}{"from": "medium", "to": "high"},
+			entity.ActivityPriorityChanged, "Priority changed from medium to high", map[string]string{"from": "medium", "to": "high"}},
+		{valueobject.EventTaskDueDateSet, "doing", map[string]interface{}{"from": "", "to": "2026-10-20"},
+			entity.ActivityDueDateChanged, "Due date set to 2026-10-20", nil},
+		{valueobject.EventTaskTagsChanged, "doing", map[string]interface{}{"added": "backend"},
+			entity.ActivityTagsChanged, "Tags added backend", map[string]string{"added": "backend"}},
+		{valueobject.EventTaskTagsChanged, "doing", map[string]interface{}{},
+			"", "", nil},
+		{valueobject.EventTaskTimeLogged, "doing", map[string]interface{}{"duration": 5400, "description": "debugging"},
+			entity.ActivityTimeLogged, "Logged 1h30m: debugging", map[string]string{"duration": "5400"}},
+		{valueobject.EventTaskCommitLinked, "doing", map[string]interface{}{"hash": "0123456789abcdef", "message": "Fix bug"},
+			entity.ActivityCommitLinked, "Linked commit 0123456: Fix bug", map[string]string{"hash": "0123456789abcdef"}},
+		{valueobject.EventTaskUpdated, "doing", map[string]interface{}{"fields": "points,stage"},
+			entity.ActivityUpdated, "Updated points, stage", map[string]string{"fields": "points,stage"}},
+		{valueobject.EventTaskUpdated, "doing", map[string]interface{}{"title": "Fix the bug"},
+			"", "", nil},
+		{valueobject.EventTaskCommented, "doing", map[string]interface{}{"text": "recorded by the comment itself"},
+			"", "", nil},
+		{valueobject.EventTaskCompleted, "doing", nil,
+			entity.ActivityCompleted, "Completed", nil},
+	}
+
+	want := make([]int, 0, len(tests))
+	for i, tt := range tests {
+		event := entity.NewDomainEvent(tt.eventType, "work/tracker", tt.column, taskID, tt.metadata)
+		event.Timestamp = start.Add(time.Duration(i) * time.Minute)
+		bus.Publish(event)
+		if tt.wantMessage != "" {
+			want = append(want, i)
+		}
+	}
+
+	// Events for other boards and events without a task are ignored
+	bus.Publish(entity.NewDomainEvent(valueobject.EventTaskCreated, "", "to-do", taskID, nil))
+	bus.Publish(entity.NewDomainEvent(valueobject.EventColumnCreated, "work/tracker", "review", nil, nil))
+
+	getActivity := task.NewGetTaskActivityUseCase(boardRepo, activityRepo)
+	activity := waitForActivity(t, getActivity, taskID.String(), len(want))
+	if err := m.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	if activity.Column != "doing" || len(activity.Entries) != len(want) {
+		t.Fatalf("expected %d entries in doing, got %d in %s: %+v", len(want), len(activity.Entries), activity.Column, activity.Entries)
+	}
+	// Entries come back in event order even though the bus delivers them concurrently
+	for i, entry := range activity.Entries {
+		tt := tests[want[i]]
+		if entry.Type != string(tt.wantType) || entry.Message != tt.wantMessage {
+			t.Errorf("entry %d: expected %s %q, got %s %q", i, tt.wantType, tt.wantMessage, entry.Type, entry.Message)
+		}
+		if wantTime := start.Add(time.Duration(want[i]) * time.Minute); !entry.Timestamp.Equal(wantTime) {
+			t.Errorf("entry %d: expected the event time %s, got %s", i, wantTime, entry.Timestamp)
+		}
+		for key, value := range tt.wantDetails {
+			if entry.Details[key] != value {
+				t.Errorf("entry %d: expected detail %s=%q, got %q", i, key, value, entry.Details[key])
+			}
+		}
+	}
+	if author := activity.Entries[0].Author; author != "ana" {
+		t.Errorf("expected the author of the event to be kept, got %q", author)
+	}
+
+	// A comment is appended after the recorded events and read back from disk
+	commented, err := task.NewAddCommentUseCase(boardRepo, activityRepo).Execute(context.Background(), "work/tracker", taskID.ShortID(), "ben", "Needs a test")
+	if err != nil {
+		t.Fatalf("comment failed: %v", err)
+	}
+	if len(commented.Entries) != len(want)+1 {
+		t.Fatalf("expected the comment to be added to the timeline, got %d entries", len(commented.Entries))
+	}
+
+	reread, err := task.NewGetTaskActivityUseCase(filesystem.NewBoardRepository(root), filesystem.NewActivityRepository(root)).Execute(context.Background(), "work/tracker", taskID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reread.Entries) != len(want)+1 {
+		t.Fatalf("expected %d entries after reading again, got %d", len(want)+1, len(reread.Entries))
+	}
+	comment := reread.Entries[len(reread.Entries)-1]
+	if comment.Type != string(entity.ActivityComment) || comment.Author != "ben" || comment.Message != "Needs a test" {
+		t.Errorf("expected the comment from ben last, got %+v", comment)
+	}
+}
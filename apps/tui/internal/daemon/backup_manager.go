@@ -0,0 +1,124 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"mkanban/internal/infrastructure/config"
+	"mkanban/internal/infrastructure/persistence/filesystem"
+)
+
+// backupCheckInterval is how often the manager checks whether a scheduled backup is due
+const backupCheckInterval = 10 * time.Minute
+
+// BackupManager takes scheduled backups of the data directory and prunes old ones
+type BackupManager struct {
+	config   *config.Config
+	store    *filesystem.BackupStore
+	dataLock sync.Locker // held while the data directory is archived
+
+	ctx        context.Context
+	cancelFunc context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+// NewBackupManager creates a new BackupManager. dataLock must keep daemon
+// mutations out while a backup is written.
+func NewBackupManager(cfg *config.Config, store *filesystem.BackupStore, dataLock sync.Locker) *BackupManager {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &BackupManager{
+		config:     cfg,
+		store:      store,
+		dataLock:   dataLock,
+		ctx:        ctx,
+		cancelFunc: cancel,
+	}
+}
+
+// Start starts the backup scheduler
+func (m *BackupManager) Start() error {
+	if !m.config.Backup.Enabled {
+		fmt.Println("Scheduled backups are disabled in configuration")
+		return nil
+	}
+
+	m.wg.Add(1)
+	go m.run()
+
+	fmt.Printf("[Backup] Scheduled backups every %v\n", m.interval())
+	return nil
+}
+
+// Stop stops the backup scheduler
+func (m *BackupManager) Stop() error {
+	m.cancelFunc()
+	m.wg.Wait()
+	return nil
+}
+
+// Retention returns the configured retention rules
+func (m *BackupManager) Retention() filesystem.BackupRetention {
+	return filesystem.BackupRetention{
+		KeepLast:   m.config.Backup.KeepLast,
+		KeepDaily:  m.config.Backup.KeepDaily,
+		KeepWeekly: m.config.Backup.KeepWeekly,
+	}
+}
+
+func (m *BackupManager) run() {
+	defer m.wg.Done()
+
+	m.backupIfDue()
+
+	ticker := time.NewTicker(backupCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-ticker.C:
+			m.backupIfDue()
+		}
+	}
+}
+
+// backupIfDue takes a backup when the newest one is older than the interval
+func (m *BackupManager) backupIfDue() {
+	latest, err := m.store.Latest()
+	if err != nil {
+		fmt.Printf("[Backup] Failed to list backups: %v\n", err)
+		return
+	}
+	if latest != nil && time.Since(latest.Created) < m.interval() {
+		return
+	}
+
+	m.dataLock.Lock()
+	backup, err := m.store.Create(filesystem.BackupReasonScheduled)
+	m.dataLock.Unlock()
+	if err != nil {
+		fmt.Printf("[Backup] Scheduled backup failed: %v\n", err)
+		return
+	}
+	fmt.Printf("[Backup] Wrote %s\n", backup.Name)
+
+	removed, err := m.store.Prune(m.Retention())
+	if err != nil {
+		fmt.Printf("[Backup] Failed to prune backups: %v\n", err)
+		return
+	}
+	if len(removed) > 0 {
+		fmt.Printf("[Backup] Pruned %d old backup(s)\n", len(removed))
+	}
+}
+
+func (m *BackupManager) interval() time.Duration {
+	if m.config.Backup.Interval <= 0 {
+		return 24 * time.Hour
+	}
+	return time.Duration(m.config.Backup.Interval) * time.Hour
+}
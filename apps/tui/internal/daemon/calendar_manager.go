@@ -0,0 +1,438 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/service"
+	"mkanban/internal/infrastructure/config"
+	"mkanban/internal/infrastructure/persistence/filesystem"
+	infraService "mkanban/internal/infrastructure/service"
+)
+
+// calendarDefaultSyncInterval is used when no positive sync interval is configured
+const calendarDefaultSyncInterval = 5 * time.Minute
+
+// CalendarManager syncs meetings with the configured calendar on an interval
+// and on demand, keeping the event-task mappings and last sync time on disk
+type CalendarManager struct {
+	config            *config.Config
+	sync              *infraService.CalendarSyncService
+	store             *filesystem.CalendarSyncStore
+	onMeetingsCreated func(boardID string, taskIDs []string) // called after a sync created meetings on a board
+	onBoardsChanged   func(boardIDs []string)                // called after a sync changed tasks on boards
+	onConflicts       func(pending int)                      // called when conflicts were queued or resolved
+
+	runMu      sync.Mutex   // one sync at a time
+	stateMu    sync.RWMutex // guards the fields below
+	syncing    bool
+	nextSync   time.Time
+	lastResult *dto.CalendarSyncResultDTO
+	lastError  string
+
+	ctx        context.Context
+	cancelFunc context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+// NewCalendarManager creates a new CalendarManager. dataLock must keep daemon
+// mutations out while synced tasks are read and saved; it is not held during
+// calendar requests.
+func NewCalendarManager(
+	cfg *config.Config,
+	syncService *infraService.CalendarSyncService,
+	store *filesystem.CalendarSyncStore,
+	dataLock sync.Locker,
+	onMeetingsCreated func(boardID string, taskIDs []string),
+	onBoardsChanged func(boardIDs []string),
+	onConflicts func(pending int),
+) *CalendarManager {
+	ctx, cancel := context.WithCancel(context.Background())
+	syncService.SetDataLock(dataLock)
+
+	return &CalendarManager{
+		config:            cfg,
+		sync:              syncService,
+		store:             store,
+		onMeetingsCreated: onMeetingsCreated,
+		onBoardsChanged:   onBoardsChanged,
+		onConflicts:       onConflicts,
+		ctx:               ctx,
+		cancelFunc:        cancel,
+	}
+}
+
+// Start restores the persisted sync state and starts periodic syncs when
+// auto sync is enabled
+func (m *CalendarManager) Start() error {
+	if m.config.Calendar.ConflictPolicy != "" {
+		m.sync.SetConflictPolicy(infraService.ConflictPolicy(m.config.Calendar.ConflictPolicy))
+	}
+
+	record, err := m.store.Load()
+	if err != nil {
+		fmt.Printf("[Calendar] Failed to load sync state, starting over: %v\n", err)
+	} else if record.Provider == m.sync.ProviderName() {
+		conflicts := make([]infraService.SyncConflict, 0, len(record.Conflicts))
+		for _, conflict := range record.Conflicts {
+			conflicts = append(conflicts, conflictFromRecord(conflict))
+		}
+		m.sync.Restore(record.LastSync, record.Events, conflicts)
+	} else if len(record.Events) > 0 {
+		fmt.Printf("[Calendar] Provider changed from %s to %s, starting over\n", record.Provider, m.sync.ProviderName())
+	}
+
+	if !m.config.Calendar.AutoSync {
+		fmt.Printf("[Calendar] Syncing with %s on demand only\n", m.sync.ProviderName())
+		return nil
+	}
+
+	m.wg.Add(1)
+	go m.run()
+
+	fmt.Printf("[Calendar] Syncing with %s every %v\n", m.sync.ProviderName(), m.interval())
+	return nil
+}
+
+// Stop stops periodic syncs
+func (m *CalendarManager) Stop() error {
+	m.cancelFunc()
+	m.wg.Wait()
+	return nil
+}
+
+func (m *CalendarManager) interval() time.Duration {
+	if m.config.Calendar.SyncInterval <= 0 {
+		return calendarDefaultSyncInterval
+	}
+	return time.Duration(m.config.Calendar.SyncInterval) * time.Second
+}
+
+func (m *CalendarManager) run() {
+	defer m.wg.Done()
+
+	m.syncAndLog()
+
+	ticker := time.NewTicker(m.interval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-ticker.C:
+			m.syncAndLog()
+		}
+	}
+}
+
+func (m *CalendarManager) syncAndLog() {
+	m.stateMu.Lock()
+	m.nextSync = time.Now().Add(m.interval())
+	m.stateMu.Unlock()
+
+	result, err := m.SyncNow(m.ctx)
+	if err != nil {
+		fmt.Printf("[Calendar] Sync failed: %v\n", err)
+		return
+	}
+	for _, syncErr := range result.Errors {
+		fmt.Printf("[Calendar] %s\n", syncErr)
+	}
+}
+
+// SyncNow runs a sync in the directions enabled in the config and persists
+// the resulting state. Errors of single events are reported in the result.
+func (m *CalendarManager) SyncNow(ctx context.Context) (*dto.CalendarSyncResultDTO, error) {
+	m.runMu.Lock()
+	defer m.runMu.Unlock()
+
+	m.stateMu.Lock()
+	m.syncing = true
+	m.stateMu.Unlock()
+
+	result, err := m.runSync(ctx)
+	if err == nil {
+		err = m.saveState()
+	}
+
+	var resultDTO *dto.CalendarSyncResultDTO
+	if result != nil {
+		resultDTO = syncResultToDTO(result)
+	}
+
+	m.stateMu.Lock()
+	m.syncing = false
+	m.lastError = ""
+	if err != nil {
+		m.lastError = err.Error()
+	}
+	if resultDTO != nil {
+		m.lastResult = resultDTO
+	}
+	m.stateMu.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+	m.notify(result, len(result.Conflicts) > 0)
+	return resultDTO, nil
+}
+
+// notify tells about the boards and conflicts a sync or resolution changed
+func (m *CalendarManager) notify(result *infraService.SyncResult, conflictsChanged bool) {
+	if m.onMeetingsCreated != nil {
+		for boardID, taskIDs := range result.CreatedTasks {
+			m.onMeetingsCreated(boardID, taskIDs)
+		}
+	}
+	if len(result.ChangedBoards) > 0 && m.onBoardsChanged != nil {
+		m.onBoardsChanged(result.ChangedBoards)
+	}
+	if conflictsChanged && m.onConflicts != nil {
+		m.onConflicts(len(m.sync.Conflicts()))
+	}
+}
+
+// Conflicts returns the conflicts awaiting a resolution, oldest first
+func (m *CalendarManager) Conflicts() []dto.CalendarSyncConflictDTO {
+	conflicts := m.sync.Conflicts()
+	result := make([]dto.CalendarSyncConflictDTO, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		result = append(result, conflictToDTO(conflict))
+	}
+	return result
+}
+
+// ResolveConflict settles the conflict of an event: resolution is
+// keep_calendar, keep_task, or merge with a calendar or task choice for each
+// conflicting field
+func (m *CalendarManager) ResolveConflict(ctx context.Context, eventID, resolution string, fields map[string]string) (*dto.CalendarSyncResultDTO, error) {
+	var keep infraService.ConflictSide
+	switch resolution {
+	case "keep_calendar":
+		keep = infraService.ConflictSideCalendar
+	case "keep_task":
+		keep = infraService.ConflictSideTask
+	case "merge":
+	default:
+		return nil, fmt.Errorf("%w: %q, use keep_calendar, keep_task or merge", entity.ErrInvalidConflictChoice, resolution)
+	}
+
+	choices := make(map[infraService.ConflictField]infraService.ConflictSide, len(fields))
+	for field, side := range fields {
+		choices[infraService.ConflictField(field)] = infraService.ConflictSide(side)
+	}
+
+	m.runMu.Lock()
+	defer m.runMu.Unlock()
+
+	result, err := m.sync.ResolveConflict(ctx, eventID, keep, choices)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.saveState(); err != nil {
+		return nil, err
+	}
+
+	m.notify(result, true)
+	return syncResultToDTO(result), nil
+}
+
+// runSync syncs in the enabled directions. The sync service takes the data
+// lock only while it reads and saves tasks, not during calendar requests.
+func (m *CalendarManager) runSync(ctx context.Context) (*infraService.SyncResult, error) {
+	pull, push := m.config.Calendar.PullEnabled, m.config.Calendar.PushEnabled
+	switch {
+	case pull && push:
+		return m.sync.SyncAll(ctx)
+	case pull:
+		return m.sync.PullFromCalendar(ctx)
+	case push:
+		return m.sync.PushToCalendar(ctx)
+	default:
+		return nil, fmt.Errorf("calendar pull and push are both disabled")
+	}
+}
+
+// saveState persists the event-task mappings and last sync time
+func (m *CalendarManager) saveState() error {
+	state := m.sync.Snapshot()
+	record := &filesystem.CalendarSyncRecord{
+		Provider:  m.sync.ProviderName(),
+		LastSync:  state.LastSyncTime,
+		Events:    state.SyncedEvents,
+		Conflicts: make([]filesystem.CalendarConflictRecord, 0, len(state.Conflicts)),
+	}
+	for _, conflict := range m.sync.Conflicts() {
+		record.Conflicts = append(record.Conflicts, conflictToRecord(conflict))
+	}
+	return m.store.Save(record)
+}
+
+// Status returns the sync configuration and the state of the last sync
+func (m *CalendarManager) Status() *dto.CalendarSyncStatusDTO {
+	status := calendarConfigStatus(m.config)
+	status.Enabled = true
+	status.Provider = m.sync.ProviderName()
+
+	state := m.sync.Snapshot()
+	status.ConflictPolicy = string(state.ConflictPolicy)
+	status.SyncedEvents = len(state.SyncedEvents)
+	status.Conflicts = len(state.Conflicts)
+	if !state.LastSyncTime.IsZero() {
+		lastSync := state.LastSyncTime
+		status.LastSync = &lastSync
+	}
+
+	m.stateMu.RLock()
+	defer m.stateMu.RUnlock()
+	status.Syncing = m.syncing
+	status.LastResult = m.lastResult
+	status.LastError = m.lastError
+	if m.config.Calendar.AutoSync && !m.nextSync.IsZero() {
+		nextSync := m.nextSync
+		status.NextSync = &nextSync
+	}
+	return status
+}
+
+// calendarConfigStatus returns the sync status as far as the config tells it,
+// which is all there is when calendar sync is disabled
+func calendarConfigStatus(cfg *config.Config) *dto.CalendarSyncStatusDTO {
+	return &dto.CalendarSyncStatusDTO{
+		Enabled:        false,
+		AutoSync:       cfg.Calendar.AutoSync,
+		SyncInterval:   cfg.Calendar.SyncInterval,
+		PullEnabled:    cfg.Calendar.PullEnabled,
+		PushEnabled:    cfg.Calendar.PushEnabled,
+		ConflictPolicy: cfg.Calendar.ConflictPolicy,
+	}
+}
+
+// syncResultToDTO converts a sync result for the protocol
+func syncResultToDTO(result *infraService.SyncResult) *dto.CalendarSyncResultDTO {
+	resultDTO := &dto.CalendarSyncResultDTO{
+		SyncedAt:      result.LastSyncTime,
+		EventsCreated: result.EventsCreated,
+		EventsUpdated: result.EventsUpdated,
+		EventsDeleted: result.EventsDeleted,
+		TasksCreated:  result.TasksCreated,
+		TasksUpdated:  result.TasksUpdated,
+		Conflicts:     make([]dto.CalendarSyncConflictDTO, 0, len(result.Conflicts)),
+		Errors:        make([]string, 0, len(result.Errors)),
+	}
+	for _, conflict := range result.Conflicts {
+		resultDTO.Conflicts = append(resultDTO.Conflicts, conflictToDTO(conflict))
+	}
+	for _, err := range result.Errors {
+		resultDTO.Errors = append(resultDTO.Errors, err.Error())
+	}
+	return resultDTO
+}
+
+// conflictToDTO converts a sync conflict for the protocol
+func conflictToDTO(conflict infraService.SyncConflict) dto.CalendarSyncConflictDTO {
+	fields := make([]string, 0, len(conflict.Fields))
+	for _, field := range conflict.Fields {
+		fields = append(fields, string(field))
+	}
+	return dto.CalendarSyncConflictDTO{
+		EventID:     conflict.EventID,
+		TaskID:      conflict.TaskID,
+		BoardID:     conflict.BoardID,
+		EventTitle:  conflict.EventTitle,
+		TaskTitle:   conflict.TaskTitle,
+		Description: conflict.Description,
+		Fields:      fields,
+		DetectedAt:  conflict.DetectedAt,
+		Event:       eventVersionToDTO(conflict.Event),
+		Task:        eventVersionToDTO(conflict.Task),
+	}
+}
+
+func eventVersionToDTO(event service.CalendarEvent) dto.CalendarEventVersionDTO {
+	return dto.CalendarEventVersionDTO{
+		Title:       event.Title,
+		Description: event.Description,
+		Start:       event.StartTime,
+		End:         event.EndTime,
+		AllDay:      event.IsAllDay,
+		Location:    event.Location,
+	}
+}
+
+// conflictToRecord converts a sync conflict for persisting
+func conflictToRecord(conflict infraService.SyncConflict) filesystem.CalendarConflictRecord {
+	fields := make([]string, 0, len(conflict.Fields))
+	for _, field := range conflict.Fields {
+		fields = append(fields, string(field))
+	}
+	return filesystem.CalendarConflictRecord{
+		EventID:     conflict.EventID,
+		TaskID:      conflict.TaskID,
+		BoardID:     conflict.BoardID,
+		Description: conflict.Description,
+		Fields:      fields,
+		DetectedAt:  conflict.DetectedAt,
+		Event:       eventVersionToRecord(conflict.Event),
+		Task:        eventVersionToRecord(conflict.Task),
+	}
+}
+
+// conflictFromRecord converts a persisted sync conflict back
+func conflictFromRecord(record filesystem.CalendarConflictRecord) infraService.SyncConflict {
+	fields := make([]infraService.ConflictField, 0, len(record.Fields))
+	for _, field := range record.Fields {
+		fields = append(fields, infraService.ConflictField(field))
+	}
+	event := eventVersionFromRecord(record.Event)
+	event.ID = record.EventID
+	return infraService.SyncConflict{
+		EventID:     record.EventID,
+		TaskID:      record.TaskID,
+		BoardID:     record.BoardID,
+		EventTitle:  record.Event.Title,
+		TaskTitle:   record.Task.Title,
+		Description: record.Description,
+		Fields:      fields,
+		Event:       event,
+		Task:        eventVersionFromRecord(record.Task),
+		DetectedAt:  record.DetectedAt,
+	}
+}
+
+func eventVersionToRecord(event service.CalendarEvent) filesystem.CalendarEventRecord {
+	return filesystem.CalendarEventRecord{
+		UID:         event.UID,
+		Title:       event.Title,
+		Description: event.Description,
+		Start:       event.StartTime,
+		End:         event.EndTime,
+		AllDay:      event.IsAllDay,
+		Location:    event.Location,
+		Attendees:   event.Attendees,
+		MeetingLink: event.MeetingLink,
+		Updated:     event.Updated,
+	}
+}
+
+func eventVersionFromRecord(record filesystem.CalendarEventRecord) service.CalendarEvent {
+	return service.CalendarEvent{
+		UID:         record.UID,
+		Title:       record.Title,
+		Description: record.Description,
+		StartTime:   record.Start,
+		EndTime:     record.End,
+		IsAllDay:    record.AllDay,
+		Location:    record.Location,
+		Attendees:   record.Attendees,
+		MeetingLink: record.MeetingLink,
+		Updated:     record.Updated,
+	}
+}
@@ -0,0 +1,105 @@
+package daemon
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"mkanban/internal/domain/service"
+	"mkanban/internal/infrastructure/config"
+	"mkanban/internal/infrastructure/persistence/filesystem"
+	infraService "mkanban/internal/infrastructure/service"
+)
+
+// stubCalendarProvider is a calendar that is never reached
+type stubCalendarProvider struct{}
+
+func (stubCalendarProvider) Name() string { return "stand-in" }
+
+func (stubCalendarProvider) ListEvents(ctx context.Context, start, end time.Time) ([]service.CalendarEvent, error) {
+	return nil, nil
+}
+
+func (stubCalendarProvider) CreateEvent(ctx context.Context, event service.CalendarEvent) (*service.CalendarEvent, error) {
+	return &event, nil
+}
+
+func (stubCalendarProvider) UpdateEvent(ctx context.Context, event service.CalendarEvent) error {
+	return nil
+}
+
+func (stubCalendarProvider) DeleteEvent(ctx context.Context, eventID string) error {
+	return nil
+}
+
+func (stubCalendarProvider) Changes(ctx context.Context, syncToken string) (*service.CalendarChanges, error) {
+	return &service.CalendarChanges{}, nil
+}
+
+// newTestCalendarManager creates a calendar manager without auto sync over
+// the sync state in root
+func newTestCalendarManager(root string) (*CalendarManager, *infraService.CalendarSyncService) {
+	cfg := &config.Config{}
+	cfg.Storage.DataPath = root
+
+	syncService := infraService.NewCalendarSyncService(stubCalendarProvider{}, filesystem.NewBoardRepository(root), nil)
+	m := NewCalendarManager(cfg, syncService, filesystem.NewCalendarSyncStore(root), &sync.Mutex{}, nil, nil, nil)
+	return m, syncService
+}
+
+func TestCalendarManagerPersistsConflictQueue(t *testing.T) {
+	root := t.TempDir()
+	lastSync := time.Date(2026, 11, 1, 8, 0, 0, 0, time.UTC)
+	start := time.Date(2026, 11, 2, 10, 0, 0, 0, time.UTC)
+
+	conflict := infraService.SyncConflict{
+		EventID:     "standup",
+		TaskID:      "MTG-001",
+		BoardID:     "work/meetings",
+		EventTitle:  "Standup (calendar)",
+		TaskTitle:   "Standup (board)",
+		Description: "event and task both changed since the last sync",
+		Fields:      []infraService.ConflictField{infraService.ConflictFieldTitle, infraService.ConflictFieldLocation},
+		Event: service.CalendarEvent{
+			ID:          "standup",
+			Title:       "Standup (calendar)",
+			StartTime:   start,
+			EndTime:     start.Add(30 * time.Minute),
+			Location:    "Room 2",
+			Attendees:   []string{"ana@example.com"},
+			MeetingLink: "https://meet.example.com/standup",
+			Updated:     lastSync.Add(time.Hour),
+		},
+		Task: service.CalendarEvent{
+			Title:     "Standup (board)",
+			StartTime: start,
+			EndTime:   start.Add(30 * time.Minute),
+		},
+		DetectedAt: lastSync.Add(2 * time.Hour),
+	}
+
+	m, syncService := newTestCalendarManager(root)
+	syncService.Restore(lastSync, map[string]string{"standup": "MTG-001"}, []infraService.SyncConflict{conflict})
+	if err := m.saveState(); err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+
+	restarted, restartedSync := newTestCalendarManager(root)
+	if err := restarted.Start(); err != nil {
+		t.Fatalf("start failed: %v", err)
+	}
+	defer restarted.Stop()
+
+	pending := restartedSync.Conflicts()
+	if len(pending) != 1 {
+		t.Fatalf("expected the queued conflict to survive a restart, got %+v", pending)
+	}
+	if !reflect.DeepEqual(pending[0], conflict) {
+		t.Errorf("expected the conflict to round trip\nwant %+v\ngot  %+v", conflict, pending[0])
+	}
+	if got := restartedSync.GetLastSyncTime(); !got.Equal(lastSync) {
+		t.Errorf("expected the last sync time %v, got %v", lastSync, got)
+	}
+}
@@ -383,8 +383,8 @@ func (c *Client) UpdateTask(ctx context.Context, boardID, taskID string, taskReq
 	return &task, nil
 }
 
-// DeleteTask deletes a task
-func (c *Client) DeleteTask(ctx context.Context, boardID, taskID string) error {
+// DeleteTask moves a task and its subtasks to the trash
+func (c *Client) DeleteTask(ctx context.Context, boardID, taskID string) (*dto.TrashItemDTO, error) {
 	req := &Request{
 		Type: RequestDeleteTask,
 		Payload: DeleteTaskPayload{
@@ -393,8 +393,7 @@ func (c *Client) DeleteTask(ctx context.Context, boardID, taskID string) error {
 		},
 	}
 
-	_, err := c.sendRequest(req)
-	return err
+	return c.trashItemRequest(req)
 }
 
 // CreateColumn creates a new column
@@ -426,8 +425,8 @@ func (c *Client) CreateColumn(ctx context.Context, boardID string, columnReq dto
 	return &board, nil
 }
 
-// DeleteColumn deletes a column
-func (c *Client) DeleteColumn(ctx context.Context, boardID, columnName string) error {
+// DeleteColumn moves a column and its tasks to the trash
+func (c *Client) DeleteColumn(ctx context.Context, boardID, columnName string) (*dto.TrashItemDTO, error) {
 	req := &Request{
 		Type: RequestDeleteColumn,
 		Payload: DeleteColumnPayload{
@@ -436,8 +435,1722 @@ func (c *Client) DeleteColumn(ctx context.Context, boardID, columnName string) e
 		},
 	}
 
-	_, err := c.sendRequest(req)
-	return err
+	return c.trashItemRequest(req)
+}
+
+// SetBoardFields replaces the custom field schema of a board
+func (c *Client) SetBoardFields(ctx context.Context, boardID string, fields []dto.FieldDefinitionDTO) (*dto.BoardDTO, error) {
+	req := &Request{
+		Type: RequestSetBoardFields,
+		Payload: SetBoardFieldsPayload{
+			BoardID: boardID,
+			Fields:  fields,
+		},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal board data: %w", err)
+	}
+
+	var board dto.BoardDTO
+	if err := json.Unmarshal(data, &board); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal board: %w", err)
+	}
+
+	return &board, nil
+}
+
+// ListTasks lists the tasks of a board that match query; an empty query lists all tasks
+func (c *Client) ListTasks(ctx context.Context, boardID string, query dto.TaskQuery) ([]dto.TaskDTO, error) {
+	req := &Request{
+		Type: RequestListTasks,
+		Payload: ListTasksPayload{
+			BoardID: boardID,
+			Query:   query,
+		},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal tasks data: %w", err)
+	}
+
+	var tasks []dto.TaskDTO
+	if err := json.Unmarshal(data, &tasks); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tasks: %w", err)
+	}
+
+	return tasks, nil
+}
+
+// Migrate applies pending storage migrations, or only reports them when dryRun is set
+func (c *Client) Migrate(ctx context.Context, dryRun bool) (*dto.MigrationReportDTO, error) {
+	req := &Request{
+		Type:    RequestMigrate,
+		Payload: MigratePayload{DryRun: dryRun},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal migration report: %w", err)
+	}
+
+	var report dto.MigrationReportDTO
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal migration report: %w", err)
+	}
+
+	return &report, nil
+}
+
+// Doctor checks the data directory for inconsistencies, repairing the safe ones when fix is set
+func (c *Client) Doctor(ctx context.Context, fix bool) (*dto.IntegrityReportDTO, error) {
+	req := &Request{
+		Type:    RequestDoctor,
+		Payload: DoctorPayload{Fix: fix},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal integrity report: %w", err)
+	}
+
+	var report dto.IntegrityReportDTO
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal integrity report: %w", err)
+	}
+
+	return &report, nil
+}
+
+// GetTaskHistory returns the recorded versions of a task, newest first
+func (c *Client) GetTaskHistory(ctx context.Context, taskID string, limit int) (*dto.HistoryDTO, error) {
+	return c.getHistory(GetHistoryPayload{TaskID: taskID, Limit: limit})
+}
+
+// GetBoardHistory returns the recorded changes to a board, newest first
+func (c *Client) GetBoardHistory(ctx context.Context, boardID string, limit int) (*dto.HistoryDTO, error) {
+	return c.getHistory(GetHistoryPayload{BoardID: boardID, Limit: limit})
+}
+
+func (c *Client) getHistory(payload GetHistoryPayload) (*dto.HistoryDTO, error) {
+	req := &Request{
+		Type:    RequestGetHistory,
+		Payload: payload,
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal history: %w", err)
+	}
+
+	var history dto.HistoryDTO
+	if err := json.Unmarshal(data, &history); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal history: %w", err)
+	}
+
+	return &history, nil
+}
+
+// RestoreTask restores a task to its version at the given revision
+func (c *Client) RestoreTask(ctx context.Context, taskID, revision string) (*dto.RestoreResultDTO, error) {
+	return c.restore(&Request{
+		Type:    RequestRestoreTask,
+		Payload: RestoreTaskPayload{TaskID: taskID, Revision: revision},
+	})
+}
+
+// RestoreBoard restores a board to its state as of at (RFC3339 or YYYY-MM-DD)
+func (c *Client) RestoreBoard(ctx context.Context, boardID, at string) (*dto.RestoreResultDTO, error) {
+	return c.restore(&Request{
+		Type:    RequestRestoreBoard,
+		Payload: RestoreBoardPayload{BoardID: boardID, At: at},
+	})
+}
+
+func (c *Client) restore(req *Request) (*dto.RestoreResultDTO, error) {
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal restore result: %w", err)
+	}
+
+	var result dto.RestoreResultDTO
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal restore result: %w", err)
+	}
+
+	return &result, nil
+}
+
+// CreateBackup writes a backup archive of the data directory
+func (c *Client) CreateBackup(ctx context.Context, reason string) (*dto.BackupDTO, error) {
+	req := &Request{
+		Type:    RequestCreateBackup,
+		Payload: CreateBackupPayload{Reason: reason},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal backup: %w", err)
+	}
+
+	var backup dto.BackupDTO
+	if err := json.Unmarshal(data, &backup); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal backup: %w", err)
+	}
+
+	return &backup, nil
+}
+
+// ListBackups returns the available backup archives, newest first
+func (c *Client) ListBackups(ctx context.Context) ([]dto.BackupDTO, error) {
+	req := &Request{
+		Type: RequestListBackups,
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal backups: %w", err)
+	}
+
+	var backups []dto.BackupDTO
+	if err := json.Unmarshal(data, &backups); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal backups: %w", err)
+	}
+
+	return backups, nil
+}
+
+// RestoreBackup replaces the data directory with a backup archive. Without
+// force the daemon refuses to overwrite data newer than the archive.
+func (c *Client) RestoreBackup(ctx context.Context, name string, force bool) (*dto.BackupRestoreDTO, error) {
+	req := &Request{
+		Type:    RequestRestoreBackup,
+		Payload: RestoreBackupPayload{Name: name, Force: force},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal restore result: %w", err)
+	}
+
+	var result dto.BackupRestoreDTO
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal restore result: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteNote moves a note to the trash
+func (c *Client) DeleteNote(ctx context.Context, noteID string) (*dto.TrashItemDTO, error) {
+	req := &Request{
+		Type:    RequestDeleteNote,
+		Payload: DeleteNotePayload{NoteID: noteID},
+	}
+
+	return c.trashItemRequest(req)
+}
+
+// trashItemRequest sends a delete request and decodes the trash item it created
+func (c *Client) trashItemRequest(req *Request) (*dto.TrashItemDTO, error) {
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal trash item: %w", err)
+	}
+
+	var item dto.TrashItemDTO
+	if err := json.Unmarshal(data, &item); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal trash item: %w", err)
+	}
+
+	return &item, nil
+}
+
+// ListTrash lists trash items, optionally only those deleted from one board
+func (c *Client) ListTrash(ctx context.Context, boardID string) ([]dto.TrashItemDTO, error) {
+	req := &Request{
+		Type:    RequestListTrash,
+		Payload: ListTrashPayload{BoardID: boardID},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal trash items: %w", err)
+	}
+
+	var items []dto.TrashItemDTO
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal trash items: %w", err)
+	}
+
+	return items, nil
+}
+
+// RestoreTrash puts a trash item back where it was deleted from
+func (c *Client) RestoreTrash(ctx context.Context, id string) (*dto.TrashRestoreDTO, error) {
+	req := &Request{
+		Type:    RequestRestoreTrash,
+		Payload: RestoreTrashPayload{ID: id},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal restore result: %w", err)
+	}
+
+	var result dto.TrashRestoreDTO
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal restore result: %w", err)
+	}
+
+	return &result, nil
+}
+
+// PurgeTrash permanently removes one trash item, every item when all is set,
+// or only the expired items when neither is given
+func (c *Client) PurgeTrash(ctx context.Context, id string, all bool) (*dto.TrashPurgeDTO, error) {
+	req := &Request{
+		Type:    RequestPurgeTrash,
+		Payload: PurgeTrashPayload{ID: id, All: all},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal purge result: %w", err)
+	}
+
+	var result dto.TrashPurgeDTO
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal purge result: %w", err)
+	}
+
+	return &result, nil
+}
+
+// AddAttachment copies a file into a task folder; name defaults to the file's base name
+func (c *Client) AddAttachment(ctx context.Context, boardID, taskID, sourcePath, name string) (*dto.AttachmentDTO, error) {
+	// The daemon may run in another working directory
+	absPath, err := filepath.Abs(sourcePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s: %w", sourcePath, err)
+	}
+
+	req := &Request{
+		Type: RequestAddAttachment,
+		Payload: AddAttachmentPayload{
+			BoardID:    boardID,
+			TaskID:     taskID,
+			SourcePath: absPath,
+			Name:       name,
+		},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal attachment: %w", err)
+	}
+
+	var attachment dto.AttachmentDTO
+	if err := json.Unmarshal(data, &attachment); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal attachment: %w", err)
+	}
+
+	return &attachment, nil
+}
+
+// ListAttachments lists the files attached to a task
+func (c *Client) ListAttachments(ctx context.Context, boardID, taskID string) ([]dto.AttachmentDTO, error) {
+	req := &Request{
+		Type:    RequestListAttachments,
+		Payload: ListAttachmentsPayload{BoardID: boardID, TaskID: taskID},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal attachments: %w", err)
+	}
+
+	var attachments []dto.AttachmentDTO
+	if err := json.Unmarshal(data, &attachments); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal attachments: %w", err)
+	}
+
+	return attachments, nil
+}
+
+// RemoveAttachment deletes a file attached to a task
+func (c *Client) RemoveAttachment(ctx context.Context, boardID, taskID, name string) (*dto.TaskDTO, error) {
+	req := &Request{
+		Type:    RequestRemoveAttachment,
+		Payload: RemoveAttachmentPayload{BoardID: boardID, TaskID: taskID, Name: name},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal task: %w", err)
+	}
+
+	var task dto.TaskDTO
+	if err := json.Unmarshal(data, &task); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal task: %w", err)
+	}
+
+	return &task, nil
+}
+
+// GetTaskActivity returns the activity timeline of a task
+func (c *Client) GetTaskActivity(ctx context.Context, boardID, taskID string) (*dto.TaskActivityDTO, error) {
+	return c.taskActivityRequest(&Request{
+		Type:    RequestGetTaskActivity,
+		Payload: GetTaskActivityPayload{BoardID: boardID, TaskID: taskID},
+	})
+}
+
+// AddComment adds a comment to a task and returns its updated activity timeline
+func (c *Client) AddComment(ctx context.Context, boardID, taskID, author, text string) (*dto.TaskActivityDTO, error) {
+	return c.taskActivityRequest(&Request{
+		Type:    RequestAddComment,
+		Payload: AddCommentPayload{BoardID: boardID, TaskID: taskID, Author: author, Text: text},
+	})
+}
+
+// LinkCommit records a commit made for a task; boardID may be empty when taskID is a full ID
+func (c *Client) LinkCommit(ctx context.Context, boardID, taskID, hash, message, author string) (*dto.TaskDTO, error) {
+	req := &Request{
+		Type: RequestLinkCommit,
+		Payload: LinkCommitPayload{
+			BoardID: boardID,
+			TaskID:  taskID,
+			Hash:    hash,
+			Message: message,
+			Author:  author,
+		},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal task: %w", err)
+	}
+
+	var task dto.TaskDTO
+	if err := json.Unmarshal(data, &task); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal task: %w", err)
+	}
+
+	return &task, nil
+}
+
+// taskActivityRequest sends a request that responds with a task activity timeline
+func (c *Client) taskActivityRequest(req *Request) (*dto.TaskActivityDTO, error) {
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal activity: %w", err)
+	}
+
+	var activity dto.TaskActivityDTO
+	if err := json.Unmarshal(data, &activity); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal activity: %w", err)
+	}
+
+	return &activity, nil
+}
+
+// SaveTaskTemplate creates or replaces a task template; an empty ProjectID saves it globally
+func (c *Client) SaveTaskTemplate(ctx context.Context, template dto.TaskTemplateDTO) (*dto.TaskTemplateDTO, error) {
+	req := &Request{
+		Type:    RequestSaveTaskTemplate,
+		Payload: SaveTaskTemplatePayload{Template: template},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal template: %w", err)
+	}
+
+	var saved dto.TaskTemplateDTO
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal template: %w", err)
+	}
+
+	return &saved, nil
+}
+
+// ListTaskTemplates lists the task templates available to a project, including global ones
+func (c *Client) ListTaskTemplates(ctx context.Context, projectID string) ([]dto.TaskTemplateDTO, error) {
+	req := &Request{
+		Type:    RequestListTaskTemplates,
+		Payload: ListTaskTemplatesPayload{ProjectID: projectID},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal templates: %w", err)
+	}
+
+	var templates []dto.TaskTemplateDTO
+	if err := json.Unmarshal(data, &templates); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal templates: %w", err)
+	}
+
+	return templates, nil
+}
+
+// DeleteTaskTemplate deletes a task template of a project, or a global one for an empty projectID
+func (c *Client) DeleteTaskTemplate(ctx context.Context, projectID, name string) error {
+	req := &Request{
+		Type: RequestDeleteTaskTemplate,
+		Payload: DeleteTaskTemplatePayload{
+			ProjectID: projectID,
+			Name:      name,
+		},
+	}
+
+	_, err := c.sendRequest(req)
+	return err
+}
+
+// CreateTaskFromTemplate creates a task from a template with the given variable values
+func (c *Client) CreateTaskFromTemplate(ctx context.Context, boardID string, taskReq dto.CreateTaskFromTemplateRequest) (*dto.TaskDTO, error) {
+	req := &Request{
+		Type: RequestCreateTaskFromTemplate,
+		Payload: CreateTaskFromTemplatePayload{
+			BoardID:     boardID,
+			TaskRequest: taskReq,
+		},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal task data: %w", err)
+	}
+
+	var task dto.TaskDTO
+	if err := json.Unmarshal(data, &task); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal task: %w", err)
+	}
+
+	return &task, nil
+}
+
+// CreateBoardFromTemplate creates a new board laid out by a board template
+func (c *Client) CreateBoardFromTemplate(ctx context.Context, projectID, name, description, template string) (*dto.BoardDTO, error) {
+	req := &Request{
+		Type: RequestCreateBoard,
+		Payload: CreateBoardPayload{
+			ProjectID:   projectID,
+			Name:        name,
+			Description: description,
+			Template:    template,
+		},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal board data: %w", err)
+	}
+
+	var board dto.BoardDTO
+	if err := json.Unmarshal(data, &board); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal board: %w", err)
+	}
+
+	return &board, nil
+}
+
+// SaveBoardTemplate creates or replaces a board template
+func (c *Client) SaveBoardTemplate(ctx context.Context, template dto.BoardTemplateDTO) (*dto.BoardTemplateDTO, error) {
+	return c.boardTemplateRequest(&Request{
+		Type:    RequestSaveBoardTemplate,
+		Payload: SaveBoardTemplatePayload{Template: template},
+	})
+}
+
+// ExportBoardTemplate saves an existing board as a board template
+func (c *Client) ExportBoardTemplate(ctx context.Context, exportReq dto.ExportBoardTemplateRequest) (*dto.BoardTemplateDTO, error) {
+	return c.boardTemplateRequest(&Request{
+		Type:    RequestExportBoardTemplate,
+		Payload: ExportBoardTemplatePayload{Export: exportReq},
+	})
+}
+
+// ListBoardTemplates lists the board templates, including the built-in default
+func (c *Client) ListBoardTemplates(ctx context.Context) ([]dto.BoardTemplateDTO, error) {
+	resp, err := c.sendRequest(&Request{Type: RequestListBoardTemplates})
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal templates: %w", err)
+	}
+
+	var templates []dto.BoardTemplateDTO
+	if err := json.Unmarshal(data, &templates); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal templates: %w", err)
+	}
+
+	return templates, nil
+}
+
+// DeleteBoardTemplate deletes a board template
+func (c *Client) DeleteBoardTemplate(ctx context.Context, name string) error {
+	req := &Request{
+		Type:    RequestDeleteBoardTemplate,
+		Payload: DeleteBoardTemplatePayload{Name: name},
+	}
+
+	_, err := c.sendRequest(req)
+	return err
+}
+
+// boardTemplateRequest sends a request answered with a board template
+func (c *Client) boardTemplateRequest(req *Request) (*dto.BoardTemplateDTO, error) {
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal template data: %w", err)
+	}
+
+	var template dto.BoardTemplateDTO
+	if err := json.Unmarshal(data, &template); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal template: %w", err)
+	}
+
+	return &template, nil
+}
+
+// SaveProjectTemplate creates or replaces a project template
+func (c *Client) SaveProjectTemplate(ctx context.Context, template dto.ProjectTemplateDTO) (*dto.ProjectTemplateDTO, error) {
+	req := &Request{
+		Type:    RequestSaveProjectTemplate,
+		Payload: SaveProjectTemplatePayload{Template: template},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal template data: %w", err)
+	}
+
+	var saved dto.ProjectTemplateDTO
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal template: %w", err)
+	}
+
+	return &saved, nil
+}
+
+// ListProjectTemplates lists the project templates
+func (c *Client) ListProjectTemplates(ctx context.Context) ([]dto.ProjectTemplateDTO, error) {
+	resp, err := c.sendRequest(&Request{Type: RequestListProjectTemplates})
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal templates: %w", err)
+	}
+
+	var templates []dto.ProjectTemplateDTO
+	if err := json.Unmarshal(data, &templates); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal templates: %w", err)
+	}
+
+	return templates, nil
+}
+
+// DeleteProjectTemplate deletes a project template
+func (c *Client) DeleteProjectTemplate(ctx context.Context, name string) error {
+	req := &Request{
+		Type:    RequestDeleteProjectTemplate,
+		Payload: DeleteProjectTemplatePayload{Name: name},
+	}
+
+	_, err := c.sendRequest(req)
+	return err
+}
+
+// ListTags lists the registered and used tags of a project
+func (c *Client) ListTags(ctx context.Context, projectID string) ([]dto.TagDTO, error) {
+	req := &Request{
+		Type:    RequestListTags,
+		Payload: ListTagsPayload{ProjectID: projectID},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal tags: %w", err)
+	}
+
+	var tags []dto.TagDTO
+	if err := json.Unmarshal(data, &tags); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// SaveTag registers or updates a tag of a project
+func (c *Client) SaveTag(ctx context.Context, projectID string, tag dto.TagDTO) (*dto.TagRewriteDTO, error) {
+	return c.tagRewriteRequest(&Request{
+		Type: RequestSaveTag,
+		Payload: SaveTagPayload{
+			ProjectID: projectID,
+			Tag:       tag,
+		},
+	})
+}
+
+// RenameTag renames a tag on every task and note of a project
+func (c *Client) RenameTag(ctx context.Context, projectID, oldName, newName string) (*dto.TagRewriteDTO, error) {
+	return c.tagRewriteRequest(&Request{
+		Type: RequestRenameTag,
+		Payload: RenameTagPayload{
+			ProjectID: projectID,
+			OldName:   oldName,
+			NewName:   newName,
+		},
+	})
+}
+
+// MergeTags replaces the source tags with the target tag across a project
+func (c *Client) MergeTags(ctx context.Context, projectID string, sources []string, target string) (*dto.TagRewriteDTO, error) {
+	return c.tagRewriteRequest(&Request{
+		Type: RequestMergeTags,
+		Payload: MergeTagsPayload{
+			ProjectID: projectID,
+			Sources:   sources,
+			Target:    target,
+		},
+	})
+}
+
+// DeleteTag removes a tag from every task and note of a project
+func (c *Client) DeleteTag(ctx context.Context, projectID, name string) (*dto.TagRewriteDTO, error) {
+	return c.tagRewriteRequest(&Request{
+		Type: RequestDeleteTag,
+		Payload: DeleteTagPayload{
+			ProjectID: projectID,
+			Name:      name,
+		},
+	})
+}
+
+// tagRewriteRequest sends a request answered with the tasks and notes it changed
+func (c *Client) tagRewriteRequest(req *Request) (*dto.TagRewriteDTO, error) {
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal tag result: %w", err)
+	}
+
+	var result dto.TagRewriteDTO
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tag result: %w", err)
+	}
+
+	return &result, nil
+}
+
+// SetTaskParent nests a task under another task; an empty parentID makes it a top-level task
+func (c *Client) SetTaskParent(ctx context.Context, boardID, taskID, parentID string) (*dto.TaskDTO, error) {
+	req := &Request{
+		Type: RequestSetTaskParent,
+		Payload: SetTaskParentPayload{
+			BoardID:  boardID,
+			TaskID:   taskID,
+			ParentID: parentID,
+		},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal task: %w", err)
+	}
+
+	var task dto.TaskDTO
+	if err := json.Unmarshal(data, &task); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal task: %w", err)
+	}
+
+	return &task, nil
+}
+
+// GetTaskTree returns a task with its subtasks at any depth
+func (c *Client) GetTaskTree(ctx context.Context, boardID, taskID string) (*dto.TaskTreeDTO, error) {
+	req := &Request{
+		Type:    RequestGetTaskTree,
+		Payload: GetTaskTreePayload{BoardID: boardID, TaskID: taskID},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal task tree: %w", err)
+	}
+
+	var tree dto.TaskTreeDTO
+	if err := json.Unmarshal(data, &tree); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal task tree: %w", err)
+	}
+
+	return &tree, nil
+}
+
+// GetMilestone returns a milestone with its member tasks and progress
+func (c *Client) GetMilestone(ctx context.Context, boardID, milestoneID string) (*dto.MilestoneDTO, error) {
+	req := &Request{
+		Type:    RequestGetMilestone,
+		Payload: GetMilestonePayload{BoardID: boardID, MilestoneID: milestoneID},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal milestone: %w", err)
+	}
+
+	var milestone dto.MilestoneDTO
+	if err := json.Unmarshal(data, &milestone); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal milestone: %w", err)
+	}
+
+	return &milestone, nil
+}
+
+// ListMilestones returns the milestones of a project, or of all projects if
+// projectID is empty
+func (c *Client) ListMilestones(ctx context.Context, projectID string) ([]dto.MilestoneDTO, error) {
+	req := &Request{
+		Type:    RequestListMilestones,
+		Payload: ListMilestonesPayload{ProjectID: projectID},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal milestones: %w", err)
+	}
+
+	var milestones []dto.MilestoneDTO
+	if err := json.Unmarshal(data, &milestones); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal milestones: %w", err)
+	}
+
+	return milestones, nil
+}
+
+// AddMilestoneTasks adds tasks, from any board, to a milestone
+func (c *Client) AddMilestoneTasks(ctx context.Context, boardID, milestoneID string, tasks []dto.MilestoneMemberDTO) (*dto.MilestoneDTO, error) {
+	req := &Request{
+		Type:    RequestAddMilestoneTasks,
+		Payload: MilestoneTasksPayload{BoardID: boardID, MilestoneID: milestoneID, Tasks: tasks},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal milestone: %w", err)
+	}
+
+	var milestone dto.MilestoneDTO
+	if err := json.Unmarshal(data, &milestone); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal milestone: %w", err)
+	}
+
+	return &milestone, nil
+}
+
+// RemoveMilestoneTasks removes tasks from a milestone
+func (c *Client) RemoveMilestoneTasks(ctx context.Context, boardID, milestoneID string, tasks []dto.MilestoneMemberDTO) (*dto.MilestoneDTO, error) {
+	req := &Request{
+		Type:    RequestRemoveMilestoneTasks,
+		Payload: MilestoneTasksPayload{BoardID: boardID, MilestoneID: milestoneID, Tasks: tasks},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal milestone: %w", err)
+	}
+
+	var milestone dto.MilestoneDTO
+	if err := json.Unmarshal(data, &milestone); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal milestone: %w", err)
+	}
+
+	return &milestone, nil
+}
+
+// PlanSprint plans a sprint in a project; dates are YYYY-MM-DD
+func (c *Client) PlanSprint(ctx context.Context, projectID, name, goal, startDate, endDate string) (*dto.SprintDTO, error) {
+	req := &Request{
+		Type:    RequestPlanSprint,
+		Payload: PlanSprintPayload{
+			ProjectID: projectID,
+			Name:      name,
+			Goal:      goal,
+			StartDate: startDate,
+			EndDate:   endDate,
+		},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal sprint: %w", err)
+	}
+
+	var sprint dto.SprintDTO
+	if err := json.Unmarshal(data, &sprint); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sprint: %w", err)
+	}
+
+	return &sprint, nil
+}
+
+// GetSprint returns a sprint with its tasks
+func (c *Client) GetSprint(ctx context.Context, projectID, sprintID string) (*dto.SprintDTO, error) {
+	req := &Request{
+		Type:    RequestGetSprint,
+		Payload: GetSprintPayload{ProjectID: projectID, SprintID: sprintID},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal sprint: %w", err)
+	}
+
+	var sprint dto.SprintDTO
+	if err := json.Unmarshal(data, &sprint); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sprint: %w", err)
+	}
+
+	return &sprint, nil
+}
+
+// ListSprints returns the sprints of a project, sorted by start date
+func (c *Client) ListSprints(ctx context.Context, projectID string) ([]dto.SprintDTO, error) {
+	req := &Request{
+		Type:    RequestListSprints,
+		Payload: ListSprintsPayload{ProjectID: projectID},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal sprints: %w", err)
+	}
+
+	var sprints []dto.SprintDTO
+	if err := json.Unmarshal(data, &sprints); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sprints: %w", err)
+	}
+
+	return sprints, nil
+}
+
+// AddSprintTasks plans tasks in a sprint
+func (c *Client) AddSprintTasks(ctx context.Context, projectID, sprintID string, tasks []dto.SprintTaskDTO) (*dto.SprintDTO, error) {
+	req := &Request{
+		Type:    RequestAddSprintTasks,
+		Payload: SprintTasksPayload{ProjectID: projectID, SprintID: sprintID, Tasks: tasks},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal sprint: %w", err)
+	}
+
+	var sprint dto.SprintDTO
+	if err := json.Unmarshal(data, &sprint); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sprint: %w", err)
+	}
+
+	return &sprint, nil
+}
+
+// RemoveSprintTasks takes tasks out of a sprint
+func (c *Client) RemoveSprintTasks(ctx context.Context, projectID, sprintID string, tasks []dto.SprintTaskDTO) (*dto.SprintDTO, error) {
+	req := &Request{
+		Type:    RequestRemoveSprintTasks,
+		Payload: SprintTasksPayload{ProjectID: projectID, SprintID: sprintID, Tasks: tasks},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal sprint: %w", err)
+	}
+
+	var sprint dto.SprintDTO
+	if err := json.Unmarshal(data, &sprint); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sprint: %w", err)
+	}
+
+	return &sprint, nil
+}
+
+// CloseSprint closes a sprint; unfinished tasks roll over to nextSprintID,
+// or to the next open sprint of the project if it is empty
+func (c *Client) CloseSprint(ctx context.Context, projectID, sprintID, nextSprintID string) (*dto.SprintDTO, error) {
+	req := &Request{
+		Type:    RequestCloseSprint,
+		Payload: CloseSprintPayload{ProjectID: projectID, SprintID: sprintID, NextSprintID: nextSprintID},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal sprint: %w", err)
+	}
+
+	var sprint dto.SprintDTO
+	if err := json.Unmarshal(data, &sprint); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sprint: %w", err)
+	}
+
+	return &sprint, nil
+}
+
+// GetFlowMetrics returns lead and cycle times, weekly throughput and
+// cumulative flow of a board; from and to are YYYY-MM-DD and may be empty
+func (c *Client) GetFlowMetrics(ctx context.Context, boardID, from, to string) (*dto.FlowMetricsDTO, error) {
+	req := &Request{
+		Type:    RequestGetFlowMetrics,
+		Payload: GetFlowMetricsPayload{BoardID: boardID, From: from, To: to},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal flow metrics: %w", err)
+	}
+
+	var metrics dto.FlowMetricsDTO
+	if err := json.Unmarshal(data, &metrics); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal flow metrics: %w", err)
+	}
+
+	return &metrics, nil
+}
+
+// ForecastCompletion forecasts when a number of tasks on boards will be done;
+// zero tasks forecasts the unfinished tasks of the boards
+func (c *Client) ForecastCompletion(ctx context.Context, boardIDs []string, tasks int) (*dto.CompletionForecastDTO, error) {
+	req := &Request{
+		Type:    RequestForecastCompletion,
+		Payload: ForecastCompletionPayload{BoardIDs: boardIDs, Tasks: tasks},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal forecast: %w", err)
+	}
+
+	var forecast dto.CompletionForecastDTO
+	if err := json.Unmarshal(data, &forecast); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal forecast: %w", err)
+	}
+
+	return &forecast, nil
+}
+
+// ForecastThroughput forecasts how many tasks of boards will be done by a
+// date given as YYYY-MM-DD
+func (c *Client) ForecastThroughput(ctx context.Context, boardIDs []string, date string) (*dto.ThroughputForecastDTO, error) {
+	req := &Request{
+		Type:    RequestForecastThroughput,
+		Payload: ForecastThroughputPayload{BoardIDs: boardIDs, Date: date},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal forecast: %w", err)
+	}
+
+	var forecast dto.ThroughputForecastDTO
+	if err := json.Unmarshal(data, &forecast); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal forecast: %w", err)
+	}
+
+	return &forecast, nil
+}
+
+// GetWorkSchedule returns the work schedule of a project, or the default
+// schedule if projectID is empty
+func (c *Client) GetWorkSchedule(ctx context.Context, projectID string) (*dto.WorkScheduleDTO, error) {
+	req := &Request{
+		Type:    RequestGetWorkSchedule,
+		Payload: GetWorkSchedulePayload{ProjectID: projectID},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal work schedule: %w", err)
+	}
+
+	var schedule dto.WorkScheduleDTO
+	if err := json.Unmarshal(data, &schedule); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal work schedule: %w", err)
+	}
+
+	return &schedule, nil
+}
+
+// UpdateWorkSchedule changes the name, time zone or weekdays of a work schedule
+func (c *Client) UpdateWorkSchedule(ctx context.Context, update dto.UpdateWorkScheduleRequest) (*dto.WorkScheduleDTO, error) {
+	req := &Request{
+		Type:    RequestUpdateWorkSchedule,
+		Payload: UpdateWorkSchedulePayload{Schedule: update},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal work schedule: %w", err)
+	}
+
+	var schedule dto.WorkScheduleDTO
+	if err := json.Unmarshal(data, &schedule); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal work schedule: %w", err)
+	}
+
+	return &schedule, nil
+}
+
+// AddScheduleException adds a holiday, time off or changed working hours
+// on a date to a work schedule
+func (c *Client) AddScheduleException(ctx context.Context, exception ScheduleExceptionPayload) (*dto.WorkScheduleDTO, error) {
+	req := &Request{
+		Type:    RequestAddScheduleException,
+		Payload: exception,
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal work schedule: %w", err)
+	}
+
+	var schedule dto.WorkScheduleDTO
+	if err := json.Unmarshal(data, &schedule); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal work schedule: %w", err)
+	}
+
+	return &schedule, nil
+}
+
+// RemoveScheduleException removes the exception on a date, given as YYYY-MM-DD,
+// from a work schedule
+func (c *Client) RemoveScheduleException(ctx context.Context, projectID, date string) (*dto.WorkScheduleDTO, error) {
+	req := &Request{
+		Type:    RequestRemoveScheduleException,
+		Payload: RemoveScheduleExceptionPayload{ProjectID: projectID, Date: date},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal work schedule: %w", err)
+	}
+
+	var schedule dto.WorkScheduleDTO
+	if err := json.Unmarshal(data, &schedule); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal work schedule: %w", err)
+	}
+
+	return &schedule, nil
+}
+
+// CheckWorkingTime reports whether a moment, given as RFC 3339 or empty
+// for now, falls within the working hours of a project
+func (c *Client) CheckWorkingTime(ctx context.Context, projectID, at string) (*dto.WorkingTimeDTO, error) {
+	req := &Request{
+		Type:    RequestCheckWorkingTime,
+		Payload: CheckWorkingTimePayload{ProjectID: projectID, Time: at},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal working time: %w", err)
+	}
+
+	var workingTime dto.WorkingTimeDTO
+	if err := json.Unmarshal(data, &workingTime); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal working time: %w", err)
+	}
+
+	return &workingTime, nil
+}
+
+// ScheduleTask schedules a task for a date, optionally at a time and for a
+// time block
+func (c *Client) ScheduleTask(ctx context.Context, payload ScheduleTaskPayload) error {
+	req := &Request{
+		Type:    RequestScheduleTask,
+		Payload: payload,
+	}
+
+	_, err := c.sendRequest(req)
+	return err
+}
+
+// PlanSchedule proposes time blocks for the unscheduled tasks of boards, or
+// of every board if boardIDs is empty, over the days starting at from
+// (YYYY-MM-DD, empty for now)
+func (c *Client) PlanSchedule(ctx context.Context, boardIDs []string, from string, days int) (*dto.SchedulePlanDTO, error) {
+	req := &Request{
+		Type:    RequestPlanSchedule,
+		Payload: PlanSchedulePayload{BoardIDs: boardIDs, From: from, Days: days},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal schedule plan: %w", err)
+	}
+
+	var plan dto.SchedulePlanDTO
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal schedule plan: %w", err)
+	}
+
+	return &plan, nil
+}
+
+// ApplySchedulePlan schedules every block of a plan
+func (c *Client) ApplySchedulePlan(ctx context.Context, plan *dto.SchedulePlanDTO) error {
+	for _, block := range plan.Blocks {
+		start := block.Start.Format(time.RFC3339)
+		duration := block.Duration
+		err := c.ScheduleTask(ctx, ScheduleTaskPayload{
+			TaskID:   block.TaskID,
+			Date:     block.Date,
+			Duration: &duration,
+			Start:    &start,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to schedule task %s: %w", block.TaskID, err)
+		}
+	}
+	return nil
+}
+
+// GetAgenda returns the agenda of a project, or of every project if projectID
+// is empty, for the days from from to to (YYYY-MM-DD, empty for today and a
+// week from from)
+func (c *Client) GetAgenda(ctx context.Context, projectID, from, to string) (*dto.AgendaDTO, error) {
+	req := &Request{
+		Type:    RequestGetAgenda,
+		Payload: GetAgendaPayload{ProjectID: projectID, From: from, To: to},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal agenda: %w", err)
+	}
+
+	var agenda dto.AgendaDTO
+	if err := json.Unmarshal(data, &agenda); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal agenda: %w", err)
+	}
+
+	return &agenda, nil
+}
+
+// UnscheduleTask removes a task from the agenda
+func (c *Client) UnscheduleTask(ctx context.Context, taskID string) error {
+	req := &Request{
+		Type:    RequestUnscheduleTask,
+		Payload: UnscheduleTaskPayload{TaskID: taskID},
+	}
+
+	_, err := c.sendRequest(req)
+	return err
+}
+
+// RescheduleTask moves a scheduled task to another date, time or start, or
+// changes the length of its time block
+func (c *Client) RescheduleTask(ctx context.Context, payload RescheduleTaskPayload) error {
+	req := &Request{
+		Type:    RequestRescheduleTask,
+		Payload: payload,
+	}
+
+	_, err := c.sendRequest(req)
+	return err
+}
+
+// StartTimer starts tracking time on a project, or on one of its tasks if
+// taskID is not empty
+func (c *Client) StartTimer(ctx context.Context, projectID, taskID, description string) error {
+	payload := StartTimerPayload{ProjectID: projectID, Description: description}
+	if taskID != "" {
+		payload.TaskID = &taskID
+	}
+
+	_, err := c.sendRequest(&Request{Type: RequestStartTimer, Payload: payload})
+	return err
+}
+
+// StopTimer stops tracking time on a project, or on one of its tasks if
+// taskID is not empty
+func (c *Client) StopTimer(ctx context.Context, projectID, taskID string) error {
+	payload := StopTimerPayload{ProjectID: projectID}
+	if taskID != "" {
+		payload.TaskID = &taskID
+	}
+
+	_, err := c.sendRequest(&Request{Type: RequestStopTimer, Payload: payload})
+	return err
+}
+
+// CalendarSyncNow syncs with the calendar provider and returns what changed
+func (c *Client) CalendarSyncNow(ctx context.Context) (*dto.CalendarSyncResultDTO, error) {
+	req := &Request{
+		Type: RequestCalendarSyncNow,
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal sync result: %w", err)
+	}
+
+	var result dto.CalendarSyncResultDTO
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sync result: %w", err)
+	}
+
+	return &result, nil
+}
+
+// CalendarSyncStatus returns the calendar sync settings and the last sync
+func (c *Client) CalendarSyncStatus(ctx context.Context) (*dto.CalendarSyncStatusDTO, error) {
+	req := &Request{
+		Type: RequestCalendarSyncStatus,
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal sync status: %w", err)
+	}
+
+	var status dto.CalendarSyncStatusDTO
+	if err := json.Unmarshal(data, &status); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal sync status: %w", err)
+	}
+
+	return &status, nil
+}
+
+// ListCalendarConflicts returns the calendar sync conflicts awaiting a resolution
+func (c *Client) ListCalendarConflicts(ctx context.Context) ([]dto.CalendarSyncConflictDTO, error) {
+	req := &Request{
+		Type: RequestListCalendarConflicts,
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal conflicts: %w", err)
+	}
+
+	var conflicts []dto.CalendarSyncConflictDTO
+	if err := json.Unmarshal(data, &conflicts); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal conflicts: %w", err)
+	}
+
+	return conflicts, nil
+}
+
+// ResolveCalendarConflict settles the conflict of a calendar event. resolution
+// is keep_calendar, keep_task or merge; merging takes each conflicting field
+// from the side (calendar or task) given for it in fields.
+func (c *Client) ResolveCalendarConflict(ctx context.Context, eventID, resolution string, fields map[string]string) (*dto.CalendarSyncResultDTO, error) {
+	req := &Request{
+		Type: RequestResolveCalendarConflict,
+		Payload: ResolveCalendarConflictPayload{
+			EventID:    eventID,
+			Resolution: resolution,
+			Fields:     fields,
+		},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resolution: %w", err)
+	}
+
+	var result dto.CalendarSyncResultDTO
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal resolution: %w", err)
+	}
+
+	return &result, nil
+}
+
+// ExportICal returns the iCalendar feed of a board, of every board of a
+// project if boardID is empty, or of every board. With a path, relative to
+// the exports directory, the daemon writes the feed to that file instead.
+func (c *Client) ExportICal(ctx context.Context, projectID, boardID, path string) (*dto.ICalExportDTO, error) {
+	req := &Request{
+		Type: RequestExportICal,
+		Payload: ExportICalPayload{
+			ProjectID: projectID,
+			BoardID:   boardID,
+			Path:      path,
+		},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal export: %w", err)
+	}
+
+	var export dto.ICalExportDTO
+	if err := json.Unmarshal(data, &export); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal export: %w", err)
+	}
+
+	return &export, nil
+}
+
+// ImportICal imports the events of an .ics file at path, or of content if
+// path is empty, into a board as meeting tasks
+func (c *Client) ImportICal(ctx context.Context, boardID, path, content string) (*dto.ICalImportResultDTO, error) {
+	req := &Request{
+		Type: RequestImportICal,
+		Payload: ImportICalPayload{
+			BoardID: boardID,
+			Path:    path,
+			Data:    content,
+		},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal import result: %w", err)
+	}
+
+	var result dto.ICalImportResultDTO
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal import result: %w", err)
+	}
+
+	return &result, nil
+}
+
+// CreateMeetingNote creates the meeting note of a meeting task, from the
+// named template or the configured one, or returns the note it already has
+func (c *Client) CreateMeetingNote(ctx context.Context, boardID, taskID, template string) (*dto.MeetingNoteDTO, error) {
+	req := &Request{
+		Type: RequestCreateMeetingNote,
+		Payload: CreateMeetingNotePayload{
+			BoardID:  boardID,
+			TaskID:   taskID,
+			Template: template,
+		},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal meeting note: %w", err)
+	}
+
+	var meetingNote dto.MeetingNoteDTO
+	if err := json.Unmarshal(data, &meetingNote); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal meeting note: %w", err)
+	}
+
+	return &meetingNote, nil
+}
+
+// CreateActionItems turns the checked action items of a meeting note into
+// tasks on the meeting's board
+func (c *Client) CreateActionItems(ctx context.Context, noteID string) (*dto.ActionItemTasksDTO, error) {
+	req := &Request{
+		Type:    RequestCreateActionItems,
+		Payload: CreateActionItemsPayload{NoteID: noteID},
+	}
+
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal action item tasks: %w", err)
+	}
+
+	var result dto.ActionItemTasksDTO
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal action item tasks: %w", err)
+	}
+
+	return &result, nil
 }
 
 // IsHealthy checks if the daemon is healthy
@@ -0,0 +1,605 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/service"
+	"mkanban/internal/domain/valueobject"
+	"mkanban/internal/infrastructure/config"
+	"mkanban/internal/infrastructure/persistence/filesystem"
+)
+
+const (
+	historyGitignore       = ".gitignore"
+	historyGitignoreData   = "backups/\n*.tmp\n"
+	historySnapshotMessage = "Snapshot data directory"
+	historyEventBuffer     = 64
+)
+
+var shortTaskIDRegex = regexp.MustCompile(`^([A-Z]{3})-(\d+)$`)
+
+// historyBatch tracks the last commit made for a subject so that further
+// changes to the same subject within the batch window can be folded into it
+type historyBatch struct {
+	subject  string
+	hash     string
+	messages []string
+	started  time.Time
+}
+
+// HistoryManager records every change to the data directory in a local git
+// repository and serves task and board history from it
+type HistoryManager struct {
+	config      *config.Config
+	vcs         service.VCSProvider
+	eventBus    entity.EventBus
+	pathBuilder *filesystem.ProjectPathBuilder
+	dataLock    sync.Locker // held while the working tree is committed
+
+	mu     sync.Mutex
+	last   *historyBatch
+	events chan *entity.DomainEvent
+
+	ctx        context.Context
+	cancelFunc context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+// NewHistoryManager creates a new HistoryManager. dataLock must keep daemon
+// mutations out while the data directory is being committed.
+func NewHistoryManager(
+	cfg *config.Config,
+	vcs service.VCSProvider,
+	eventBus entity.EventBus,
+	dataLock sync.Locker,
+) *HistoryManager {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &HistoryManager{
+		config:      cfg,
+		vcs:         vcs,
+		eventBus:    eventBus,
+		pathBuilder: filesystem.NewProjectPathBuilder(cfg.Storage.DataPath),
+		dataLock:    dataLock,
+		events:      make(chan *entity.DomainEvent, historyEventBuffer),
+		ctx:         ctx,
+		cancelFunc:  cancel,
+	}
+}
+
+// Start initializes the history repository and begins recording changes
+func (m *HistoryManager) Start() error {
+	if !m.config.History.Enabled {
+		fmt.Println("History is disabled in configuration")
+		return nil
+	}
+
+	root := m.rootPath()
+	if err := m.vcs.InitRepository(root); err != nil {
+		return err
+	}
+
+	gitignorePath := filepath.Join(root, historyGitignore)
+	if _, err := os.Stat(gitignorePath); os.IsNotExist(err) {
+		if err := os.WriteFile(gitignorePath, []byte(historyGitignoreData), 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", historyGitignore, err)
+		}
+	}
+
+	// Capture anything that changed while the daemon was not running
+	m.snapshot()
+
+	m.eventBus.SubscribeAll(func(event *entity.DomainEvent) {
+		select {
+		case m.events <- event:
+		case <-m.ctx.Done():
+		}
+	})
+
+	m.wg.Add(1)
+	go m.run()
+
+	fmt.Printf("[History] Recording changes in %s\n", root)
+	return nil
+}
+
+// Stop commits outstanding changes and stops recording
+func (m *HistoryManager) Stop() error {
+	if !m.config.History.Enabled {
+		return nil
+	}
+
+	m.cancelFunc()
+	m.wg.Wait()
+
+	m.snapshot()
+	return nil
+}
+
+// run commits changes as domain events arrive and periodically picks up
+// changes made outside the daemon's request handlers
+func (m *HistoryManager) run() {
+	defer m.wg.Done()
+
+	interval := time.Duration(m.config.History.SnapshotInterval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case event := <-m.events:
+			m.record(event)
+		case <-ticker.C:
+			m.snapshot()
+		}
+	}
+}
+
+// record commits the change described by a domain event
+func (m *HistoryManager) record(event *entity.DomainEvent) {
+	subject, message := describeHistoryEvent(event)
+
+	m.dataLock.Lock()
+	defer m.dataLock.Unlock()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.commit(subject, message); err != nil {
+		fmt.Printf("[History] Failed to record %s: %v\n", event.Type, err)
+	}
+}
+
+// snapshot commits any outstanding changes in the data directory
+func (m *HistoryManager) snapshot() {
+	m.dataLock.Lock()
+	defer m.dataLock.Unlock()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.commit("", historySnapshotMessage); err != nil {
+		fmt.Printf("[History] Failed to snapshot data directory: %v\n", err)
+	}
+}
+
+// commit records the working tree. Changes to the subject of the previous
+// commit made within the batch window amend that commit instead of adding a
+// new one. Callers must hold m.mu.
+func (m *HistoryManager) commit(subject, message string) error {
+	root := m.rootPath()
+	window := time.Duration(m.config.History.BatchWindow) * time.Second
+
+	if subject != "" && m.last != nil && m.last.subject == subject && time.Since(m.last.started) < window {
+		if head, err := m.vcs.Log(root, "", 1); err == nil && len(head) == 1 && head[0].Hash == m.last.hash {
+			m.last.messages = append(m.last.messages, message)
+
+			hash, err := m.vcs.AmendCommit(root, batchCommitMessage(m.last.messages))
+			if err != nil {
+				return err
+			}
+			m.last.hash = hash
+			return nil
+		}
+	}
+
+	hash, err := m.vcs.CommitAll(root, message)
+	if err != nil {
+		return err
+	}
+	if hash == "" {
+		return nil
+	}
+
+	m.last = nil
+	if subject != "" {
+		m.last = &historyBatch{
+			subject:  subject,
+			hash:     hash,
+			messages: []string{message},
+			started:  time.Now(),
+		}
+	}
+
+	return nil
+}
+
+// TaskHistory returns the recorded versions of a task, newest first.
+// taskRef may be a full or short task ID; boardID optionally narrows the search.
+func (m *HistoryManager) TaskHistory(taskRef, boardID string, limit int) (*dto.HistoryDTO, error) {
+	if !m.config.History.Enabled {
+		return nil, fmt.Errorf("history is disabled")
+	}
+
+	shortID, err := normalizeShortTaskID(taskRef)
+	if err != nil {
+		return nil, err
+	}
+
+	pathspec, err := m.taskPathspec(shortID, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	root := m.rootPath()
+	commits, err := m.vcs.Log(root, pathspec, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	history := &dto.HistoryDTO{
+		TaskID:  shortID,
+		BoardID: boardID,
+		Entries: make([]dto.HistoryEntryDTO, 0, len(commits)),
+	}
+
+	for _, commit := range commits {
+		entry := historyEntryFromCommit(commit)
+
+		// A move touches both the old and the new folder; report the one that exists
+		taskDirs := findTaskDirs(commit.Files, shortID)
+		if len(taskDirs) == 0 {
+			continue
+		}
+
+		entry.Deleted = true
+		entry.Column = columnFromTaskDir(taskDirs[0])
+		for _, taskDir := range taskDirs {
+			metadata, err := m.vcs.ShowFile(root, commit.Hash, filepath.Join(taskDir, "metadata.yml"))
+			if err != nil {
+				continue
+			}
+
+			entry.Deleted = false
+			entry.Column = columnFromTaskDir(taskDir)
+			entry.Metadata = string(metadata)
+			if content, err := m.vcs.ShowFile(root, commit.Hash, filepath.Join(taskDir, "task.md")); err == nil {
+				entry.Content = string(content)
+			}
+			break
+		}
+
+		history.Entries = append(history.Entries, entry)
+	}
+
+	return history, nil
+}
+
+// BoardHistory returns the commits that touched a board, newest first
+func (m *HistoryManager) BoardHistory(boardID string, limit int) (*dto.HistoryDTO, error) {
+	if !m.config.History.Enabled {
+		return nil, fmt.Errorf("history is disabled")
+	}
+
+	boardDir, err := m.boardRelDir(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	commits, err := m.vcs.Log(m.rootPath(), boardDir, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	history := &dto.HistoryDTO{
+		BoardID: boardID,
+		Entries: make([]dto.HistoryEntryDTO, 0, len(commits)),
+	}
+	for _, commit := range commits {
+		history.Entries = append(history.Entries, historyEntryFromCommit(commit))
+	}
+
+	return history, nil
+}
+
+// RestoreTask replaces a task with its version at revision. The task is put
+// back into the column it was in at that revision, which must still exist.
+// Callers must keep other daemon mutations out while this runs.
+func (m *HistoryManager) RestoreTask(taskRef, revision string) (*dto.RestoreResultDTO, error) {
+	if !m.config.History.Enabled {
+		return nil, fmt.Errorf("history is disabled")
+	}
+
+	shortID, err := normalizeShortTaskID(taskRef)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// The revision comes from the client, only a resolved commit hash is passed on
+	root := m.rootPath()
+	revision, err = m.vcs.ResolveRevision(root, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := m.vcs.ListFiles(root, revision, m.relPath(m.pathBuilder.ProjectsRoot()))
+	if err != nil {
+		return nil, err
+	}
+
+	taskDirs := findTaskDirs(files, shortID)
+	if len(taskDirs) == 0 {
+		return nil, fmt.Errorf("task %s does not exist at revision %s", shortID, revision)
+	}
+	taskDir := taskDirs[0]
+
+	boardID, err := boardIDFromPath(taskDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// tasks/<task> sits inside the column folder
+	columnDir := filepath.Dir(filepath.Dir(taskDir))
+	if _, err := os.Stat(filepath.Join(root, columnDir)); err != nil {
+		return nil, fmt.Errorf("column %s no longer exists on board %s", filepath.Base(columnDir), boardID)
+	}
+
+	// Drop the current version wherever it lives on the board now
+	boardDir, err := m.boardRelDir(boardID)
+	if err != nil {
+		return nil, err
+	}
+	current, err := filepath.Glob(filepath.Join(root, boardDir, "columns", "*", "tasks", shortID+"-*"))
+	if err != nil {
+		return nil, err
+	}
+	for _, dir := range current {
+		if err := os.RemoveAll(dir); err != nil {
+			return nil, fmt.Errorf("failed to remove current version of %s: %w", shortID, err)
+		}
+	}
+
+	if err := m.vcs.RestorePath(root, revision, taskDir); err != nil {
+		return nil, err
+	}
+
+	message := fmt.Sprintf("%s restored from %s", shortID, shortRevision(revision))
+	if err := m.commit("", message); err != nil {
+		return nil, err
+	}
+
+	return &dto.RestoreResultDTO{
+		BoardID:  boardID,
+		TaskID:   filepath.Base(taskDir),
+		Revision: revision,
+		Commit:   m.headRevision(),
+	}, nil
+}
+
+// RestoreBoard replaces a board with its state as of the given time.
+// Callers must keep other daemon mutations out while this runs.
+func (m *HistoryManager) RestoreBoard(boardID string, at time.Time) (*dto.RestoreResultDTO, error) {
+	if !m.config.History.Enabled {
+		return nil, fmt.Errorf("history is disabled")
+	}
+
+	boardDir, err := m.boardRelDir(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	root := m.rootPath()
+	revision, err := m.vcs.RevisionAt(root, at)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := m.vcs.ListFiles(root, revision, boardDir)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("board %s did not exist at %s", boardID, at.Format(time.RFC3339))
+	}
+
+	if err := os.RemoveAll(filepath.Join(root, boardDir)); err != nil {
+		return nil, fmt.Errorf("failed to remove current board: %w", err)
+	}
+	if err := m.vcs.RestorePath(root, revision, boardDir); err != nil {
+		return nil, err
+	}
+
+	message := fmt.Sprintf("Board %s restored to %s", boardID, at.Format("2006-01-02 15:04"))
+	if err := m.commit("", message); err != nil {
+		return nil, err
+	}
+
+	return &dto.RestoreResultDTO{
+		BoardID:  boardID,
+		Revision: revision,
+		Commit:   m.headRevision(),
+	}, nil
+}
+
+// taskPathspec builds a pathspec matching every file of a task, on one board or all of them
+func (m *HistoryManager) taskPathspec(shortID, boardID string) (string, error) {
+	boardDir := "projects/*/boards/*"
+	if boardID != "" {
+		dir, err := m.boardRelDir(boardID)
+		if err != nil {
+			return "", err
+		}
+		boardDir = filepath.ToSlash(dir)
+	}
+
+	return fmt.Sprintf(":(glob)%s/columns/*/tasks/%s-*/**", boardDir, shortID), nil
+}
+
+// boardRelDir returns a board's directory relative to the data root
+func (m *HistoryManager) boardRelDir(boardID string) (string, error) {
+	projectSlug, boardSlug, err := valueobject.ParseBoardID(boardID)
+	if err != nil {
+		return "", err
+	}
+
+	return m.relPath(filepath.Join(m.pathBuilder.ProjectBoardsDir(projectSlug), boardSlug)), nil
+}
+
+// relPath renders a path relative to the data root, as the repository sees it
+func (m *HistoryManager) relPath(path string) string {
+	rel, err := filepath.Rel(m.rootPath(), path)
+	if err != nil {
+		return path
+	}
+	return rel
+}
+
+func (m *HistoryManager) headRevision() string {
+	head, err := m.vcs.Log(m.rootPath(), "", 1)
+	if err != nil || len(head) == 0 {
+		return ""
+	}
+	return head[0].Hash
+}
+
+func (m *HistoryManager) rootPath() string {
+	return m.pathBuilder.RootPath()
+}
+
+// describeHistoryEvent derives the batching subject and commit message for a domain event
+func describeHistoryEvent(event *entity.DomainEvent) (string, string) {
+	if event.TaskID != nil {
+		shortID := event.TaskID.ShortID()
+		subject := "task:" + shortID
+
+		switch event.Type {
+		case valueobject.EventTaskCreated:
+			return subject, fmt.Sprintf("%s created in %s", shortID, event.ColumnID)
+		case valueobject.EventTaskMoved:
+			from, _ := event.Metadata["from_column"].(string)
+			return subject, fmt.Sprintf("%s moved %s → %s", shortID, from, event.ColumnID)
+		default:
+			action := strings.ReplaceAll(strings.TrimPrefix(event.Type.String(), "task."), "_", " ")
+			return subject, fmt.Sprintf("%s %s", shortID, action)
+		}
+	}
+
+	switch event.Type {
+	case valueobject.EventBoardCreated:
+		return "board:" + event.BoardID, fmt.Sprintf("Board %s created", event.BoardID)
+	case valueobject.EventColumnCreated:
+		return "board:" + event.BoardID, fmt.Sprintf("Column %s created on %s", event.ColumnID, event.BoardID)
+	case valueobject.EventColumnDeleted:
+		return "board:" + event.BoardID, fmt.Sprintf("Column %s deleted from %s", event.ColumnID, event.BoardID)
+	case valueobject.EventProjectCreated, valueobject.EventProjectUpdated, valueobject.EventProjectDeleted:
+		projectID, _ := event.Metadata["project_id"].(string)
+		action := strings.TrimPrefix(event.Type.String(), "project.")
+		return "project:" + projectID, fmt.Sprintf("Project %s %s", projectID, action)
+	}
+
+	return "", event.Type.String()
+}
+
+// batchCommitMessage joins the messages of a batched commit, newest as the summary
+func batchCommitMessage(messages []string) string {
+	if len(messages) == 1 {
+		return messages[0]
+	}
+
+	var b strings.Builder
+	b.WriteString(messages[len(messages)-1])
+	b.WriteString("\n")
+	for _, message := range messages {
+		b.WriteString("\n- ")
+		b.WriteString(message)
+	}
+	return b.String()
+}
+
+func historyEntryFromCommit(commit service.VCSCommit) dto.HistoryEntryDTO {
+	return dto.HistoryEntryDTO{
+		Revision: commit.Hash,
+		Date:     commit.Date,
+		Author:   commit.Author,
+		Message:  commit.Message,
+		Files:    commit.Files,
+	}
+}
+
+// normalizeShortTaskID turns a full or short task ID into its zero-padded short form
+func normalizeShortTaskID(taskRef string) (string, error) {
+	taskRef = strings.TrimSpace(taskRef)
+	if taskID, err := valueobject.ParseTaskID(taskRef); err == nil {
+		return taskID.ShortID(), nil
+	}
+
+	matches := shortTaskIDRegex.FindStringSubmatch(taskRef)
+	if matches == nil {
+		return "", fmt.Errorf("invalid task ID: %s", taskRef)
+	}
+
+	number, _ := strconv.Atoi(matches[2])
+	return fmt.Sprintf("%s-%03d", matches[1], number), nil
+}
+
+// findTaskDirs returns the distinct task folders of shortID among paths relative to the data root
+func findTaskDirs(paths []string, shortID string) []string {
+	dirs := make([]string, 0, 1)
+	seen := make(map[string]bool)
+
+	for _, path := range paths {
+		parts := strings.Split(filepath.ToSlash(path), "/")
+		for i := 1; i < len(parts); i++ {
+			if parts[i-1] != "tasks" || !strings.HasPrefix(parts[i], shortID+"-") {
+				continue
+			}
+
+			dir := filepath.FromSlash(strings.Join(parts[:i+1], "/"))
+			if !seen[dir] {
+				seen[dir] = true
+				dirs = append(dirs, dir)
+			}
+			break
+		}
+	}
+
+	return dirs
+}
+
+// columnFromTaskDir returns the column folder name for a task folder path
+func columnFromTaskDir(taskDir string) string {
+	return filepath.Base(filepath.Dir(filepath.Dir(taskDir)))
+}
+
+// boardIDFromPath extracts the board ID from a path below projects/<project>/boards/<board>
+func boardIDFromPath(path string) (string, error) {
+	parts := strings.Split(filepath.ToSlash(path), "/")
+	if len(parts) < 4 || parts[0] != "projects" || parts[2] != "boards" {
+		return "", fmt.Errorf("not a board path: %s", path)
+	}
+	return valueobject.BuildBoardID(parts[1], parts[3])
+}
+
+func shortRevision(revision string) string {
+	if len(revision) > 7 {
+		return revision[:7]
+	}
+	return revision
+}
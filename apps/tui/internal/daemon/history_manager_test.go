@@ -0,0 +1,143 @@
+package daemon
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/infrastructure/config"
+	"mkanban/internal/infrastructure/external"
+)
+
+const (
+	historyTestBoardDir = "projects/work/boards/tracker"
+	historyTestTaskFile = historyTestBoardDir + "/columns/to-do/tasks/TRK-001-fix-bug/task.md"
+)
+
+// newTestHistoryManager creates a history manager over a git repository in a
+// temp data root, with a first snapshot of one task
+func newTestHistoryManager(t *testing.T) (*HistoryManager, string) {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	root := t.TempDir()
+	cfg := &config.Config{}
+	cfg.Storage.DataPath = root
+	cfg.History.Enabled = true
+
+	vcs := external.NewGitVCSProvider()
+	if err := vcs.InitRepository(root); err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewHistoryManager(cfg, vcs, nil, &sync.Mutex{})
+	writeHistoryTestFile(t, root, historyTestTaskFile, "# Fix bug\n")
+	m.snapshot()
+	return m, root
+}
+
+// writeHistoryTestFile writes a file below root, creating its directories
+func writeHistoryTestFile(t *testing.T, root, rel, content string) {
+	t.Helper()
+	path := filepath.Join(root, filepath.FromSlash(rel))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// readHistoryTestFile returns the contents of a file below root
+func readHistoryTestFile(t *testing.T, root, rel string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(rel)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestHistoryRestoreTask(t *testing.T) {
+	m, root := newTestHistoryManager(t)
+	first := m.headRevision()
+
+	// The task is edited and moved to another column after the first snapshot
+	movedFile := historyTestBoardDir + "/columns/doing/tasks/TRK-001-fix-bug/task.md"
+	writeHistoryTestFile(t, root, historyTestBoardDir+"/columns/doing/column.md", "# Doing\n")
+	writeHistoryTestFile(t, root, movedFile, "# Fix bug\n\nEdited\n")
+	if err := os.RemoveAll(filepath.Join(root, historyTestBoardDir, "columns/to-do/tasks")); err != nil {
+		t.Fatal(err)
+	}
+	m.snapshot()
+
+	result, err := m.RestoreTask("TRK-1", first[:10])
+	if err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+	if result.Revision != first {
+		t.Errorf("expected the revision to be resolved to %s, got %s", first, result.Revision)
+	}
+	if result.BoardID != "work/tracker" || result.TaskID != "TRK-001-fix-bug" {
+		t.Errorf("unexpected result %+v", result)
+	}
+	if got := readHistoryTestFile(t, root, historyTestTaskFile); got != "# Fix bug\n" {
+		t.Errorf("expected the first version of the task, got %q", got)
+	}
+	if _, err := os.Stat(filepath.Join(root, filepath.FromSlash(movedFile))); !os.IsNotExist(err) {
+		t.Error("expected the current version of the task to be replaced")
+	}
+	if result.Commit == "" || result.Commit == first {
+		t.Errorf("expected the restore to be committed, got %q", result.Commit)
+	}
+}
+
+func TestHistoryRestoreTaskRejectsInvalidRevisions(t *testing.T) {
+	m, root := newTestHistoryManager(t)
+	head := m.headRevision()
+
+	for _, revision := range []string{"--output=" + filepath.Join(root, "out"), "-p", "missing"} {
+		if _, err := m.RestoreTask("TRK-001", revision); !errors.Is(err, entity.ErrInvalidRevision) {
+			t.Errorf("%q: expected %v, got %v", revision, entity.ErrInvalidRevision, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(root, "out")); !os.IsNotExist(err) {
+		t.Error("an option passed as revision was run by git")
+	}
+	if m.headRevision() != head {
+		t.Error("expected rejected restores to leave the history alone")
+	}
+
+	if _, err := m.RestoreTask("TRK-002", head); err == nil {
+		t.Error("expected a task missing at the revision to be rejected")
+	}
+}
+
+func TestHistoryRestoreBoard(t *testing.T) {
+	m, root := newTestHistoryManager(t)
+
+	// Changes made since the last snapshot are replaced by the board as of now
+	writeHistoryTestFile(t, root, historyTestTaskFile, "# Fix bug\n\nUnrecorded\n")
+	writeHistoryTestFile(t, root, historyTestBoardDir+"/columns/to-do/tasks/TRK-002-new/task.md", "# New\n")
+
+	if _, err := m.RestoreBoard("work/tracker", time.Now().Add(time.Minute)); err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+	if got := readHistoryTestFile(t, root, historyTestTaskFile); got != "# Fix bug\n" {
+		t.Errorf("expected the recorded version of the task, got %q", got)
+	}
+	if _, err := os.Stat(filepath.Join(root, historyTestBoardDir, "columns/to-do/tasks/TRK-002-new")); !os.IsNotExist(err) {
+		t.Error("expected a task created after the revision to be removed")
+	}
+
+	if _, err := m.RestoreBoard("work/other", time.Now()); err == nil {
+		t.Error("expected a board missing at the revision to be rejected")
+	}
+}
@@ -0,0 +1,117 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/application/usecase/milestone"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/valueobject"
+)
+
+// milestoneCheckInterval is how often the manager re-evaluates milestone risk
+const milestoneCheckInterval = time.Hour
+
+// MilestoneManager periodically projects milestone completion and publishes
+// an event when a milestone becomes at risk of missing its due date
+type MilestoneManager struct {
+	check    *milestone.CheckMilestonesUseCase
+	eventBus entity.EventBus
+	dataLock sync.Locker // held while at-risk flags are saved
+
+	ctx        context.Context
+	cancelFunc context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+// NewMilestoneManager creates a new MilestoneManager. dataLock must keep
+// daemon mutations out while milestones are checked.
+func NewMilestoneManager(check *milestone.CheckMilestonesUseCase, eventBus entity.EventBus, dataLock sync.Locker) *MilestoneManager {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &MilestoneManager{
+		check:      check,
+		eventBus:   eventBus,
+		dataLock:   dataLock,
+		ctx:        ctx,
+		cancelFunc: cancel,
+	}
+}
+
+// Start starts the milestone risk checks
+func (m *MilestoneManager) Start() error {
+	m.wg.Add(1)
+	go m.run()
+
+	fmt.Println("[Milestone] Checking milestones for risk")
+	return nil
+}
+
+// Stop stops the milestone risk checks
+func (m *MilestoneManager) Stop() error {
+	m.cancelFunc()
+	m.wg.Wait()
+	return nil
+}
+
+func (m *MilestoneManager) run() {
+	defer m.wg.Done()
+
+	m.checkMilestones()
+
+	ticker := time.NewTicker(milestoneCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-ticker.C:
+			m.checkMilestones()
+		}
+	}
+}
+
+func (m *MilestoneManager) checkMilestones() {
+	m.dataLock.Lock()
+	atRisk, err := m.check.Execute(m.ctx)
+	m.dataLock.Unlock()
+	if err != nil {
+		fmt.Printf("[Milestone] Failed to check milestones: %v\n", err)
+		return
+	}
+	publishMilestonesAtRisk(m.eventBus, atRisk)
+}
+
+// publishMilestonesAtRisk publishes a milestone.at_risk event for each
+// milestone that has become at risk
+func publishMilestonesAtRisk(eventBus entity.EventBus, milestones []dto.MilestoneDTO) {
+	if eventBus == nil {
+		return
+	}
+
+	for _, ms := range milestones {
+		taskID, err := valueobject.ParseTaskID(ms.Task.ID)
+		if err != nil {
+			continue
+		}
+
+		metadata := map[string]interface{}{
+			"title":            ms.Task.Title,
+			"percent_complete": ms.Progress.PercentComplete,
+			"remaining_tasks":  ms.Progress.Members - ms.Progress.MissingMembers - ms.Progress.DoneMembers,
+		}
+		if ms.Task.DueDate != nil {
+			metadata["due_date"] = ms.Task.DueDate.Format(time.RFC3339)
+		}
+		if ms.Progress.ProjectedCompletion != nil {
+			metadata["projected_completion"] = ms.Progress.ProjectedCompletion.Format(time.RFC3339)
+		}
+
+		eventBus.Publish(entity.NewDomainEvent(valueobject.EventMilestoneAtRisk, ms.BoardID, ms.Task.ColumnName, taskID, metadata))
+		fmt.Printf("[Milestone] %s is at risk of missing its due date\n", ms.Task.ID)
+	}
+}
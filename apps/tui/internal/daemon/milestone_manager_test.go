@@ -0,0 +1,71 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/valueobject"
+)
+
+// recordingEventBus keeps the events published to it
+type recordingEventBus struct {
+	events []*entity.DomainEvent
+}
+
+func (b *recordingEventBus) Publish(event *entity.DomainEvent) {
+	b.events = append(b.events, event)
+}
+
+func (b *recordingEventBus) Subscribe(eventType valueobject.EventType, handler entity.EventHandler) {}
+
+func (b *recordingEventBus) Unsubscribe(eventType valueobject.EventType, handler entity.EventHandler) {
+}
+
+func (b *recordingEventBus) SubscribeAll(handler entity.EventHandler) {}
+
+func TestPublishMilestonesAtRisk(t *testing.T) {
+	due := time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC)
+	projected := due.AddDate(0, 0, 5)
+	milestone := dto.MilestoneDTO{
+		BoardID: "work/tracker",
+		Task: dto.TaskDTO{
+			ID:         "TRK-001-release",
+			Title:      "Release",
+			ColumnName: "to-do",
+			DueDate:    &due,
+		},
+		Progress: dto.MilestoneProgressDTO{
+			Members:             4,
+			DoneMembers:         1,
+			MissingMembers:      1,
+			PercentComplete:     50,
+			ProjectedCompletion: &projected,
+			AtRisk:              true,
+		},
+	}
+
+	bus := &recordingEventBus{}
+	publishMilestonesAtRisk(bus, []dto.MilestoneDTO{milestone, {Task: dto.TaskDTO{ID: "not a task ID"}}})
+
+	if len(bus.events) != 1 {
+		t.Fatalf("expected one event for the milestone with a valid ID, got %d", len(bus.events))
+	}
+	event := bus.events[0]
+	if event.Type != valueobject.EventMilestoneAtRisk || event.BoardID != "work/tracker" || event.ColumnID != "to-do" || event.TaskID.String() != "TRK-001-release" {
+		t.Errorf("expected a milestone.at_risk event for TRK-001-release, got %+v", event)
+	}
+	want := map[string]interface{}{
+		"title":                "Release",
+		"percent_complete":     50.0,
+		"remaining_tasks":      2,
+		"due_date":             due.Format(time.RFC3339),
+		"projected_completion": projected.Format(time.RFC3339),
+	}
+	for key, value := range want {
+		if event.Metadata[key] != value {
+			t.Errorf("%s: expected %v, got %v", key, value, event.Metadata[key])
+		}
+	}
+}
@@ -14,6 +14,8 @@ const (
 	RequestAddColumn       = "add_column"
 	RequestDeleteColumn    = "delete_column"
 	RequestGetActiveBoard  = "get_active_board"
+	RequestSetBoardFields  = "set_board_fields"
+	RequestListTasks       = "list_tasks"
 
 	// Action request types
 	RequestCreateAction    = "create_action"
@@ -44,8 +46,106 @@ const (
 	RequestDeleteProject = "delete_project"
 
 	// Agenda request types
-	RequestScheduleTask  = "schedule_task"
-	RequestCreateMeeting = "create_meeting"
+	RequestScheduleTask   = "schedule_task"
+	RequestCreateMeeting  = "create_meeting"
+	RequestPlanSchedule   = "plan_schedule"
+	RequestGetAgenda      = "get_agenda"
+	RequestUnscheduleTask = "unschedule_task"
+	RequestRescheduleTask = "reschedule_task"
+
+	// Storage request types
+	RequestMigrate = "migrate"
+	RequestDoctor  = "doctor"
+
+	// History request types
+	RequestGetHistory   = "get_history"
+	RequestRestoreTask  = "restore_task"
+	RequestRestoreBoard = "restore_board"
+
+	// Backup request types
+	RequestCreateBackup  = "create_backup"
+	RequestListBackups   = "list_backups"
+	RequestRestoreBackup = "restore_backup"
+
+	// Trash request types
+	RequestDeleteNote   = "delete_note"
+	RequestListTrash    = "list_trash"
+	RequestRestoreTrash = "restore_trash"
+	RequestPurgeTrash   = "purge_trash"
+
+	// Attachment request types
+	RequestAddAttachment    = "add_attachment"
+	RequestListAttachments  = "list_attachments"
+	RequestRemoveAttachment = "remove_attachment"
+
+	// Activity request types
+	RequestGetTaskActivity = "get_task_activity"
+	RequestAddComment      = "add_comment"
+	RequestLinkCommit      = "link_commit"
+
+	// Template request types
+	RequestSaveTaskTemplate       = "save_task_template"
+	RequestListTaskTemplates      = "list_task_templates"
+	RequestDeleteTaskTemplate     = "delete_task_template"
+	RequestCreateTaskFromTemplate = "create_task_from_template"
+	RequestSaveBoardTemplate      = "save_board_template"
+	RequestListBoardTemplates     = "list_board_templates"
+	RequestDeleteBoardTemplate    = "delete_board_template"
+	RequestExportBoardTemplate    = "export_board_template"
+	RequestSaveProjectTemplate    = "save_project_template"
+	RequestListProjectTemplates   = "list_project_templates"
+	RequestDeleteProjectTemplate  = "delete_project_template"
+
+	// Tag request types
+	RequestListTags  = "list_tags"
+	RequestSaveTag   = "save_tag"
+	RequestRenameTag = "rename_tag"
+	RequestMergeTags = "merge_tags"
+	RequestDeleteTag = "delete_tag"
+
+	// Hierarchy request types
+	RequestSetTaskParent = "set_task_parent"
+	RequestGetTaskTree   = "get_task_tree"
+
+	// Milestone request types
+	RequestGetMilestone         = "get_milestone"
+	RequestListMilestones       = "list_milestones"
+	RequestAddMilestoneTasks    = "add_milestone_tasks"
+	RequestRemoveMilestoneTasks = "remove_milestone_tasks"
+
+	// Sprint request types
+	RequestPlanSprint        = "plan_sprint"
+	RequestGetSprint         = "get_sprint"
+	RequestListSprints       = "list_sprints"
+	RequestAddSprintTasks    = "add_sprint_tasks"
+	RequestRemoveSprintTasks = "remove_sprint_tasks"
+	RequestCloseSprint       = "close_sprint"
+
+	// Metrics request types
+	RequestGetFlowMetrics = "get_flow_metrics"
+
+	// Forecast request types
+	RequestForecastCompletion = "forecast_completion"
+	RequestForecastThroughput = "forecast_throughput"
+
+	// Work schedule request types
+	RequestGetWorkSchedule         = "get_work_schedule"
+	RequestUpdateWorkSchedule      = "update_work_schedule"
+	RequestAddScheduleException    = "add_schedule_exception"
+	RequestRemoveScheduleException = "remove_schedule_exception"
+	RequestCheckWorkingTime        = "check_working_time"
+
+	// Calendar request types
+	RequestCalendarSyncNow         = "calendar_sync_now"
+	RequestCalendarSyncStatus      = "calendar_sync_status"
+	RequestListCalendarConflicts   = "list_calendar_conflicts"
+	RequestResolveCalendarConflict = "resolve_calendar_conflict"
+	RequestExportICal              = "export_ical"
+	RequestImportICal              = "import_ical"
+
+	// Meeting note request types
+	RequestCreateMeetingNote = "create_meeting_note"
+	RequestCreateActionItems = "create_action_items"
 )
 
 // Request represents a client request to the daemon
@@ -71,6 +171,7 @@ type CreateBoardPayload struct {
 	ProjectID   string `json:"project_id"`
 	Name        string `json:"name"`
 	Description string `json:"description"`
+	Template    string `json:"template,omitempty"`
 }
 
 // AddTaskPayload contains data for adding a task
@@ -111,6 +212,18 @@ type DeleteColumnPayload struct {
 	ColumnName string `json:"column_name"`
 }
 
+// SetBoardFieldsPayload contains the custom field schema for a board
+type SetBoardFieldsPayload struct {
+	BoardID string                   `json:"board_id"`
+	Fields  []dto.FieldDefinitionDTO `json:"fields"`
+}
+
+// ListTasksPayload contains data for listing the tasks of a board
+type ListTasksPayload struct {
+	BoardID string        `json:"board_id"`
+	Query   dto.TaskQuery `json:"query"`
+}
+
 // CreateActionPayload contains data for creating an action
 type CreateActionPayload struct {
 	ID          string                 `json:"id"`
@@ -218,6 +331,7 @@ type CreateProjectPayload struct {
 	Description string `json:"description,omitempty"`
 	WorkingDir  string `json:"working_dir,omitempty"`
 	Color       string `json:"color,omitempty"`
+	Template    string `json:"template,omitempty"`
 }
 
 type GetProjectPayload struct {
@@ -240,28 +354,367 @@ type DeleteProjectPayload struct {
 // Agenda payloads
 
 type ScheduleTaskPayload struct {
+	TaskID     string  `json:"task_id"`
+	Date       string  `json:"date"`
+	Time       *string `json:"time,omitempty"`
+	Duration   *string `json:"duration,omitempty"`
+	Start      *string `json:"start,omitempty"`      // RFC 3339, overrides date and time
+	Recurrence *string `json:"recurrence,omitempty"` // e.g. weekly or every 2 weeks; empty or none clears it
+}
+
+type CreateMeetingPayload struct {
+	BoardID    string   `json:"board_id"`
+	Title      string   `json:"title"`
+	Date       string   `json:"date"`
+	Time       *string  `json:"time,omitempty"`
+	Duration   *string  `json:"duration,omitempty"`
+	Attendees  []string `json:"attendees,omitempty"`
+	Location   *string  `json:"location,omitempty"`
+	Recurrence *string  `json:"recurrence,omitempty"`
+}
+
+type PlanSchedulePayload struct {
+	BoardIDs []string `json:"board_ids,omitempty"`
+	From     string   `json:"from,omitempty"`
+	Days     int      `json:"days,omitempty"`
+}
+
+type GetAgendaPayload struct {
+	ProjectID string `json:"project_id,omitempty"`
+	From      string `json:"from,omitempty"`
+	To        string `json:"to,omitempty"`
+}
+
+type UnscheduleTaskPayload struct {
+	TaskID string `json:"task_id"`
+}
+
+type RescheduleTaskPayload struct {
 	TaskID   string  `json:"task_id"`
-	Date     string  `json:"date"`
+	Date     *string `json:"date,omitempty"`
 	Time     *string `json:"time,omitempty"`
+	Start    *string `json:"start,omitempty"` // RFC 3339, overrides date and time
 	Duration *string `json:"duration,omitempty"`
 }
 
-type CreateMeetingPayload struct {
-	BoardID   string   `json:"board_id"`
-	Title     string   `json:"title"`
-	Date      string   `json:"date"`
-	Time      *string  `json:"time,omitempty"`
-	Duration  *string  `json:"duration,omitempty"`
-	Attendees []string `json:"attendees,omitempty"`
-	Location  *string  `json:"location,omitempty"`
+// Storage payloads
+
+type MigratePayload struct {
+	DryRun bool `json:"dry_run,omitempty"`
+}
+
+type DoctorPayload struct {
+	Fix bool `json:"fix,omitempty"`
+}
+
+// History payloads
+
+type GetHistoryPayload struct {
+	TaskID  string `json:"task_id,omitempty"`
+	BoardID string `json:"board_id,omitempty"`
+	Limit   int    `json:"limit,omitempty"`
+}
+
+type RestoreTaskPayload struct {
+	TaskID   string `json:"task_id"`
+	Revision string `json:"revision"`
+}
+
+type RestoreBoardPayload struct {
+	BoardID string `json:"board_id"`
+	At      string `json:"at"` // RFC3339 timestamp or YYYY-MM-DD (end of that day)
+}
+
+// Backup payloads
+
+type CreateBackupPayload struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+type RestoreBackupPayload struct {
+	Name  string `json:"name"`
+	Force bool   `json:"force,omitempty"`
+}
+
+// Trash payloads
+
+type DeleteNotePayload struct {
+	NoteID string `json:"note_id"`
+}
+
+type ListTrashPayload struct {
+	BoardID string `json:"board_id,omitempty"`
+}
+
+type RestoreTrashPayload struct {
+	ID string `json:"id"`
+}
+
+type PurgeTrashPayload struct {
+	ID  string `json:"id,omitempty"`
+	All bool   `json:"all,omitempty"` // without ID or All only expired items are purged
+}
+
+// Attachment payloads
+
+type AddAttachmentPayload struct {
+	BoardID    string `json:"board_id"`
+	TaskID     string `json:"task_id"`
+	SourcePath string `json:"source_path"`    // absolute path readable by the daemon
+	Name       string `json:"name,omitempty"` // defaults to the base name of the source
+}
+
+type ListAttachmentsPayload struct {
+	BoardID string `json:"board_id"`
+	TaskID  string `json:"task_id"`
+}
+
+type RemoveAttachmentPayload struct {
+	BoardID string `json:"board_id"`
+	TaskID  string `json:"task_id"`
+	Name    string `json:"name"`
+}
+
+// Activity payloads
+
+type GetTaskActivityPayload struct {
+	BoardID string `json:"board_id"`
+	TaskID  string `json:"task_id"`
+}
+
+type AddCommentPayload struct {
+	BoardID string `json:"board_id"`
+	TaskID  string `json:"task_id"`
+	Author  string `json:"author,omitempty"`
+	Text    string `json:"text"`
+}
+
+type LinkCommitPayload struct {
+	BoardID string `json:"board_id,omitempty"` // looked up from a full task ID when empty
+	TaskID  string `json:"task_id"`
+	Hash    string `json:"hash"`
+	Message string `json:"message,omitempty"`
+	Author  string `json:"author,omitempty"`
+}
+
+// Template payloads
+
+type SaveTaskTemplatePayload struct {
+	Template dto.TaskTemplateDTO `json:"template"`
+}
+
+type ListTaskTemplatesPayload struct {
+	ProjectID string `json:"project_id,omitempty"` // empty lists only global templates
+}
+
+type DeleteTaskTemplatePayload struct {
+	ProjectID string `json:"project_id,omitempty"` // empty deletes a global template
+	Name      string `json:"name"`
+}
+
+type CreateTaskFromTemplatePayload struct {
+	BoardID     string                            `json:"board_id"`
+	TaskRequest dto.CreateTaskFromTemplateRequest `json:"task"`
+}
+
+type SaveBoardTemplatePayload struct {
+	Template dto.BoardTemplateDTO `json:"template"`
+}
+
+type DeleteBoardTemplatePayload struct {
+	Name string `json:"name"`
+}
+
+type ExportBoardTemplatePayload struct {
+	Export dto.ExportBoardTemplateRequest `json:"export"`
+}
+
+type SaveProjectTemplatePayload struct {
+	Template dto.ProjectTemplateDTO `json:"template"`
+}
+
+type DeleteProjectTemplatePayload struct {
+	Name string `json:"name"`
+}
+
+// Tag payloads
+
+type ListTagsPayload struct {
+	ProjectID string `json:"project_id"`
+}
+
+type SaveTagPayload struct {
+	ProjectID string     `json:"project_id"`
+	Tag       dto.TagDTO `json:"tag"`
+}
+
+type RenameTagPayload struct {
+	ProjectID string `json:"project_id"`
+	OldName   string `json:"old_name"`
+	NewName   string `json:"new_name"`
+}
+
+type MergeTagsPayload struct {
+	ProjectID string   `json:"project_id"`
+	Sources   []string `json:"sources"`
+	Target    string   `json:"target"`
+}
+
+type DeleteTagPayload struct {
+	ProjectID string `json:"project_id"`
+	Name      string `json:"name"`
+}
+
+// Hierarchy payloads
+
+type SetTaskParentPayload struct {
+	BoardID  string `json:"board_id"`
+	TaskID   string `json:"task_id"`
+	ParentID string `json:"parent_id,omitempty"` // empty makes the task a top-level task
+}
+
+type GetTaskTreePayload struct {
+	BoardID string `json:"board_id"`
+	TaskID  string `json:"task_id"`
+}
+
+// Milestone payloads
+
+type GetMilestonePayload struct {
+	BoardID     string `json:"board_id"`
+	MilestoneID string `json:"milestone_id"`
+}
+
+type ListMilestonesPayload struct {
+	ProjectID string `json:"project_id,omitempty"` // empty lists the milestones of all projects
+}
+
+type MilestoneTasksPayload struct {
+	BoardID     string                   `json:"board_id"`
+	MilestoneID string                   `json:"milestone_id"`
+	Tasks       []dto.MilestoneMemberDTO `json:"tasks"`
+}
+
+// Sprint payloads
+
+type PlanSprintPayload struct {
+	ProjectID string    `json:"project_id"`
+	Name      string    `json:"name"`
+	Goal      string    `json:"goal,omitempty"`
+	StartDate string `json:"start_date"` // YYYY-MM-DD
+	EndDate   string `json:"end_date"`   // YYYY-MM-DD
+}
+
+type GetSprintPayload struct {
+	ProjectID string `json:"project_id"`
+	SprintID  string `json:"sprint_id"`
+}
+
+type ListSprintsPayload struct {
+	ProjectID string `json:"project_id"`
+}
+
+type SprintTasksPayload struct {
+	ProjectID string              `json:"project_id"`
+	SprintID  string              `json:"sprint_id"`
+	Tasks     []dto.SprintTaskDTO `json:"tasks"`
+}
+
+type CloseSprintPayload struct {
+	ProjectID    string `json:"project_id"`
+	SprintID     string `json:"sprint_id"`
+	NextSprintID string `json:"next_sprint_id,omitempty"` // defaults to the next open sprint of the project
+}
+
+// Metrics payloads
+
+type GetFlowMetricsPayload struct {
+	BoardID string `json:"board_id"`
+	From    string `json:"from,omitempty"` // YYYY-MM-DD, twelve weeks before to by default
+	To      string `json:"to,omitempty"`   // YYYY-MM-DD, today by default
+}
+
+// Forecast payloads
+
+type ForecastCompletionPayload struct {
+	BoardIDs []string `json:"board_ids"`
+	Tasks    int      `json:"tasks,omitempty"` // the unfinished tasks of the boards by default
+}
+
+type ForecastThroughputPayload struct {
+	BoardIDs []string `json:"board_ids"`
+	Date     string   `json:"date"` // YYYY-MM-DD
+}
+
+// Work schedule payloads
+
+type GetWorkSchedulePayload struct {
+	ProjectID string `json:"project_id,omitempty"` // the default schedule when empty
+}
+
+type UpdateWorkSchedulePayload struct {
+	Schedule dto.UpdateWorkScheduleRequest `json:"schedule"`
+}
+
+type ScheduleExceptionPayload struct {
+	ProjectID   string `json:"project_id,omitempty"`
+	Date        string `json:"date"` // YYYY-MM-DD
+	Type        string `json:"type"` // holiday, time_off or override
+	Description string `json:"description,omitempty"`
+	Start       string `json:"start,omitempty"` // HH:MM, overrides only
+	End         string `json:"end,omitempty"`
+}
+
+type RemoveScheduleExceptionPayload struct {
+	ProjectID string `json:"project_id,omitempty"`
+	Date      string `json:"date"` // YYYY-MM-DD
+}
+
+type CheckWorkingTimePayload struct {
+	ProjectID string `json:"project_id,omitempty"`
+	Time      string `json:"time,omitempty"` // RFC 3339, now by default
+}
+
+// Calendar payloads
+
+type ResolveCalendarConflictPayload struct {
+	EventID    string            `json:"event_id"`
+	Resolution string            `json:"resolution"`       // keep_calendar, keep_task or merge
+	Fields     map[string]string `json:"fields,omitempty"` // field -> calendar or task, when merging
+}
+
+type ExportICalPayload struct {
+	ProjectID string `json:"project_id,omitempty"` // every project by default
+	BoardID   string `json:"board_id,omitempty"`   // every board of the project by default
+	Path      string `json:"path,omitempty"`       // file in the exports directory to write the .ics feed to instead of returning it
+}
+
+type ImportICalPayload struct {
+	BoardID string `json:"board_id"`
+	Path    string `json:"path,omitempty"` // absolute path of an .ics file readable by the daemon
+	Data    string `json:"data,omitempty"` // iCalendar content, when no path is given
+}
+
+// Meeting note payloads
+
+type CreateMeetingNotePayload struct {
+	BoardID  string `json:"board_id"`
+	TaskID   string `json:"task_id"`
+	Template string `json:"template,omitempty"` // the configured meeting note template by default
+}
+
+type CreateActionItemsPayload struct {
+	NoteID string `json:"note_id"`
 }
 
 // Notification types
 const (
-	NotificationBoardUpdated = "board_updated"
-	NotificationTaskCreated  = "task_created"
-	NotificationTaskUpdated  = "task_updated"
-	NotificationTaskMoved    = "task_moved"
-	NotificationTaskDeleted  = "task_deleted"
-	NotificationPong         = "pong"
+	NotificationBoardUpdated      = "board_updated"
+	NotificationTaskCreated       = "task_created"
+	NotificationTaskUpdated       = "task_updated"
+	NotificationTaskMoved         = "task_moved"
+	NotificationTaskDeleted       = "task_deleted"
+	NotificationTagsUpdated       = "tags_updated"
+	NotificationCalendarConflicts = "calendar_conflicts"
+	NotificationPong              = "pong"
 )
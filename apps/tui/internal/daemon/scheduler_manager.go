@@ -0,0 +1,144 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/application/usecase/planner"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/valueobject"
+)
+
+// schedulerCheckInterval is how often the manager looks for missed blocks
+const schedulerCheckInterval = 15 * time.Minute
+
+// SchedulerManager periodically moves time blocks that passed without their
+// task being done to the next free slot, publishing an event for each
+type SchedulerManager struct {
+	replan   *planner.ReplanMissedBlocksUseCase
+	eventBus entity.EventBus
+	dataLock sync.Locker // held while re-planned blocks are saved
+
+	onBoardsChanged func(boardIDs []string) // called after blocks were moved on boards
+
+	ctx        context.Context
+	cancelFunc context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+// NewSchedulerManager creates a new SchedulerManager. dataLock must keep
+// daemon mutations out while blocks are re-planned.
+func NewSchedulerManager(
+	replan *planner.ReplanMissedBlocksUseCase,
+	eventBus entity.EventBus,
+	dataLock sync.Locker,
+	onBoardsChanged func(boardIDs []string),
+) *SchedulerManager {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &SchedulerManager{
+		replan:   replan,
+		eventBus: eventBus,
+		dataLock: dataLock,
+
+		onBoardsChanged: onBoardsChanged,
+
+		ctx:        ctx,
+		cancelFunc: cancel,
+	}
+}
+
+// Start starts re-planning missed blocks
+func (m *SchedulerManager) Start() error {
+	m.wg.Add(1)
+	go m.run()
+
+	fmt.Println("[Scheduler] Re-planning missed time blocks")
+	return nil
+}
+
+// Stop stops re-planning missed blocks
+func (m *SchedulerManager) Stop() error {
+	m.cancelFunc()
+	m.wg.Wait()
+	return nil
+}
+
+func (m *SchedulerManager) run() {
+	defer m.wg.Done()
+
+	m.replanMissed()
+
+	ticker := time.NewTicker(schedulerCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-ticker.C:
+			m.replanMissed()
+		}
+	}
+}
+
+func (m *SchedulerManager) replanMissed() {
+	m.dataLock.Lock()
+	plan, err := m.replan.Execute(m.ctx)
+	m.dataLock.Unlock()
+	if err != nil {
+		fmt.Printf("[Scheduler] Failed to re-plan missed blocks: %v\n", err)
+		return
+	}
+	publishBlocksMissed(m.eventBus, plan.Blocks)
+
+	if m.onBoardsChanged != nil {
+		if boardIDs := blockBoards(plan.Blocks); len(boardIDs) > 0 {
+			m.onBoardsChanged(boardIDs)
+		}
+	}
+}
+
+// blockBoards returns the boards of the blocks, each once
+func blockBoards(blocks []dto.PlannedBlockDTO) []string {
+	seen := make(map[string]bool)
+	boardIDs := make([]string, 0)
+	for _, block := range blocks {
+		if !seen[block.BoardID] {
+			seen[block.BoardID] = true
+			boardIDs = append(boardIDs, block.BoardID)
+		}
+	}
+	return boardIDs
+}
+
+// publishBlocksMissed publishes a task.block_missed event for each block
+// that was moved
+func publishBlocksMissed(eventBus entity.EventBus, blocks []dto.PlannedBlockDTO) {
+	if eventBus == nil {
+		return
+	}
+
+	for _, block := range blocks {
+		taskID, err := valueobject.ParseTaskID(block.TaskID)
+		if err != nil {
+			continue
+		}
+
+		metadata := map[string]interface{}{
+			"title":          block.Title,
+			"scheduled_time": block.Start.Format(time.RFC3339),
+			"time_block":     block.Duration,
+			"late":           block.Late,
+		}
+		if block.MissedAt != nil {
+			metadata["missed_at"] = block.MissedAt.Format(time.RFC3339)
+		}
+
+		eventBus.Publish(entity.NewDomainEvent(valueobject.EventTaskBlockMissed, block.BoardID, block.ColumnName, taskID, metadata))
+		fmt.Printf("[Scheduler] %s missed its block, moved to %s\n", block.TaskID, block.Start.Format("2006-01-02 15:04"))
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -20,6 +21,7 @@ import (
 	"mkanban/internal/domain/entity"
 	"mkanban/internal/domain/valueobject"
 	"mkanban/internal/infrastructure/config"
+	"mkanban/internal/infrastructure/persistence/filesystem"
 	"mkanban/pkg/slug"
 )
 
@@ -31,6 +33,14 @@ type Server struct {
 	sessionManager      *SessionManager
 	actionManager       *ActionManager
 	timeTrackingManager *TimeTrackingManager
+	historyManager      *HistoryManager
+	backupManager       *BackupManager
+	trashManager        *TrashManager
+	activityManager     *ActivityManager
+	milestoneManager    *MilestoneManager
+	schedulerManager    *SchedulerManager
+	calendarManager     *CalendarManager
+	migrationPending    bool // pending migrations left for the migrate request; guarded by mu
 	mu                  sync.RWMutex
 	subscribers         map[string]map[net.Conn]chan *Notification // boardID -> conn -> channel
 	subMu               sync.RWMutex
@@ -59,6 +69,45 @@ func (s *Server) Start() error {
 
 	ctx := context.Background()
 
+	// Bring the data root up to the current storage schema before anything reads it
+	if err := s.runMigrations(ctx); err != nil {
+		s.releaseLock()
+		return err
+	}
+
+	// With migrations left for the migrate request, nothing may read the data
+	// root until they have been applied
+	if !s.migrationPending {
+		if err := s.startManagers(ctx); err != nil {
+			return err
+		}
+	}
+
+	socketDir := s.config.Daemon.SocketDir
+	if err := os.MkdirAll(socketDir, 0755); err != nil {
+		return fmt.Errorf("failed to create socket directory: %w", err)
+	}
+
+	socketPath := filepath.Join(socketDir, s.config.Daemon.SocketName)
+
+	// Remove existing socket if it exists
+	if err := os.RemoveAll(socketPath); err != nil {
+		return fmt.Errorf("failed to remove existing socket: %w", err)
+	}
+
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return fmt.Errorf("failed to listen on socket: %w", err)
+	}
+
+	s.listener = listener
+	fmt.Printf("Daemon listening on %s\n", socketPath)
+
+	return s.acceptConnections()
+}
+
+// startManagers starts the background managers that read and write the data root
+func (s *Server) startManagers(ctx context.Context) error {
 	// Initialize session manager if session tracking use cases are available
 	if s.container.TrackSessionsUseCase != nil &&
 		s.container.SessionTracker != nil &&
@@ -92,6 +141,7 @@ func (s *Server) Start() error {
 			s.container.ExecuteActionUseCase,
 			s.container.ProcessEventUseCase,
 			s.container.ActionRepo,
+			s.container.WorkScheduleService,
 			s.container.EventBus,
 		)
 
@@ -101,27 +151,100 @@ func (s *Server) Start() error {
 		fmt.Println("Action manager started")
 	}
 
-	socketDir := s.config.Daemon.SocketDir
-	if err := os.MkdirAll(socketDir, 0755); err != nil {
-		return fmt.Errorf("failed to create socket directory: %w", err)
+	// Initialize backup manager for scheduled data directory backups
+	if s.container.BackupStore != nil {
+		s.backupManager = NewBackupManager(s.container.Config, s.container.BackupStore, s.mu.RLocker())
+
+		if err := s.backupManager.Start(); err != nil {
+			return fmt.Errorf("failed to start backup manager: %w", err)
+		}
 	}
 
-	socketPath := filepath.Join(socketDir, s.config.Daemon.SocketName)
+	// Initialize trash manager to purge expired trash items
+	if s.container.PurgeTrashUseCase != nil {
+		s.trashManager = NewTrashManager(s.container.Config, s.container.PurgeTrashUseCase, &s.mu)
 
-	// Remove existing socket if it exists
-	if err := os.RemoveAll(socketPath); err != nil {
-		return fmt.Errorf("failed to remove existing socket: %w", err)
+		if err := s.trashManager.Start(); err != nil {
+			return fmt.Errorf("failed to start trash manager: %w", err)
+		}
 	}
 
-	listener, err := net.Listen("unix", socketPath)
-	if err != nil {
-		return fmt.Errorf("failed to listen on socket: %w", err)
+	// Initialize milestone manager to flag milestones at risk
+	if s.container.CheckMilestonesUseCase != nil {
+		s.milestoneManager = NewMilestoneManager(s.container.CheckMilestonesUseCase, s.container.EventBus, &s.mu)
+
+		if err := s.milestoneManager.Start(); err != nil {
+			return fmt.Errorf("failed to start milestone manager: %w", err)
+		}
 	}
 
-	s.listener = listener
-	fmt.Printf("Daemon listening on %s\n", socketPath)
+	// Initialize scheduler manager to re-plan missed time blocks
+	if s.container.ReplanMissedBlocksUseCase != nil {
+		s.schedulerManager = NewSchedulerManager(
+			s.container.ReplanMissedBlocksUseCase,
+			s.container.EventBus,
+			&s.mu,
+			func(boardIDs []string) {
+				for _, boardID := range boardIDs {
+					s.notifyBoardUpdated(context.Background(), boardID)
+				}
+			},
+		)
 
-	return s.acceptConnections()
+		if err := s.schedulerManager.Start(); err != nil {
+			return fmt.Errorf("failed to start scheduler manager: %w", err)
+		}
+	}
+
+	// Initialize calendar manager to sync meetings with the calendar provider
+	if s.container.CalendarSyncService != nil && s.container.CalendarSyncStore != nil {
+		s.calendarManager = NewCalendarManager(
+			s.container.Config,
+			s.container.CalendarSyncService,
+			s.container.CalendarSyncStore,
+			&s.mu,
+			func(boardID string, taskIDs []string) {
+				s.mu.Lock()
+				defer s.mu.Unlock()
+				s.createMeetingNotes(context.Background(), boardID, taskIDs)
+			},
+			func(boardIDs []string) {
+				for _, boardID := range boardIDs {
+					s.notifyBoardUpdated(context.Background(), boardID)
+				}
+			},
+			s.notifyCalendarConflicts,
+		)
+
+		if err := s.calendarManager.Start(); err != nil {
+			return fmt.Errorf("failed to start calendar manager: %w", err)
+		}
+	}
+
+	// Initialize activity manager to keep the per-task activity logs
+	if s.container.RecordActivityUseCase != nil && s.container.EventBus != nil {
+		s.activityManager = NewActivityManager(s.container.RecordActivityUseCase, s.container.EventBus, &s.mu)
+
+		if err := s.activityManager.Start(); err != nil {
+			return fmt.Errorf("failed to start activity manager: %w", err)
+		}
+	}
+
+	// Initialize history manager to record data directory changes in git
+	if s.container.VCSProvider != nil && s.container.EventBus != nil {
+		s.historyManager = NewHistoryManager(
+			s.container.Config,
+			s.container.VCSProvider,
+			s.container.EventBus,
+			s.mu.RLocker(),
+		)
+
+		if err := s.historyManager.Start(); err != nil {
+			return fmt.Errorf("failed to start history manager: %w", err)
+		}
+	}
+
+	return nil
 }
 
 // acceptConnections handles incoming connections
@@ -179,6 +302,10 @@ func (s *Server) handleConnection(conn net.Conn) {
 func (s *Server) handleRequest(req *Request) *Response {
 	ctx := context.Background()
 
+	if resp := s.checkMigrationPending(req); resp != nil {
+		return resp
+	}
+
 	switch req.Type {
 	case RequestGetBoard:
 		return s.handleGetBoard(ctx, req)
@@ -200,6 +327,10 @@ func (s *Server) handleRequest(req *Request) *Response {
 		return s.handleDeleteColumn(ctx, req)
 	case RequestGetActiveBoard:
 		return s.handleGetActiveBoard(ctx, req)
+	case RequestSetBoardFields:
+		return s.handleSetBoardFields(ctx, req)
+	case RequestListTasks:
+		return s.handleListTasks(ctx, req)
 	case RequestPing:
 		return &Response{Success: true, Data: "pong"}
 
@@ -229,6 +360,154 @@ func (s *Server) handleRequest(req *Request) *Response {
 		return s.handleScheduleTask(ctx, req)
 	case RequestCreateMeeting:
 		return s.handleCreateMeeting(ctx, req)
+	case RequestPlanSchedule:
+		return s.handlePlanSchedule(ctx, req)
+	case RequestGetAgenda:
+		return s.handleGetAgenda(ctx, req)
+	case RequestUnscheduleTask:
+		return s.handleUnscheduleTask(ctx, req)
+	case RequestRescheduleTask:
+		return s.handleRescheduleTask(ctx, req)
+
+	case RequestMigrate:
+		return s.handleMigrate(ctx, req)
+	case RequestDoctor:
+		return s.handleDoctor(ctx, req)
+
+	case RequestGetHistory:
+		return s.handleGetHistory(ctx, req)
+	case RequestRestoreTask:
+		return s.handleRestoreTask(ctx, req)
+	case RequestRestoreBoard:
+		return s.handleRestoreBoard(ctx, req)
+
+	case RequestCreateBackup:
+		return s.handleCreateBackup(ctx, req)
+	case RequestListBackups:
+		return s.handleListBackups(ctx)
+	case RequestRestoreBackup:
+		return s.handleRestoreBackup(ctx, req)
+
+	case RequestDeleteNote:
+		return s.handleDeleteNote(ctx, req)
+	case RequestListTrash:
+		return s.handleListTrash(ctx, req)
+	case RequestRestoreTrash:
+		return s.handleRestoreTrash(ctx, req)
+	case RequestPurgeTrash:
+		return s.handlePurgeTrash(ctx, req)
+
+	case RequestAddAttachment:
+		return s.handleAddAttachment(ctx, req)
+	case RequestListAttachments:
+		return s.handleListAttachments(ctx, req)
+	case RequestRemoveAttachment:
+		return s.handleRemoveAttachment(ctx, req)
+
+	case RequestGetTaskActivity:
+		return s.handleGetTaskActivity(ctx, req)
+	case RequestAddComment:
+		return s.handleAddComment(ctx, req)
+	case RequestLinkCommit:
+		return s.handleLinkCommit(ctx, req)
+
+	case RequestSaveTaskTemplate:
+		return s.handleSaveTaskTemplate(ctx, req)
+	case RequestListTaskTemplates:
+		return s.handleListTaskTemplates(ctx, req)
+	case RequestDeleteTaskTemplate:
+		return s.handleDeleteTaskTemplate(ctx, req)
+	case RequestCreateTaskFromTemplate:
+		return s.handleCreateTaskFromTemplate(ctx, req)
+	case RequestSaveBoardTemplate:
+		return s.handleSaveBoardTemplate(ctx, req)
+	case RequestListBoardTemplates:
+		return s.handleListBoardTemplates(ctx, req)
+	case RequestDeleteBoardTemplate:
+		return s.handleDeleteBoardTemplate(ctx, req)
+	case RequestExportBoardTemplate:
+		return s.handleExportBoardTemplate(ctx, req)
+	case RequestSaveProjectTemplate:
+		return s.handleSaveProjectTemplate(ctx, req)
+	case RequestListProjectTemplates:
+		return s.handleListProjectTemplates(ctx, req)
+	case RequestDeleteProjectTemplate:
+		return s.handleDeleteProjectTemplate(ctx, req)
+
+	case RequestListTags:
+		return s.handleListTags(ctx, req)
+	case RequestSaveTag:
+		return s.handleSaveTag(ctx, req)
+	case RequestRenameTag:
+		return s.handleRenameTag(ctx, req)
+	case RequestMergeTags:
+		return s.handleMergeTags(ctx, req)
+	case RequestDeleteTag:
+		return s.handleDeleteTag(ctx, req)
+
+	case RequestSetTaskParent:
+		return s.handleSetTaskParent(ctx, req)
+	case RequestGetTaskTree:
+		return s.handleGetTaskTree(ctx, req)
+
+	case RequestGetMilestone:
+		return s.handleGetMilestone(ctx, req)
+	case RequestListMilestones:
+		return s.handleListMilestones(ctx, req)
+	case RequestAddMilestoneTasks:
+		return s.handleAddMilestoneTasks(ctx, req)
+	case RequestRemoveMilestoneTasks:
+		return s.handleRemoveMilestoneTasks(ctx, req)
+
+	case RequestPlanSprint:
+		return s.handlePlanSprint(ctx, req)
+	case RequestGetSprint:
+		return s.handleGetSprint(ctx, req)
+	case RequestListSprints:
+		return s.handleListSprints(ctx, req)
+	case RequestAddSprintTasks:
+		return s.handleAddSprintTasks(ctx, req)
+	case RequestRemoveSprintTasks:
+		return s.handleRemoveSprintTasks(ctx, req)
+	case RequestCloseSprint:
+		return s.handleCloseSprint(ctx, req)
+
+	case RequestGetFlowMetrics:
+		return s.handleGetFlowMetrics(ctx, req)
+
+	case RequestForecastCompletion:
+		return s.handleForecastCompletion(ctx, req)
+	case RequestForecastThroughput:
+		return s.handleForecastThroughput(ctx, req)
+
+	case RequestGetWorkSchedule:
+		return s.handleGetWorkSchedule(ctx, req)
+	case RequestUpdateWorkSchedule:
+		return s.handleUpdateWorkSchedule(ctx, req)
+	case RequestAddScheduleException:
+		return s.handleAddScheduleException(ctx, req)
+	case RequestRemoveScheduleException:
+		return s.handleRemoveScheduleException(ctx, req)
+	case RequestCheckWorkingTime:
+		return s.handleCheckWorkingTime(ctx, req)
+
+	case RequestCalendarSyncNow:
+		return s.handleCalendarSyncNow(ctx)
+	case RequestCalendarSyncStatus:
+		return s.handleCalendarSyncStatus()
+	case RequestListCalendarConflicts:
+		return s.handleListCalendarConflicts()
+	case RequestResolveCalendarConflict:
+		return s.handleResolveCalendarConflict(ctx, req)
+	case RequestExportICal:
+		return s.handleExportICal(ctx, req)
+	case RequestImportICal:
+		return s.handleImportICal(ctx, req)
+
+	case RequestCreateMeetingNote:
+		return s.handleCreateMeetingNote(ctx, req)
+	case RequestCreateActionItems:
+		return s.handleCreateActionItems(ctx, req)
 
 	default:
 		return &Response{
@@ -283,6 +562,7 @@ func (s *Server) handleCreateBoard(ctx context.Context, req *Request) *Response
 		ProjectID:   payload.ProjectID,
 		Name:        payload.Name,
 		Description: payload.Description,
+		Template:    payload.Template,
 	}
 
 	boardDTO, err := s.container.CreateBoardUseCase.Execute(ctx, createReq)
@@ -290,6 +570,8 @@ func (s *Server) handleCreateBoard(ctx context.Context, req *Request) *Response
 		return &Response{Success: false, Error: err.Error()}
 	}
 
+	s.publishEvent(valueobject.EventBoardCreated, boardDTO.ID, "", nil, nil)
+
 	return &Response{Success: true, Data: boardDTO}
 }
 
@@ -308,6 +590,8 @@ func (s *Server) handleAddTask(ctx context.Context, req *Request) *Response {
 		return &Response{Success: false, Error: err.Error()}
 	}
 
+	s.publishTaskEvent(valueobject.EventTaskCreated, payload.BoardID, payload.TaskRequest.ColumnName, taskDTO.ID, nil)
+
 	// Notify subscribers
 	s.notifySubscribers(payload.BoardID, &Notification{
 		Type:    NotificationTaskCreated,
@@ -333,11 +617,17 @@ func (s *Server) handleMoveTask(ctx context.Context, req *Request) *Response {
 		TargetColumnName: payload.TargetColumnName,
 	}
 
+	fromColumn := s.taskColumnName(ctx, payload.BoardID, payload.TaskID)
+
 	boardDTO, err := s.container.MoveTaskUseCase.Execute(ctx, payload.BoardID, moveReq)
 	if err != nil {
 		return &Response{Success: false, Error: err.Error()}
 	}
 
+	s.publishTaskEvent(valueobject.EventTaskMoved, payload.BoardID, payload.TargetColumnName, payload.TaskID, map[string]interface{}{
+		"from_column": fromColumn,
+	})
+
 	// Notify subscribers
 	s.notifySubscribers(payload.BoardID, &Notification{
 		Type:    NotificationTaskMoved,
@@ -358,11 +648,21 @@ func (s *Server) handleUpdateTask(ctx context.Context, req *Request) *Response {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	before, _ := s.findTaskDTO(ctx, payload.BoardID, payload.TaskID)
+
 	taskDTO, err := s.container.UpdateTaskUseCase.Execute(ctx, payload.BoardID, payload.TaskID, payload.TaskRequest)
 	if err != nil {
 		return &Response{Success: false, Error: err.Error()}
 	}
 
+	// Compare against the stored task so the activity log matches what was saved
+	after, err := s.findTaskDTO(ctx, payload.BoardID, taskDTO.ID)
+	if err != nil {
+		after = taskDTO
+		after.ColumnName = s.taskColumnName(ctx, payload.BoardID, taskDTO.ID)
+	}
+	s.publishTaskChanges(payload.BoardID, before, after)
+
 	// Notify subscribers
 	s.notifySubscribers(payload.BoardID, &Notification{
 		Type:    NotificationTaskUpdated,
@@ -383,9 +683,25 @@ func (s *Server) handleDeleteTask(ctx context.Context, req *Request) *Response {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	// We'll need to add DeleteTaskUseCase
-	// For now, return not implemented
-	return &Response{Success: false, Error: "delete task not yet implemented"}
+	item, err := s.container.DeleteTaskUseCase.Execute(ctx, payload.BoardID, payload.TaskID)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	// Subtasks are trashed with their parent and each gets its own event
+	for _, task := range item.Tasks {
+		s.publishTaskEvent(valueobject.EventTaskDeleted, payload.BoardID, task.Column, task.ID, map[string]interface{}{
+			"trash_id": item.ID,
+		})
+	}
+
+	s.notifySubscribers(payload.BoardID, &Notification{
+		Type:    NotificationTaskDeleted,
+		BoardID: payload.BoardID,
+		Data:    item,
+	})
+
+	return &Response{Success: true, Data: item}
 }
 
 // handleAddColumn adds a new column
@@ -403,6 +719,8 @@ func (s *Server) handleAddColumn(ctx context.Context, req *Request) *Response {
 		return &Response{Success: false, Error: err.Error()}
 	}
 
+	s.publishEvent(valueobject.EventColumnCreated, payload.BoardID, payload.ColumnRequest.Name, nil, nil)
+
 	return &Response{Success: true, Data: boardDTO}
 }
 
@@ -416,9 +734,60 @@ func (s *Server) handleDeleteColumn(ctx context.Context, req *Request) *Response
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	// We'll need to add DeleteColumnUseCase
-	// For now, return not implemented
-	return &Response{Success: false, Error: "delete column not yet implemented"}
+	item, err := s.container.DeleteColumnUseCase.Execute(ctx, payload.BoardID, payload.ColumnName)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.publishEvent(valueobject.EventColumnDeleted, payload.BoardID, item.Column, nil, map[string]interface{}{
+		"trash_id": item.ID,
+		"tasks":    len(item.Tasks),
+	})
+	s.notifyBoardUpdated(ctx, payload.BoardID)
+
+	return &Response{Success: true, Data: item}
+}
+
+// handleSetBoardFields replaces the custom field schema of a board
+func (s *Server) handleSetBoardFields(ctx context.Context, req *Request) *Response {
+	var payload SetBoardFieldsPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	boardDTO, err := s.container.SetBoardFieldsUseCase.Execute(ctx, payload.BoardID, payload.Fields)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.notifySubscribers(payload.BoardID, &Notification{
+		Type:    NotificationBoardUpdated,
+		BoardID: payload.BoardID,
+		Data:    boardDTO,
+	})
+
+	return &Response{Success: true, Data: boardDTO}
+}
+
+// handleListTasks lists the tasks of a board, filtered by the query conditions
+func (s *Server) handleListTasks(ctx context.Context, req *Request) *Response {
+	var payload ListTasksPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tasks, err := s.container.ListTasksUseCase.Query(ctx, payload.BoardID, payload.Query)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: tasks}
 }
 
 // handleGetActiveBoard returns the board ID for the active session
@@ -471,6 +840,56 @@ func (s *Server) sendError(encoder *json.Encoder, message string) {
 	encoder.Encode(resp)
 }
 
+// runMigrations applies pending storage migrations at startup. With
+// storage.manual_migrations set they are only reported and left for the
+// migrate request.
+func (s *Server) runMigrations(ctx context.Context) error {
+	if s.container.Migrator == nil {
+		return nil
+	}
+
+	manual := s.config.Storage.ManualMigrations
+	report, err := s.container.Migrator.Run(ctx, manual)
+	if err != nil {
+		return fmt.Errorf("failed to migrate data directory: %w", err)
+	}
+
+	if manual {
+		if len(report.Steps) > 0 {
+			s.migrationPending = true
+			fmt.Printf("Data directory needs migrating from schema v%d to v%d (%d steps); review them with mkanban migrate --dry-run and apply them with mkanban migrate\n",
+				report.FromVersion, report.ToVersion, len(report.Steps))
+		}
+		return nil
+	}
+
+	if report.FromVersion != report.ToVersion {
+		fmt.Printf("Migrated data directory from schema v%d to v%d\n", report.FromVersion, report.ToVersion)
+		if report.BackupPath != "" {
+			fmt.Printf("Backup written to %s\n", report.BackupPath)
+		}
+	}
+
+	return nil
+}
+
+// checkMigrationPending rejects requests that read the data root while
+// migrations are left for the migrate request
+func (s *Server) checkMigrationPending(req *Request) *Response {
+	switch req.Type {
+	case RequestPing, RequestMigrate, RequestCreateBackup, RequestListBackups:
+		return nil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if !s.migrationPending {
+		return nil
+	}
+	return &Response{Success: false, Error: "data directory needs migrating; review the steps with mkanban migrate --dry-run and apply them with mkanban migrate"}
+}
+
 // Stop stops the daemon server
 func (s *Server) Stop() error {
 	// Stop time tracking manager if it exists
@@ -494,6 +913,55 @@ func (s *Server) Stop() error {
 		}
 	}
 
+	// Stop backup manager if it exists
+	if s.backupManager != nil {
+		if err := s.backupManager.Stop(); err != nil {
+			fmt.Printf("Error stopping backup manager: %v\n", err)
+		}
+	}
+
+	// Stop trash manager if it exists
+	if s.trashManager != nil {
+		if err := s.trashManager.Stop(); err != nil {
+			fmt.Printf("Error stopping trash manager: %v\n", err)
+		}
+	}
+
+	// Stop milestone manager if it exists
+	if s.milestoneManager != nil {
+		if err := s.milestoneManager.Stop(); err != nil {
+			fmt.Printf("Error stopping milestone manager: %v\n", err)
+		}
+	}
+
+	// Stop scheduler manager if it exists
+	if s.schedulerManager != nil {
+		if err := s.schedulerManager.Stop(); err != nil {
+			fmt.Printf("Error stopping scheduler manager: %v\n", err)
+		}
+	}
+
+	// Stop calendar manager if it exists
+	if s.calendarManager != nil {
+		if err := s.calendarManager.Stop(); err != nil {
+			fmt.Printf("Error stopping calendar manager: %v\n", err)
+		}
+	}
+
+	// Stop activity manager once the events it has queued are recorded
+	if s.activityManager != nil {
+		if err := s.activityManager.Stop(); err != nil {
+			fmt.Printf("Error stopping activity manager: %v\n", err)
+		}
+	}
+
+	// Stop history manager last so it records changes made while shutting down
+	if s.historyManager != nil {
+		if err := s.historyManager.Stop(); err != nil {
+			fmt.Printf("Error stopping history manager: %v\n", err)
+		}
+	}
+
 	s.releaseLock()
 
 	// Close the listener
@@ -637,7 +1105,12 @@ func (s *Server) handleStartTimer(ctx context.Context, req *Request) *Response {
 		return &Response{Success: false, Error: err.Error()}
 	}
 
-	log, err := s.timeTrackingManager.StartTimer(ctx, payload.ProjectID, nil, payload.Description)
+	taskID, err := parseOptionalTaskID(payload.TaskID)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	log, err := s.timeTrackingManager.StartTimer(ctx, payload.ProjectID, taskID, payload.Description)
 	if err != nil {
 		return &Response{Success: false, Error: err.Error()}
 	}
@@ -660,11 +1133,18 @@ func (s *Server) handleStopTimer(ctx context.Context, req *Request) *Response {
 		return &Response{Success: false, Error: err.Error()}
 	}
 
-	log, err := s.timeTrackingManager.StopTimer(ctx, payload.ProjectID, nil)
+	taskID, err := parseOptionalTaskID(payload.TaskID)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	log, err := s.timeTrackingManager.StopTimer(ctx, payload.ProjectID, taskID)
 	if err != nil {
 		return &Response{Success: false, Error: err.Error()}
 	}
 
+	s.publishTimeLogged(ctx, log)
+
 	return &Response{Success: true, Data: map[string]interface{}{
 		"id":         log.ID(),
 		"project_id": log.ProjectID(),
@@ -674,6 +1154,14 @@ func (s *Server) handleStopTimer(ctx context.Context, req *Request) *Response {
 	}}
 }
 
+// parseOptionalTaskID parses the task a timer is for, if any
+func parseOptionalTaskID(id *string) (*valueobject.TaskID, error) {
+	if id == nil || *id == "" {
+		return nil, nil
+	}
+	return valueobject.ParseTaskID(*id)
+}
+
 func (s *Server) handleGetActiveTimers(ctx context.Context) *Response {
 	if s.timeTrackingManager == nil {
 		return &Response{Success: false, Error: "time tracking not available"}
@@ -795,6 +1283,8 @@ func (s *Server) handleAddTimeEntry(ctx context.Context, req *Request) *Response
 		return &Response{Success: false, Error: err.Error()}
 	}
 
+	s.publishTimeLogged(ctx, log)
+
 	return &Response{Success: true, Data: map[string]interface{}{
 		"id":          log.ID(),
 		"project_id":  log.ProjectID(),
@@ -821,14 +1311,43 @@ func (s *Server) handleCreateProject(ctx context.Context, req *Request) *Respons
 		project.SetWorkingDir(payload.WorkingDir)
 	}
 
-	if err := s.container.ProjectRepo.Save(ctx, project); err != nil {
+	if payload.Template == "" {
+		if err := s.container.ProjectRepo.Save(ctx, project); err != nil {
+			return &Response{Success: false, Error: err.Error()}
+		}
+
+		s.publishEvent(valueobject.EventProjectCreated, "", "", nil, map[string]interface{}{
+			"project_id": project.ID(),
+		})
+
+		return &Response{Success: true, Data: map[string]interface{}{
+			"id":   project.ID(),
+			"name": project.Name(),
+			"slug": project.Slug(),
+		}}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	boards, err := s.container.CreateProjectFromTemplateUseCase.Execute(ctx, project, payload.Template)
+	if err != nil {
 		return &Response{Success: false, Error: err.Error()}
 	}
 
+	s.publishEvent(valueobject.EventProjectCreated, "", "", nil, map[string]interface{}{
+		"project_id": project.ID(),
+		"template":   payload.Template,
+	})
+	for _, board := range boards {
+		s.publishEvent(valueobject.EventBoardCreated, board.ID, "", nil, nil)
+	}
+
 	return &Response{Success: true, Data: map[string]interface{}{
-		"id":   project.ID(),
-		"name": project.Name(),
-		"slug": project.Slug(),
+		"id":     project.ID(),
+		"name":   project.Name(),
+		"slug":   project.Slug(),
+		"boards": boards,
 	}}
 }
 
@@ -914,6 +1433,10 @@ func (s *Server) handleUpdateProject(ctx context.Context, req *Request) *Respons
 		return &Response{Success: false, Error: err.Error()}
 	}
 
+	s.publishEvent(valueobject.EventProjectUpdated, "", "", nil, map[string]interface{}{
+		"project_id": project.ID(),
+	})
+
 	return &Response{Success: true, Data: map[string]interface{}{
 		"id":   project.ID(),
 		"name": project.Name(),
@@ -931,6 +1454,10 @@ func (s *Server) handleDeleteProject(ctx context.Context, req *Request) *Respons
 		return &Response{Success: false, Error: err.Error()}
 	}
 
+	s.publishEvent(valueobject.EventProjectDeleted, "", "", nil, map[string]interface{}{
+		"project_id": payload.ProjectID,
+	})
+
 	return &Response{Success: true, Data: "project deleted"}
 }
 
@@ -942,10 +1469,23 @@ func (s *Server) handleScheduleTask(ctx context.Context, req *Request) *Response
 	}
 	fmt.Printf("[Schedule] Task ID: %s, Date: %s\n", payload.TaskID, payload.Date)
 
-	scheduledDate, err := time.Parse("2006-01-02", payload.Date)
+	var start *time.Time
+	if payload.Start != nil {
+		parsed, err := time.Parse(time.RFC3339, *payload.Start)
+		if err != nil {
+			return &Response{Success: false, Error: "invalid start format, use RFC 3339"}
+		}
+		start = &parsed
+		payload.Date = parsed.Format("2006-01-02")
+	}
+
+	scheduledDate, err := time.ParseInLocation("2006-01-02", payload.Date, time.Local)
 	if err != nil {
 		return &Response{Success: false, Error: "invalid date format, use YYYY-MM-DD"}
 	}
+	if start != nil {
+		scheduledDate = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+	}
 
 	fmt.Println("[Schedule] Finding task...")
 	var board *entity.Board
@@ -968,7 +1508,9 @@ func (s *Server) handleScheduleTask(ctx context.Context, req *Request) *Response
 	fmt.Println("[Schedule] Setting scheduled date...")
 	task.SetScheduledDate(scheduledDate)
 
-	if payload.Time != nil {
+	if start != nil {
+		task.SetScheduledTime(*start)
+	} else if payload.Time != nil {
 		fmt.Println("[Schedule] Setting scheduled time...")
 		scheduledTime, err := time.Parse("15:04", *payload.Time)
 		if err != nil {
@@ -991,6 +1533,12 @@ func (s *Server) handleScheduleTask(ctx context.Context, req *Request) *Response
 		task.SetTimeBlock(duration)
 	}
 
+	if payload.Recurrence != nil {
+		if err := setRecurrence(task, *payload.Recurrence); err != nil {
+			return &Response{Success: false, Error: err.Error()}
+		}
+	}
+
 	fmt.Printf("[Schedule] Board ID: %s, Column: %s\n", board.ID(), columnName)
 	fmt.Println("[Schedule] Saving task...")
 	if err := s.container.BoardRepo.SaveTask(ctx, board.ID(), columnName, task); err != nil {
@@ -999,6 +1547,10 @@ func (s *Server) handleScheduleTask(ctx context.Context, req *Request) *Response
 	}
 	fmt.Println("[Schedule] Task saved!")
 
+	s.publishEvent(valueobject.EventTaskUpdated, board.ID(), columnName, task.ID(), map[string]interface{}{
+		"scheduled_date": payload.Date,
+	})
+
 	fmt.Println("[Schedule] Notifying subscribers...")
 	s.notifySubscribers(board.ID(), &Notification{
 		Type:    NotificationTaskUpdated,
@@ -1013,16 +1565,51 @@ func (s *Server) handleScheduleTask(ctx context.Context, req *Request) *Response
 		"scheduled_date": task.ScheduledDate(),
 		"scheduled_time": task.ScheduledTime(),
 		"time_block":     task.TimeBlock(),
+		"recurrence":     recurrenceString(task),
 	}}
 }
 
+func (s *Server) handlePlanSchedule(ctx context.Context, req *Request) *Response {
+	var payload PlanSchedulePayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	from := time.Now()
+	if payload.From != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", payload.From, time.Local)
+		if err != nil {
+			return &Response{Success: false, Error: "invalid from format, use YYYY-MM-DD"}
+		}
+		from = parsed
+	}
+
+	days := payload.Days
+	if days == 0 {
+		days = 7
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	plan, err := s.container.PlanScheduleUseCase.Execute(ctx, payload.BoardIDs, from, days)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: plan}
+}
+
 func (s *Server) handleCreateMeeting(ctx context.Context, req *Request) *Response {
 	var payload CreateMeetingPayload
 	if err := s.decodePayload(req.Payload, &payload); err != nil {
 		return &Response{Success: false, Error: err.Error()}
 	}
 
-	scheduledDate, err := time.Parse("2006-01-02", payload.Date)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	scheduledDate, err := time.ParseInLocation("2006-01-02", payload.Date, time.Local)
 	if err != nil {
 		return &Response{Success: false, Error: "invalid date format, use YYYY-MM-DD"}
 	}
@@ -1073,6 +1660,12 @@ func (s *Server) handleCreateMeeting(ctx context.Context, req *Request) *Respons
 		task.SetTimeBlock(duration)
 	}
 
+	if payload.Recurrence != nil {
+		if err := setRecurrence(task, *payload.Recurrence); err != nil {
+			return &Response{Success: false, Error: err.Error()}
+		}
+	}
+
 	meetingData := &entity.MeetingData{}
 	if len(payload.Attendees) > 0 {
 		meetingData.Attendees = payload.Attendees
@@ -1104,12 +1697,24 @@ func (s *Server) handleCreateMeeting(ctx context.Context, req *Request) *Respons
 		return &Response{Success: false, Error: err.Error()}
 	}
 
+	s.publishEvent(valueobject.EventTaskCreated, board.ID(), targetColumn.Name(), task.ID(), nil)
+
 	s.notifySubscribers(board.ID(), &Notification{
 		Type:    NotificationTaskCreated,
 		BoardID: board.ID(),
 		Data:    task,
 	})
 
+	noteID := ""
+	if s.config.MeetingNotes.Enabled {
+		meetingNote, err := s.container.CreateMeetingNoteUseCase.Execute(ctx, board.ID(), task.ID().String(), s.config.MeetingNotes.Template)
+		if err != nil {
+			fmt.Printf("[Meeting notes] Failed to create the note of %s: %v\n", task.ID().String(), err)
+		} else {
+			noteID = meetingNote.Note.ID
+		}
+	}
+
 	return &Response{Success: true, Data: map[string]interface{}{
 		"id":             task.ID().String(),
 		"title":          task.Title(),
@@ -1117,9 +1722,1970 @@ func (s *Server) handleCreateMeeting(ctx context.Context, req *Request) *Respons
 		"scheduled_date": task.ScheduledDate(),
 		"scheduled_time": task.ScheduledTime(),
 		"time_block":     task.TimeBlock(),
+		"recurrence":     recurrenceString(task),
+		"note_id":        noteID,
 	}}
 }
 
+func (s *Server) handleGetAgenda(ctx context.Context, req *Request) *Response {
+	var payload GetAgendaPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	from := time.Now()
+	if payload.From != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", payload.From, time.Local)
+		if err != nil {
+			return &Response{Success: false, Error: "invalid from format, use YYYY-MM-DD"}
+		}
+		from = parsed
+	}
+
+	to := from.AddDate(0, 0, 6)
+	if payload.To != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", payload.To, time.Local)
+		if err != nil {
+			return &Response{Success: false, Error: "invalid to format, use YYYY-MM-DD"}
+		}
+		to = parsed
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	agenda, err := s.container.GetAgendaUseCase.Execute(ctx, payload.ProjectID, from, to)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: agenda}
+}
+
+func (s *Server) handleUnscheduleTask(ctx context.Context, req *Request) *Response {
+	var payload UnscheduleTaskPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	board, task, columnName, err := s.findTask(ctx, payload.TaskID)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+	if !task.IsScheduled() {
+		return &Response{Success: false, Error: entity.ErrTaskNotScheduled.Error()}
+	}
+
+	task.ClearScheduledDate()
+	task.ClearScheduledTime()
+	task.ClearTimeBlock()
+	task.ClearRecurrence()
+
+	if err := s.container.BoardRepo.SaveTask(ctx, board.ID(), columnName, task); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.publishEvent(valueobject.EventTaskUpdated, board.ID(), columnName, task.ID(), map[string]interface{}{
+		"unscheduled": true,
+	})
+
+	s.notifySubscribers(board.ID(), &Notification{
+		Type:    NotificationTaskUpdated,
+		BoardID: board.ID(),
+		Data:    task,
+	})
+
+	return &Response{Success: true, Data: map[string]interface{}{
+		"id":    task.ID().String(),
+		"title": task.Title(),
+	}}
+}
+
+func (s *Server) handleRescheduleTask(ctx context.Context, req *Request) *Response {
+	var payload RescheduleTaskPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+	if payload.Date == nil && payload.Time == nil && payload.Start == nil && payload.Duration == nil {
+		return &Response{Success: false, Error: "nothing to reschedule, give a date, time, start or duration"}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	board, task, columnName, err := s.findTask(ctx, payload.TaskID)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+	if !task.IsScheduled() {
+		return &Response{Success: false, Error: entity.ErrTaskNotScheduled.Error()}
+	}
+
+	// Moving a block to another day keeps its time of day, and a new time
+	// keeps its day
+	var scheduledDate time.Time
+	if date := task.ScheduledDate(); date != nil {
+		scheduledDate = *date
+	} else {
+		scheduledDate = task.ScheduledTime().In(time.Local)
+	}
+	scheduledDate = time.Date(scheduledDate.Year(), scheduledDate.Month(), scheduledDate.Day(), 0, 0, 0, 0, time.Local)
+
+	var scheduledTime *time.Time
+	if current := task.ScheduledTime(); current != nil {
+		local := current.In(time.Local)
+		scheduledTime = &local
+	}
+
+	if payload.Start != nil {
+		start, err := time.Parse(time.RFC3339, *payload.Start)
+		if err != nil {
+			return &Response{Success: false, Error: "invalid start format, use RFC 3339"}
+		}
+		scheduledDate = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+		scheduledTime = &start
+	} else {
+		if payload.Date != nil {
+			date, err := time.ParseInLocation("2006-01-02", *payload.Date, time.Local)
+			if err != nil {
+				return &Response{Success: false, Error: "invalid date format, use YYYY-MM-DD"}
+			}
+			scheduledDate = date
+		}
+		if payload.Time != nil {
+			clock, err := time.Parse("15:04", *payload.Time)
+			if err != nil {
+				return &Response{Success: false, Error: "invalid time format, use HH:MM"}
+			}
+			scheduledTime = &clock
+		}
+		if scheduledTime != nil {
+			moved := time.Date(
+				scheduledDate.Year(), scheduledDate.Month(), scheduledDate.Day(),
+				scheduledTime.Hour(), scheduledTime.Minute(), 0, 0,
+				scheduledDate.Location(),
+			)
+			scheduledTime = &moved
+		}
+	}
+
+	if payload.Duration != nil {
+		duration, err := time.ParseDuration(*payload.Duration)
+		if err != nil {
+			return &Response{Success: false, Error: "invalid duration format, use e.g., 2h, 30m"}
+		}
+		task.SetTimeBlock(duration)
+	}
+
+	task.SetScheduledDate(scheduledDate)
+	if scheduledTime != nil {
+		task.SetScheduledTime(*scheduledTime)
+	}
+
+	if err := s.container.BoardRepo.SaveTask(ctx, board.ID(), columnName, task); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	metadata := map[string]interface{}{
+		"scheduled_date": scheduledDate.Format("2006-01-02"),
+	}
+	if scheduledTime != nil {
+		metadata["scheduled_time"] = scheduledTime.Format(time.RFC3339)
+	}
+	s.publishEvent(valueobject.EventTaskUpdated, board.ID(), columnName, task.ID(), metadata)
+
+	s.notifySubscribers(board.ID(), &Notification{
+		Type:    NotificationTaskUpdated,
+		BoardID: board.ID(),
+		Data:    task,
+	})
+
+	return &Response{Success: true, Data: map[string]interface{}{
+		"id":             task.ID().String(),
+		"title":          task.Title(),
+		"scheduled_date": task.ScheduledDate(),
+		"scheduled_time": task.ScheduledTime(),
+		"time_block":     task.TimeBlock(),
+		"recurrence":     recurrenceString(task),
+	}}
+}
+
+// setRecurrence sets the recurrence of a task from its text form, clearing
+// it for an empty rule or "none"
+func setRecurrence(task *entity.Task, rule string) error {
+	if rule == "" || strings.EqualFold(rule, "none") {
+		task.ClearRecurrence()
+		return nil
+	}
+	recurrence, err := valueobject.ParseRecurrenceRule(rule)
+	if err != nil {
+		return err
+	}
+	task.SetRecurrence(recurrence)
+	return nil
+}
+
+// recurrenceString returns the text form of a task's recurrence, or an empty
+// string if it does not repeat
+func recurrenceString(task *entity.Task) string {
+	if rule := task.Recurrence(); rule != nil {
+		return rule.String()
+	}
+	return ""
+}
+
+func (s *Server) handleMigrate(ctx context.Context, req *Request) *Response {
+	var payload MigratePayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	if s.container.Migrator == nil {
+		return &Response{Success: false, Error: "migrations not available"}
+	}
+
+	s.mu.Lock()
+	report, err := s.container.Migrator.Run(ctx, payload.DryRun)
+	startManagers := err == nil && !payload.DryRun && s.migrationPending
+	if startManagers {
+		s.migrationPending = false
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	// The managers were held back at startup until the data root was migrated;
+	// they take the lock themselves
+	if startManagers {
+		if err := s.startManagers(ctx); err != nil {
+			return &Response{Success: false, Error: err.Error()}
+		}
+	}
+
+	return &Response{Success: true, Data: migrationReportToDTO(report)}
+}
+
+func migrationReportToDTO(report *filesystem.MigrationReport) dto.MigrationReportDTO {
+	steps := make([]dto.MigrationStepDTO, len(report.Steps))
+	for i, step := range report.Steps {
+		steps[i] = dto.MigrationStepDTO{
+			Version:     step.Version,
+			Name:        step.Name,
+			Description: step.Description,
+			Changes:     step.Changes,
+		}
+	}
+
+	return dto.MigrationReportDTO{
+		DryRun:      report.DryRun,
+		FromVersion: report.FromVersion,
+		ToVersion:   report.ToVersion,
+		BackupPath:  report.BackupPath,
+		Steps:       steps,
+	}
+}
+
+func (s *Server) handleDoctor(ctx context.Context, req *Request) *Response {
+	var payload DoctorPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	if s.container.IntegrityChecker == nil {
+		return &Response{Success: false, Error: "integrity checker not available"}
+	}
+
+	if payload.Fix {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+	} else {
+		s.mu.RLock()
+		defer s.mu.RUnlock()
+	}
+
+	report, err := s.container.IntegrityChecker.Check(ctx, payload.Fix)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: integrityReportToDTO(report)}
+}
+
+func integrityReportToDTO(report *filesystem.IntegrityReport) dto.IntegrityReportDTO {
+	result := dto.IntegrityReportDTO{
+		Fix:    report.Fix,
+		Issues: make([]dto.IntegrityIssueDTO, len(report.Issues)),
+	}
+
+	for i, issue := range report.Issues {
+		result.Issues[i] = dto.IntegrityIssueDTO{
+			Severity: string(issue.Severity),
+			Code:     issue.Code,
+			Path:     issue.Path,
+			Message:  issue.Message,
+			Fixable:  issue.Fixable,
+			Fixed:    issue.Fixed,
+		}
+
+		switch issue.Severity {
+		case filesystem.IssueSeverityError:
+			result.Errors++
+		case filesystem.IssueSeverityWarning:
+			result.Warnings++
+		}
+		if issue.Fixed {
+			result.Fixed++
+		}
+	}
+
+	return result
+}
+
+func (s *Server) handleGetHistory(ctx context.Context, req *Request) *Response {
+	var payload GetHistoryPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	if s.historyManager == nil {
+		return &Response{Success: false, Error: "history not available"}
+	}
+
+	var history *dto.HistoryDTO
+	var err error
+
+	switch {
+	case payload.TaskID != "":
+		history, err = s.historyManager.TaskHistory(payload.TaskID, payload.BoardID, payload.Limit)
+	case payload.BoardID != "":
+		history, err = s.historyManager.BoardHistory(payload.BoardID, payload.Limit)
+	default:
+		return &Response{Success: false, Error: "task_id or board_id is required"}
+	}
+
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: history}
+}
+
+func (s *Server) handleRestoreTask(ctx context.Context, req *Request) *Response {
+	var payload RestoreTaskPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	if s.historyManager == nil {
+		return &Response{Success: false, Error: "history not available"}
+	}
+	if payload.TaskID == "" || payload.Revision == "" {
+		return &Response{Success: false, Error: "task_id and revision are required"}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result, err := s.historyManager.RestoreTask(payload.TaskID, payload.Revision)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.notifyBoardUpdated(ctx, result.BoardID)
+
+	return &Response{Success: true, Data: result}
+}
+
+func (s *Server) handleRestoreBoard(ctx context.Context, req *Request) *Response {
+	var payload RestoreBoardPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	if s.historyManager == nil {
+		return &Response{Success: false, Error: "history not available"}
+	}
+
+	at, err := time.Parse(time.RFC3339, payload.At)
+	if err != nil {
+		day, dayErr := time.ParseInLocation("2006-01-02", payload.At, time.Local)
+		if dayErr != nil {
+			return &Response{Success: false, Error: "invalid at format, use RFC3339 or YYYY-MM-DD"}
+		}
+		// A bare date means the state at the end of that day
+		at = day.AddDate(0, 0, 1).Add(-time.Second)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result, err := s.historyManager.RestoreBoard(payload.BoardID, at)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.notifyBoardUpdated(ctx, result.BoardID)
+
+	return &Response{Success: true, Data: result}
+}
+
+func (s *Server) handleCreateBackup(ctx context.Context, req *Request) *Response {
+	var payload CreateBackupPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	if s.container.BackupStore == nil {
+		return &Response{Success: false, Error: "backups not available"}
+	}
+
+	reason := payload.Reason
+	if reason == "" {
+		reason = filesystem.BackupReasonManual
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	backup, err := s.container.BackupStore.Create(reason)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: backupInfoToDTO(*backup)}
+}
+
+func (s *Server) handleListBackups(ctx context.Context) *Response {
+	if s.container.BackupStore == nil {
+		return &Response{Success: false, Error: "backups not available"}
+	}
+
+	backups, err := s.container.BackupStore.List()
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	result := make([]dto.BackupDTO, len(backups))
+	for i, backup := range backups {
+		result[i] = backupInfoToDTO(backup)
+	}
+
+	return &Response{Success: true, Data: result}
+}
+
+func (s *Server) handleRestoreBackup(ctx context.Context, req *Request) *Response {
+	var payload RestoreBackupPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	if s.container.BackupStore == nil || s.container.Migrator == nil {
+		return &Response{Success: false, Error: "backups not available"}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	report, err := s.container.BackupStore.Restore(payload.Name, s.container.Migrator.LatestVersion(), payload.Force)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	result := dto.BackupRestoreDTO{
+		Backup:         backupInfoToDTO(report.Backup),
+		SafetyBackup:   report.SafetyBackup,
+		Files:          report.Files,
+		ConfigRestored: report.ConfigRestored,
+	}
+
+	// Archives from older versions are brought up to the current schema
+	if report.FromVersion < s.container.Migrator.LatestVersion() {
+		migration, err := s.container.Migrator.Run(ctx, false)
+		if err != nil {
+			return &Response{Success: false, Error: fmt.Sprintf("restored %s but migration failed: %v", report.Backup.Name, err)}
+		}
+		migrationDTO := migrationReportToDTO(migration)
+		result.Migration = &migrationDTO
+	}
+
+	return &Response{Success: true, Data: result}
+}
+
+func backupInfoToDTO(backup filesystem.BackupInfo) dto.BackupDTO {
+	return dto.BackupDTO{
+		Name:          backup.Name,
+		Path:          backup.Path,
+		Created:       backup.Created,
+		Reason:        backup.Reason,
+		SchemaVersion: backup.SchemaVersion,
+		Size:          backup.Size,
+	}
+}
+
+func (s *Server) handleDeleteNote(ctx context.Context, req *Request) *Response {
+	var payload DeleteNotePayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, err := s.container.DeleteNoteUseCase.Execute(ctx, payload.NoteID)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: item}
+}
+
+func (s *Server) handleListTrash(ctx context.Context, req *Request) *Response {
+	var payload ListTrashPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	items, err := s.container.ListTrashUseCase.Execute(ctx, payload.BoardID)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: items}
+}
+
+func (s *Server) handleRestoreTrash(ctx context.Context, req *Request) *Response {
+	var payload RestoreTrashPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result, err := s.container.RestoreTrashUseCase.Execute(ctx, payload.ID)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	if result.BoardID != "" {
+		s.notifyBoardUpdated(ctx, result.BoardID)
+	}
+
+	return &Response{Success: true, Data: result}
+}
+
+func (s *Server) handlePurgeTrash(ctx context.Context, req *Request) *Response {
+	var payload PurgeTrashPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result, err := s.container.PurgeTrashUseCase.Execute(ctx, payload.ID, payload.All)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: result}
+}
+
+func (s *Server) handleAddAttachment(ctx context.Context, req *Request) *Response {
+	var payload AddAttachmentPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	if !filepath.IsAbs(payload.SourcePath) {
+		return &Response{Success: false, Error: "attachment source path must be absolute"}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attachment, err := s.container.AddAttachmentUseCase.Execute(ctx, payload.BoardID, payload.TaskID, payload.SourcePath, payload.Name)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.publishAttachmentEvent(ctx, payload.BoardID, payload.TaskID, "attachment_added", attachment.Name)
+
+	return &Response{Success: true, Data: attachment}
+}
+
+func (s *Server) handleListAttachments(ctx context.Context, req *Request) *Response {
+	var payload ListAttachmentsPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	attachments, err := s.container.ListAttachmentsUseCase.Execute(ctx, payload.BoardID, payload.TaskID)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: attachments}
+}
+
+func (s *Server) handleRemoveAttachment(ctx context.Context, req *Request) *Response {
+	var payload RemoveAttachmentPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	taskDTO, err := s.container.RemoveAttachmentUseCase.Execute(ctx, payload.BoardID, payload.TaskID, payload.Name)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.publishAttachmentEvent(ctx, payload.BoardID, taskDTO.ID, "attachment_removed", payload.Name)
+
+	return &Response{Success: true, Data: taskDTO}
+}
+
+// handleGetTaskActivity returns the activity timeline of a task
+func (s *Server) handleGetTaskActivity(ctx context.Context, req *Request) *Response {
+	var payload GetTaskActivityPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	activity, err := s.container.GetTaskActivityUseCase.Execute(ctx, payload.BoardID, payload.TaskID)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: activity}
+}
+
+// handleAddComment adds a comment to the activity log of a task
+func (s *Server) handleAddComment(ctx context.Context, req *Request) *Response {
+	var payload AddCommentPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	activity, err := s.container.AddCommentUseCase.Execute(ctx, payload.BoardID, payload.TaskID, payload.Author, payload.Text)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.publishTaskEvent(valueobject.EventTaskCommented, payload.BoardID, activity.Column, activity.TaskID, map[string]interface{}{
+		"author": payload.Author,
+	})
+
+	return &Response{Success: true, Data: activity}
+}
+
+// handleLinkCommit records a commit made for a task in its activity log
+func (s *Server) handleLinkCommit(ctx context.Context, req *Request) *Response {
+	var payload LinkCommitPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	if strings.TrimSpace(payload.Hash) == "" {
+		return &Response{Success: false, Error: "commit hash is required"}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	boardID := payload.BoardID
+	var task *dto.TaskDTO
+	if boardID != "" {
+		task, _ = s.findTaskDTO(ctx, boardID, payload.TaskID)
+	} else if taskID, err := valueobject.ParseTaskID(payload.TaskID); err == nil {
+		if board, found, column, err := s.findTaskAcrossBoards(ctx, taskID); err == nil {
+			boardID = board.ID()
+			taskDTO := dto.TaskToDTO(found)
+			taskDTO.ColumnName = column
+			task = &taskDTO
+		}
+	}
+	if task == nil {
+		return &Response{Success: false, Error: entity.ErrTaskNotFound.Error()}
+	}
+
+	s.publishTaskEvent(valueobject.EventTaskCommitLinked, boardID, task.ColumnName, task.ID, map[string]interface{}{
+		"hash":    strings.TrimSpace(payload.Hash),
+		"message": strings.TrimSpace(payload.Message),
+		"author":  payload.Author,
+	})
+
+	return &Response{Success: true, Data: task}
+}
+
+// handleSaveTaskTemplate creates or replaces a task template
+func (s *Server) handleSaveTaskTemplate(ctx context.Context, req *Request) *Response {
+	var payload SaveTaskTemplatePayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	template, err := s.container.SaveTaskTemplateUseCase.Execute(ctx, payload.Template)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: template}
+}
+
+// handleListTaskTemplates lists the task templates available to a project
+func (s *Server) handleListTaskTemplates(ctx context.Context, req *Request) *Response {
+	var payload ListTaskTemplatesPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	templates, err := s.container.ListTaskTemplatesUseCase.Execute(ctx, payload.ProjectID)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: templates}
+}
+
+// handleDeleteTaskTemplate deletes a task template
+func (s *Server) handleDeleteTaskTemplate(ctx context.Context, req *Request) *Response {
+	var payload DeleteTaskTemplatePayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.container.DeleteTaskTemplateUseCase.Execute(ctx, payload.ProjectID, payload.Name); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true}
+}
+
+// handleCreateTaskFromTemplate creates a task, and subtasks for its checkboxes, from a template
+func (s *Server) handleCreateTaskFromTemplate(ctx context.Context, req *Request) *Response {
+	var payload CreateTaskFromTemplatePayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	taskDTO, err := s.container.CreateTaskFromTemplateUseCase.Execute(ctx, payload.BoardID, payload.TaskRequest)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.publishTaskEvent(valueobject.EventTaskCreated, payload.BoardID, taskDTO.ColumnName, taskDTO.ID, map[string]interface{}{
+		"template": payload.TaskRequest.Template,
+	})
+	s.notifyBoardUpdated(ctx, payload.BoardID)
+
+	return &Response{Success: true, Data: taskDTO}
+}
+
+// handleSaveBoardTemplate creates or replaces a board template
+func (s *Server) handleSaveBoardTemplate(ctx context.Context, req *Request) *Response {
+	var payload SaveBoardTemplatePayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	template, err := s.container.SaveBoardTemplateUseCase.Execute(ctx, payload.Template)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: template}
+}
+
+// handleListBoardTemplates lists the board templates, including the built-in default
+func (s *Server) handleListBoardTemplates(ctx context.Context, req *Request) *Response {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	templates, err := s.container.ListBoardTemplatesUseCase.Execute(ctx)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: templates}
+}
+
+// handleDeleteBoardTemplate deletes a board template
+func (s *Server) handleDeleteBoardTemplate(ctx context.Context, req *Request) *Response {
+	var payload DeleteBoardTemplatePayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.container.DeleteBoardTemplateUseCase.Execute(ctx, payload.Name); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true}
+}
+
+// handleExportBoardTemplate saves an existing board as a board template
+func (s *Server) handleExportBoardTemplate(ctx context.Context, req *Request) *Response {
+	var payload ExportBoardTemplatePayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	template, err := s.container.ExportBoardTemplateUseCase.Execute(ctx, payload.Export)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: template}
+}
+
+// handleSaveProjectTemplate creates or replaces a project template
+func (s *Server) handleSaveProjectTemplate(ctx context.Context, req *Request) *Response {
+	var payload SaveProjectTemplatePayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	template, err := s.container.SaveProjectTemplateUseCase.Execute(ctx, payload.Template)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: template}
+}
+
+// handleListProjectTemplates lists the project templates
+func (s *Server) handleListProjectTemplates(ctx context.Context, req *Request) *Response {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	templates, err := s.container.ListProjectTemplatesUseCase.Execute(ctx)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: templates}
+}
+
+// handleDeleteProjectTemplate deletes a project template
+func (s *Server) handleDeleteProjectTemplate(ctx context.Context, req *Request) *Response {
+	var payload DeleteProjectTemplatePayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.container.DeleteProjectTemplateUseCase.Execute(ctx, payload.Name); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true}
+}
+
+// handleListTags lists the registered and used tags of a project
+func (s *Server) handleListTags(ctx context.Context, req *Request) *Response {
+	var payload ListTagsPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tags, err := s.container.ListTagsUseCase.Execute(ctx, payload.ProjectID)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: tags}
+}
+
+// handleSaveTag registers or updates a tag of a project
+func (s *Server) handleSaveTag(ctx context.Context, req *Request) *Response {
+	var payload SaveTagPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result, err := s.container.SaveTagUseCase.Execute(ctx, payload.ProjectID, payload.Tag)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.notifyTagsUpdated(ctx, payload.ProjectID)
+
+	return &Response{Success: true, Data: result}
+}
+
+// handleRenameTag renames a tag across a project
+func (s *Server) handleRenameTag(ctx context.Context, req *Request) *Response {
+	var payload RenameTagPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result, err := s.container.RenameTagUseCase.Execute(ctx, payload.ProjectID, payload.OldName, payload.NewName)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.notifyTagsUpdated(ctx, payload.ProjectID)
+
+	return &Response{Success: true, Data: result}
+}
+
+// handleMergeTags merges tags of a project into one
+func (s *Server) handleMergeTags(ctx context.Context, req *Request) *Response {
+	var payload MergeTagsPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result, err := s.container.MergeTagsUseCase.Execute(ctx, payload.ProjectID, payload.Sources, payload.Target)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.notifyTagsUpdated(ctx, payload.ProjectID)
+
+	return &Response{Success: true, Data: result}
+}
+
+// handleDeleteTag removes a tag from a project
+func (s *Server) handleDeleteTag(ctx context.Context, req *Request) *Response {
+	var payload DeleteTagPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result, err := s.container.DeleteTagUseCase.Execute(ctx, payload.ProjectID, payload.Name)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.notifyTagsUpdated(ctx, payload.ProjectID)
+
+	return &Response{Success: true, Data: result}
+}
+
+// handleSetTaskParent nests a task under another task
+func (s *Server) handleSetTaskParent(ctx context.Context, req *Request) *Response {
+	var payload SetTaskParentPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	taskDTO, err := s.container.SetTaskParentUseCase.Execute(ctx, payload.BoardID, payload.TaskID, payload.ParentID)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	column := s.taskColumnName(ctx, payload.BoardID, taskDTO.ID)
+	s.publishTaskEvent(valueobject.EventTaskUpdated, payload.BoardID, column, taskDTO.ID, map[string]interface{}{
+		"fields": "parent",
+		"parent": taskDTO.ParentID,
+	})
+
+	// Rollups of the old and new parents change with the task
+	s.notifyBoardUpdated(ctx, payload.BoardID)
+
+	return &Response{Success: true, Data: taskDTO}
+}
+
+// handleGetTaskTree returns a task with its subtasks at any depth
+func (s *Server) handleGetTaskTree(ctx context.Context, req *Request) *Response {
+	var payload GetTaskTreePayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	tree, err := s.container.GetTaskTreeUseCase.Execute(ctx, payload.BoardID, payload.TaskID)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: tree}
+}
+
+// handleGetMilestone returns a milestone with its member tasks and progress
+func (s *Server) handleGetMilestone(ctx context.Context, req *Request) *Response {
+	var payload GetMilestonePayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	milestoneDTO, err := s.container.GetMilestoneUseCase.Execute(ctx, payload.BoardID, payload.MilestoneID)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: milestoneDTO}
+}
+
+// handleListMilestones returns the milestones of a project with their progress
+func (s *Server) handleListMilestones(ctx context.Context, req *Request) *Response {
+	var payload ListMilestonesPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	milestones, err := s.container.ListMilestonesUseCase.Execute(ctx, payload.ProjectID)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: milestones}
+}
+
+// handleAddMilestoneTasks adds tasks to a milestone
+func (s *Server) handleAddMilestoneTasks(ctx context.Context, req *Request) *Response {
+	var payload MilestoneTasksPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	milestoneDTO, err := s.container.AddMilestoneTasksUseCase.Execute(ctx, payload.BoardID, payload.MilestoneID, payload.Tasks)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.publishTaskEvent(valueobject.EventTaskUpdated, payload.BoardID, milestoneDTO.Task.ColumnName, milestoneDTO.Task.ID, map[string]interface{}{
+		"fields": "milestone",
+	})
+	s.checkMilestoneRisk(ctx, payload.BoardID, milestoneDTO.Task.ID)
+	s.notifyBoardUpdated(ctx, payload.BoardID)
+
+	return &Response{Success: true, Data: milestoneDTO}
+}
+
+// handleRemoveMilestoneTasks removes tasks from a milestone
+func (s *Server) handleRemoveMilestoneTasks(ctx context.Context, req *Request) *Response {
+	var payload MilestoneTasksPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	milestoneDTO, err := s.container.RemoveMilestoneTasksUseCase.Execute(ctx, payload.BoardID, payload.MilestoneID, payload.Tasks)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.publishTaskEvent(valueobject.EventTaskUpdated, payload.BoardID, milestoneDTO.Task.ColumnName, milestoneDTO.Task.ID, map[string]interface{}{
+		"fields": "milestone",
+	})
+	s.checkMilestoneRisk(ctx, payload.BoardID, milestoneDTO.Task.ID)
+	s.notifyBoardUpdated(ctx, payload.BoardID)
+
+	return &Response{Success: true, Data: milestoneDTO}
+}
+
+// checkMilestoneRisk re-evaluates the risk of a milestone after it changes so
+// actions hear about it without waiting for the milestone manager. The caller
+// must hold s.mu.
+func (s *Server) checkMilestoneRisk(ctx context.Context, boardID, milestoneID string) {
+	atRisk, err := s.container.CheckMilestonesUseCase.ExecuteForMilestone(ctx, boardID, milestoneID)
+	if err != nil {
+		fmt.Printf("[Milestone] Failed to check milestones: %v\n", err)
+		return
+	}
+	publishMilestonesAtRisk(s.container.EventBus, atRisk)
+}
+
+// handlePlanSprint plans a new sprint in a project
+func (s *Server) handlePlanSprint(ctx context.Context, req *Request) *Response {
+	var payload PlanSprintPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	startDate, err := time.Parse("2006-01-02", payload.StartDate)
+	if err != nil {
+		return &Response{Success: false, Error: "invalid start_date format, use YYYY-MM-DD"}
+	}
+	endDate, err := time.Parse("2006-01-02", payload.EndDate)
+	if err != nil {
+		return &Response{Success: false, Error: "invalid end_date format, use YYYY-MM-DD"}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	planReq := dto.PlanSprintRequest{
+		ProjectID: payload.ProjectID,
+		Name:      payload.Name,
+		Goal:      payload.Goal,
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+
+	sprintDTO, err := s.container.PlanSprintUseCase.Execute(ctx, planReq)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: sprintDTO}
+}
+
+// handleGetSprint returns a sprint with its tasks
+func (s *Server) handleGetSprint(ctx context.Context, req *Request) *Response {
+	var payload GetSprintPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sprintDTO, err := s.container.GetSprintUseCase.Execute(ctx, payload.ProjectID, payload.SprintID)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: sprintDTO}
+}
+
+// handleListSprints returns the sprints of a project
+func (s *Server) handleListSprints(ctx context.Context, req *Request) *Response {
+	var payload ListSprintsPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sprints, err := s.container.ListSprintsUseCase.Execute(ctx, payload.ProjectID)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: sprints}
+}
+
+// handleAddSprintTasks plans tasks in a sprint
+func (s *Server) handleAddSprintTasks(ctx context.Context, req *Request) *Response {
+	var payload SprintTasksPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sprintDTO, err := s.container.AddSprintTasksUseCase.Execute(ctx, payload.ProjectID, payload.SprintID, payload.Tasks)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: sprintDTO}
+}
+
+// handleRemoveSprintTasks takes tasks out of a sprint
+func (s *Server) handleRemoveSprintTasks(ctx context.Context, req *Request) *Response {
+	var payload SprintTasksPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sprintDTO, err := s.container.RemoveSprintTasksUseCase.Execute(ctx, payload.ProjectID, payload.SprintID, payload.Tasks)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: sprintDTO}
+}
+
+// handleCloseSprint closes a sprint, rolling its unfinished tasks over
+func (s *Server) handleCloseSprint(ctx context.Context, req *Request) *Response {
+	var payload CloseSprintPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sprintDTO, err := s.container.CloseSprintUseCase.Execute(ctx, payload.ProjectID, payload.SprintID, payload.NextSprintID)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: sprintDTO}
+}
+
+// handleGetFlowMetrics returns the flow metrics of a board over a date range
+func (s *Server) handleGetFlowMetrics(ctx context.Context, req *Request) *Response {
+	var payload GetFlowMetricsPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	var from, to *time.Time
+	if payload.From != "" {
+		t, err := time.Parse("2006-01-02", payload.From)
+		if err != nil {
+			return &Response{Success: false, Error: "invalid from format, use YYYY-MM-DD"}
+		}
+		from = &t
+	}
+	if payload.To != "" {
+		t, err := time.Parse("2006-01-02", payload.To)
+		if err != nil {
+			return &Response{Success: false, Error: "invalid to format, use YYYY-MM-DD"}
+		}
+		to = &t
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	metrics, err := s.container.GetFlowMetricsUseCase.Execute(ctx, payload.BoardID, from, to)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: metrics}
+}
+
+// handleForecastCompletion forecasts when a number of tasks on boards will be done
+func (s *Server) handleForecastCompletion(ctx context.Context, req *Request) *Response {
+	var payload ForecastCompletionPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	forecast, err := s.container.ForecastCompletionUseCase.Execute(ctx, payload.BoardIDs, payload.Tasks)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: forecast}
+}
+
+// handleForecastThroughput forecasts how many tasks of boards will be done by a date
+func (s *Server) handleForecastThroughput(ctx context.Context, req *Request) *Response {
+	var payload ForecastThroughputPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	date, err := time.Parse("2006-01-02", payload.Date)
+	if err != nil {
+		return &Response{Success: false, Error: "invalid date format, use YYYY-MM-DD"}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	forecast, err := s.container.ForecastThroughputUseCase.Execute(ctx, payload.BoardIDs, date)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: forecast}
+}
+
+// handleGetWorkSchedule returns the work schedule of a project, or the default one
+func (s *Server) handleGetWorkSchedule(ctx context.Context, req *Request) *Response {
+	var payload GetWorkSchedulePayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	schedule, err := s.container.GetWorkScheduleUseCase.Execute(ctx, payload.ProjectID)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: schedule}
+}
+
+// handleUpdateWorkSchedule changes the work schedule of a project, or the default one
+func (s *Server) handleUpdateWorkSchedule(ctx context.Context, req *Request) *Response {
+	var payload UpdateWorkSchedulePayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schedule, err := s.container.UpdateWorkScheduleUseCase.Execute(ctx, payload.Schedule)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: schedule}
+}
+
+// handleAddScheduleException adds a holiday, time off or changed working hours
+// to a work schedule
+func (s *Server) handleAddScheduleException(ctx context.Context, req *Request) *Response {
+	var payload ScheduleExceptionPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	date, err := time.Parse("2006-01-02", payload.Date)
+	if err != nil {
+		return &Response{Success: false, Error: "invalid date format, use YYYY-MM-DD"}
+	}
+
+	exception := dto.ScheduleExceptionDTO{
+		Date:        date,
+		Type:        payload.Type,
+		Description: payload.Description,
+		Start:       payload.Start,
+		End:         payload.End,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schedule, err := s.container.AddScheduleExceptionUseCase.Execute(ctx, payload.ProjectID, exception)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: schedule}
+}
+
+// handleRemoveScheduleException removes the exception on a date from a work schedule
+func (s *Server) handleRemoveScheduleException(ctx context.Context, req *Request) *Response {
+	var payload RemoveScheduleExceptionPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	date, err := time.Parse("2006-01-02", payload.Date)
+	if err != nil {
+		return &Response{Success: false, Error: "invalid date format, use YYYY-MM-DD"}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schedule, err := s.container.RemoveScheduleExceptionUseCase.Execute(ctx, payload.ProjectID, date)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: schedule}
+}
+
+// handleCheckWorkingTime reports whether a moment falls within working hours
+func (s *Server) handleCheckWorkingTime(ctx context.Context, req *Request) *Response {
+	var payload CheckWorkingTimePayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	at := time.Now()
+	if payload.Time != "" {
+		t, err := time.Parse(time.RFC3339, payload.Time)
+		if err != nil {
+			return &Response{Success: false, Error: "invalid time format, use RFC 3339"}
+		}
+		at = t
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	workingTime, err := s.container.CheckWorkingTimeUseCase.Execute(ctx, payload.ProjectID, at)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: workingTime}
+}
+
+// handleCalendarSyncNow syncs with the calendar provider right away
+func (s *Server) handleCalendarSyncNow(ctx context.Context) *Response {
+	if s.calendarManager == nil {
+		return &Response{Success: false, Error: "calendar sync is not enabled"}
+	}
+
+	// The manager takes the data lock itself while synced tasks are saved
+	result, err := s.calendarManager.SyncNow(ctx)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: result}
+}
+
+// handleCalendarSyncStatus returns the calendar sync settings and the last sync
+func (s *Server) handleCalendarSyncStatus() *Response {
+	if s.calendarManager == nil {
+		return &Response{Success: true, Data: calendarConfigStatus(s.config)}
+	}
+
+	return &Response{Success: true, Data: s.calendarManager.Status()}
+}
+
+// handleListCalendarConflicts returns the calendar sync conflicts awaiting a resolution
+func (s *Server) handleListCalendarConflicts() *Response {
+	if s.calendarManager == nil {
+		return &Response{Success: true, Data: []dto.CalendarSyncConflictDTO{}}
+	}
+
+	return &Response{Success: true, Data: s.calendarManager.Conflicts()}
+}
+
+// handleResolveCalendarConflict settles a calendar sync conflict
+func (s *Server) handleResolveCalendarConflict(ctx context.Context, req *Request) *Response {
+	var payload ResolveCalendarConflictPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	if s.calendarManager == nil {
+		return &Response{Success: false, Error: "calendar sync is not enabled"}
+	}
+
+	// The manager takes the data lock itself while the task is saved
+	result, err := s.calendarManager.ResolveConflict(ctx, payload.EventID, payload.Resolution, payload.Fields)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: result}
+}
+
+func (s *Server) handleExportICal(ctx context.Context, req *Request) *Response {
+	var payload ExportICalPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	path := ""
+	if payload.Path != "" {
+		resolved, err := resolveExportPath(s.exportsDir(), payload.Path)
+		if err != nil {
+			return &Response{Success: false, Error: err.Error()}
+		}
+		path = resolved
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	export, err := s.container.ExportICalUseCase.Execute(ctx, payload.ProjectID, payload.BoardID, path)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	return &Response{Success: true, Data: export}
+}
+
+// exportsDir returns the directory exports are written to
+func (s *Server) exportsDir() string {
+	if s.config.Storage.ExportsPath != "" {
+		return s.config.Storage.ExportsPath
+	}
+	return filepath.Join(s.config.Storage.DataPath, "exports")
+}
+
+// resolveExportPath returns the file an export named path is written to:
+// path relative to dir, or an absolute path inside it. Paths leading out of
+// dir are refused so clients cannot overwrite files elsewhere.
+func resolveExportPath(dir, path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+	path = filepath.Clean(path)
+
+	rel, err := filepath.Rel(filepath.Clean(dir), path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("export path must be a file in %s", dir)
+	}
+	return path, nil
+}
+
+func (s *Server) handleImportICal(ctx context.Context, req *Request) *Response {
+	var payload ImportICalPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	data := []byte(payload.Data)
+	if payload.Path != "" {
+		if !filepath.IsAbs(payload.Path) {
+			return &Response{Success: false, Error: "import path must be absolute"}
+		}
+		content, err := os.ReadFile(payload.Path)
+		if err != nil {
+			return &Response{Success: false, Error: err.Error()}
+		}
+		data = content
+	}
+	if len(data) == 0 {
+		return &Response{Success: false, Error: "no calendar data to import"}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Imports can touch many tasks at once, keep a way back
+	backupName := ""
+	if s.container.BackupStore != nil {
+		backup, err := s.container.BackupStore.Create(filesystem.BackupReasonPreImport)
+		if err != nil {
+			return &Response{Success: false, Error: fmt.Sprintf("failed to create backup: %v", err)}
+		}
+		backupName = backup.Name
+	}
+
+	result, err := s.container.ImportICalUseCase.Execute(ctx, payload.BoardID, data)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+	result.Backup = backupName
+
+	notesCreated := s.createMeetingNotes(ctx, result.BoardID, result.TaskIDs)
+
+	if result.Created > 0 || result.Updated > 0 || notesCreated > 0 {
+		s.notifyBoardUpdated(ctx, result.BoardID)
+	}
+
+	return &Response{Success: true, Data: result}
+}
+
+func (s *Server) handleCreateMeetingNote(ctx context.Context, req *Request) *Response {
+	var payload CreateMeetingNotePayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	templateName := payload.Template
+	if templateName == "" {
+		templateName = s.config.MeetingNotes.Template
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result, err := s.container.CreateMeetingNoteUseCase.Execute(ctx, payload.BoardID, payload.TaskID, templateName)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	if result.Created {
+		s.notifyBoardUpdated(ctx, result.BoardID)
+	}
+
+	return &Response{Success: true, Data: result}
+}
+
+func (s *Server) handleCreateActionItems(ctx context.Context, req *Request) *Response {
+	var payload CreateActionItemsPayload
+	if err := s.decodePayload(req.Payload, &payload); err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result, err := s.container.CreateActionItemTasksUseCase.Execute(ctx, payload.NoteID)
+	if err != nil {
+		return &Response{Success: false, Error: err.Error()}
+	}
+
+	for _, task := range result.Tasks {
+		s.publishTaskEvent(valueobject.EventTaskCreated, result.BoardID, task.ColumnName, task.ID, nil)
+	}
+	if len(result.Tasks) > 0 {
+		s.notifyBoardUpdated(ctx, result.BoardID)
+	}
+
+	return &Response{Success: true, Data: result}
+}
+
+// createMeetingNotes creates the meeting notes of meetings on a board when
+// meeting notes are enabled, returning how many were created. Meetings that
+// have a note keep it. Must be called with s.mu held.
+func (s *Server) createMeetingNotes(ctx context.Context, boardID string, taskIDs []string) int {
+	if !s.config.MeetingNotes.Enabled {
+		return 0
+	}
+
+	created := 0
+	for _, taskID := range taskIDs {
+		result, err := s.container.CreateMeetingNoteUseCase.Execute(ctx, boardID, taskID, s.config.MeetingNotes.Template)
+		if err != nil {
+			fmt.Printf("[Meeting notes] Failed to create the note of %s: %v\n", taskID, err)
+			continue
+		}
+		if result.Created {
+			created++
+		}
+	}
+	return created
+}
+
+// findTaskDTO returns a task, accepted as a full or short ID, with its column name set
+func (s *Server) findTaskDTO(ctx context.Context, boardID, taskID string) (*dto.TaskDTO, error) {
+	boardDTO, err := s.container.GetBoardUseCase.Execute(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, column := range boardDTO.Columns {
+		for _, task := range column.Tasks {
+			if task.ID == taskID || task.ShortID == taskID {
+				task.ColumnName = column.Name
+				return &task, nil
+			}
+		}
+	}
+
+	return nil, entity.ErrTaskNotFound
+}
+
+// publishTaskChanges publishes a task update followed by an event for each
+// tracked field that changed between before and after
+func (s *Server) publishTaskChanges(boardID string, before, after *dto.TaskDTO) {
+	column := after.ColumnName
+	if before == nil {
+		s.publishTaskEvent(valueobject.EventTaskUpdated, boardID, column, after.ID, nil)
+		return
+	}
+
+	var fields []string
+	if before.Title != after.Title {
+		fields = append(fields, "title")
+	}
+	if before.Description != after.Description {
+		fields = append(fields, "description")
+	}
+	fields = append(fields, changedCustomFields(before.Fields, after.Fields)...)
+
+	metadata := map[string]interface{}{}
+	if len(fields) > 0 {
+		metadata["fields"] = strings.Join(fields, ",")
+	}
+	s.publishTaskEvent(valueobject.EventTaskUpdated, boardID, column, after.ID, metadata)
+
+	if before.Status != after.Status {
+		s.publishTaskEvent(valueobject.EventTaskStatusChanged, boardID, column, after.ID, map[string]interface{}{
+			"from": before.Status,
+			"to":   after.Status,
+		})
+		if after.Status == valueobject.StatusDone.String() {
+			s.publishTaskEvent(valueobject.EventTaskCompleted, boardID, column, after.ID, nil)
+		}
+	}
+
+	if before.Priority != after.Priority {
+		s.publishTaskEvent(valueobject.EventTaskPriorityChanged, boardID, column, after.ID, map[string]interface{}{
+			"from": before.Priority,
+			"to":   after.Priority,
+		})
+	}
+
+	fromDue, toDue := formatEventDate(before.DueDate), formatEventDate(after.DueDate)
+	if fromDue != toDue {
+		eventType := valueobject.EventTaskDueDateChanged
+		if fromDue == "" {
+			eventType = valueobject.EventTaskDueDateSet
+		}
+		s.publishTaskEvent(eventType, boardID, column, after.ID, map[string]interface{}{
+			"from": fromDue,
+			"to":   toDue,
+		})
+	}
+
+	added, removed := diffTags(before.Tags, after.Tags)
+	if len(added) > 0 || len(removed) > 0 {
+		s.publishTaskEvent(valueobject.EventTaskTagsChanged, boardID, column, after.ID, map[string]interface{}{
+			"added":   strings.Join(added, ","),
+			"removed": strings.Join(removed, ","),
+		})
+	}
+}
+
+// publishTimeLogged reports a finished time log against its task. Callers
+// must not hold s.mu.
+func (s *Server) publishTimeLogged(ctx context.Context, log *entity.TimeLog) {
+	if log.TaskID() == nil || log.EndTime() == nil {
+		return
+	}
+
+	s.mu.RLock()
+	board, task, column, err := s.findTaskAcrossBoards(ctx, log.TaskID())
+	s.mu.RUnlock()
+	if err != nil {
+		return
+	}
+
+	s.publishEvent(valueobject.EventTaskTimeLogged, board.ID(), column, task.ID(), map[string]interface{}{
+		"time_log_id": log.ID(),
+		"duration":    int64(log.Duration().Seconds()),
+		"description": log.Description(),
+	})
+}
+
+// formatEventDate formats an optional date for event metadata
+func formatEventDate(date *time.Time) string {
+	if date == nil {
+		return ""
+	}
+	return date.Format("2006-01-02")
+}
+
+// diffTags returns the tags only present in after and the tags only present in before
+func diffTags(before, after []string) (added, removed []string) {
+	seen := make(map[string]bool, len(before))
+	for _, tag := range before {
+		seen[tag] = true
+	}
+	for _, tag := range after {
+		if !seen[tag] {
+			added = append(added, tag)
+		}
+		delete(seen, tag)
+	}
+	for _, tag := range before {
+		if seen[tag] {
+			removed = append(removed, tag)
+		}
+	}
+	return added, removed
+}
+
+// changedCustomFields returns the names of custom fields whose value differs, sorted
+func changedCustomFields(before, after map[string]string) []string {
+	var changed []string
+	for name, value := range after {
+		if previous, ok := before[name]; !ok || previous != value {
+			changed = append(changed, name)
+		}
+	}
+	for name := range before {
+		if _, ok := after[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// publishAttachmentEvent reports an attachment change as a task update
+func (s *Server) publishAttachmentEvent(ctx context.Context, boardID, taskID, change, name string) {
+	boardDTO, err := s.container.GetBoardUseCase.Execute(ctx, boardID)
+	if err != nil {
+		return
+	}
+
+	for _, column := range boardDTO.Columns {
+		for _, task := range column.Tasks {
+			if task.ID != taskID && task.ShortID != taskID {
+				continue
+			}
+
+			s.publishTaskEvent(valueobject.EventTaskUpdated, boardID, column.Name, task.ID, map[string]interface{}{
+				change: name,
+			})
+			s.notifySubscribers(boardID, &Notification{
+				Type:    NotificationTaskUpdated,
+				BoardID: boardID,
+				Data:    task,
+			})
+			return
+		}
+	}
+}
+
+// notifyBoardUpdated sends the current state of a board to its subscribers
+func (s *Server) notifyBoardUpdated(ctx context.Context, boardID string) {
+	boardDTO, err := s.container.GetBoardUseCase.Execute(ctx, boardID)
+	if err != nil {
+		return
+	}
+
+	s.notifySubscribers(boardID, &Notification{
+		Type:    NotificationBoardUpdated,
+		BoardID: boardID,
+		Data:    boardDTO,
+	})
+}
+
+// notifyTagsUpdated tells the subscribers of every board of a project that its
+// tag registry changed, and sends them the boards whose task tags may have been rewritten
+func (s *Server) notifyTagsUpdated(ctx context.Context, projectID string) {
+	s.subMu.RLock()
+	boardIDs := make([]string, 0)
+	for boardID := range s.subscribers {
+		if strings.HasPrefix(boardID, projectID+"/") {
+			boardIDs = append(boardIDs, boardID)
+		}
+	}
+	s.subMu.RUnlock()
+
+	for _, boardID := range boardIDs {
+		s.notifySubscribers(boardID, &Notification{
+			Type:    NotificationTagsUpdated,
+			BoardID: boardID,
+		})
+		s.notifyBoardUpdated(ctx, boardID)
+	}
+}
+
+// notifyCalendarConflicts tells every subscriber how many calendar sync
+// conflicts await a resolution
+func (s *Server) notifyCalendarConflicts(pending int) {
+	s.subMu.RLock()
+	boardIDs := make([]string, 0, len(s.subscribers))
+	for boardID := range s.subscribers {
+		boardIDs = append(boardIDs, boardID)
+	}
+	s.subMu.RUnlock()
+
+	for _, boardID := range boardIDs {
+		s.notifySubscribers(boardID, &Notification{
+			Type:    NotificationCalendarConflicts,
+			BoardID: boardID,
+			Data:    pending,
+		})
+	}
+}
+
+// publishEvent publishes a domain event for a change made by the daemon
+func (s *Server) publishEvent(eventType valueobject.EventType, boardID, columnID string, taskID *valueobject.TaskID, metadata map[string]interface{}) {
+	if s.container.EventBus == nil {
+		return
+	}
+
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+	}
+
+	s.container.EventBus.Publish(entity.NewDomainEvent(eventType, boardID, columnID, taskID, metadata))
+}
+
+// publishTaskEvent publishes a task domain event from a task ID string
+func (s *Server) publishTaskEvent(eventType valueobject.EventType, boardID, columnID, taskID string, metadata map[string]interface{}) {
+	parsedID, err := valueobject.ParseTaskID(taskID)
+	if err != nil {
+		return
+	}
+
+	s.publishEvent(eventType, boardID, columnID, parsedID, metadata)
+}
+
+// taskColumnName returns the name of the column currently holding a task
+func (s *Server) taskColumnName(ctx context.Context, boardID, taskID string) string {
+	parsedID, err := valueobject.ParseTaskID(taskID)
+	if err != nil {
+		return ""
+	}
+
+	board, err := s.container.BoardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		return ""
+	}
+
+	_, column, err := board.FindTask(parsedID)
+	if err != nil {
+		return ""
+	}
+
+	return column.Name()
+}
+
+// findTask finds a task on any board by its full or short ID
+func (s *Server) findTask(ctx context.Context, id string) (*entity.Board, *entity.Task, string, error) {
+	if taskID, err := valueobject.ParseTaskID(id); err == nil {
+		return s.findTaskAcrossBoards(ctx, taskID)
+	}
+	return s.findTaskByShortID(ctx, id)
+}
+
 func (s *Server) findTaskAcrossBoards(ctx context.Context, taskID *valueobject.TaskID) (*entity.Board, *entity.Task, string, error) {
 	boards, err := s.container.ListBoardsUseCase.Execute(ctx)
 	if err != nil {
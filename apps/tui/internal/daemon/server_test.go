@@ -0,0 +1,149 @@
+package daemon
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"mkanban/internal/application/dto"
+	"mkanban/internal/application/usecase/calendar"
+	"mkanban/internal/di"
+	"mkanban/internal/infrastructure/config"
+	"mkanban/internal/infrastructure/external"
+	"mkanban/internal/infrastructure/persistence/filesystem"
+)
+
+func TestManualMigrationsHoldRequestsUntilMigrated(t *testing.T) {
+	root := t.TempDir()
+	// A board laid out before schema v1, with columns at the board root
+	writeHistoryTestFile(t, root, "projects/work/boards/tracker/board.md", "---\nnext_task_num: 2\n---\n")
+	writeHistoryTestFile(t, root, "projects/work/boards/tracker/To Do/column.md", "---\norder: 1\n---\n")
+	writeHistoryTestFile(t, root, "projects/work/boards/tracker/To Do/TRK-001-fix-bug/task.md", "# Fix bug\n")
+
+	cfg := &config.Config{}
+	cfg.Storage.DataPath = root
+	cfg.Storage.ManualMigrations = true
+
+	s := &Server{
+		container:   &di.Container{Migrator: filesystem.NewMigrator(root, filesystem.NewBackupStore(root, ""))},
+		config:      cfg,
+		subscribers: make(map[string]map[net.Conn]chan *Notification),
+	}
+
+	if err := s.runMigrations(context.Background()); err != nil {
+		t.Fatalf("startup migrations failed: %v", err)
+	}
+	if !s.migrationPending {
+		t.Fatal("expected the migrations to be left for the migrate request")
+	}
+	if version, err := filesystem.ReadSchemaVersion(root); err != nil || version != 0 {
+		t.Fatalf("expected the data root to stay at schema v0, got v%d (%v)", version, err)
+	}
+
+	resp := s.handleRequest(&Request{Type: RequestListBoards})
+	if resp.Success || !strings.Contains(resp.Error, "needs migrating") {
+		t.Errorf("expected board requests to be refused before migrating, got %+v", resp)
+	}
+
+	resp = s.handleRequest(&Request{Type: RequestMigrate, Payload: MigratePayload{DryRun: true}})
+	if !resp.Success {
+		t.Fatalf("dry run failed: %s", resp.Error)
+	}
+	if report := resp.Data.(dto.MigrationReportDTO); !report.DryRun || len(report.Steps) == 0 {
+		t.Errorf("expected a dry run report of the pending steps, got %+v", report)
+	}
+	if !s.migrationPending {
+		t.Error("expected a dry run to leave the migrations pending")
+	}
+
+	resp = s.handleRequest(&Request{Type: RequestMigrate})
+	if !resp.Success {
+		t.Fatalf("migrate failed: %s", resp.Error)
+	}
+	if s.migrationPending {
+		t.Error("expected requests to be served after migrating")
+	}
+	if version, err := filesystem.ReadSchemaVersion(root); err != nil || version == 0 {
+		t.Errorf("expected the data root to be migrated, got v%d (%v)", version, err)
+	}
+}
+
+func TestResolveExportPath(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "exports")
+
+	tests := []struct {
+		name string
+		path string
+		want string // empty when the path is refused
+	}{
+		{"file name", "work.ics", filepath.Join(dir, "work.ics")},
+		{"nested file", "feeds/work.ics", filepath.Join(dir, "feeds", "work.ics")},
+		{"absolute path inside", filepath.Join(dir, "work.ics"), filepath.Join(dir, "work.ics")},
+		{"parent reference that stays inside", "feeds/../work.ics", filepath.Join(dir, "work.ics")},
+		{"parent directory", "../work.ics", ""},
+		{"absolute path outside", filepath.Join(filepath.Dir(dir), "work.ics"), ""},
+		{"sibling with the same prefix", dir + "-old/work.ics", ""},
+		{"the directory itself", dir, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveExportPath(dir, tt.path)
+			if tt.want == "" {
+				if err == nil {
+					t.Errorf("expected %s to be refused, got %s", tt.path, got)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("expected %s, got %s (%v)", tt.want, got, err)
+			}
+		})
+	}
+}
+
+func TestExportICalWritesOnlyToExportsDir(t *testing.T) {
+	root := t.TempDir()
+	cfg := &config.Config{}
+	cfg.Storage.DataPath = root
+
+	s := &Server{
+		container: &di.Container{
+			ExportICalUseCase: calendar.NewExportICalUseCase(filesystem.NewBoardRepository(root), external.NewICalCodec()),
+		},
+		config:      cfg,
+		subscribers: make(map[string]map[net.Conn]chan *Notification),
+	}
+
+	outside := filepath.Join(t.TempDir(), "profile")
+	writeHistoryTestFile(t, filepath.Dir(outside), "profile", "keep me\n")
+	for _, path := range []string{outside, "../../profile"} {
+		resp := s.handleExportICal(context.Background(), &Request{Payload: ExportICalPayload{Path: path}})
+		if resp.Success {
+			t.Errorf("expected the export to %s to be refused", path)
+		}
+	}
+	if got := readHistoryTestFile(t, filepath.Dir(outside), "profile"); got != "keep me\n" {
+		t.Errorf("expected the file outside the exports directory to be left alone, got %q", got)
+	}
+
+	resp := s.handleExportICal(context.Background(), &Request{Payload: ExportICalPayload{Path: "all.ics"}})
+	if !resp.Success {
+		t.Fatalf("export failed: %s", resp.Error)
+	}
+	export := resp.Data.(*dto.ICalExportDTO)
+	if want := filepath.Join(root, "exports", "all.ics"); export.Path != want {
+		t.Errorf("expected the feed to be written to %s, got %s", want, export.Path)
+	}
+	if got := readHistoryTestFile(t, root, "exports/all.ics"); !strings.Contains(got, "BEGIN:VCALENDAR") {
+		t.Errorf("expected a calendar feed, got %q", got)
+	}
+
+	// A configured exports directory replaces the default one
+	cfg.Storage.ExportsPath = filepath.Join(t.TempDir(), "feeds")
+	resp = s.handleExportICal(context.Background(), &Request{Payload: ExportICalPayload{Path: "all.ics"}})
+	if !resp.Success || resp.Data.(*dto.ICalExportDTO).Path != filepath.Join(cfg.Storage.ExportsPath, "all.ics") {
+		t.Errorf("expected the feed to be written to the configured directory, got %+v", resp)
+	}
+}
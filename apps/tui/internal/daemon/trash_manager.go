@@ -0,0 +1,91 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"mkanban/internal/application/usecase/trash"
+	"mkanban/internal/infrastructure/config"
+)
+
+// trashPurgeInterval is how often the manager purges expired trash items
+const trashPurgeInterval = time.Hour
+
+// TrashManager purges trash items older than the configured retention period
+type TrashManager struct {
+	config   *config.Config
+	purge    *trash.PurgeTrashUseCase
+	dataLock sync.Locker // held while expired items are removed
+
+	ctx        context.Context
+	cancelFunc context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+// NewTrashManager creates a new TrashManager. dataLock must keep daemon
+// mutations, restores in particular, out while items are purged.
+func NewTrashManager(cfg *config.Config, purge *trash.PurgeTrashUseCase, dataLock sync.Locker) *TrashManager {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &TrashManager{
+		config:     cfg,
+		purge:      purge,
+		dataLock:   dataLock,
+		ctx:        ctx,
+		cancelFunc: cancel,
+	}
+}
+
+// Start starts the purge scheduler
+func (m *TrashManager) Start() error {
+	if m.config.Trash.RetentionDays <= 0 {
+		fmt.Println("Trash retention is disabled, trashed items are kept until purged")
+		return nil
+	}
+
+	m.wg.Add(1)
+	go m.run()
+
+	fmt.Printf("[Trash] Purging items older than %d day(s)\n", m.config.Trash.RetentionDays)
+	return nil
+}
+
+// Stop stops the purge scheduler
+func (m *TrashManager) Stop() error {
+	m.cancelFunc()
+	m.wg.Wait()
+	return nil
+}
+
+func (m *TrashManager) run() {
+	defer m.wg.Done()
+
+	m.purgeExpired()
+
+	ticker := time.NewTicker(trashPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-ticker.C:
+			m.purgeExpired()
+		}
+	}
+}
+
+func (m *TrashManager) purgeExpired() {
+	m.dataLock.Lock()
+	result, err := m.purge.Execute(m.ctx, "", false)
+	m.dataLock.Unlock()
+	if err != nil {
+		fmt.Printf("[Trash] Failed to purge expired items: %v\n", err)
+		return
+	}
+	if len(result.Purged) > 0 {
+		fmt.Printf("[Trash] Purged %d expired item(s)\n", len(result.Purged))
+	}
+}
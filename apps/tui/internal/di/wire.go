@@ -4,14 +4,27 @@
 package di
 
 import (
+	"fmt"
+
 	"github.com/google/wire"
 
 	"mkanban/internal/application/strategy"
 	"mkanban/internal/application/usecase/action"
+	"mkanban/internal/application/usecase/agenda"
 	"mkanban/internal/application/usecase/board"
+	"mkanban/internal/application/usecase/calendar"
 	"mkanban/internal/application/usecase/column"
+	"mkanban/internal/application/usecase/forecast"
+	"mkanban/internal/application/usecase/milestone"
+	"mkanban/internal/application/usecase/note"
+	"mkanban/internal/application/usecase/planner"
+	"mkanban/internal/application/usecase/schedule"
 	"mkanban/internal/application/usecase/session"
+	"mkanban/internal/application/usecase/sprint"
+	"mkanban/internal/application/usecase/tag"
 	"mkanban/internal/application/usecase/task"
+	"mkanban/internal/application/usecase/template"
+	"mkanban/internal/application/usecase/trash"
 	"mkanban/internal/domain/entity"
 	"mkanban/internal/domain/repository"
 	"mkanban/internal/domain/service"
@@ -27,37 +40,137 @@ type Container struct {
 	Config *config.Config
 
 	// Repositories
-	BoardRepo   repository.BoardRepository
-	ActionRepo  repository.ActionRepository
-	ProjectRepo repository.ProjectRepository
-	TimeLogRepo repository.TimeLogRepository
-	NoteRepo    repository.NoteRepository
+	BoardRepo           repository.BoardRepository
+	ActionRepo          repository.ActionRepository
+	ProjectRepo         repository.ProjectRepository
+	TimeLogRepo         repository.TimeLogRepository
+	NoteRepo            repository.NoteRepository
+	TrashRepo           repository.TrashRepository
+	AttachmentRepo      repository.AttachmentRepository
+	ActivityRepo        repository.ActivityRepository
+	TaskTemplateRepo    repository.TaskTemplateRepository
+	BoardTemplateRepo   repository.BoardTemplateRepository
+	ProjectTemplateRepo repository.ProjectTemplateRepository
+	TagRepo             repository.TagRepository
+	SprintRepo          repository.SprintRepository
+	WorkScheduleRepo    repository.WorkScheduleRepository
 
 	// Domain Services
-	ValidationService *service.ValidationService
-	BoardService      *service.BoardService
-	SessionTracker    service.SessionTracker
-	VCSProvider       service.VCSProvider
-	ChangeWatcher     service.ChangeWatcher
-	RepoPathResolver  service.RepoPathResolver
+	ValidationService    *service.ValidationService
+	BoardService         *service.BoardService
+	BoardTemplateService *service.BoardTemplateService
+	TagService           *service.TagService
+	MilestoneService     *service.MilestoneService
+	SprintService        *service.SprintService
+	FlowMetricsService   *service.FlowMetricsService
+	ForecastService      *service.ForecastService
+	WorkScheduleService  *service.WorkScheduleService
+	AutoSchedulerService *service.AutoSchedulerService
+	AgendaService        *service.AgendaService
+	SessionTracker       service.SessionTracker
+	VCSProvider          service.VCSProvider
+	ChangeWatcher        service.ChangeWatcher
+	RepoPathResolver     service.RepoPathResolver
+	CalendarProvider     service.CalendarProvider // nil when calendar sync is disabled
+	CalendarCodec        service.CalendarCodec
 
 	// Strategies
 	BoardSyncStrategies []strategy.BoardSyncStrategy
 
 	// Use Cases - Board
-	CreateBoardUseCase *board.CreateBoardUseCase
-	GetBoardUseCase    *board.GetBoardUseCase
-	ListBoardsUseCase  *board.ListBoardsUseCase
+	CreateBoardUseCase    *board.CreateBoardUseCase
+	GetBoardUseCase       *board.GetBoardUseCase
+	ListBoardsUseCase     *board.ListBoardsUseCase
+	SetBoardFieldsUseCase *board.SetBoardFieldsUseCase
+	GetFlowMetricsUseCase *board.GetFlowMetricsUseCase
 
 	// Use Cases - Column
 	CreateColumnUseCase *column.CreateColumnUseCase
+	DeleteColumnUseCase *column.DeleteColumnUseCase
 
 	// Use Cases - Task
-	CreateTaskUseCase   *task.CreateTaskUseCase
-	MoveTaskUseCase     *task.MoveTaskUseCase
-	UpdateTaskUseCase   *task.UpdateTaskUseCase
-	ListTasksUseCase    *task.ListTasksUseCase
-	CheckoutTaskUseCase *task.CheckoutTaskUseCase
+	CreateTaskUseCase       *task.CreateTaskUseCase
+	MoveTaskUseCase         *task.MoveTaskUseCase
+	UpdateTaskUseCase       *task.UpdateTaskUseCase
+	ListTasksUseCase        *task.ListTasksUseCase
+	CheckoutTaskUseCase     *task.CheckoutTaskUseCase
+	DeleteTaskUseCase       *task.DeleteTaskUseCase
+	AddAttachmentUseCase    *task.AddAttachmentUseCase
+	ListAttachmentsUseCase  *task.ListAttachmentsUseCase
+	RemoveAttachmentUseCase *task.RemoveAttachmentUseCase
+	GetTaskActivityUseCase  *task.GetTaskActivityUseCase
+	AddCommentUseCase       *task.AddCommentUseCase
+	RecordActivityUseCase   *task.RecordActivityUseCase
+	SetTaskParentUseCase    *task.SetTaskParentUseCase
+	GetTaskTreeUseCase      *task.GetTaskTreeUseCase
+
+	// Use Cases - Template
+	SaveTaskTemplateUseCase          *template.SaveTaskTemplateUseCase
+	ListTaskTemplatesUseCase         *template.ListTaskTemplatesUseCase
+	DeleteTaskTemplateUseCase        *template.DeleteTaskTemplateUseCase
+	CreateTaskFromTemplateUseCase    *template.CreateTaskFromTemplateUseCase
+	SaveBoardTemplateUseCase         *template.SaveBoardTemplateUseCase
+	ListBoardTemplatesUseCase        *template.ListBoardTemplatesUseCase
+	DeleteBoardTemplateUseCase       *template.DeleteBoardTemplateUseCase
+	ExportBoardTemplateUseCase       *template.ExportBoardTemplateUseCase
+	SaveProjectTemplateUseCase       *template.SaveProjectTemplateUseCase
+	ListProjectTemplatesUseCase      *template.ListProjectTemplatesUseCase
+	DeleteProjectTemplateUseCase     *template.DeleteProjectTemplateUseCase
+	CreateProjectFromTemplateUseCase *template.CreateProjectFromTemplateUseCase
+
+	// Use Cases - Note
+	DeleteNoteUseCase            *note.DeleteNoteUseCase
+	CreateMeetingNoteUseCase     *note.CreateMeetingNoteUseCase
+	CreateActionItemTasksUseCase *note.CreateActionItemTasksUseCase
+
+	// Use Cases - Tag
+	ListTagsUseCase  *tag.ListTagsUseCase
+	SaveTagUseCase   *tag.SaveTagUseCase
+	RenameTagUseCase *tag.RenameTagUseCase
+	MergeTagsUseCase *tag.MergeTagsUseCase
+	DeleteTagUseCase *tag.DeleteTagUseCase
+
+	// Use Cases - Milestone
+	GetMilestoneUseCase         *milestone.GetMilestoneUseCase
+	ListMilestonesUseCase       *milestone.ListMilestonesUseCase
+	AddMilestoneTasksUseCase    *milestone.AddMilestoneTasksUseCase
+	RemoveMilestoneTasksUseCase *milestone.RemoveMilestoneTasksUseCase
+	CheckMilestonesUseCase      *milestone.CheckMilestonesUseCase
+
+	// Use Cases - Sprint
+	PlanSprintUseCase        *sprint.PlanSprintUseCase
+	GetSprintUseCase         *sprint.GetSprintUseCase
+	ListSprintsUseCase       *sprint.ListSprintsUseCase
+	AddSprintTasksUseCase    *sprint.AddSprintTasksUseCase
+	RemoveSprintTasksUseCase *sprint.RemoveSprintTasksUseCase
+	CloseSprintUseCase       *sprint.CloseSprintUseCase
+
+	// Use Cases - Forecast
+	ForecastCompletionUseCase *forecast.ForecastCompletionUseCase
+	ForecastThroughputUseCase *forecast.ForecastThroughputUseCase
+
+	// Use Cases - Work Schedule
+	GetWorkScheduleUseCase         *schedule.GetWorkScheduleUseCase
+	UpdateWorkScheduleUseCase      *schedule.UpdateWorkScheduleUseCase
+	AddScheduleExceptionUseCase    *schedule.AddScheduleExceptionUseCase
+	RemoveScheduleExceptionUseCase *schedule.RemoveScheduleExceptionUseCase
+	CheckWorkingTimeUseCase        *schedule.CheckWorkingTimeUseCase
+
+	// Use Cases - Planner
+	PlanScheduleUseCase       *planner.PlanScheduleUseCase
+	ReplanMissedBlocksUseCase *planner.ReplanMissedBlocksUseCase
+
+	// Use Cases - Agenda
+	GetAgendaUseCase *agenda.GetAgendaUseCase
+
+	// Use Cases - Calendar
+	ExportICalUseCase *calendar.ExportICalUseCase
+	ImportICalUseCase *calendar.ImportICalUseCase
+
+	// Use Cases - Trash
+	ListTrashUseCase    *trash.ListTrashUseCase
+	RestoreTrashUseCase *trash.RestoreTrashUseCase
+	PurgeTrashUseCase   *trash.PurgeTrashUseCase
 
 	// Use Cases - Session
 	TrackSessionsUseCase        *session.TrackSessionsUseCase
@@ -81,6 +194,11 @@ type Container struct {
 	Notifier      entity.Notifier
 	ScriptRunner  entity.ScriptRunner
 	TaskMutator   entity.TaskMutator
+	Migrator      *filesystem.Migrator
+	IntegrityChecker *filesystem.IntegrityChecker
+	BackupStore   *filesystem.BackupStore
+	CalendarSyncService *infraService.CalendarSyncService // nil when calendar sync is disabled
+	CalendarSyncStore   *filesystem.CalendarSyncStore
 }
 
 // InitializeContainer sets up all dependencies
@@ -95,14 +213,34 @@ func InitializeContainer() (*Container, error) {
 		ProvideProjectRepository,
 		ProvideTimeLogRepository,
 		ProvideNoteRepository,
+		ProvideTrashRepository,
+		ProvideAttachmentRepository,
+		ProvideActivityRepository,
+		ProvideTaskTemplateRepository,
+		ProvideBoardTemplateRepository,
+		ProvideProjectTemplateRepository,
+		ProvideTagRepository,
+		ProvideSprintRepository,
+		ProvideWorkScheduleRepository,
 
 		// Domain Services
 		ProvideValidationService,
 		ProvideBoardService,
+		ProvideBoardTemplateService,
+		ProvideTagService,
+		ProvideMilestoneService,
+		ProvideSprintService,
+		ProvideFlowMetricsService,
+		ProvideForecastService,
+		ProvideWorkScheduleService,
+		ProvideAutoSchedulerService,
+		ProvideAgendaService,
 		ProvideSessionTracker,
 		ProvideVCSProvider,
 		ProvideChangeWatcher,
 		ProvideRepoPathResolver,
+		ProvideCalendarProvider,
+		ProvideCalendarCodec,
 
 		// Strategies
 		ProvideBoardSyncStrategies,
@@ -111,9 +249,12 @@ func InitializeContainer() (*Container, error) {
 		board.NewCreateBoardUseCase,
 		board.NewGetBoardUseCase,
 		board.NewListBoardsUseCase,
+		board.NewSetBoardFieldsUseCase,
+		board.NewGetFlowMetricsUseCase,
 
 		// Use Cases - Column
 		column.NewCreateColumnUseCase,
+		column.NewDeleteColumnUseCase,
 
 		// Use Cases - Task
 		task.NewCreateTaskUseCase,
@@ -121,6 +262,83 @@ func InitializeContainer() (*Container, error) {
 		task.NewUpdateTaskUseCase,
 		task.NewListTasksUseCase,
 		task.NewCheckoutTaskUseCase,
+		task.NewDeleteTaskUseCase,
+		task.NewAddAttachmentUseCase,
+		task.NewListAttachmentsUseCase,
+		task.NewRemoveAttachmentUseCase,
+		task.NewGetTaskActivityUseCase,
+		task.NewAddCommentUseCase,
+		task.NewRecordActivityUseCase,
+		task.NewSetTaskParentUseCase,
+		task.NewGetTaskTreeUseCase,
+
+		// Use Cases - Template
+		template.NewSaveTaskTemplateUseCase,
+		template.NewListTaskTemplatesUseCase,
+		template.NewDeleteTaskTemplateUseCase,
+		template.NewCreateTaskFromTemplateUseCase,
+		template.NewSaveBoardTemplateUseCase,
+		template.NewListBoardTemplatesUseCase,
+		template.NewDeleteBoardTemplateUseCase,
+		template.NewExportBoardTemplateUseCase,
+		template.NewSaveProjectTemplateUseCase,
+		template.NewListProjectTemplatesUseCase,
+		template.NewDeleteProjectTemplateUseCase,
+		template.NewCreateProjectFromTemplateUseCase,
+
+		// Use Cases - Note
+		note.NewDeleteNoteUseCase,
+		note.NewCreateMeetingNoteUseCase,
+		note.NewCreateActionItemTasksUseCase,
+
+		// Use Cases - Tag
+		tag.NewListTagsUseCase,
+		tag.NewSaveTagUseCase,
+		tag.NewRenameTagUseCase,
+		tag.NewMergeTagsUseCase,
+		tag.NewDeleteTagUseCase,
+
+		// Use Cases - Milestone
+		milestone.NewGetMilestoneUseCase,
+		milestone.NewListMilestonesUseCase,
+		milestone.NewAddMilestoneTasksUseCase,
+		milestone.NewRemoveMilestoneTasksUseCase,
+		milestone.NewCheckMilestonesUseCase,
+
+		// Use Cases - Sprint
+		sprint.NewPlanSprintUseCase,
+		sprint.NewGetSprintUseCase,
+		sprint.NewListSprintsUseCase,
+		sprint.NewAddSprintTasksUseCase,
+		sprint.NewRemoveSprintTasksUseCase,
+		sprint.NewCloseSprintUseCase,
+
+		// Use Cases - Forecast
+		forecast.NewForecastCompletionUseCase,
+		forecast.NewForecastThroughputUseCase,
+
+		// Use Cases - Work Schedule
+		schedule.NewGetWorkScheduleUseCase,
+		schedule.NewUpdateWorkScheduleUseCase,
+		schedule.NewAddScheduleExceptionUseCase,
+		schedule.NewRemoveScheduleExceptionUseCase,
+		schedule.NewCheckWorkingTimeUseCase,
+
+		// Use Cases - Planner
+		planner.NewPlanScheduleUseCase,
+		planner.NewReplanMissedBlocksUseCase,
+
+		// Use Cases - Agenda
+		agenda.NewGetAgendaUseCase,
+
+		// Use Cases - Calendar
+		calendar.NewExportICalUseCase,
+		calendar.NewImportICalUseCase,
+
+		// Use Cases - Trash
+		trash.NewListTrashUseCase,
+		trash.NewRestoreTrashUseCase,
+		trash.NewPurgeTrashUseCase,
 
 		// Use Cases - Session
 		session.NewSessionBoardPlanner,
@@ -133,6 +351,11 @@ func InitializeContainer() (*Container, error) {
 		ProvideNotifier,
 		ProvideScriptRunner,
 		ProvideTaskMutator,
+		ProvideMigrator,
+		ProvideIntegrityChecker,
+		ProvideBackupStore,
+		ProvideCalendarSyncService,
+		ProvideCalendarSyncStore,
 
 		// Use Cases - Action
 		action.NewCreateActionUseCase,
@@ -178,6 +401,70 @@ func ProvideBoardService(
 	return service.NewBoardService(boardRepo, validationService, cfg)
 }
 
+func ProvideBoardTemplateService(
+	boardRepo repository.BoardRepository,
+	actionRepo repository.ActionRepository,
+	templateRepo repository.BoardTemplateRepository,
+	boardService *service.BoardService,
+) *service.BoardTemplateService {
+	return service.NewBoardTemplateService(boardRepo, actionRepo, templateRepo, boardService)
+}
+
+func ProvideTagService(
+	boardRepo repository.BoardRepository,
+	noteRepo repository.NoteRepository,
+	tagRepo repository.TagRepository,
+) *service.TagService {
+	return service.NewTagService(boardRepo, noteRepo, tagRepo)
+}
+
+func ProvideMilestoneService(
+	boardRepo repository.BoardRepository,
+	activityRepo repository.ActivityRepository,
+	forecastService *service.ForecastService,
+) *service.MilestoneService {
+	return service.NewMilestoneService(boardRepo, activityRepo, forecastService)
+}
+
+func ProvideSprintService(
+	sprintRepo repository.SprintRepository,
+	boardRepo repository.BoardRepository,
+	timeLogRepo repository.TimeLogRepository,
+	forecastService *service.ForecastService,
+) *service.SprintService {
+	return service.NewSprintService(sprintRepo, boardRepo, timeLogRepo, forecastService)
+}
+
+func ProvideFlowMetricsService(boardRepo repository.BoardRepository) *service.FlowMetricsService {
+	return service.NewFlowMetricsService(boardRepo)
+}
+
+func ProvideForecastService(
+	boardRepo repository.BoardRepository,
+	flowMetricsService *service.FlowMetricsService,
+	workScheduleService *service.WorkScheduleService,
+) *service.ForecastService {
+	return service.NewForecastService(boardRepo, flowMetricsService, workScheduleService)
+}
+
+func ProvideWorkScheduleService(scheduleRepo repository.WorkScheduleRepository) *service.WorkScheduleService {
+	return service.NewWorkScheduleService(scheduleRepo)
+}
+
+func ProvideAutoSchedulerService(
+	boardRepo repository.BoardRepository,
+	workScheduleService *service.WorkScheduleService,
+) *service.AutoSchedulerService {
+	return service.NewAutoSchedulerService(boardRepo, workScheduleService)
+}
+
+func ProvideAgendaService(
+	boardRepo repository.BoardRepository,
+	workScheduleService *service.WorkScheduleService,
+) *service.AgendaService {
+	return service.NewAgendaService(boardRepo, workScheduleService)
+}
+
 func ProvideSessionTracker() service.SessionTracker {
 	return external.NewTmuxSessionTracker()
 }
@@ -190,6 +477,28 @@ func ProvideChangeWatcher() (service.ChangeWatcher, error) {
 	return external.NewFSNotifyWatcher()
 }
 
+func ProvideCalendarCodec() service.CalendarCodec {
+	return external.NewICalCodec()
+}
+
+// ProvideCalendarProvider selects the calendar named by the calendar
+// config's provider, nil when calendar sync is disabled
+func ProvideCalendarProvider(cfg *config.Config) (service.CalendarProvider, error) {
+	if !cfg.Calendar.Enabled {
+		return nil, nil
+	}
+
+	switch cfg.Calendar.Provider {
+	case "", "google":
+		return external.NewGoogleCalendarClient(cfg.Calendar.CredentialsPath, cfg.Calendar.TokenPath, cfg.Calendar.CalendarID)
+	case "caldav":
+		caldav := cfg.Calendar.CalDAV
+		return external.NewCalDAVCalendarClient(caldav.URL, caldav.Username, caldav.Password)
+	default:
+		return nil, fmt.Errorf("%w: %s", entity.ErrUnknownCalendarProvider, cfg.Calendar.Provider)
+	}
+}
+
 func ProvideRepoPathResolver(
 	sessionTracker service.SessionTracker,
 	vcsProvider service.VCSProvider,
@@ -250,3 +559,72 @@ func ProvideTimeLogRepository(cfg *config.Config) repository.TimeLogRepository {
 func ProvideNoteRepository(cfg *config.Config) repository.NoteRepository {
 	return filesystem.NewNoteRepository(cfg.Storage.DataPath)
 }
+
+func ProvideTrashRepository(cfg *config.Config) repository.TrashRepository {
+	return filesystem.NewTrashRepository(cfg.Storage.DataPath)
+}
+
+func ProvideAttachmentRepository(cfg *config.Config) repository.AttachmentRepository {
+	return filesystem.NewAttachmentRepository(cfg.Storage.DataPath)
+}
+
+func ProvideActivityRepository(cfg *config.Config) repository.ActivityRepository {
+	return filesystem.NewActivityRepository(cfg.Storage.DataPath)
+}
+
+func ProvideTaskTemplateRepository(cfg *config.Config) repository.TaskTemplateRepository {
+	return filesystem.NewTaskTemplateRepository(cfg.Storage.DataPath)
+}
+
+func ProvideBoardTemplateRepository(cfg *config.Config) repository.BoardTemplateRepository {
+	return filesystem.NewBoardTemplateRepository(cfg.Storage.DataPath)
+}
+
+func ProvideProjectTemplateRepository(cfg *config.Config) repository.ProjectTemplateRepository {
+	return filesystem.NewProjectTemplateRepository(cfg.Storage.DataPath)
+}
+
+func ProvideTagRepository(cfg *config.Config) repository.TagRepository {
+	return filesystem.NewTagRepository(cfg.Storage.DataPath)
+}
+
+func ProvideSprintRepository(cfg *config.Config) repository.SprintRepository {
+	return filesystem.NewSprintRepository(cfg.Storage.DataPath)
+}
+
+func ProvideWorkScheduleRepository(cfg *config.Config) repository.WorkScheduleRepository {
+	return filesystem.NewWorkScheduleRepository(cfg.Storage.DataPath)
+}
+
+func ProvideMigrator(cfg *config.Config, backups *filesystem.BackupStore) *filesystem.Migrator {
+	return filesystem.NewMigrator(cfg.Storage.DataPath, backups)
+}
+
+func ProvideIntegrityChecker(cfg *config.Config) *filesystem.IntegrityChecker {
+	return filesystem.NewIntegrityChecker(cfg.Storage.DataPath)
+}
+
+func ProvideBackupStore(cfg *config.Config) *filesystem.BackupStore {
+	// The config file is archived alongside the data root when its location is known
+	configPath := ""
+	if loader, err := config.NewLoader(); err == nil {
+		configPath = loader.GetConfigPath()
+	}
+	return filesystem.NewBackupStore(cfg.Storage.DataPath, configPath)
+}
+
+// ProvideCalendarSyncService syncs with the configured calendar provider, if any
+func ProvideCalendarSyncService(
+	provider service.CalendarProvider,
+	boardRepo repository.BoardRepository,
+	projectRepo repository.ProjectRepository,
+) *infraService.CalendarSyncService {
+	if provider == nil {
+		return nil
+	}
+	return infraService.NewCalendarSyncService(provider, boardRepo, projectRepo)
+}
+
+func ProvideCalendarSyncStore(cfg *config.Config) *filesystem.CalendarSyncStore {
+	return filesystem.NewCalendarSyncStore(cfg.Storage.DataPath)
+}
@@ -7,12 +7,24 @@
 package di
 
 import (
+	"fmt"
 	"mkanban/internal/application/strategy"
 	"mkanban/internal/application/usecase/action"
+	"mkanban/internal/application/usecase/agenda"
 	"mkanban/internal/application/usecase/board"
+	"mkanban/internal/application/usecase/calendar"
 	"mkanban/internal/application/usecase/column"
+	"mkanban/internal/application/usecase/forecast"
+	"mkanban/internal/application/usecase/milestone"
+	"mkanban/internal/application/usecase/note"
+	"mkanban/internal/application/usecase/planner"
+	"mkanban/internal/application/usecase/schedule"
 	"mkanban/internal/application/usecase/session"
+	"mkanban/internal/application/usecase/sprint"
+	"mkanban/internal/application/usecase/tag"
 	"mkanban/internal/application/usecase/task"
+	"mkanban/internal/application/usecase/template"
+	"mkanban/internal/application/usecase/trash"
 	"mkanban/internal/domain/entity"
 	"mkanban/internal/domain/repository"
 	"mkanban/internal/domain/service"
@@ -35,8 +47,26 @@ func InitializeContainer() (*Container, error) {
 	projectRepository := ProvideProjectRepository(config)
 	timeLogRepository := ProvideTimeLogRepository(config)
 	noteRepository := ProvideNoteRepository(config)
+	trashRepository := ProvideTrashRepository(config)
+	attachmentRepository := ProvideAttachmentRepository(config)
+	activityRepository := ProvideActivityRepository(config)
+	taskTemplateRepository := ProvideTaskTemplateRepository(config)
+	boardTemplateRepository := ProvideBoardTemplateRepository(config)
+	projectTemplateRepository := ProvideProjectTemplateRepository(config)
+	tagRepository := ProvideTagRepository(config)
+	sprintRepository := ProvideSprintRepository(config)
+	workScheduleRepository := ProvideWorkScheduleRepository(config)
 	validationService := ProvideValidationService(boardRepository)
 	boardService := ProvideBoardService(boardRepository, validationService, config)
+	boardTemplateService := ProvideBoardTemplateService(boardRepository, actionRepository, boardTemplateRepository, boardService)
+	tagService := ProvideTagService(boardRepository, noteRepository, tagRepository)
+	flowMetricsService := ProvideFlowMetricsService(boardRepository)
+	workScheduleService := ProvideWorkScheduleService(workScheduleRepository)
+	forecastService := ProvideForecastService(boardRepository, flowMetricsService, workScheduleService)
+	milestoneService := ProvideMilestoneService(boardRepository, activityRepository, forecastService)
+	sprintService := ProvideSprintService(sprintRepository, boardRepository, timeLogRepository, forecastService)
+	autoSchedulerService := ProvideAutoSchedulerService(boardRepository, workScheduleService)
+	agendaService := ProvideAgendaService(boardRepository, workScheduleService)
 	sessionTracker := ProvideSessionTracker()
 	vcsProvider := ProvideVCSProvider()
 	changeWatcher, err := ProvideChangeWatcher()
@@ -44,18 +74,81 @@ func InitializeContainer() (*Container, error) {
 		return nil, err
 	}
 	repoPathResolver := ProvideRepoPathResolver(sessionTracker, vcsProvider, projectRepository)
+	calendarProvider, err := ProvideCalendarProvider(config)
+	if err != nil {
+		return nil, err
+	}
+	calendarCodec := ProvideCalendarCodec()
 	v := ProvideBoardSyncStrategies(vcsProvider, config)
-	sessionBoardPlanner := session.NewSessionBoardPlanner(vcsProvider)
-	createBoardUseCase := board.NewCreateBoardUseCase(boardService)
-	getBoardUseCase := board.NewGetBoardUseCase(boardRepository)
+	createBoardUseCase := board.NewCreateBoardUseCase(boardService, boardTemplateService)
+	getBoardUseCase := board.NewGetBoardUseCase(boardRepository, timeLogRepository)
 	listBoardsUseCase := board.NewListBoardsUseCase(boardRepository)
+	setBoardFieldsUseCase := board.NewSetBoardFieldsUseCase(boardService)
+	getFlowMetricsUseCase := board.NewGetFlowMetricsUseCase(flowMetricsService)
 	createColumnUseCase := column.NewCreateColumnUseCase(boardService)
+	deleteColumnUseCase := column.NewDeleteColumnUseCase(boardRepository, trashRepository)
 	createTaskUseCase := task.NewCreateTaskUseCase(boardService)
 	moveTaskUseCase := task.NewMoveTaskUseCase(boardService)
 	updateTaskUseCase := task.NewUpdateTaskUseCase(boardService)
 	listTasksUseCase := task.NewListTasksUseCase(boardRepository, config)
 	checkoutTaskUseCase := task.NewCheckoutTaskUseCase(boardRepository, vcsProvider, repoPathResolver)
-	syncSessionBoardUseCase := session.NewSyncSessionBoardUseCase(boardRepository, projectRepository, boardService, v, sessionBoardPlanner)
+	deleteTaskUseCase := task.NewDeleteTaskUseCase(boardRepository, trashRepository)
+	addAttachmentUseCase := task.NewAddAttachmentUseCase(boardRepository, attachmentRepository)
+	listAttachmentsUseCase := task.NewListAttachmentsUseCase(boardRepository, attachmentRepository)
+	removeAttachmentUseCase := task.NewRemoveAttachmentUseCase(boardRepository, attachmentRepository)
+	getTaskActivityUseCase := task.NewGetTaskActivityUseCase(boardRepository, activityRepository)
+	addCommentUseCase := task.NewAddCommentUseCase(boardRepository, activityRepository)
+	recordActivityUseCase := task.NewRecordActivityUseCase(boardRepository, activityRepository)
+	setTaskParentUseCase := task.NewSetTaskParentUseCase(boardService)
+	getTaskTreeUseCase := task.NewGetTaskTreeUseCase(boardRepository, timeLogRepository)
+	saveTaskTemplateUseCase := template.NewSaveTaskTemplateUseCase(taskTemplateRepository, projectRepository)
+	listTaskTemplatesUseCase := template.NewListTaskTemplatesUseCase(taskTemplateRepository)
+	deleteTaskTemplateUseCase := template.NewDeleteTaskTemplateUseCase(taskTemplateRepository)
+	createTaskFromTemplateUseCase := template.NewCreateTaskFromTemplateUseCase(boardService, boardRepository, taskTemplateRepository)
+	saveBoardTemplateUseCase := template.NewSaveBoardTemplateUseCase(boardTemplateRepository)
+	listBoardTemplatesUseCase := template.NewListBoardTemplatesUseCase(boardTemplateRepository)
+	deleteBoardTemplateUseCase := template.NewDeleteBoardTemplateUseCase(boardTemplateRepository)
+	exportBoardTemplateUseCase := template.NewExportBoardTemplateUseCase(boardTemplateService, boardTemplateRepository)
+	saveProjectTemplateUseCase := template.NewSaveProjectTemplateUseCase(projectTemplateRepository, boardTemplateService)
+	listProjectTemplatesUseCase := template.NewListProjectTemplatesUseCase(projectTemplateRepository)
+	deleteProjectTemplateUseCase := template.NewDeleteProjectTemplateUseCase(projectTemplateRepository)
+	createProjectFromTemplateUseCase := template.NewCreateProjectFromTemplateUseCase(projectRepository, noteRepository, projectTemplateRepository, boardTemplateService)
+	deleteNoteUseCase := note.NewDeleteNoteUseCase(noteRepository, trashRepository)
+	createMeetingNoteUseCase := note.NewCreateMeetingNoteUseCase(boardRepository, noteRepository, taskTemplateRepository)
+	createActionItemTasksUseCase := note.NewCreateActionItemTasksUseCase(boardService, boardRepository, noteRepository)
+	listTagsUseCase := tag.NewListTagsUseCase(tagRepository, tagService)
+	saveTagUseCase := tag.NewSaveTagUseCase(tagService)
+	renameTagUseCase := tag.NewRenameTagUseCase(tagService)
+	mergeTagsUseCase := tag.NewMergeTagsUseCase(tagService)
+	deleteTagUseCase := tag.NewDeleteTagUseCase(tagService)
+	getMilestoneUseCase := milestone.NewGetMilestoneUseCase(milestoneService)
+	listMilestonesUseCase := milestone.NewListMilestonesUseCase(milestoneService)
+	addMilestoneTasksUseCase := milestone.NewAddMilestoneTasksUseCase(milestoneService)
+	removeMilestoneTasksUseCase := milestone.NewRemoveMilestoneTasksUseCase(milestoneService)
+	checkMilestonesUseCase := milestone.NewCheckMilestonesUseCase(milestoneService)
+	planSprintUseCase := sprint.NewPlanSprintUseCase(sprintService)
+	getSprintUseCase := sprint.NewGetSprintUseCase(sprintService)
+	listSprintsUseCase := sprint.NewListSprintsUseCase(sprintService)
+	addSprintTasksUseCase := sprint.NewAddSprintTasksUseCase(sprintService)
+	removeSprintTasksUseCase := sprint.NewRemoveSprintTasksUseCase(sprintService)
+	closeSprintUseCase := sprint.NewCloseSprintUseCase(sprintService)
+	forecastCompletionUseCase := forecast.NewForecastCompletionUseCase(forecastService)
+	forecastThroughputUseCase := forecast.NewForecastThroughputUseCase(forecastService)
+	getWorkScheduleUseCase := schedule.NewGetWorkScheduleUseCase(workScheduleService)
+	updateWorkScheduleUseCase := schedule.NewUpdateWorkScheduleUseCase(workScheduleService)
+	addScheduleExceptionUseCase := schedule.NewAddScheduleExceptionUseCase(workScheduleService)
+	removeScheduleExceptionUseCase := schedule.NewRemoveScheduleExceptionUseCase(workScheduleService)
+	checkWorkingTimeUseCase := schedule.NewCheckWorkingTimeUseCase(workScheduleService)
+	planScheduleUseCase := planner.NewPlanScheduleUseCase(autoSchedulerService)
+	replanMissedBlocksUseCase := planner.NewReplanMissedBlocksUseCase(autoSchedulerService)
+	getAgendaUseCase := agenda.NewGetAgendaUseCase(agendaService)
+	exportICalUseCase := calendar.NewExportICalUseCase(boardRepository, calendarCodec)
+	importICalUseCase := calendar.NewImportICalUseCase(boardRepository, calendarCodec)
+	listTrashUseCase := trash.NewListTrashUseCase(trashRepository, config)
+	restoreTrashUseCase := trash.NewRestoreTrashUseCase(trashRepository, boardRepository, config)
+	purgeTrashUseCase := trash.NewPurgeTrashUseCase(trashRepository, config)
+	sessionBoardPlanner := session.NewSessionBoardPlanner(vcsProvider)
+	syncSessionBoardUseCase := session.NewSyncSessionBoardUseCase(boardRepository, projectRepository, boardService, v, sessionBoardPlanner, boardTemplateService, config)
 	trackSessionsUseCase := session.NewTrackSessionsUseCase(sessionTracker, syncSessionBoardUseCase)
 	getActiveSessionBoardUseCase := session.NewGetActiveSessionBoardUseCase(sessionTracker, boardRepository, syncSessionBoardUseCase, sessionBoardPlanner)
 	createActionUseCase := action.NewCreateActionUseCase(actionRepository)
@@ -72,46 +165,134 @@ func InitializeContainer() (*Container, error) {
 	executeActionUseCase := action.NewExecuteActionUseCase(actionRepository, notifier, scriptRunner, taskMutator)
 	processEventUseCase := action.NewProcessEventUseCase(evaluateActionsUseCase, executeActionUseCase, actionRepository)
 	eventBus := ProvideEventBus()
+	backupStore := ProvideBackupStore(config)
+	migrator := ProvideMigrator(config, backupStore)
+	integrityChecker := ProvideIntegrityChecker(config)
+	calendarSyncService := ProvideCalendarSyncService(calendarProvider, boardRepository, projectRepository)
+	calendarSyncStore := ProvideCalendarSyncStore(config)
 	container := &Container{
-		Config:                       config,
-		BoardRepo:                    boardRepository,
-		ActionRepo:                   actionRepository,
-		ProjectRepo:                  projectRepository,
-		TimeLogRepo:                  timeLogRepository,
-		NoteRepo:                     noteRepository,
-		ValidationService:            validationService,
-		BoardService:                 boardService,
-		SessionTracker:               sessionTracker,
-		VCSProvider:                  vcsProvider,
-		ChangeWatcher:                changeWatcher,
-		RepoPathResolver:             repoPathResolver,
-		BoardSyncStrategies:          v,
-		CreateBoardUseCase:           createBoardUseCase,
-		GetBoardUseCase:              getBoardUseCase,
-		ListBoardsUseCase:            listBoardsUseCase,
-		CreateColumnUseCase:          createColumnUseCase,
-		CreateTaskUseCase:            createTaskUseCase,
-		MoveTaskUseCase:              moveTaskUseCase,
-		UpdateTaskUseCase:            updateTaskUseCase,
-		ListTasksUseCase:             listTasksUseCase,
-		CheckoutTaskUseCase:          checkoutTaskUseCase,
-		TrackSessionsUseCase:         trackSessionsUseCase,
-		GetActiveSessionBoardUseCase: getActiveSessionBoardUseCase,
-		SyncSessionBoardUseCase:      syncSessionBoardUseCase,
-		CreateActionUseCase:          createActionUseCase,
-		UpdateActionUseCase:          updateActionUseCase,
-		DeleteActionUseCase:          deleteActionUseCase,
-		GetActionUseCase:             getActionUseCase,
-		ListActionsUseCase:           listActionsUseCase,
-		EnableActionUseCase:          enableActionUseCase,
-		DisableActionUseCase:         disableActionUseCase,
-		EvaluateActionsUseCase:       evaluateActionsUseCase,
-		ExecuteActionUseCase:         executeActionUseCase,
-		ProcessEventUseCase:          processEventUseCase,
-		EventBus:                     eventBus,
-		Notifier:                     notifier,
-		ScriptRunner:                 scriptRunner,
-		TaskMutator:                  taskMutator,
+		Config:                           config,
+		BoardRepo:                        boardRepository,
+		ActionRepo:                       actionRepository,
+		ProjectRepo:                      projectRepository,
+		TimeLogRepo:                      timeLogRepository,
+		NoteRepo:                         noteRepository,
+		TrashRepo:                        trashRepository,
+		AttachmentRepo:                   attachmentRepository,
+		ActivityRepo:                     activityRepository,
+		TaskTemplateRepo:                 taskTemplateRepository,
+		BoardTemplateRepo:                boardTemplateRepository,
+		ProjectTemplateRepo:              projectTemplateRepository,
+		TagRepo:                          tagRepository,
+		SprintRepo:                       sprintRepository,
+		WorkScheduleRepo:                 workScheduleRepository,
+		ValidationService:                validationService,
+		BoardService:                     boardService,
+		BoardTemplateService:             boardTemplateService,
+		TagService:                       tagService,
+		MilestoneService:                 milestoneService,
+		SprintService:                    sprintService,
+		FlowMetricsService:               flowMetricsService,
+		ForecastService:                  forecastService,
+		WorkScheduleService:              workScheduleService,
+		AutoSchedulerService:             autoSchedulerService,
+		AgendaService:                    agendaService,
+		SessionTracker:                   sessionTracker,
+		VCSProvider:                      vcsProvider,
+		ChangeWatcher:                    changeWatcher,
+		RepoPathResolver:                 repoPathResolver,
+		CalendarProvider:                 calendarProvider,
+		CalendarCodec:                    calendarCodec,
+		BoardSyncStrategies:              v,
+		CreateBoardUseCase:               createBoardUseCase,
+		GetBoardUseCase:                  getBoardUseCase,
+		ListBoardsUseCase:                listBoardsUseCase,
+		SetBoardFieldsUseCase:            setBoardFieldsUseCase,
+		GetFlowMetricsUseCase:            getFlowMetricsUseCase,
+		CreateColumnUseCase:              createColumnUseCase,
+		DeleteColumnUseCase:              deleteColumnUseCase,
+		CreateTaskUseCase:                createTaskUseCase,
+		MoveTaskUseCase:                  moveTaskUseCase,
+		UpdateTaskUseCase:                updateTaskUseCase,
+		ListTasksUseCase:                 listTasksUseCase,
+		CheckoutTaskUseCase:              checkoutTaskUseCase,
+		DeleteTaskUseCase:                deleteTaskUseCase,
+		AddAttachmentUseCase:             addAttachmentUseCase,
+		ListAttachmentsUseCase:           listAttachmentsUseCase,
+		RemoveAttachmentUseCase:          removeAttachmentUseCase,
+		GetTaskActivityUseCase:           getTaskActivityUseCase,
+		AddCommentUseCase:                addCommentUseCase,
+		RecordActivityUseCase:            recordActivityUseCase,
+		SetTaskParentUseCase:             setTaskParentUseCase,
+		GetTaskTreeUseCase:               getTaskTreeUseCase,
+		SaveTaskTemplateUseCase:          saveTaskTemplateUseCase,
+		ListTaskTemplatesUseCase:         listTaskTemplatesUseCase,
+		DeleteTaskTemplateUseCase:        deleteTaskTemplateUseCase,
+		CreateTaskFromTemplateUseCase:    createTaskFromTemplateUseCase,
+		SaveBoardTemplateUseCase:         saveBoardTemplateUseCase,
+		ListBoardTemplatesUseCase:        listBoardTemplatesUseCase,
+		DeleteBoardTemplateUseCase:       deleteBoardTemplateUseCase,
+		ExportBoardTemplateUseCase:       exportBoardTemplateUseCase,
+		SaveProjectTemplateUseCase:       saveProjectTemplateUseCase,
+		ListProjectTemplatesUseCase:      listProjectTemplatesUseCase,
+		DeleteProjectTemplateUseCase:     deleteProjectTemplateUseCase,
+		CreateProjectFromTemplateUseCase: createProjectFromTemplateUseCase,
+		DeleteNoteUseCase:                deleteNoteUseCase,
+		CreateMeetingNoteUseCase:         createMeetingNoteUseCase,
+		CreateActionItemTasksUseCase:     createActionItemTasksUseCase,
+		ListTagsUseCase:                  listTagsUseCase,
+		SaveTagUseCase:                   saveTagUseCase,
+		RenameTagUseCase:                 renameTagUseCase,
+		MergeTagsUseCase:                 mergeTagsUseCase,
+		DeleteTagUseCase:                 deleteTagUseCase,
+		GetMilestoneUseCase:              getMilestoneUseCase,
+		ListMilestonesUseCase:            listMilestonesUseCase,
+		AddMilestoneTasksUseCase:         addMilestoneTasksUseCase,
+		RemoveMilestoneTasksUseCase:      removeMilestoneTasksUseCase,
+		CheckMilestonesUseCase:           checkMilestonesUseCase,
+		PlanSprintUseCase:                planSprintUseCase,
+		GetSprintUseCase:                 getSprintUseCase,
+		ListSprintsUseCase:               listSprintsUseCase,
+		AddSprintTasksUseCase:            addSprintTasksUseCase,
+		RemoveSprintTasksUseCase:         removeSprintTasksUseCase,
+		CloseSprintUseCase:               closeSprintUseCase,
+		ForecastCompletionUseCase:        forecastCompletionUseCase,
+		ForecastThroughputUseCase:        forecastThroughputUseCase,
+		GetWorkScheduleUseCase:           getWorkScheduleUseCase,
+		UpdateWorkScheduleUseCase:        updateWorkScheduleUseCase,
+		AddScheduleExceptionUseCase:      addScheduleExceptionUseCase,
+		RemoveScheduleExceptionUseCase:   removeScheduleExceptionUseCase,
+		CheckWorkingTimeUseCase:          checkWorkingTimeUseCase,
+		PlanScheduleUseCase:              planScheduleUseCase,
+		ReplanMissedBlocksUseCase:        replanMissedBlocksUseCase,
+		GetAgendaUseCase:                 getAgendaUseCase,
+		ExportICalUseCase:                exportICalUseCase,
+		ImportICalUseCase:                importICalUseCase,
+		ListTrashUseCase:                 listTrashUseCase,
+		RestoreTrashUseCase:              restoreTrashUseCase,
+		PurgeTrashUseCase:                purgeTrashUseCase,
+		TrackSessionsUseCase:             trackSessionsUseCase,
+		GetActiveSessionBoardUseCase:     getActiveSessionBoardUseCase,
+		SyncSessionBoardUseCase:          syncSessionBoardUseCase,
+		CreateActionUseCase:              createActionUseCase,
+		UpdateActionUseCase:              updateActionUseCase,
+		DeleteActionUseCase:              deleteActionUseCase,
+		GetActionUseCase:                 getActionUseCase,
+		ListActionsUseCase:               listActionsUseCase,
+		EnableActionUseCase:              enableActionUseCase,
+		DisableActionUseCase:             disableActionUseCase,
+		EvaluateActionsUseCase:           evaluateActionsUseCase,
+		ExecuteActionUseCase:             executeActionUseCase,
+		ProcessEventUseCase:              processEventUseCase,
+		EventBus:                         eventBus,
+		Notifier:                         notifier,
+		ScriptRunner:                     scriptRunner,
+		TaskMutator:                      taskMutator,
+		Migrator:                         migrator,
+		IntegrityChecker:                 integrityChecker,
+		BackupStore:                      backupStore,
+		CalendarSyncService:              calendarSyncService,
+		CalendarSyncStore:                calendarSyncStore,
 	}
 	return container, nil
 }
@@ -124,37 +305,137 @@ type Container struct {
 	Config *config.Config
 
 	// Repositories
-	BoardRepo   repository.BoardRepository
-	ActionRepo  repository.ActionRepository
-	ProjectRepo repository.ProjectRepository
-	TimeLogRepo repository.TimeLogRepository
-	NoteRepo    repository.NoteRepository
+	BoardRepo           repository.BoardRepository
+	ActionRepo          repository.ActionRepository
+	ProjectRepo         repository.ProjectRepository
+	TimeLogRepo         repository.TimeLogRepository
+	NoteRepo            repository.NoteRepository
+	TrashRepo           repository.TrashRepository
+	AttachmentRepo      repository.AttachmentRepository
+	ActivityRepo        repository.ActivityRepository
+	TaskTemplateRepo    repository.TaskTemplateRepository
+	BoardTemplateRepo   repository.BoardTemplateRepository
+	ProjectTemplateRepo repository.ProjectTemplateRepository
+	TagRepo             repository.TagRepository
+	SprintRepo          repository.SprintRepository
+	WorkScheduleRepo    repository.WorkScheduleRepository
 
 	// Domain Services
-	ValidationService *service.ValidationService
-	BoardService      *service.BoardService
-	SessionTracker    service.SessionTracker
-	VCSProvider       service.VCSProvider
-	ChangeWatcher     service.ChangeWatcher
-	RepoPathResolver  service.RepoPathResolver
+	ValidationService    *service.ValidationService
+	BoardService         *service.BoardService
+	BoardTemplateService *service.BoardTemplateService
+	TagService           *service.TagService
+	MilestoneService     *service.MilestoneService
+	SprintService        *service.SprintService
+	FlowMetricsService   *service.FlowMetricsService
+	ForecastService      *service.ForecastService
+	WorkScheduleService  *service.WorkScheduleService
+	AutoSchedulerService *service.AutoSchedulerService
+	AgendaService        *service.AgendaService
+	SessionTracker       service.SessionTracker
+	VCSProvider          service.VCSProvider
+	ChangeWatcher        service.ChangeWatcher
+	RepoPathResolver     service.RepoPathResolver
+	CalendarProvider     service.CalendarProvider // nil when calendar sync is disabled
+	CalendarCodec        service.CalendarCodec
 
 	// Strategies
 	BoardSyncStrategies []strategy.BoardSyncStrategy
 
 	// Use Cases - Board
-	CreateBoardUseCase *board.CreateBoardUseCase
-	GetBoardUseCase    *board.GetBoardUseCase
-	ListBoardsUseCase  *board.ListBoardsUseCase
+	CreateBoardUseCase    *board.CreateBoardUseCase
+	GetBoardUseCase       *board.GetBoardUseCase
+	ListBoardsUseCase     *board.ListBoardsUseCase
+	SetBoardFieldsUseCase *board.SetBoardFieldsUseCase
+	GetFlowMetricsUseCase *board.GetFlowMetricsUseCase
 
 	// Use Cases - Column
 	CreateColumnUseCase *column.CreateColumnUseCase
+	DeleteColumnUseCase *column.DeleteColumnUseCase
 
 	// Use Cases - Task
-	CreateTaskUseCase   *task.CreateTaskUseCase
-	MoveTaskUseCase     *task.MoveTaskUseCase
-	UpdateTaskUseCase   *task.UpdateTaskUseCase
-	ListTasksUseCase    *task.ListTasksUseCase
-	CheckoutTaskUseCase *task.CheckoutTaskUseCase
+	CreateTaskUseCase       *task.CreateTaskUseCase
+	MoveTaskUseCase         *task.MoveTaskUseCase
+	UpdateTaskUseCase       *task.UpdateTaskUseCase
+	ListTasksUseCase        *task.ListTasksUseCase
+	CheckoutTaskUseCase     *task.CheckoutTaskUseCase
+	DeleteTaskUseCase       *task.DeleteTaskUseCase
+	AddAttachmentUseCase    *task.AddAttachmentUseCase
+	ListAttachmentsUseCase  *task.ListAttachmentsUseCase
+	RemoveAttachmentUseCase *task.RemoveAttachmentUseCase
+	GetTaskActivityUseCase  *task.GetTaskActivityUseCase
+	AddCommentUseCase       *task.AddCommentUseCase
+	RecordActivityUseCase   *task.RecordActivityUseCase
+	SetTaskParentUseCase    *task.SetTaskParentUseCase
+	GetTaskTreeUseCase      *task.GetTaskTreeUseCase
+
+	// Use Cases - Template
+	SaveTaskTemplateUseCase          *template.SaveTaskTemplateUseCase
+	ListTaskTemplatesUseCase         *template.ListTaskTemplatesUseCase
+	DeleteTaskTemplateUseCase        *template.DeleteTaskTemplateUseCase
+	CreateTaskFromTemplateUseCase    *template.CreateTaskFromTemplateUseCase
+	SaveBoardTemplateUseCase         *template.SaveBoardTemplateUseCase
+	ListBoardTemplatesUseCase        *template.ListBoardTemplatesUseCase
+	DeleteBoardTemplateUseCase       *template.DeleteBoardTemplateUseCase
+	ExportBoardTemplateUseCase       *template.ExportBoardTemplateUseCase
+	SaveProjectTemplateUseCase       *template.SaveProjectTemplateUseCase
+	ListProjectTemplatesUseCase      *template.ListProjectTemplatesUseCase
+	DeleteProjectTemplateUseCase     *template.DeleteProjectTemplateUseCase
+	CreateProjectFromTemplateUseCase *template.CreateProjectFromTemplateUseCase
+
+	// Use Cases - Note
+	DeleteNoteUseCase            *note.DeleteNoteUseCase
+	CreateMeetingNoteUseCase     *note.CreateMeetingNoteUseCase
+	CreateActionItemTasksUseCase *note.CreateActionItemTasksUseCase
+
+	// Use Cases - Tag
+	ListTagsUseCase  *tag.ListTagsUseCase
+	SaveTagUseCase   *tag.SaveTagUseCase
+	RenameTagUseCase *tag.RenameTagUseCase
+	MergeTagsUseCase *tag.MergeTagsUseCase
+	DeleteTagUseCase *tag.DeleteTagUseCase
+
+	// Use Cases - Milestone
+	GetMilestoneUseCase         *milestone.GetMilestoneUseCase
+	ListMilestonesUseCase       *milestone.ListMilestonesUseCase
+	AddMilestoneTasksUseCase    *milestone.AddMilestoneTasksUseCase
+	RemoveMilestoneTasksUseCase *milestone.RemoveMilestoneTasksUseCase
+	CheckMilestonesUseCase      *milestone.CheckMilestonesUseCase
+
+	// Use Cases - Sprint
+	PlanSprintUseCase        *sprint.PlanSprintUseCase
+	GetSprintUseCase         *sprint.GetSprintUseCase
+	ListSprintsUseCase       *sprint.ListSprintsUseCase
+	AddSprintTasksUseCase    *sprint.AddSprintTasksUseCase
+	RemoveSprintTasksUseCase *sprint.RemoveSprintTasksUseCase
+	CloseSprintUseCase       *sprint.CloseSprintUseCase
+
+	// Use Cases - Forecast
+	ForecastCompletionUseCase *forecast.ForecastCompletionUseCase
+	ForecastThroughputUseCase *forecast.ForecastThroughputUseCase
+
+	// Use Cases - Work Schedule
+	GetWorkScheduleUseCase         *schedule.GetWorkScheduleUseCase
+	UpdateWorkScheduleUseCase      *schedule.UpdateWorkScheduleUseCase
+	AddScheduleExceptionUseCase    *schedule.AddScheduleExceptionUseCase
+	RemoveScheduleExceptionUseCase *schedule.RemoveScheduleExceptionUseCase
+	CheckWorkingTimeUseCase        *schedule.CheckWorkingTimeUseCase
+
+	// Use Cases - Planner
+	PlanScheduleUseCase       *planner.PlanScheduleUseCase
+	ReplanMissedBlocksUseCase *planner.ReplanMissedBlocksUseCase
+
+	// Use Cases - Agenda
+	GetAgendaUseCase *agenda.GetAgendaUseCase
+
+	// Use Cases - Calendar
+	ExportICalUseCase *calendar.ExportICalUseCase
+	ImportICalUseCase *calendar.ImportICalUseCase
+
+	// Use Cases - Trash
+	ListTrashUseCase    *trash.ListTrashUseCase
+	RestoreTrashUseCase *trash.RestoreTrashUseCase
+	PurgeTrashUseCase   *trash.PurgeTrashUseCase
 
 	// Use Cases - Session
 	TrackSessionsUseCase         *session.TrackSessionsUseCase
@@ -174,10 +455,15 @@ type Container struct {
 	ProcessEventUseCase    *action.ProcessEventUseCase
 
 	// Infrastructure Services
-	EventBus     entity.EventBus
-	Notifier     entity.Notifier
-	ScriptRunner entity.ScriptRunner
-	TaskMutator  entity.TaskMutator
+	EventBus            entity.EventBus
+	Notifier            entity.Notifier
+	ScriptRunner        entity.ScriptRunner
+	TaskMutator         entity.TaskMutator
+	Migrator            *filesystem.Migrator
+	IntegrityChecker    *filesystem.IntegrityChecker
+	BackupStore         *filesystem.BackupStore
+	CalendarSyncService *service2.CalendarSyncService // nil when calendar sync is disabled
+	CalendarSyncStore   *filesystem.CalendarSyncStore
 }
 
 func ProvideConfig() (*config.Config, error) {
@@ -204,6 +490,70 @@ func ProvideBoardService(
 	return service.NewBoardService(boardRepo, validationService, cfg)
 }
 
+func ProvideBoardTemplateService(
+	boardRepo repository.BoardRepository,
+	actionRepo repository.ActionRepository,
+	templateRepo repository.BoardTemplateRepository,
+	boardService *service.BoardService,
+) *service.BoardTemplateService {
+	return service.NewBoardTemplateService(boardRepo, actionRepo, templateRepo, boardService)
+}
+
+func ProvideTagService(
+	boardRepo repository.BoardRepository,
+	noteRepo repository.NoteRepository,
+	tagRepo repository.TagRepository,
+) *service.TagService {
+	return service.NewTagService(boardRepo, noteRepo, tagRepo)
+}
+
+func ProvideMilestoneService(
+	boardRepo repository.BoardRepository,
+	activityRepo repository.ActivityRepository,
+	forecastService *service.ForecastService,
+) *service.MilestoneService {
+	return service.NewMilestoneService(boardRepo, activityRepo, forecastService)
+}
+
+func ProvideSprintService(
+	sprintRepo repository.SprintRepository,
+	boardRepo repository.BoardRepository,
+	timeLogRepo repository.TimeLogRepository,
+	forecastService *service.ForecastService,
+) *service.SprintService {
+	return service.NewSprintService(sprintRepo, boardRepo, timeLogRepo, forecastService)
+}
+
+func ProvideFlowMetricsService(boardRepo repository.BoardRepository) *service.FlowMetricsService {
+	return service.NewFlowMetricsService(boardRepo)
+}
+
+func ProvideForecastService(
+	boardRepo repository.BoardRepository,
+	flowMetricsService *service.FlowMetricsService,
+	workScheduleService *service.WorkScheduleService,
+) *service.ForecastService {
+	return service.NewForecastService(boardRepo, flowMetricsService, workScheduleService)
+}
+
+func ProvideWorkScheduleService(scheduleRepo repository.WorkScheduleRepository) *service.WorkScheduleService {
+	return service.NewWorkScheduleService(scheduleRepo)
+}
+
+func ProvideAutoSchedulerService(
+	boardRepo repository.BoardRepository,
+	workScheduleService *service.WorkScheduleService,
+) *service.AutoSchedulerService {
+	return service.NewAutoSchedulerService(boardRepo, workScheduleService)
+}
+
+func ProvideAgendaService(
+	boardRepo repository.BoardRepository,
+	workScheduleService *service.WorkScheduleService,
+) *service.AgendaService {
+	return service.NewAgendaService(boardRepo, workScheduleService)
+}
+
 func ProvideSessionTracker() service.SessionTracker {
 	return external.NewTmuxSessionTracker()
 }
@@ -216,6 +566,28 @@ func ProvideChangeWatcher() (service.ChangeWatcher, error) {
 	return external.NewFSNotifyWatcher()
 }
 
+func ProvideCalendarCodec() service.CalendarCodec {
+	return external.NewICalCodec()
+}
+
+// ProvideCalendarProvider selects the calendar named by the calendar
+// config's provider, nil when calendar sync is disabled
+func ProvideCalendarProvider(cfg *config.Config) (service.CalendarProvider, error) {
+	if !cfg.Calendar.Enabled {
+		return nil, nil
+	}
+
+	switch cfg.Calendar.Provider {
+	case "", "google":
+		return external.NewGoogleCalendarClient(cfg.Calendar.CredentialsPath, cfg.Calendar.TokenPath, cfg.Calendar.CalendarID)
+	case "caldav":
+		caldav := cfg.Calendar.CalDAV
+		return external.NewCalDAVCalendarClient(caldav.URL, caldav.Username, caldav.Password)
+	default:
+		return nil, fmt.Errorf("%w: %s", entity.ErrUnknownCalendarProvider, cfg.Calendar.Provider)
+	}
+}
+
 func ProvideRepoPathResolver(
 	sessionTracker service.SessionTracker,
 	vcsProvider service.VCSProvider,
@@ -274,3 +646,72 @@ func ProvideTimeLogRepository(cfg *config.Config) repository.TimeLogRepository {
 func ProvideNoteRepository(cfg *config.Config) repository.NoteRepository {
 	return filesystem.NewNoteRepository(cfg.Storage.DataPath)
 }
+
+func ProvideTrashRepository(cfg *config.Config) repository.TrashRepository {
+	return filesystem.NewTrashRepository(cfg.Storage.DataPath)
+}
+
+func ProvideAttachmentRepository(cfg *config.Config) repository.AttachmentRepository {
+	return filesystem.NewAttachmentRepository(cfg.Storage.DataPath)
+}
+
+func ProvideActivityRepository(cfg *config.Config) repository.ActivityRepository {
+	return filesystem.NewActivityRepository(cfg.Storage.DataPath)
+}
+
+func ProvideTaskTemplateRepository(cfg *config.Config) repository.TaskTemplateRepository {
+	return filesystem.NewTaskTemplateRepository(cfg.Storage.DataPath)
+}
+
+func ProvideBoardTemplateRepository(cfg *config.Config) repository.BoardTemplateRepository {
+	return filesystem.NewBoardTemplateRepository(cfg.Storage.DataPath)
+}
+
+func ProvideProjectTemplateRepository(cfg *config.Config) repository.ProjectTemplateRepository {
+	return filesystem.NewProjectTemplateRepository(cfg.Storage.DataPath)
+}
+
+func ProvideTagRepository(cfg *config.Config) repository.TagRepository {
+	return filesystem.NewTagRepository(cfg.Storage.DataPath)
+}
+
+func ProvideSprintRepository(cfg *config.Config) repository.SprintRepository {
+	return filesystem.NewSprintRepository(cfg.Storage.DataPath)
+}
+
+func ProvideWorkScheduleRepository(cfg *config.Config) repository.WorkScheduleRepository {
+	return filesystem.NewWorkScheduleRepository(cfg.Storage.DataPath)
+}
+
+func ProvideMigrator(cfg *config.Config, backups *filesystem.BackupStore) *filesystem.Migrator {
+	return filesystem.NewMigrator(cfg.Storage.DataPath, backups)
+}
+
+func ProvideIntegrityChecker(cfg *config.Config) *filesystem.IntegrityChecker {
+	return filesystem.NewIntegrityChecker(cfg.Storage.DataPath)
+}
+
+func ProvideBackupStore(cfg *config.Config) *filesystem.BackupStore {
+
+	configPath := ""
+	if loader, err := config.NewLoader(); err == nil {
+		configPath = loader.GetConfigPath()
+	}
+	return filesystem.NewBackupStore(cfg.Storage.DataPath, configPath)
+}
+
+// ProvideCalendarSyncService syncs with the configured calendar provider, if any
+func ProvideCalendarSyncService(
+	provider service.CalendarProvider,
+	boardRepo repository.BoardRepository,
+	projectRepo repository.ProjectRepository,
+) *service2.CalendarSyncService {
+	if provider == nil {
+		return nil
+	}
+	return service2.NewCalendarSyncService(provider, boardRepo, projectRepo)
+}
+
+func ProvideCalendarSyncStore(cfg *config.Config) *filesystem.CalendarSyncStore {
+	return filesystem.NewCalendarSyncStore(cfg.Storage.DataPath)
+}
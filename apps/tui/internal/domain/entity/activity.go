@@ -0,0 +1,152 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// ActivityType identifies what an activity entry records
+type ActivityType string
+
+const (
+	ActivityCreated         ActivityType = "created"
+	ActivityMoved           ActivityType = "moved"
+	ActivityUpdated         ActivityType = "updated"
+	ActivityStatusChanged   ActivityType = "status_changed"
+	ActivityPriorityChanged ActivityType = "priority_changed"
+	ActivityDueDateChanged  ActivityType = "due_date_changed"
+	ActivityTagsChanged     ActivityType = "tags_changed"
+	ActivityCompleted       ActivityType = "completed"
+	ActivityTimeLogged      ActivityType = "time_logged"
+	ActivityCommitLinked    ActivityType = "commit_linked"
+	ActivityComment         ActivityType = "comment"
+)
+
+// IsValid checks if the activity type is known
+func (t ActivityType) IsValid() bool {
+	switch t {
+	case ActivityCreated, ActivityMoved, ActivityUpdated, ActivityStatusChanged,
+		ActivityPriorityChanged, ActivityDueDateChanged, ActivityTagsChanged,
+		ActivityCompleted, ActivityTimeLogged, ActivityCommitLinked, ActivityComment:
+		return true
+	}
+	return false
+}
+
+// ActivityEntry is a single item in a task's activity log: either a state
+// change recorded by the daemon or a comment added by a user
+type ActivityEntry struct {
+	id           string
+	activityType ActivityType
+	timestamp    time.Time
+	author       string
+	message      string
+	details      map[string]string
+}
+
+// NewActivityEntry creates a new ActivityEntry
+func NewActivityEntry(id string, activityType ActivityType, message string, timestamp time.Time) (*ActivityEntry, error) {
+	if id == "" {
+		return nil, ErrInvalidActivityID
+	}
+	if !activityType.IsValid() {
+		return nil, ErrInvalidActivityType
+	}
+
+	return &ActivityEntry{
+		id:           id,
+		activityType: activityType,
+		timestamp:    timestamp,
+		message:      message,
+		details:      make(map[string]string),
+	}, nil
+}
+
+// NewComment creates an ActivityEntry holding a user comment
+func NewComment(id string, author string, text string) (*ActivityEntry, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, ErrEmptyComment
+	}
+
+	entry, err := NewActivityEntry(id, ActivityComment, text, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	entry.author = author
+
+	return entry, nil
+}
+
+// ID returns the entry ID
+func (a *ActivityEntry) ID() string {
+	return a.id
+}
+
+// Type returns what the entry records
+func (a *ActivityEntry) Type() ActivityType {
+	return a.activityType
+}
+
+// Timestamp returns when the recorded change happened
+func (a *ActivityEntry) Timestamp() time.Time {
+	return a.timestamp
+}
+
+// Author returns who made the change, if known
+func (a *ActivityEntry) Author() string {
+	return a.author
+}
+
+// Message returns the human readable description, or the text of a comment
+func (a *ActivityEntry) Message() string {
+	return a.message
+}
+
+// IsComment returns true if the entry is a user comment
+func (a *ActivityEntry) IsComment() bool {
+	return a.activityType == ActivityComment
+}
+
+// Details returns a copy of the structured values describing the change
+func (a *ActivityEntry) Details() map[string]string {
+	details := make(map[string]string, len(a.details))
+	for k, v := range a.details {
+		details[k] = v
+	}
+	return details
+}
+
+// SetAuthor records who made the change
+func (a *ActivityEntry) SetAuthor(author string) {
+	a.author = author
+}
+
+// SetDetail sets a structured value describing the change
+func (a *ActivityEntry) SetDetail(key string, value string) {
+	if value == "" {
+		delete(a.details, key)
+		return
+	}
+	a.details[key] = value
+}
+
+// CompletedAt returns when an activity log last records the task being
+// completed or moved to the Done column, nil if it never was
+func CompletedAt(entries []*ActivityEntry) *time.Time {
+	var completedAt *time.Time
+	for _, entry := range entries {
+		switch entry.activityType {
+		case ActivityCompleted:
+		case ActivityMoved:
+			if !strings.EqualFold(entry.details["to"], doneColumnName) {
+				continue
+			}
+		default:
+			continue
+		}
+		timestamp := entry.timestamp
+		completedAt = &timestamp
+	}
+	return completedAt
+}
@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"fmt"
 	"mkanban/internal/domain/valueobject"
 	"time"
 )
@@ -13,6 +14,7 @@ type Board struct {
 	prefix      string
 	description string
 	columns     []*Column
+	fields      []FieldDefinition
 	nextTaskNum int
 	createdAt   time.Time
 	modifiedAt  time.Time
@@ -80,6 +82,87 @@ func (b *Board) Columns() []*Column {
 	return columnsCopy
 }
 
+// Fields returns a copy of the custom field schema
+func (b *Board) Fields() []FieldDefinition {
+	fieldsCopy := make([]FieldDefinition, len(b.fields))
+	copy(fieldsCopy, b.fields)
+	return fieldsCopy
+}
+
+// GetField retrieves a custom field definition by name
+func (b *Board) GetField(name string) (FieldDefinition, bool) {
+	for _, field := range b.fields {
+		if field.Name == name {
+			return field, true
+		}
+	}
+	return FieldDefinition{}, false
+}
+
+// SetFields replaces the custom field schema. Values already set on tasks are
+// kept; they are checked again the next time the task's fields change.
+func (b *Board) SetFields(fields []FieldDefinition) error {
+	seen := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		if err := field.Validate(); err != nil {
+			return err
+		}
+		if seen[field.Name] {
+			return fmt.Errorf("%w: %s", ErrDuplicateField, field.Name)
+		}
+		seen[field.Name] = true
+	}
+
+	b.fields = make([]FieldDefinition, len(fields))
+	copy(b.fields, fields)
+	b.modifiedAt = time.Now()
+	return nil
+}
+
+// ResolveFields applies changes to a task's current field values and validates
+// the result against the schema. An empty value in changes clears the field;
+// required fields cannot be cleared. Whether every required field is set is
+// left to CheckRequiredFields, so tasks created before a field became required
+// can still be changed. Returns the normalized values the task should store.
+func (b *Board) ResolveFields(current map[string]string, changes map[string]string) (map[string]string, error) {
+	resolved := make(map[string]string, len(current)+len(changes))
+	for name, value := range current {
+		resolved[name] = value
+	}
+
+	for name, value := range changes {
+		field, ok := b.GetField(name)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownField, name)
+		}
+		if value == "" {
+			if field.Required {
+				return nil, fmt.Errorf("%w: %s", ErrMissingField, name)
+			}
+			delete(resolved, name)
+			continue
+		}
+		normalized, err := field.Normalize(value)
+		if err != nil {
+			return nil, err
+		}
+		resolved[name] = normalized
+	}
+
+	return resolved, nil
+}
+
+// CheckRequiredFields reports the first required field of the schema that is
+// not set in values
+func (b *Board) CheckRequiredFields(values map[string]string) error {
+	for _, field := range b.fields {
+		if _, ok := values[field.Name]; field.Required && !ok {
+			return fmt.Errorf("%w: %s", ErrMissingField, field.Name)
+		}
+	}
+	return nil
+}
+
 // NextTaskNum returns the next task number
 func (b *Board) NextTaskNum() int {
 	return b.nextTaskNum
@@ -210,6 +293,9 @@ func (b *Board) MoveTask(taskID *valueobject.TaskID, targetColumnName string) er
 	}
 
 	b.modifiedAt = time.Now()
+	if sourceColumn != targetColumn {
+		task.RecordMove(sourceColumn.Name(), targetColumn.Name(), b.modifiedAt)
+	}
 	return nil
 }
 
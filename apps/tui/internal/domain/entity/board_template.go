@@ -0,0 +1,336 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"mkanban/internal/domain/valueobject"
+)
+
+// DefaultBoardTemplateName is the template used for boards that do not name one.
+// It is built in, but a saved template with this name replaces it.
+const DefaultBoardTemplateName = "default"
+
+// ColumnBlueprint describes a column created from a board template
+type ColumnBlueprint struct {
+	Name        string
+	Description string
+	Order       int
+	WIPLimit    int
+	Color       string // hex color, empty for none
+}
+
+// TaskBlueprint describes a seed task created with a board; checkboxes in the
+// description become subtasks like in any new task
+type TaskBlueprint struct {
+	Title       string
+	Description string
+	Column      string // empty for the first column
+	Priority    valueobject.Priority
+	Tags        []string
+	Fields      map[string]string
+}
+
+// ActionBlueprint describes a board-scoped action created with a board.
+// It is triggered by Event, or else by a relative or recurring schedule.
+type ActionBlueprint struct {
+	Name         string
+	Description  string
+	Event        valueobject.EventType
+	ScheduleType valueobject.ScheduleType
+	Offset       time.Duration // for relative schedules
+	Cron         string        // for recurring schedules
+	Type         ActionTypeEnum
+	Title        string // notification title, or title of the created task
+	Message      string // notification message, or description of the created task
+	ScriptPath   string
+	Column       string // target column of a move, or column of the created task
+	AddTags      []string
+	RemoveTags   []string
+}
+
+// BoardTemplate is a reusable board layout: columns, custom fields, seed tasks
+// and actions that are set up when a board is created from it
+type BoardTemplate struct {
+	name        string
+	description string
+	columns     []ColumnBlueprint
+	fields      []FieldDefinition
+	tasks       []TaskBlueprint
+	actions     []ActionBlueprint
+}
+
+// NewBoardTemplate creates a new, empty BoardTemplate
+func NewBoardTemplate(name string, description string) (*BoardTemplate, error) {
+	if !IsValidTemplateName(name) {
+		return nil, ErrInvalidTemplateName
+	}
+
+	return &BoardTemplate{
+		name:        name,
+		description: description,
+		columns:     make([]ColumnBlueprint, 0),
+		fields:      make([]FieldDefinition, 0),
+		tasks:       make([]TaskBlueprint, 0),
+		actions:     make([]ActionBlueprint, 0),
+	}, nil
+}
+
+// NewDefaultBoardTemplate returns the built-in template with the classic
+// To Do / In Progress / Done columns
+func NewDefaultBoardTemplate() *BoardTemplate {
+	template, _ := NewBoardTemplate(DefaultBoardTemplateName, "To Do, In Progress and Done columns")
+	template.columns = []ColumnBlueprint{
+		{Name: "To Do", Description: "Tasks to be started", Order: 0},
+		{Name: "In Progress", Description: "Tasks currently being worked on", Order: 1, WIPLimit: 3},
+		{Name: "Done", Description: "Completed tasks", Order: 2},
+	}
+	return template
+}
+
+// Name returns the template name
+func (t *BoardTemplate) Name() string {
+	return t.name
+}
+
+// Description returns the template description
+func (t *BoardTemplate) Description() string {
+	return t.description
+}
+
+// Columns returns a copy of the column blueprints
+func (t *BoardTemplate) Columns() []ColumnBlueprint {
+	columnsCopy := make([]ColumnBlueprint, len(t.columns))
+	copy(columnsCopy, t.columns)
+	return columnsCopy
+}
+
+// SetColumns replaces the column blueprints; names must be unique and colors valid
+func (t *BoardTemplate) SetColumns(columns []ColumnBlueprint) error {
+	seen := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		if strings.TrimSpace(column.Name) == "" {
+			return ErrEmptyColumnName
+		}
+		key := strings.ToLower(column.Name)
+		if seen[key] {
+			return ErrColumnAlreadyExists
+		}
+		seen[key] = true
+
+		if column.WIPLimit < 0 {
+			return ErrInvalidWIPLimit
+		}
+		if column.Color != "" {
+			if _, err := valueobject.NewColor(column.Color); err != nil {
+				return ErrInvalidColor
+			}
+		}
+	}
+
+	t.columns = make([]ColumnBlueprint, len(columns))
+	copy(t.columns, columns)
+	return nil
+}
+
+// Fields returns a copy of the custom field schema
+func (t *BoardTemplate) Fields() []FieldDefinition {
+	fieldsCopy := make([]FieldDefinition, len(t.fields))
+	copy(fieldsCopy, t.fields)
+	return fieldsCopy
+}
+
+// SetFields replaces the custom field schema
+func (t *BoardTemplate) SetFields(fields []FieldDefinition) error {
+	seen := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		if err := field.Validate(); err != nil {
+			return err
+		}
+		if seen[field.Name] {
+			return ErrDuplicateField
+		}
+		seen[field.Name] = true
+	}
+
+	t.fields = make([]FieldDefinition, len(fields))
+	copy(t.fields, fields)
+	return nil
+}
+
+// Tasks returns a copy of the seed task blueprints
+func (t *BoardTemplate) Tasks() []TaskBlueprint {
+	tasksCopy := make([]TaskBlueprint, len(t.tasks))
+	copy(tasksCopy, t.tasks)
+	return tasksCopy
+}
+
+// SetTasks replaces the seed tasks; a task column must be one of the template columns
+func (t *BoardTemplate) SetTasks(tasks []TaskBlueprint) error {
+	for _, task := range tasks {
+		if strings.TrimSpace(task.Title) == "" {
+			return ErrEmptyTaskName
+		}
+		if !task.Priority.IsValid() {
+			return ErrInvalidPriority
+		}
+		if task.Column != "" && !t.hasColumn(task.Column) {
+			return ErrColumnNotFound
+		}
+	}
+
+	t.tasks = make([]TaskBlueprint, len(tasks))
+	copy(t.tasks, tasks)
+	return nil
+}
+
+// Actions returns a copy of the action blueprints
+func (t *BoardTemplate) Actions() []ActionBlueprint {
+	actionsCopy := make([]ActionBlueprint, len(t.actions))
+	copy(actionsCopy, t.actions)
+	return actionsCopy
+}
+
+// SetActions replaces the action blueprints
+func (t *BoardTemplate) SetActions(actions []ActionBlueprint) error {
+	for _, action := range actions {
+		// Build validates trigger and action type the same way a real action does
+		if _, err := action.Build("template", ""); err != nil {
+			return err
+		}
+	}
+
+	t.actions = make([]ActionBlueprint, len(actions))
+	copy(t.actions, actions)
+	return nil
+}
+
+// hasColumn checks if a column blueprint with the given name exists
+func (t *BoardTemplate) hasColumn(name string) bool {
+	for _, column := range t.columns {
+		if strings.EqualFold(column.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Build creates a board-scoped action from the blueprint
+func (b ActionBlueprint) Build(id string, boardID string) (*Action, error) {
+	trigger, err := b.buildTrigger()
+	if err != nil {
+		return nil, err
+	}
+
+	actionType, err := b.buildActionType()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAction(id, b.Name, b.Description, valueobject.ActionScopeBoard, boardID, trigger, actionType, nil)
+}
+
+// buildTrigger creates the event or schedule trigger of the blueprint
+func (b ActionBlueprint) buildTrigger() (Trigger, error) {
+	if b.Event != "" {
+		trigger, err := NewEventTrigger(b.Event)
+		if err != nil {
+			return nil, err
+		}
+		return trigger, nil
+	}
+
+	var schedule *valueobject.Schedule
+	switch b.ScheduleType {
+	case valueobject.ScheduleTypeRelativeDueDate:
+		schedule = valueobject.NewRelativeDueDateSchedule(b.Offset)
+	case valueobject.ScheduleTypeRelativeCreation:
+		schedule = valueobject.NewRelativeCreationSchedule(b.Offset)
+	case valueobject.ScheduleTypeRecurring:
+		recurring, err := valueobject.NewRecurringSchedule(b.Cron)
+		if err != nil {
+			return nil, ErrInvalidSchedule
+		}
+		schedule = recurring
+	default:
+		// Absolute schedules name a fixed time and make no sense in a template
+		return nil, ErrInvalidTrigger
+	}
+
+	trigger, err := NewTimeTrigger(schedule)
+	if err != nil {
+		return nil, err
+	}
+	return trigger, nil
+}
+
+// buildActionType creates the action type of the blueprint
+func (b ActionBlueprint) buildActionType() (ActionType, error) {
+	switch b.Type {
+	case ActionTypeNotification:
+		return NewNotificationAction(b.Title, b.Message, nil), nil
+	case ActionTypeScript:
+		return NewScriptAction(b.ScriptPath, nil), nil
+	case ActionTypeTaskMovement:
+		return NewTaskMovementAction(b.Column), nil
+	case ActionTypeTaskCreation:
+		return NewTaskCreationAction(b.Title, b.Message, b.Column), nil
+	case ActionTypeTaskMutation:
+		mutation := NewTaskMutationAction()
+		mutation.AddTags = append(mutation.AddTags, b.AddTags...)
+		mutation.RemoveTags = append(mutation.RemoveTags, b.RemoveTags...)
+		return mutation, nil
+	default:
+		return nil, ErrInvalidActionType
+	}
+}
+
+// ActionBlueprintFromAction describes an existing action as a blueprint.
+// It returns false for actions a template cannot express.
+func ActionBlueprintFromAction(action *Action) (ActionBlueprint, bool) {
+	blueprint := ActionBlueprint{
+		Name:        action.Name(),
+		Description: action.Description(),
+	}
+
+	switch trigger := action.Trigger().(type) {
+	case *EventTrigger:
+		blueprint.Event = trigger.EventType()
+	case *TimeTrigger:
+		schedule := trigger.Schedule()
+		switch schedule.Type {
+		case valueobject.ScheduleTypeRelativeDueDate, valueobject.ScheduleTypeRelativeCreation:
+			blueprint.ScheduleType = schedule.Type
+			blueprint.Offset = *schedule.Offset
+		case valueobject.ScheduleTypeRecurring:
+			blueprint.ScheduleType = schedule.Type
+			blueprint.Cron = schedule.CronExpr
+		default:
+			return ActionBlueprint{}, false
+		}
+	default:
+		return ActionBlueprint{}, false
+	}
+
+	switch actionType := action.ActionType().(type) {
+	case *NotificationAction:
+		blueprint.Title = actionType.Title
+		blueprint.Message = actionType.Message
+	case *ScriptAction:
+		blueprint.ScriptPath = actionType.ScriptPath
+	case *TaskMovementAction:
+		blueprint.Column = actionType.TargetColumn
+	case *TaskCreationAction:
+		blueprint.Title = actionType.Title
+		blueprint.Message = actionType.Description
+		blueprint.Column = actionType.ColumnName
+	case *TaskMutationAction:
+		blueprint.AddTags = actionType.AddTags
+		blueprint.RemoveTags = actionType.RemoveTags
+	default:
+		return ActionBlueprint{}, false
+	}
+	blueprint.Type = action.ActionType().Type()
+
+	return blueprint, true
+}
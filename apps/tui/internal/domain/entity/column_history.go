@@ -0,0 +1,90 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// ColumnVisit records when a task entered a column and when it left it
+type ColumnVisit struct {
+	Column    string // column name
+	EnteredAt time.Time
+	ExitedAt  *time.Time // nil while the task is in the column
+}
+
+// ColumnHistory returns a copy of the columns the task has been in, oldest
+// first. Tasks that were never moved have no history.
+func (t *Task) ColumnHistory() []ColumnVisit {
+	history := make([]ColumnVisit, len(t.columnHistory))
+	copy(history, t.columnHistory)
+	return history
+}
+
+// SetColumnHistory replaces the column history (used during loading from storage)
+func (t *Task) SetColumnHistory(history []ColumnVisit) {
+	t.columnHistory = make([]ColumnVisit, len(history))
+	copy(t.columnHistory, history)
+}
+
+// RecordMove records that the task left one column for another. The first
+// move also records the task's stay in the column it was created in.
+func (t *Task) RecordMove(fromColumn, toColumn string, at time.Time) {
+	if len(t.columnHistory) == 0 {
+		t.columnHistory = append(t.columnHistory, ColumnVisit{
+			Column:    fromColumn,
+			EnteredAt: t.createdAt,
+		})
+	}
+
+	last := &t.columnHistory[len(t.columnHistory)-1]
+	if last.ExitedAt == nil {
+		exitedAt := at
+		last.ExitedAt = &exitedAt
+	}
+
+	t.columnHistory = append(t.columnHistory, ColumnVisit{
+		Column:    toColumn,
+		EnteredAt: at,
+	})
+}
+
+// ColumnVisits returns the columns a task has been in, oldest first, ending
+// with currentColumn. A task that was never moved has been in its current
+// column since it was created.
+func (t *Task) ColumnVisits(currentColumn string) []ColumnVisit {
+	history := t.ColumnHistory()
+	if len(history) > 0 && history[len(history)-1].Column == currentColumn {
+		return history
+	}
+
+	// Tasks placed without a move, by a trash restore for instance, are
+	// taken to have entered their column when they were last modified
+	enteredAt := t.createdAt
+	if len(history) > 0 {
+		last := &history[len(history)-1]
+		if last.ExitedAt == nil {
+			exitedAt := t.modifiedAt
+			if exitedAt.Before(last.EnteredAt) {
+				exitedAt = last.EnteredAt
+			}
+			last.ExitedAt = &exitedAt
+		}
+		enteredAt = *last.ExitedAt
+	}
+	return append(history, ColumnVisit{Column: currentColumn, EnteredAt: enteredAt})
+}
+
+// IsDoneColumn checks if tasks in a column are completed. Columns that no
+// longer exist are matched by name.
+func (b *Board) IsDoneColumn(columnName string) bool {
+	column, err := b.GetColumn(columnName)
+	if err != nil {
+		return strings.EqualFold(columnName, doneColumnName)
+	}
+	return isDoneColumn(column)
+}
+
+// isDoneColumn checks if tasks in a column are completed
+func isDoneColumn(column *Column) bool {
+	return strings.EqualFold(column.Name(), doneColumnName) || strings.EqualFold(column.DisplayName(), doneColumnName)
+}
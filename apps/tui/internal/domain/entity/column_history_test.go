@@ -0,0 +1,123 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+// newFlowBoard creates a board with To Do, Doing and Done columns
+func newFlowBoard(t *testing.T) *Board {
+	t.Helper()
+	board, err := NewBoard("project/board", "Board", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, name := range []string{"To Do", "Doing", "Done"} {
+		column, err := NewColumn(name, "", i, 0, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := board.AddColumn(column); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return board
+}
+
+func TestMoveTaskRecordsColumnHistory(t *testing.T) {
+	board := newFlowBoard(t)
+	task := addHierarchyTask(t, board, "To Do", "task", nil)
+
+	if history := task.ColumnHistory(); len(history) != 0 {
+		t.Fatalf("expected a task that was never moved to have no history, got %v", history)
+	}
+
+	for _, column := range []string{"Doing", "Doing", "Done"} {
+		if err := board.MoveTask(task.ID(), column); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	history := task.ColumnHistory()
+	want := []string{"To Do", "Doing", "Done"}
+	if len(history) != len(want) {
+		t.Fatalf("expected a visit per column, moving within a column not counting, got %v", history)
+	}
+	for i, visit := range history {
+		if visit.Column != want[i] {
+			t.Errorf("visit %d: expected %s, got %s", i, want[i], visit.Column)
+		}
+	}
+	if !history[0].EnteredAt.Equal(task.CreatedAt()) {
+		t.Errorf("expected the first column to be entered when the task was created, got %v", history[0].EnteredAt)
+	}
+	for i := 0; i < len(history)-1; i++ {
+		if history[i].ExitedAt == nil || !history[i].ExitedAt.Equal(history[i+1].EnteredAt) {
+			t.Errorf("expected visit %d to end when the next one starts, got %v", i, history[i].ExitedAt)
+		}
+	}
+	if history[len(history)-1].ExitedAt != nil {
+		t.Error("expected the current column to have no exit time")
+	}
+
+	// The history is a copy
+	history[0].Column = "Changed"
+	if task.ColumnHistory()[0].Column != "To Do" {
+		t.Error("expected changing the returned history to leave the task alone")
+	}
+}
+
+func TestColumnVisits(t *testing.T) {
+	created := time.Date(2026, 3, 2, 9, 0, 0, 0, time.Local)
+	moved := created.Add(24 * time.Hour)
+	modified := moved.Add(48 * time.Hour)
+
+	board := newFlowBoard(t)
+	task := addHierarchyTask(t, board, "To Do", "task", nil)
+	task.RestoreDates(created, modified, nil, nil)
+
+	visits := task.ColumnVisits("To Do")
+	if len(visits) != 1 || visits[0].Column != "To Do" || !visits[0].EnteredAt.Equal(created) || visits[0].ExitedAt != nil {
+		t.Fatalf("expected a task that was never moved to be in its column since it was created, got %v", visits)
+	}
+
+	task.RecordMove("To Do", "Doing", moved)
+	if visits := task.ColumnVisits("Doing"); len(visits) != 2 {
+		t.Fatalf("expected the recorded history when it ends in the current column, got %v", visits)
+	}
+
+	// Placed in Done without a move, by a trash restore for instance
+	visits = task.ColumnVisits("Done")
+	if len(visits) != 3 {
+		t.Fatalf("expected the current column to be appended, got %v", visits)
+	}
+	if visits[1].ExitedAt == nil || !visits[1].ExitedAt.Equal(modified) {
+		t.Errorf("expected the last recorded column to be left when the task was modified, got %v", visits[1].ExitedAt)
+	}
+	if visits[2].Column != "Done" || !visits[2].EnteredAt.Equal(modified) {
+		t.Errorf("expected Done to be entered when the task was modified, got %v", visits[2])
+	}
+	if len(task.ColumnHistory()) != 2 {
+		t.Error("expected ColumnVisits to leave the recorded history alone")
+	}
+}
+
+func TestIsDoneColumn(t *testing.T) {
+	board := newFlowBoard(t)
+
+	tests := []struct {
+		column string
+		want   bool
+	}{
+		{"Done", true},
+		{"Doing", false},
+		{"To Do", false},
+		{"done", true},      // matched regardless of case
+		{"Archived", false}, // a column that no longer exists
+	}
+	for _, tt := range tests {
+		if got := board.IsDoneColumn(tt.column); got != tt.want {
+			t.Errorf("IsDoneColumn(%q) = %v, want %v", tt.column, got, tt.want)
+		}
+	}
+}
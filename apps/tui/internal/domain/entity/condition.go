@@ -1,7 +1,10 @@
 package entity
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // ConditionOperator represents comparison operators
@@ -18,7 +21,17 @@ const (
 	OperatorNotIn        ConditionOperator = "not_in"
 )
 
-// Condition represents a filtering condition for actions
+// IsValid checks if the operator is known
+func (o ConditionOperator) IsValid() bool {
+	switch o {
+	case OperatorEquals, OperatorNotEquals, OperatorContains, OperatorNotContains,
+		OperatorGreaterThan, OperatorLessThan, OperatorIn, OperatorNotIn:
+		return true
+	}
+	return false
+}
+
+// Condition represents a filtering condition for actions and task queries
 type Condition struct {
 	Field    string            // e.g., "priority", "status", "tags", "column"
 	Operator ConditionOperator
@@ -46,8 +59,10 @@ func (c *Condition) Evaluate(task *Task, column *Column) bool {
 	case "is_overdue":
 		actualValue = task.IsOverdue()
 	default:
-		// Check metadata
-		if val, exists := task.GetMetadata(c.Field); exists {
+		// Check custom fields, which may also be written as fields.<name>
+		if val, exists := task.GetField(strings.TrimPrefix(c.Field, "fields.")); exists {
+			actualValue = val
+		} else if val, exists := task.GetMetadata(c.Field); exists {
 			actualValue = val
 		} else {
 			return false
@@ -57,44 +72,111 @@ func (c *Condition) Evaluate(task *Task, column *Column) bool {
 	return c.compareValues(actualValue)
 }
 
-// compareValues compares the actual value with the condition value using the operator
+// compareValues compares the actual value with the condition value using the operator.
+// Numbers and dates compare by value, so "3" equals 3.0 and gt/lt order them.
 func (c *Condition) compareValues(actualValue interface{}) bool {
 	switch c.Operator {
 	case OperatorEquals:
-		return actualValue == c.Value
+		return valuesEqual(actualValue, c.Value)
 	case OperatorNotEquals:
-		return actualValue != c.Value
+		return !valuesEqual(actualValue, c.Value)
 	case OperatorContains:
-		if tags, ok := actualValue.([]string); ok {
-			if val, ok := c.Value.(string); ok {
-				return containsString(tags, val)
-			}
-		}
-		if str, ok := actualValue.(string); ok {
-			if val, ok := c.Value.(string); ok {
-				return strings.Contains(str, val)
-			}
-		}
-		return false
+		return c.contains(actualValue)
 	case OperatorNotContains:
-		return !c.compareValues(actualValue)
+		return !c.contains(actualValue)
+	case OperatorGreaterThan:
+		cmp, ok := compareOrdered(actualValue, c.Value)
+		return ok && cmp > 0
+	case OperatorLessThan:
+		cmp, ok := compareOrdered(actualValue, c.Value)
+		return ok && cmp < 0
 	case OperatorIn:
-		if values, ok := c.Value.([]string); ok {
-			if str, ok := actualValue.(string); ok {
-				return containsString(values, str)
-			}
-		}
-		return false
+		return c.in(actualValue)
 	case OperatorNotIn:
-		if values, ok := c.Value.([]string); ok {
-			if str, ok := actualValue.(string); ok {
-				return !containsString(values, str)
-			}
-		}
+		return !c.in(actualValue)
+	default:
+		return false
+	}
+}
+
+// contains checks if a tag list holds the condition value or a string contains it
+func (c *Condition) contains(actualValue interface{}) bool {
+	val, ok := c.Value.(string)
+	if !ok {
 		return false
+	}
+	if tags, ok := actualValue.([]string); ok {
+		return containsString(tags, val)
+	}
+	if str, ok := actualValue.(string); ok {
+		return strings.Contains(str, val)
+	}
+	return false
+}
+
+// in checks if the actual value equals one of the condition values
+func (c *Condition) in(actualValue interface{}) bool {
+	var values []interface{}
+	switch v := c.Value.(type) {
+	case []string:
+		for _, s := range v {
+			values = append(values, s)
+		}
+	case []interface{}:
+		values = v
 	default:
 		return false
 	}
+
+	for _, value := range values {
+		if valuesEqual(actualValue, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// valuesEqual compares two values, by number or date when both parse as one
+func valuesEqual(actual, expected interface{}) bool {
+	if _, ok := actual.([]string); ok {
+		return false
+	}
+	if cmp, ok := compareOrdered(actual, expected); ok {
+		return cmp == 0
+	}
+	return fmt.Sprint(actual) == fmt.Sprint(expected)
+}
+
+// compareOrdered compares two values as numbers or dates. The second return
+// value is false when they are not both numbers or both dates.
+func compareOrdered(actual, expected interface{}) (int, bool) {
+	if actual == nil || expected == nil {
+		return 0, false
+	}
+	if _, ok := actual.(bool); ok {
+		return 0, false
+	}
+	a, b := fmt.Sprint(actual), fmt.Sprint(expected)
+
+	if x, err := strconv.ParseFloat(a, 64); err == nil {
+		if y, err := strconv.ParseFloat(b, 64); err == nil {
+			switch {
+			case x < y:
+				return -1, true
+			case x > y:
+				return 1, true
+			}
+			return 0, true
+		}
+		return 0, false
+	}
+
+	if x, err := time.Parse(FieldDateFormat, a); err == nil {
+		if y, err := time.Parse(FieldDateFormat, b); err == nil {
+			return x.Compare(y), true
+		}
+	}
+	return 0, false
 }
 
 // containsString checks if a slice contains a string
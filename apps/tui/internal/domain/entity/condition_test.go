@@ -0,0 +1,208 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+
+	"mkanban/internal/domain/valueobject"
+)
+
+// newConditionTask creates a high priority task in the To Do column, tagged
+// backend and urgent, with a value for each type of custom field
+func newConditionTask(t *testing.T) (*Task, *Column) {
+	t.Helper()
+	board := newHierarchyBoard(t)
+	task := addHierarchyTask(t, board, "To Do", "task", nil)
+	if err := task.UpdatePriority(valueobject.PriorityHigh); err != nil {
+		t.Fatal(err)
+	}
+	task.AddTag("backend")
+	task.AddTag("urgent")
+	task.SetFields(map[string]string{
+		"points":  "3",
+		"stage":   "Review",
+		"started": "2026-03-10",
+		"note":    "waiting on design",
+		"blocked": "true",
+		"owner":   "ana",
+	})
+	task.SetMetadata("source", "email")
+
+	column, err := board.GetColumn("To Do")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return task, column
+}
+
+func TestConditionEvaluate(t *testing.T) {
+	task, column := newConditionTask(t)
+
+	tests := []struct {
+		name     string
+		field    string
+		operator ConditionOperator
+		value    interface{}
+		want     bool
+	}{
+		// built-in fields
+		{"priority eq", "priority", OperatorEquals, "high", true},
+		{"priority ne", "priority", OperatorNotEquals, "high", false},
+		{"status eq", "status", OperatorEquals, "todo", true},
+		{"column eq", "column", OperatorEquals, column.Name(), true},
+		{"column in", "column", OperatorIn, []string{"Done"}, false},
+		{"has due date", "has_due_date", OperatorEquals, false, true},
+		{"is overdue", "is_overdue", OperatorEquals, true, false},
+
+		// tags
+		{"tags contains", "tags", OperatorContains, "urgent", true},
+		{"tags contains matches whole tags", "tags", OperatorContains, "urg", false},
+		// not_contains used to call itself until the stack overflowed
+		{"tags not contains", "tags", OperatorNotContains, "frontend", true},
+		{"tags not contains held tag", "tags", OperatorNotContains, "urgent", false},
+		{"tags never equal a value", "tags", OperatorEquals, "urgent", false},
+
+		// number fields compare by value
+		{"number eq float", "points", OperatorEquals, 3.0, true},
+		{"number eq string", "fields.points", OperatorEquals, "3.00", true},
+		{"number ne", "points", OperatorNotEquals, 4, true},
+		{"number gt", "points", OperatorGreaterThan, 2, true},
+		{"number gt equal", "points", OperatorGreaterThan, 3, false},
+		{"number lt", "points", OperatorLessThan, "10", true},
+		{"number gt non-number", "points", OperatorGreaterThan, "many", false},
+		{"number in", "points", OperatorIn, []interface{}{1, 3.0}, true},
+
+		// date fields compare by day
+		{"date eq", "started", OperatorEquals, "2026-03-10", true},
+		{"date gt", "fields.started", OperatorGreaterThan, "2026-03-01", true},
+		{"date lt", "started", OperatorLessThan, "2026-03-01", false},
+		{"date gt non-date", "started", OperatorGreaterThan, "soon", false},
+
+		// enum, string, bool and user fields compare as text
+		{"enum eq", "stage", OperatorEquals, "Review", true},
+		{"enum in", "stage", OperatorIn, []string{"Todo", "Review"}, true},
+		{"enum not in", "stage", OperatorNotIn, []string{"Todo", "Done"}, true},
+		{"enum not in held value", "stage", OperatorNotIn, []string{"Review"}, false},
+		{"in without a list", "stage", OperatorIn, "Review", false},
+		{"string contains", "note", OperatorContains, "design", true},
+		{"string not contains", "fields.note", OperatorNotContains, "legal", true},
+		{"string not contains held text", "note", OperatorNotContains, "design", false},
+		{"not contains non-string value", "note", OperatorNotContains, 5, true},
+		{"bool eq", "blocked", OperatorEquals, true, true},
+		{"bool gt", "blocked", OperatorGreaterThan, false, false},
+		{"user eq", "owner", OperatorEquals, "ana", true},
+		{"user ne", "fields.owner", OperatorNotEquals, "ben", true},
+
+		// metadata and missing fields
+		{"metadata eq", "source", OperatorEquals, "email", true},
+		{"missing field eq", "fields.estimate", OperatorEquals, "", false},
+		{"missing field ne", "estimate", OperatorNotEquals, "x", false},
+		{"missing field not contains", "estimate", OperatorNotContains, "x", false},
+
+		{"unknown operator", "priority", ConditionOperator("like"), "high", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition := NewCondition(tt.field, tt.operator, tt.value)
+			if got := condition.Evaluate(task, column); got != tt.want {
+				t.Errorf("%s %s %v: expected %v, got %v", tt.field, tt.operator, tt.value, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestConditionGroupEvaluate(t *testing.T) {
+	task, column := newConditionTask(t)
+	high := NewCondition("priority", OperatorEquals, "high")
+	low := NewCondition("priority", OperatorEquals, "low")
+
+	tests := []struct {
+		name  string
+		group *ConditionGroup
+		want  bool
+	}{
+		{"and all match", NewConditionGroup(LogicalAnd, high, NewCondition("points", OperatorGreaterThan, 1)), true},
+		{"and one fails", NewConditionGroup(LogicalAnd, high, low), false},
+		{"or one matches", NewConditionGroup(LogicalOr, low, high), true},
+		{"or none match", NewConditionGroup(LogicalOr, low), false},
+		{"no conditions", NewConditionGroup(LogicalAnd), true},
+	}
+	for _, tt := range tests {
+		if got := tt.group.Evaluate(task, column); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestResolveFields(t *testing.T) {
+	board := newHierarchyBoard(t)
+	err := board.SetFields([]FieldDefinition{
+		{Name: "points", Type: FieldTypeNumber},
+		{Name: "stage", Type: FieldTypeEnum, Options: []string{"Todo", "Review"}, Required: true},
+		{Name: "started", Type: FieldTypeDate},
+		{Name: "note", Type: FieldTypeString},
+		{Name: "blocked", Type: FieldTypeBool},
+		{Name: "owner", Type: FieldTypeUser},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	current := map[string]string{"stage": "Todo", "points": "5"}
+
+	tests := []struct {
+		name    string
+		changes map[string]string
+		field   string
+		want    string // the resolved value of field, empty when it is cleared
+		wantErr error
+	}{
+		{"number normalized", map[string]string{"points": " 3.0 "}, "points", "3", nil},
+		{"number invalid", map[string]string{"points": "three"}, "", "", ErrInvalidFieldValue},
+		{"enum matched regardless of case", map[string]string{"stage": "review"}, "stage", "Review", nil},
+		{"enum not an option", map[string]string{"stage": "Done"}, "", "", ErrInvalidFieldValue},
+		{"date kept", map[string]string{"started": "2026-03-01"}, "started", "2026-03-01", nil},
+		{"date invalid", map[string]string{"started": "2026-3-1"}, "", "", ErrInvalidFieldValue},
+		{"string kept", map[string]string{"note": "waiting on design"}, "note", "waiting on design", nil},
+		{"bool from yes", map[string]string{"blocked": "yes"}, "blocked", "true", nil},
+		{"bool from 0", map[string]string{"blocked": "0"}, "blocked", "false", nil},
+		{"bool invalid", map[string]string{"blocked": "maybe"}, "", "", ErrInvalidFieldValue},
+		{"user without @", map[string]string{"owner": "@ana"}, "owner", "ana", nil},
+		{"user with spaces", map[string]string{"owner": "ana b"}, "", "", ErrInvalidFieldValue},
+		{"unchanged fields kept", map[string]string{"note": "x"}, "points", "5", nil},
+		{"optional field cleared", map[string]string{"points": ""}, "points", "", nil},
+		{"required field cleared", map[string]string{"stage": ""}, "", "", ErrMissingField},
+		{"unknown field", map[string]string{"estimate": "3"}, "", "", ErrUnknownField},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolved, err := board.ResolveFields(current, tt.changes)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolve failed: %v", err)
+			}
+			if got, ok := resolved[tt.field]; got != tt.want || ok != (tt.want != "") {
+				t.Errorf("expected %s to resolve to %q, got %q (set: %v)", tt.field, tt.want, got, ok)
+			}
+			if current["stage"] != "Todo" || current["points"] != "5" {
+				t.Errorf("expected the current values to be left alone, got %v", current)
+			}
+		})
+	}
+
+	// A task created before a field became required can still be changed
+	resolved, err := board.ResolveFields(map[string]string{}, map[string]string{"note": "x"})
+	if err != nil || resolved["note"] != "x" {
+		t.Errorf("expected a task without the required field to be changed, got %v (%v)", resolved, err)
+	}
+	if err := board.CheckRequiredFields(resolved); !errors.Is(err, ErrMissingField) {
+		t.Errorf("expected the missing required field to be reported, got %v", err)
+	}
+	if err := board.CheckRequiredFields(current); err != nil {
+		t.Errorf("expected the required field to be set, got %v", err)
+	}
+}
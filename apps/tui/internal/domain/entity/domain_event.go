@@ -46,6 +46,7 @@ type EventBus interface {
 	Publish(event *DomainEvent)
 	Subscribe(eventType valueobject.EventType, handler EventHandler)
 	Unsubscribe(eventType valueobject.EventType, handler EventHandler)
+	SubscribeAll(handler EventHandler)
 }
 
 // EventHandler is a function that handles domain events
@@ -24,11 +24,21 @@ var (
 	ErrInvalidWIPLimit     = errors.New("wip limit must be positive")
 
 	// Task errors
-	ErrTaskNotFound      = errors.New("task not found")
-	ErrTaskAlreadyExists = errors.New("task already exists")
-	ErrInvalidTaskName   = errors.New("invalid task name")
-	ErrEmptyTaskName     = errors.New("task name cannot be empty")
-	ErrInvalidTaskID     = errors.New("invalid task ID format")
+	ErrTaskNotFound       = errors.New("task not found")
+	ErrTaskAlreadyExists  = errors.New("task already exists")
+	ErrInvalidTaskName    = errors.New("invalid task name")
+	ErrEmptyTaskName      = errors.New("task name cannot be empty")
+	ErrInvalidTaskID      = errors.New("invalid task ID format")
+	ErrTaskHierarchyCycle = errors.New("task cannot be nested under itself or one of its subtasks")
+
+	// Attachment errors
+	ErrAttachmentNotFound    = errors.New("attachment not found")
+	ErrInvalidAttachmentName = errors.New("invalid attachment name")
+
+	// Activity errors
+	ErrInvalidActivityID   = errors.New("invalid activity entry ID")
+	ErrInvalidActivityType = errors.New("invalid activity type")
+	ErrEmptyComment        = errors.New("comment cannot be empty")
 
 	// Session errors
 	ErrSessionNotFound    = errors.New("session not found")
@@ -46,9 +56,76 @@ var (
 	ErrInvalidDuration       = errors.New("duration must be non-negative")
 
 	// Note errors
-	ErrNoteNotFound   = errors.New("note not found")
-	ErrInvalidNoteID  = errors.New("invalid note ID")
-	ErrEmptyNoteTitle = errors.New("note title cannot be empty")
+	ErrNoteNotFound    = errors.New("note not found")
+	ErrInvalidNoteID   = errors.New("invalid note ID")
+	ErrEmptyNoteTitle  = errors.New("note title cannot be empty")
+	ErrNotMeetingNote  = errors.New("note is not the note of a meeting")
+	ErrTaskNotAMeeting = errors.New("task is not a meeting")
+
+	// History errors
+	ErrInvalidRevision = errors.New("invalid revision")
+
+	// Trash errors
+	ErrTrashItemNotFound    = errors.New("trash item not found")
+	ErrInvalidTrashItemID   = errors.New("invalid trash item ID")
+	ErrInvalidTrashItemType = errors.New("invalid trash item type")
+	ErrAmbiguousTrashItemID = errors.New("trash item ID is ambiguous")
+
+	// Custom field errors
+	ErrInvalidFieldName  = errors.New("invalid field name")
+	ErrInvalidFieldType  = errors.New("invalid field type")
+	ErrEmptyFieldOptions = errors.New("enum field needs distinct, non-empty options")
+	ErrDuplicateField    = errors.New("field is defined more than once")
+	ErrUnknownField      = errors.New("field is not defined on this board")
+	ErrInvalidFieldValue = errors.New("invalid field value")
+	ErrMissingField      = errors.New("required field is not set")
+
+	// Template errors
+	ErrTemplateNotFound        = errors.New("template not found")
+	ErrInvalidTemplateName     = errors.New("invalid template name")
+	ErrMissingTemplateVariable = errors.New("template variable has no value")
+
+	// Tag errors
+	ErrEmptyTagName = errors.New("tag name cannot be empty")
+	ErrTagNotFound  = errors.New("tag not found")
+	ErrTagConflict  = errors.New("tag name or alias is already used by another tag")
+
+	// Milestone errors
+	ErrNotMilestone           = errors.New("task is not a milestone")
+	ErrInvalidMilestoneMember = errors.New("invalid milestone member")
+
+	// Sprint errors
+	ErrEmptySprintName     = errors.New("sprint name cannot be empty")
+	ErrInvalidSprintDates  = errors.New("sprint must end after it starts")
+	ErrInvalidSprintTask   = errors.New("invalid sprint task")
+	ErrSprintNotFound      = errors.New("sprint not found")
+	ErrSprintAlreadyExists = errors.New("sprint already exists")
+	ErrSprintClosed        = errors.New("sprint is closed")
+	ErrTaskInOtherSprint   = errors.New("task is already planned in another open sprint")
+
+	// Forecast errors
+	ErrNoThroughputHistory = errors.New("no completed tasks to forecast from")
+
+	// Work schedule errors
+	ErrWorkScheduleNotFound      = errors.New("work schedule not found")
+	ErrInvalidWeekday            = errors.New("invalid weekday")
+	ErrInvalidTimeOfDay          = errors.New("invalid time of day")
+	ErrInvalidDaySchedule        = errors.New("invalid day schedule")
+	ErrInvalidScheduleException  = errors.New("invalid schedule exception")
+	ErrScheduleExceptionNotFound = errors.New("schedule exception not found")
+	ErrInvalidTimezone           = errors.New("invalid timezone")
+
+	// Agenda errors
+	ErrTaskNotScheduled   = errors.New("task is not scheduled")
+	ErrInvalidAgendaRange = errors.New("invalid agenda range")
+
+	// Calendar errors
+	ErrCalendarEventNotFound    = errors.New("calendar event not found")
+	ErrCalendarSyncTokenExpired = errors.New("calendar sync token expired")
+	ErrUnknownCalendarProvider  = errors.New("unknown calendar provider")
+	ErrCalendarConflictNotFound = errors.New("calendar conflict not found")
+	ErrInvalidConflictChoice    = errors.New("invalid conflict resolution")
+	ErrInvalidCalendarData      = errors.New("invalid calendar data")
 
 	// Validation errors
 	ErrInvalidPriority = errors.New("invalid priority value")
@@ -75,4 +152,5 @@ var (
 	ErrInvalidNotificationMessage  = errors.New("notification message cannot be empty")
 	ErrInvalidScriptPath           = errors.New("script path cannot be empty")
 	ErrInvalidTargetColumn         = errors.New("target column cannot be empty")
+	ErrInvalidConditionOperator    = errors.New("invalid condition operator")
 )
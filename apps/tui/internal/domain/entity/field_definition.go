@@ -0,0 +1,134 @@
+package entity
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FieldType is the type of a custom task field
+type FieldType string
+
+const (
+	FieldTypeNumber FieldType = "number"
+	FieldTypeEnum   FieldType = "enum"
+	FieldTypeDate   FieldType = "date"
+	FieldTypeString FieldType = "string"
+	FieldTypeBool   FieldType = "bool"
+	FieldTypeUser   FieldType = "user"
+)
+
+// FieldDateFormat is the format date field values are stored in
+const FieldDateFormat = "2006-01-02"
+
+// fieldNamePattern keeps field names usable as YAML keys and in conditions
+var fieldNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// reservedFieldNames are task properties that conditions already resolve
+var reservedFieldNames = map[string]bool{
+	"id":           true,
+	"title":        true,
+	"description":  true,
+	"priority":     true,
+	"status":       true,
+	"column":       true,
+	"tags":         true,
+	"due_date":     true,
+	"has_due_date": true,
+	"is_overdue":   true,
+}
+
+// IsValid checks if the field type is known
+func (t FieldType) IsValid() bool {
+	switch t {
+	case FieldTypeNumber, FieldTypeEnum, FieldTypeDate, FieldTypeString, FieldTypeBool, FieldTypeUser:
+		return true
+	}
+	return false
+}
+
+// FieldDefinition describes a typed custom field that tasks on a board may set
+type FieldDefinition struct {
+	Name        string
+	Type        FieldType
+	Description string
+	Options     []string // allowed values of an enum field
+	Required    bool
+}
+
+// Validate checks that the definition itself is usable
+func (d FieldDefinition) Validate() error {
+	if !fieldNamePattern.MatchString(d.Name) || reservedFieldNames[d.Name] {
+		return fmt.Errorf("%w: %q", ErrInvalidFieldName, d.Name)
+	}
+	if !d.Type.IsValid() {
+		return fmt.Errorf("%w: %q", ErrInvalidFieldType, d.Type)
+	}
+	if d.Type == FieldTypeEnum {
+		if len(d.Options) == 0 {
+			return fmt.Errorf("%w: %s", ErrEmptyFieldOptions, d.Name)
+		}
+		seen := make(map[string]bool, len(d.Options))
+		for _, option := range d.Options {
+			if strings.TrimSpace(option) == "" || seen[option] {
+				return fmt.Errorf("%w: %s", ErrEmptyFieldOptions, d.Name)
+			}
+			seen[option] = true
+		}
+	}
+	return nil
+}
+
+// Normalize validates a value for the field and returns it in its stored form,
+// so equal values always compare equal (e.g. "3.0" becomes "3", "yes" becomes "true")
+func (d FieldDefinition) Normalize(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", fmt.Errorf("%w: %s is empty", ErrInvalidFieldValue, d.Name)
+	}
+
+	switch d.Type {
+	case FieldTypeNumber:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return "", fmt.Errorf("%w: %s must be a number, got %q", ErrInvalidFieldValue, d.Name, value)
+		}
+		return strconv.FormatFloat(number, 'f', -1, 64), nil
+
+	case FieldTypeEnum:
+		for _, option := range d.Options {
+			if strings.EqualFold(option, value) {
+				return option, nil
+			}
+		}
+		return "", fmt.Errorf("%w: %s must be one of %s, got %q", ErrInvalidFieldValue, d.Name, strings.Join(d.Options, ", "), value)
+
+	case FieldTypeDate:
+		date, err := time.Parse(FieldDateFormat, value)
+		if err != nil {
+			return "", fmt.Errorf("%w: %s must be a date (YYYY-MM-DD), got %q", ErrInvalidFieldValue, d.Name, value)
+		}
+		return date.Format(FieldDateFormat), nil
+
+	case FieldTypeBool:
+		switch strings.ToLower(value) {
+		case "true", "yes", "y", "1":
+			return "true", nil
+		case "false", "no", "n", "0":
+			return "false", nil
+		}
+		return "", fmt.Errorf("%w: %s must be true or false, got %q", ErrInvalidFieldValue, d.Name, value)
+
+	case FieldTypeUser:
+		user := strings.TrimPrefix(value, "@")
+		if user == "" || strings.ContainsAny(user, " \t\n") {
+			return "", fmt.Errorf("%w: %s must be a user name, got %q", ErrInvalidFieldValue, d.Name, value)
+		}
+		return user, nil
+
+	default:
+		return value, nil
+	}
+}
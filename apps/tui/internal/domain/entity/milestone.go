@@ -0,0 +1,81 @@
+package entity
+
+import (
+	"time"
+)
+
+// MilestoneMember references a task tracked by a milestone; members may sit
+// on any board
+type MilestoneMember struct {
+	BoardID string
+	TaskID  string // full task ID
+}
+
+// MilestoneProgress reports how far the member tasks of a milestone are
+type MilestoneProgress struct {
+	Members             int
+	DoneMembers         int
+	MissingMembers      int // members whose board or task no longer exists
+	PercentComplete     float64
+	RemainingEstimate   time.Duration // estimates of unfinished members and their subtasks
+	Throughput          float64       // tasks completed per day on the member boards, recently
+	ProjectedCompletion *time.Time    // nil without remaining work or recent throughput
+	AtRisk              bool          // the projection, if any, passes the milestone due date
+}
+
+// IsMilestone checks if the task is a milestone
+func (t *Task) IsMilestone() bool {
+	return t.taskType == TaskTypeMilestone
+}
+
+// MilestoneMembers returns a copy of the tasks tracked by the milestone
+func (t *Task) MilestoneMembers() []MilestoneMember {
+	members := make([]MilestoneMember, len(t.milestoneMembers))
+	copy(members, t.milestoneMembers)
+	return members
+}
+
+// AddMilestoneMember adds a task to the milestone. Adding a member turns a
+// regular task into a milestone. It reports whether the member was added.
+func (t *Task) AddMilestoneMember(member MilestoneMember) (bool, error) {
+	if member.BoardID == "" || member.TaskID == "" {
+		return false, ErrInvalidMilestoneMember
+	}
+	if taskType := t.TaskType(); taskType != TaskTypeRegular && taskType != TaskTypeMilestone {
+		return false, ErrNotMilestone
+	}
+	for _, existing := range t.milestoneMembers {
+		if existing == member {
+			return false, nil
+		}
+	}
+
+	t.taskType = TaskTypeMilestone
+	t.milestoneMembers = append(t.milestoneMembers, member)
+	t.modifiedAt = time.Now()
+	return true, nil
+}
+
+// RemoveMilestoneMember removes a task from the milestone. It reports whether
+// the milestone had the member.
+func (t *Task) RemoveMilestoneMember(member MilestoneMember) bool {
+	for i, existing := range t.milestoneMembers {
+		if existing == member {
+			t.milestoneMembers = append(t.milestoneMembers[:i], t.milestoneMembers[i+1:]...)
+			t.modifiedAt = time.Now()
+			return true
+		}
+	}
+	return false
+}
+
+// MilestoneAtRisk returns whether the milestone was last found at risk
+func (t *Task) MilestoneAtRisk() bool {
+	return t.milestoneAtRisk
+}
+
+// SetMilestoneAtRisk records whether the milestone is at risk; the flag is
+// kept by the milestone check and does not count as a change to the task
+func (t *Task) SetMilestoneAtRisk(atRisk bool) {
+	t.milestoneAtRisk = atRisk
+}
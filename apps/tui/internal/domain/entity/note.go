@@ -134,6 +134,15 @@ func (n *Note) RemoveTag(tag string) {
 	}
 }
 
+func (n *Note) ReplaceTag(oldTag, newTag string) bool {
+	var replaced bool
+	n.tags, replaced = replaceTag(n.tags, oldTag, newTag)
+	if replaced {
+		n.modifiedAt = time.Now()
+	}
+	return replaced
+}
+
 func (n *Note) HasTag(tag string) bool {
 	for _, t := range n.tags {
 		if t == tag {
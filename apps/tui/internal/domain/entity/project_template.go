@@ -0,0 +1,107 @@
+package entity
+
+import (
+	"strings"
+
+	"mkanban/internal/domain/valueobject"
+)
+
+// BoardBlueprint describes a board created with a project
+type BoardBlueprint struct {
+	Name        string
+	Description string
+	Template    string // board template name, empty for the default template
+}
+
+// NoteBlueprint describes a note created with a project
+type NoteBlueprint struct {
+	Title   string
+	Type    NoteType
+	Content string
+	Tags    []string
+}
+
+// ProjectTemplate is a reusable project skeleton: its boards, each built from
+// a board template, and the notes it starts with
+type ProjectTemplate struct {
+	name        string
+	description string
+	boards      []BoardBlueprint
+	notes       []NoteBlueprint
+}
+
+// NewProjectTemplate creates a new, empty ProjectTemplate
+func NewProjectTemplate(name string, description string) (*ProjectTemplate, error) {
+	if !IsValidTemplateName(name) {
+		return nil, ErrInvalidTemplateName
+	}
+
+	return &ProjectTemplate{
+		name:        name,
+		description: description,
+		boards:      make([]BoardBlueprint, 0),
+		notes:       make([]NoteBlueprint, 0),
+	}, nil
+}
+
+// Name returns the template name
+func (t *ProjectTemplate) Name() string {
+	return t.name
+}
+
+// Description returns the template description
+func (t *ProjectTemplate) Description() string {
+	return t.description
+}
+
+// Boards returns a copy of the board blueprints
+func (t *ProjectTemplate) Boards() []BoardBlueprint {
+	boardsCopy := make([]BoardBlueprint, len(t.boards))
+	copy(boardsCopy, t.boards)
+	return boardsCopy
+}
+
+// SetBoards replaces the board blueprints; board names must give distinct slugs
+func (t *ProjectTemplate) SetBoards(boards []BoardBlueprint) error {
+	seen := make(map[string]bool, len(boards))
+	for _, board := range boards {
+		if strings.TrimSpace(board.Name) == "" {
+			return ErrEmptyBoardName
+		}
+		boardSlug := valueobject.GenerateSlug(board.Name)
+		if seen[boardSlug] {
+			return ErrBoardAlreadyExists
+		}
+		seen[boardSlug] = true
+
+		if board.Template != "" && !IsValidTemplateName(board.Template) {
+			return ErrInvalidTemplateName
+		}
+	}
+
+	t.boards = make([]BoardBlueprint, len(boards))
+	copy(t.boards, boards)
+	return nil
+}
+
+// Notes returns a copy of the note blueprints
+func (t *ProjectTemplate) Notes() []NoteBlueprint {
+	notesCopy := make([]NoteBlueprint, len(t.notes))
+	copy(notesCopy, t.notes)
+	return notesCopy
+}
+
+// SetNotes replaces the note blueprints; an unknown note type becomes general
+func (t *ProjectTemplate) SetNotes(notes []NoteBlueprint) error {
+	t.notes = make([]NoteBlueprint, 0, len(notes))
+	for _, note := range notes {
+		if strings.TrimSpace(note.Title) == "" {
+			return ErrEmptyNoteTitle
+		}
+		if !note.Type.IsValid() {
+			note.Type = NoteTypeGeneral
+		}
+		t.notes = append(t.notes, note)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"mkanban/internal/domain/valueobject"
+)
+
+// SprintTask references a task planned in a sprint
+type SprintTask struct {
+	BoardID string
+	TaskID  string    // full task ID
+	AddedAt time.Time // tasks added after the start day are scope added mid-sprint
+}
+
+// SprintSummary records the outcome of a closed sprint
+type SprintSummary struct {
+	ClosedAt      time.Time
+	Committed     int // tasks planned by the end of the start day
+	CommittedDone int
+	Added         int // tasks added mid-sprint
+	AddedDone     int
+	RolledOver    []SprintTask // unfinished tasks carried over to RolledOverTo
+	RolledOverTo  string       // empty if unfinished tasks went back to the backlog
+	TrackedTime   time.Duration
+}
+
+// Sprint is a time-boxed iteration of a project with the tasks planned in it
+type Sprint struct {
+	id        string
+	projectID string
+	name      string
+	goal      string
+	startDate time.Time
+	endDate   time.Time
+	tasks     []SprintTask
+	summary   *SprintSummary
+}
+
+// NewSprint creates a new Sprint; its ID is derived from its name
+func NewSprint(projectID string, name string, goal string, startDate, endDate time.Time) (*Sprint, error) {
+	if projectID == "" {
+		return nil, ErrEmptyProjectID
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrEmptySprintName
+	}
+	if !endDate.After(startDate) {
+		return nil, ErrInvalidSprintDates
+	}
+
+	return &Sprint{
+		id:        valueobject.GenerateSlug(name),
+		projectID: projectID,
+		name:      name,
+		goal:      goal,
+		startDate: startDate,
+		endDate:   endDate,
+		tasks:     make([]SprintTask, 0),
+	}, nil
+}
+
+// ID returns the sprint ID
+func (s *Sprint) ID() string {
+	return s.id
+}
+
+// ProjectID returns the ID of the project the sprint belongs to
+func (s *Sprint) ProjectID() string {
+	return s.projectID
+}
+
+// Name returns the sprint name
+func (s *Sprint) Name() string {
+	return s.name
+}
+
+// Goal returns the sprint goal
+func (s *Sprint) Goal() string {
+	return s.goal
+}
+
+// StartDate returns when the sprint starts
+func (s *Sprint) StartDate() time.Time {
+	return s.startDate
+}
+
+// EndDate returns when the sprint ends
+func (s *Sprint) EndDate() time.Time {
+	return s.endDate
+}
+
+// Tasks returns a copy of the tasks planned in the sprint
+func (s *Sprint) Tasks() []SprintTask {
+	tasks := make([]SprintTask, len(s.tasks))
+	copy(tasks, s.tasks)
+	return tasks
+}
+
+// Summary returns the outcome of the sprint, nil while it is open
+func (s *Sprint) Summary() *SprintSummary {
+	return s.summary
+}
+
+// IsClosed checks if the sprint has been closed
+func (s *Sprint) IsClosed() bool {
+	return s.summary != nil
+}
+
+// IsAddedMidSprint checks if a task was added after the sprint started. Tasks
+// added up to the end of the start day are part of the commitment, so the
+// sprint can be planned on its first day.
+func (s *Sprint) IsAddedMidSprint(task SprintTask) bool {
+	year, month, day := s.startDate.Date()
+	cutoff := time.Date(year, month, day+1, 0, 0, 0, 0, s.startDate.Location())
+	return !task.AddedAt.Before(cutoff)
+}
+
+// AddTask plans a task in the sprint. It reports whether the task was added.
+func (s *Sprint) AddTask(task SprintTask) (bool, error) {
+	if s.IsClosed() {
+		return false, ErrSprintClosed
+	}
+	if task.BoardID == "" || task.TaskID == "" {
+		return false, ErrInvalidSprintTask
+	}
+	for _, existing := range s.tasks {
+		if existing.BoardID == task.BoardID && existing.TaskID == task.TaskID {
+			return false, nil
+		}
+	}
+
+	if task.AddedAt.IsZero() {
+		task.AddedAt = time.Now()
+	}
+	s.tasks = append(s.tasks, task)
+	return true, nil
+}
+
+// RemoveTask takes a task out of the sprint. It reports whether the sprint had
+// the task.
+func (s *Sprint) RemoveTask(boardID, taskID string) (bool, error) {
+	if s.IsClosed() {
+		return false, ErrSprintClosed
+	}
+	for i, existing := range s.tasks {
+		if existing.BoardID == boardID && existing.TaskID == taskID {
+			s.tasks = append(s.tasks[:i], s.tasks[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Close closes the sprint with its summary; a closed sprint cannot change
+func (s *Sprint) Close(summary SprintSummary) error {
+	if s.IsClosed() {
+		return ErrSprintClosed
+	}
+	if summary.ClosedAt.IsZero() {
+		summary.ClosedAt = time.Now()
+	}
+	s.summary = &summary
+	return nil
+}
@@ -0,0 +1,247 @@
+package entity
+
+import (
+	"sort"
+	"strings"
+
+	"mkanban/internal/domain/valueobject"
+)
+
+// Tag is a registered tag of a project. Aliases are other spellings of the
+// tag; tasks and notes tagged with an alias are rewritten to the tag name.
+type Tag struct {
+	name        string
+	color       *valueobject.Color
+	description string
+	aliases     []string
+}
+
+// NewTag creates a new Tag
+func NewTag(name string, description string, color *valueobject.Color) (*Tag, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrEmptyTagName
+	}
+
+	return &Tag{
+		name:        name,
+		color:       color,
+		description: description,
+		aliases:     make([]string, 0),
+	}, nil
+}
+
+// Name returns the tag name
+func (t *Tag) Name() string {
+	return t.name
+}
+
+// Color returns the tag color, nil if it has none
+func (t *Tag) Color() *valueobject.Color {
+	return t.color
+}
+
+// Description returns the tag description
+func (t *Tag) Description() string {
+	return t.description
+}
+
+// Aliases returns a copy of the tag aliases
+func (t *Tag) Aliases() []string {
+	aliasesCopy := make([]string, len(t.aliases))
+	copy(aliasesCopy, t.aliases)
+	return aliasesCopy
+}
+
+// SetAliases replaces the aliases; blanks, duplicates and the tag name itself are dropped
+func (t *Tag) SetAliases(aliases []string) {
+	t.aliases = make([]string, 0, len(aliases))
+	for _, alias := range aliases {
+		alias = strings.TrimSpace(alias)
+		if alias == "" || t.Matches(alias) {
+			continue
+		}
+		t.aliases = append(t.aliases, alias)
+	}
+}
+
+// Matches checks if a tag string is the tag name or one of its aliases,
+// ignoring case
+func (t *Tag) Matches(tag string) bool {
+	if strings.EqualFold(t.name, tag) {
+		return true
+	}
+	for _, alias := range t.aliases {
+		if strings.EqualFold(alias, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// spellings returns the tag name followed by its aliases
+func (t *Tag) spellings() []string {
+	return append([]string{t.name}, t.aliases...)
+}
+
+// TagRegistry holds the registered tags of a project
+type TagRegistry struct {
+	projectID string
+	tags      []*Tag
+}
+
+// NewTagRegistry creates a new, empty TagRegistry
+func NewTagRegistry(projectID string) *TagRegistry {
+	return &TagRegistry{
+		projectID: projectID,
+		tags:      make([]*Tag, 0),
+	}
+}
+
+// ProjectID returns the project the registry belongs to
+func (r *TagRegistry) ProjectID() string {
+	return r.projectID
+}
+
+// Tags returns the registered tags sorted by name
+func (r *TagRegistry) Tags() []*Tag {
+	tagsCopy := make([]*Tag, len(r.tags))
+	copy(tagsCopy, r.tags)
+	sort.Slice(tagsCopy, func(i, j int) bool {
+		return strings.ToLower(tagsCopy[i].name) < strings.ToLower(tagsCopy[j].name)
+	})
+	return tagsCopy
+}
+
+// Find returns the registered tag a tag string is the name or an alias of
+func (r *TagRegistry) Find(tag string) (*Tag, bool) {
+	for _, registered := range r.tags {
+		if registered.Matches(tag) {
+			return registered, true
+		}
+	}
+	return nil, false
+}
+
+// Resolve returns the name of the registered tag a tag string refers to,
+// or the string itself if it is not registered
+func (r *TagRegistry) Resolve(tag string) string {
+	if registered, ok := r.Find(tag); ok {
+		return registered.name
+	}
+	return tag
+}
+
+// Set registers a tag, replacing the registered tag of the same name. Its
+// name and aliases may not be spellings of another registered tag.
+func (r *TagRegistry) Set(tag *Tag) error {
+	index := -1
+	for i, registered := range r.tags {
+		if strings.EqualFold(registered.name, tag.name) {
+			index = i
+			continue
+		}
+		for _, spelling := range tag.spellings() {
+			if registered.Matches(spelling) {
+				return ErrTagConflict
+			}
+		}
+	}
+
+	if index >= 0 {
+		r.tags[index] = tag
+	} else {
+		r.tags = append(r.tags, tag)
+	}
+	return nil
+}
+
+// Remove unregisters the tag a tag string refers to
+func (r *TagRegistry) Remove(tag string) error {
+	for i, registered := range r.tags {
+		if registered.Matches(tag) {
+			r.tags = append(r.tags[:i], r.tags[i+1:]...)
+			return nil
+		}
+	}
+	return ErrTagNotFound
+}
+
+// Rename renames a registered tag, keeping its color, description and aliases.
+// Renaming a tag that is not registered is not an error: there is nothing to update.
+func (r *TagRegistry) Rename(oldName, newName string) error {
+	newName = strings.TrimSpace(newName)
+	if newName == "" {
+		return ErrEmptyTagName
+	}
+
+	tag, ok := r.Find(oldName)
+	if !ok {
+		if other, taken := r.Find(newName); taken && !strings.EqualFold(other.name, newName) {
+			return ErrTagConflict
+		}
+		return nil
+	}
+	if other, taken := r.Find(newName); taken && other != tag {
+		return ErrTagConflict
+	}
+
+	tag.name = newName
+	tag.SetAliases(tag.aliases)
+	return nil
+}
+
+// Merge folds the source tags into the target tag: registered sources are
+// removed, and every source spelling becomes an alias of the target so that
+// it keeps resolving to it. An unregistered target is registered.
+func (r *TagRegistry) Merge(sources []string, target string) error {
+	targetTag, ok := r.Find(target)
+	if !ok {
+		var err error
+		targetTag, err = NewTag(target, "", nil)
+		if err != nil {
+			return err
+		}
+		r.tags = append(r.tags, targetTag)
+	}
+
+	aliases := targetTag.Aliases()
+	for _, source := range sources {
+		sourceTag, ok := r.Find(source)
+		if !ok {
+			aliases = append(aliases, source)
+			continue
+		}
+		if sourceTag == targetTag {
+			continue
+		}
+		aliases = append(aliases, sourceTag.spellings()...)
+		_ = r.Remove(sourceTag.name)
+	}
+	targetTag.SetAliases(aliases)
+
+	return nil
+}
+
+// replaceTag replaces oldTag in tags, keeping its position; newTag is dropped
+// instead if tags already has it, and an empty newTag removes oldTag
+func replaceTag(tags []string, oldTag, newTag string) ([]string, bool) {
+	index := -1
+	hasNew := false
+	for i, tag := range tags {
+		if tag == oldTag {
+			index = i
+		} else if tag == newTag {
+			hasNew = true
+		}
+	}
+	if index < 0 {
+		return tags, false
+	}
+
+	if newTag == "" || hasNew {
+		return append(tags[:index], tags[index+1:]...), true
+	}
+	tags[index] = newTag
+	return tags, true
+}
@@ -21,11 +21,24 @@ func (t TaskType) IsValid() bool {
 	return false
 }
 
+// UnresolvedParentMetadataKey holds the short ID of a parent that is not on
+// the task's board, so that it is kept until the parent is set again
+const UnresolvedParentMetadataKey = "parent_short_id"
+
 type MeetingData struct {
 	Attendees     []string
 	Location      string
 	MeetingURL    string
 	GoogleEventID string
+	ICalUID       string // UID of the iCalendar event the meeting was imported from
+}
+
+// Attachment describes a file stored in the task folder
+type Attachment struct {
+	Name     string
+	Size     int64
+	MimeType string
+	AddedAt  time.Time
 }
 
 // Task represents a work item within a column
@@ -38,6 +51,7 @@ type Task struct {
 	status        valueobject.Status
 	tags          []string
 	metadata      map[string]string
+	fields        map[string]string
 	parentID      *valueobject.TaskID
 	createdAt     time.Time
 	modifiedAt    time.Time
@@ -55,6 +69,13 @@ type Task struct {
 
 	taskType    TaskType
 	meetingData *MeetingData
+
+	milestoneMembers []MilestoneMember
+	milestoneAtRisk  bool
+
+	columnHistory []ColumnVisit
+
+	attachments []Attachment
 }
 
 // NewTask creates a new Task entity
@@ -87,7 +108,9 @@ func NewTask(
 		status:      status,
 		tags:        make([]string, 0),
 		metadata:    make(map[string]string),
+		fields:      make(map[string]string),
 		linkedNotes: make([]string, 0),
+		attachments: make([]Attachment, 0),
 		taskType:    TaskTypeRegular,
 		createdAt:   now,
 		modifiedAt:  now,
@@ -162,6 +185,7 @@ func (t *Task) ParentID() *valueobject.TaskID {
 // SetParentID sets the parent task ID for this subtask
 func (t *Task) SetParentID(parentID *valueobject.TaskID) {
 	t.parentID = parentID
+	delete(t.metadata, UnresolvedParentMetadataKey)
 	t.modifiedAt = time.Now()
 }
 
@@ -252,6 +276,17 @@ func (t *Task) RemoveTag(tag string) {
 	}
 }
 
+// ReplaceTag replaces a tag in place, or removes it for an empty newTag.
+// It reports whether the task had the tag.
+func (t *Task) ReplaceTag(oldTag, newTag string) bool {
+	var replaced bool
+	t.tags, replaced = replaceTag(t.tags, oldTag, newTag)
+	if replaced {
+		t.modifiedAt = time.Now()
+	}
+	return replaced
+}
+
 // MarkAsCompleted marks the task as completed
 func (t *Task) MarkAsCompleted() error {
 	if err := t.UpdateStatus(valueobject.StatusDone); err != nil {
@@ -264,6 +299,19 @@ func (t *Task) MarkAsCompleted() error {
 	return nil
 }
 
+// RestoreDates sets the creation, modification, due and completion dates
+// (used during loading from storage, where the due date may be in the past)
+func (t *Task) RestoreDates(createdAt, modifiedAt time.Time, dueDate, completedDate *time.Time) {
+	if !createdAt.IsZero() {
+		t.createdAt = createdAt
+	}
+	if !modifiedAt.IsZero() {
+		t.modifiedAt = modifiedAt
+	}
+	t.dueDate = dueDate
+	t.completedDate = completedDate
+}
+
 // IsOverdue checks if the task is overdue
 func (t *Task) IsOverdue() bool {
 	if t.dueDate == nil || t.status == valueobject.StatusDone {
@@ -320,6 +368,31 @@ func (t *Task) RemoveMetadata(key string) {
 	t.modifiedAt = time.Now()
 }
 
+// Fields returns a copy of the custom field values, keyed by field name
+func (t *Task) Fields() map[string]string {
+	fieldsCopy := make(map[string]string, len(t.fields))
+	for k, v := range t.fields {
+		fieldsCopy[k] = v
+	}
+	return fieldsCopy
+}
+
+// GetField retrieves a custom field value by name
+func (t *Task) GetField(name string) (string, bool) {
+	value, exists := t.fields[name]
+	return value, exists
+}
+
+// SetFields replaces the custom field values; they are expected to have been
+// validated against the board's field schema
+func (t *Task) SetFields(fields map[string]string) {
+	t.fields = make(map[string]string, len(fields))
+	for k, v := range fields {
+		t.fields[k] = v
+	}
+	t.modifiedAt = time.Now()
+}
+
 func (t *Task) ProjectID() string {
 	return t.projectID
 }
@@ -470,3 +543,45 @@ func (t *Task) SetMeetingData(data *MeetingData) {
 func (t *Task) IsScheduled() bool {
 	return t.scheduledDate != nil || t.scheduledTime != nil
 }
+
+// Attachments returns the files attached to the task
+func (t *Task) Attachments() []Attachment {
+	attachments := make([]Attachment, len(t.attachments))
+	copy(attachments, t.attachments)
+	return attachments
+}
+
+// GetAttachment retrieves an attachment by file name
+func (t *Task) GetAttachment(name string) (Attachment, error) {
+	for _, attachment := range t.attachments {
+		if attachment.Name == name {
+			return attachment, nil
+		}
+	}
+	return Attachment{}, ErrAttachmentNotFound
+}
+
+// AddAttachment records an attachment, replacing one with the same file name
+func (t *Task) AddAttachment(attachment Attachment) {
+	for i, existing := range t.attachments {
+		if existing.Name == attachment.Name {
+			t.attachments[i] = attachment
+			t.modifiedAt = time.Now()
+			return
+		}
+	}
+	t.attachments = append(t.attachments, attachment)
+	t.modifiedAt = time.Now()
+}
+
+// RemoveAttachment removes an attachment by file name
+func (t *Task) RemoveAttachment(name string) error {
+	for i, attachment := range t.attachments {
+		if attachment.Name == name {
+			t.attachments = append(t.attachments[:i], t.attachments[i+1:]...)
+			t.modifiedAt = time.Now()
+			return nil
+		}
+	}
+	return ErrAttachmentNotFound
+}
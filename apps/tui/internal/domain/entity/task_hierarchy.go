@@ -0,0 +1,242 @@
+package entity
+
+import (
+	"time"
+
+	"mkanban/internal/domain/valueobject"
+)
+
+// doneColumnName is the column tasks are moved to when they are completed
+const doneColumnName = "Done"
+
+// TaskRollup summarizes the subtree below a task
+type TaskRollup struct {
+	Subtasks        int // all tasks nested below the task, at any depth
+	DoneSubtasks    int
+	PercentComplete float64
+	EstimatedTime   time.Duration // the task's own estimate plus those of its subtasks
+	TrackedTime     time.Duration // the task's own tracked time plus that of its subtasks
+	EarliestDueDate *time.Time    // earliest due date of an unfinished subtask
+}
+
+// TaskHierarchy indexes the subtasks of the tasks of a board, so walking the
+// hierarchy does not rescan the board at every level. It reflects the board
+// as it was when the index was built.
+type TaskHierarchy struct {
+	children map[string][]*Task // parent short ID -> direct subtasks, in board order
+	columns  map[string]*Column // short ID -> column of the task
+	done     map[string]bool    // short ID -> task is done
+}
+
+// Hierarchy indexes the subtasks and completion of the tasks of the board.
+// Build it once when walking the hierarchy of many tasks.
+func (b *Board) Hierarchy() *TaskHierarchy {
+	h := &TaskHierarchy{
+		children: make(map[string][]*Task),
+		columns:  make(map[string]*Column),
+		done:     make(map[string]bool),
+	}
+	for _, column := range b.columns {
+		columnDone := isDoneColumn(column)
+		for _, task := range column.tasks {
+			h.columns[task.ID().ShortID()] = column
+			h.done[task.ID().ShortID()] = columnDone || task.Status() == valueobject.StatusDone
+			if parentID := task.ParentID(); parentID != nil {
+				h.children[parentID.ShortID()] = append(h.children[parentID.ShortID()], task)
+			}
+		}
+	}
+	return h
+}
+
+// Subtasks returns the direct subtasks of a task
+func (b *Board) Subtasks(parentID *valueobject.TaskID) []*Task {
+	return b.Hierarchy().Subtasks(parentID)
+}
+
+// Descendants returns every task nested below a task, depth first
+func (b *Board) Descendants(taskID *valueobject.TaskID) []*Task {
+	return b.Hierarchy().Descendants(taskID)
+}
+
+// Subtasks returns the direct subtasks of a task
+func (h *TaskHierarchy) Subtasks(parentID *valueobject.TaskID) []*Task {
+	subtasks := make([]*Task, 0)
+	if parentID == nil {
+		return subtasks
+	}
+	return append(subtasks, h.children[parentID.ShortID()]...)
+}
+
+// Column returns the column of a task of the board, or nil
+func (h *TaskHierarchy) Column(taskID *valueobject.TaskID) *Column {
+	return h.columns[taskID.ShortID()]
+}
+
+// IsDone checks if a task of the board is completed
+func (h *TaskHierarchy) IsDone(taskID *valueobject.TaskID) bool {
+	return h.done[taskID.ShortID()]
+}
+
+// Descendants returns every task nested below a task, depth first
+func (h *TaskHierarchy) Descendants(taskID *valueobject.TaskID) []*Task {
+	return h.descendants(taskID, map[string]bool{taskID.ShortID(): true})
+}
+
+// descendants walks the subtree below a task; visited guards against cycles
+// in hand-edited metadata
+func (h *TaskHierarchy) descendants(taskID *valueobject.TaskID, visited map[string]bool) []*Task {
+	descendants := make([]*Task, 0)
+	for _, subtask := range h.children[taskID.ShortID()] {
+		if visited[subtask.ID().ShortID()] {
+			continue
+		}
+		visited[subtask.ID().ShortID()] = true
+		descendants = append(descendants, subtask)
+		descendants = append(descendants, h.descendants(subtask.ID(), visited)...)
+	}
+	return descendants
+}
+
+// SetTaskParent nests a task under another task of the board, or makes it a
+// top-level task if parentID is nil. A task cannot be nested under itself or
+// one of its own subtasks.
+func (b *Board) SetTaskParent(taskID, parentID *valueobject.TaskID) error {
+	task, _, err := b.FindTask(taskID)
+	if err != nil {
+		return err
+	}
+
+	if parentID == nil {
+		task.SetParentID(nil)
+		b.modifiedAt = time.Now()
+		return nil
+	}
+
+	parent, _, err := b.FindTask(parentID)
+	if err != nil {
+		return err
+	}
+	if parent.ID().ShortID() == task.ID().ShortID() {
+		return ErrTaskHierarchyCycle
+	}
+	for _, descendant := range b.Descendants(task.ID()) {
+		if descendant.ID().ShortID() == parent.ID().ShortID() {
+			return ErrTaskHierarchyCycle
+		}
+	}
+
+	task.SetParentID(parent.ID())
+	b.modifiedAt = time.Now()
+	return nil
+}
+
+// IsTaskDone checks if a task is completed: its status is done or it sits in
+// a Done column
+func (b *Board) IsTaskDone(taskID *valueobject.TaskID) bool {
+	task, column, err := b.FindTask(taskID)
+	if err != nil {
+		return false
+	}
+	return isDone(task, column)
+}
+
+// SubtasksDone checks if a task has subtasks and all of them are done
+func (b *Board) SubtasksDone(parentID *valueobject.TaskID) bool {
+	h := b.Hierarchy()
+	subtasks := h.Subtasks(parentID)
+	if len(subtasks) == 0 {
+		return false
+	}
+	for _, subtask := range subtasks {
+		if !h.IsDone(subtask.ID()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Rollup summarizes the subtree below a task. Progress counts the leaf tasks
+// of the subtree; a done task counts all the leaves below it as done. Tracked
+// time is looked up by short task ID in trackedTimes, which may be nil.
+func (b *Board) Rollup(taskID *valueobject.TaskID, trackedTimes map[string]time.Duration) (TaskRollup, error) {
+	task, _, err := b.FindTask(taskID)
+	if err != nil {
+		return TaskRollup{}, err
+	}
+	return b.Hierarchy().Rollup(task, trackedTimes), nil
+}
+
+// Rollup summarizes the subtree below a task of the board, like Board.Rollup
+func (h *TaskHierarchy) Rollup(task *Task, trackedTimes map[string]time.Duration) TaskRollup {
+	rollup := TaskRollup{}
+	if estimate := task.EstimatedTime(); estimate != nil {
+		rollup.EstimatedTime += *estimate
+	}
+	rollup.TrackedTime += trackedTimes[task.ID().ShortID()]
+
+	for _, descendant := range h.Descendants(task.ID()) {
+		rollup.Subtasks++
+		done := h.IsDone(descendant.ID())
+		if done {
+			rollup.DoneSubtasks++
+		}
+		if estimate := descendant.EstimatedTime(); estimate != nil {
+			rollup.EstimatedTime += *estimate
+		}
+		rollup.TrackedTime += trackedTimes[descendant.ID().ShortID()]
+
+		dueDate := descendant.DueDate()
+		if !done && dueDate != nil && (rollup.EarliestDueDate == nil || dueDate.Before(*rollup.EarliestDueDate)) {
+			rollup.EarliestDueDate = dueDate
+		}
+	}
+
+	leaves, doneLeaves := h.countLeaves(task.ID(), false, map[string]bool{task.ID().ShortID(): true})
+	if leaves > 0 {
+		rollup.PercentComplete = float64(doneLeaves) / float64(leaves) * 100
+	}
+	return rollup
+}
+
+// TrackedTimeByTask sums the time logged on each task, keyed by short task ID
+func TrackedTimeByTask(logs []*TimeLog) map[string]time.Duration {
+	trackedTimes := make(map[string]time.Duration)
+	for _, log := range logs {
+		if log.TaskID() == nil {
+			continue
+		}
+		trackedTimes[log.TaskID().ShortID()] += log.Duration()
+	}
+	return trackedTimes
+}
+
+// countLeaves counts the leaf tasks below a task and how many of them are done;
+// done is set once an ancestor is done
+func (h *TaskHierarchy) countLeaves(taskID *valueobject.TaskID, done bool, visited map[string]bool) (int, int) {
+	leaves, doneLeaves := 0, 0
+	for _, subtask := range h.children[taskID.ShortID()] {
+		if visited[subtask.ID().ShortID()] {
+			continue
+		}
+		visited[subtask.ID().ShortID()] = true
+
+		subtaskDone := done || h.IsDone(subtask.ID())
+		if len(h.children[subtask.ID().ShortID()]) == 0 {
+			leaves++
+			if subtaskDone {
+				doneLeaves++
+			}
+			continue
+		}
+		subLeaves, subDoneLeaves := h.countLeaves(subtask.ID(), subtaskDone, visited)
+		leaves += subLeaves
+		doneLeaves += subDoneLeaves
+	}
+	return leaves, doneLeaves
+}
+
+// isDone checks if a task is completed within its column
+func isDone(task *Task, column *Column) bool {
+	return task.Status() == valueobject.StatusDone || isDoneColumn(column)
+}
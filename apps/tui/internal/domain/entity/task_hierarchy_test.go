@@ -0,0 +1,206 @@
+package entity
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"mkanban/internal/domain/valueobject"
+)
+
+// newHierarchyBoard creates a board with a To Do and a Done column
+func newHierarchyBoard(t *testing.T) *Board {
+	t.Helper()
+	board, err := NewBoard("project/board", "Board", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, name := range []string{"To Do", "Done"} {
+		column, err := NewColumn(name, "", i, 0, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := board.AddColumn(column); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return board
+}
+
+// addHierarchyTask adds a task to a column of the board, nested under parent
+// if it is not nil
+func addHierarchyTask(t *testing.T, board *Board, columnName, title string, parent *Task) *Task {
+	t.Helper()
+	taskID, err := board.GenerateNextTaskID(title)
+	if err != nil {
+		t.Fatal(err)
+	}
+	task, err := NewTask(taskID, title, "", valueobject.PriorityMedium, valueobject.StatusTodo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	column, err := board.GetColumn(columnName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := column.AddTask(task); err != nil {
+		t.Fatal(err)
+	}
+	if parent != nil {
+		if err := board.SetTaskParent(task.ID(), parent.ID()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return task
+}
+
+func TestRollup(t *testing.T) {
+	board := newHierarchyBoard(t)
+	epic := addHierarchyTask(t, board, "To Do", "epic", nil)
+	story := addHierarchyTask(t, board, "To Do", "story", epic)
+	done := addHierarchyTask(t, board, "Done", "done", story)
+	open := addHierarchyTask(t, board, "To Do", "open", story)
+	closed := addHierarchyTask(t, board, "Done", "closed", epic)
+	addHierarchyTask(t, board, "To Do", "child", closed)
+
+	epic.SetEstimatedTime(time.Hour)
+	done.SetEstimatedTime(2 * time.Hour)
+	open.SetEstimatedTime(30 * time.Minute)
+	due := time.Now().Add(48 * time.Hour)
+	if err := open.SetDueDate(due); err != nil {
+		t.Fatal(err)
+	}
+	if err := done.SetDueDate(time.Now().Add(24 * time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	trackedTimes := map[string]time.Duration{
+		epic.ID().ShortID(): 10 * time.Minute,
+		done.ID().ShortID(): 20 * time.Minute,
+	}
+	rollup, err := board.Rollup(epic.ID(), trackedTimes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rollup.Subtasks != 5 {
+		t.Errorf("expected 5 subtasks, got %d", rollup.Subtasks)
+	}
+	if rollup.DoneSubtasks != 2 {
+		t.Errorf("expected 2 done subtasks, got %d", rollup.DoneSubtasks)
+	}
+	// Leaves are done, open and child; child counts as done below closed
+	if want := float64(2) / float64(3) * 100; rollup.PercentComplete != want {
+		t.Errorf("expected %.2f%% complete, got %.2f%%", want, rollup.PercentComplete)
+	}
+	if want := 3*time.Hour + 30*time.Minute; rollup.EstimatedTime != want {
+		t.Errorf("expected %v estimated, got %v", want, rollup.EstimatedTime)
+	}
+	if want := 30 * time.Minute; rollup.TrackedTime != want {
+		t.Errorf("expected %v tracked, got %v", want, rollup.TrackedTime)
+	}
+	if rollup.EarliestDueDate == nil || !rollup.EarliestDueDate.Equal(due) {
+		t.Errorf("expected the earliest due date of an unfinished subtask to be %v, got %v", due, rollup.EarliestDueDate)
+	}
+}
+
+func TestHierarchy(t *testing.T) {
+	board := newHierarchyBoard(t)
+	// A done column is recognized regardless of case, like in flow metrics
+	column, err := NewColumn("DONE", "", 2, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := board.AddColumn(column); err != nil {
+		t.Fatal(err)
+	}
+
+	epic := addHierarchyTask(t, board, "To Do", "epic", nil)
+	story := addHierarchyTask(t, board, "To Do", "story", epic)
+	shipped := addHierarchyTask(t, board, "DONE", "shipped", story)
+	open := addHierarchyTask(t, board, "To Do", "open", epic)
+
+	h := board.Hierarchy()
+	if subtasks := h.Subtasks(epic.ID()); len(subtasks) != 2 || subtasks[0] != story || subtasks[1] != open {
+		t.Errorf("expected the direct subtasks of epic in board order, got %v", subtasks)
+	}
+	if descendants := h.Descendants(epic.ID()); len(descendants) != 3 || descendants[1] != shipped {
+		t.Errorf("expected the subtree of epic depth first, got %v", descendants)
+	}
+	if h.Column(shipped.ID()) != column {
+		t.Error("expected the index to know the column of a task")
+	}
+	if !h.IsDone(shipped.ID()) || !board.IsTaskDone(shipped.ID()) || h.IsDone(open.ID()) {
+		t.Error("expected tasks in the DONE column, and only those, to be done")
+	}
+
+	rollup := h.Rollup(epic, nil)
+	if rollup.Subtasks != 3 || rollup.DoneSubtasks != 1 || rollup.PercentComplete != 50 {
+		t.Errorf("expected 1 of 3 subtasks and half the leaves done, got %+v", rollup)
+	}
+
+	// The hierarchy reflects the board when it was built
+	addHierarchyTask(t, board, "To Do", "later", epic)
+	if len(h.Subtasks(epic.ID())) != 2 || len(board.Subtasks(epic.ID())) != 3 {
+		t.Error("expected a new hierarchy to see tasks added after the old one was built")
+	}
+}
+
+func TestSubtasksDone(t *testing.T) {
+	board := newHierarchyBoard(t)
+	parent := addHierarchyTask(t, board, "To Do", "parent", nil)
+	if board.SubtasksDone(parent.ID()) {
+		t.Error("a task without subtasks should not have its subtasks done")
+	}
+
+	addHierarchyTask(t, board, "Done", "first", parent)
+	second := addHierarchyTask(t, board, "To Do", "second", parent)
+	if board.SubtasksDone(parent.ID()) {
+		t.Error("subtasks should not be done while one is open")
+	}
+
+	if err := second.UpdateStatus(valueobject.StatusDone); err != nil {
+		t.Fatal(err)
+	}
+	if !board.SubtasksDone(parent.ID()) {
+		t.Error("subtasks should be done once all of them are done")
+	}
+}
+
+func TestSetTaskParentRejectsCycles(t *testing.T) {
+	board := newHierarchyBoard(t)
+	root := addHierarchyTask(t, board, "To Do", "root", nil)
+	child := addHierarchyTask(t, board, "To Do", "child", root)
+	grandchild := addHierarchyTask(t, board, "To Do", "grandchild", child)
+
+	tests := []struct {
+		name   string
+		task   *Task
+		parent *Task
+	}{
+		{name: "itself", task: root, parent: root},
+		{name: "direct subtask", task: root, parent: child},
+		{name: "nested subtask", task: root, parent: grandchild},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := board.SetTaskParent(tt.task.ID(), tt.parent.ID())
+			if !errors.Is(err, ErrTaskHierarchyCycle) {
+				t.Fatalf("expected %v, got %v", ErrTaskHierarchyCycle, err)
+			}
+			if tt.task.ParentID() != nil {
+				t.Errorf("expected %s to stay top-level, got parent %s", tt.task.ID(), tt.task.ParentID())
+			}
+		})
+	}
+
+	if err := board.SetTaskParent(grandchild.ID(), root.ID()); err != nil {
+		t.Fatalf("moving a subtask up the tree failed: %v", err)
+	}
+	if err := board.SetTaskParent(child.ID(), nil); err != nil {
+		t.Fatalf("making a subtask top-level failed: %v", err)
+	}
+	if child.ParentID() != nil {
+		t.Errorf("expected %s to be top-level", child.ID())
+	}
+}
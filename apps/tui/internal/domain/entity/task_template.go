@@ -0,0 +1,214 @@
+package entity
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"mkanban/internal/domain/valueobject"
+)
+
+// templateNamePattern keeps template names usable as file names
+var templateNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// templateVariablePattern matches {{name}} placeholders in template text
+var templateVariablePattern = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// TaskTemplate is a named blueprint for tasks that are created over and over.
+// Title, description and field values may contain {{name}} placeholders that
+// are filled in when a task is created from the template.
+type TaskTemplate struct {
+	name        string
+	projectID   string // empty for a global template
+	title       string
+	description string
+	priority    valueobject.Priority
+	column      string
+	tags        []string
+	fields      map[string]string
+	variables   map[string]string // declared variables and their defaults
+}
+
+// NewTaskTemplate creates a new TaskTemplate
+func NewTaskTemplate(name string, title string, description string) (*TaskTemplate, error) {
+	if !IsValidTemplateName(name) {
+		return nil, ErrInvalidTemplateName
+	}
+	if strings.TrimSpace(title) == "" {
+		return nil, ErrEmptyTaskName
+	}
+
+	return &TaskTemplate{
+		name:        name,
+		title:       title,
+		description: description,
+		priority:    valueobject.PriorityNone,
+		tags:        make([]string, 0),
+		fields:      make(map[string]string),
+		variables:   make(map[string]string),
+	}, nil
+}
+
+// IsValidTemplateName checks if a name can be used for a template; names are
+// lower-case letters, digits, hyphens and underscores
+func IsValidTemplateName(name string) bool {
+	return templateNamePattern.MatchString(name)
+}
+
+// Name returns the template name
+func (t *TaskTemplate) Name() string {
+	return t.name
+}
+
+// ProjectID returns the project the template belongs to, or empty if it is global
+func (t *TaskTemplate) ProjectID() string {
+	return t.projectID
+}
+
+// SetProjectID scopes the template to a project; empty makes it global
+func (t *TaskTemplate) SetProjectID(projectID string) {
+	t.projectID = projectID
+}
+
+// IsGlobal checks if the template is available to all projects
+func (t *TaskTemplate) IsGlobal() bool {
+	return t.projectID == ""
+}
+
+// Title returns the title text, with placeholders
+func (t *TaskTemplate) Title() string {
+	return t.title
+}
+
+// Description returns the description text, with placeholders
+func (t *TaskTemplate) Description() string {
+	return t.description
+}
+
+// Priority returns the priority of created tasks
+func (t *TaskTemplate) Priority() valueobject.Priority {
+	return t.priority
+}
+
+// SetPriority sets the priority of created tasks
+func (t *TaskTemplate) SetPriority(priority valueobject.Priority) error {
+	if !priority.IsValid() {
+		return ErrInvalidPriority
+	}
+	t.priority = priority
+	return nil
+}
+
+// Column returns the column tasks are created in, if the template sets one
+func (t *TaskTemplate) Column() string {
+	return t.column
+}
+
+// SetColumn sets the column tasks are created in
+func (t *TaskTemplate) SetColumn(column string) {
+	t.column = column
+}
+
+// Tags returns a copy of the tags of created tasks
+func (t *TaskTemplate) Tags() []string {
+	tagsCopy := make([]string, len(t.tags))
+	copy(tagsCopy, t.tags)
+	return tagsCopy
+}
+
+// SetTags sets the tags of created tasks
+func (t *TaskTemplate) SetTags(tags []string) {
+	t.tags = make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag != "" && !containsString(t.tags, tag) {
+			t.tags = append(t.tags, tag)
+		}
+	}
+}
+
+// Fields returns a copy of the custom field values of created tasks
+func (t *TaskTemplate) Fields() map[string]string {
+	fieldsCopy := make(map[string]string, len(t.fields))
+	for k, v := range t.fields {
+		fieldsCopy[k] = v
+	}
+	return fieldsCopy
+}
+
+// SetFields sets the custom field values of created tasks
+func (t *TaskTemplate) SetFields(fields map[string]string) {
+	t.fields = make(map[string]string, len(fields))
+	for k, v := range fields {
+		t.fields[k] = v
+	}
+}
+
+// Variables returns a copy of the declared variables and their defaults
+func (t *TaskTemplate) Variables() map[string]string {
+	variablesCopy := make(map[string]string, len(t.variables))
+	for k, v := range t.variables {
+		variablesCopy[k] = v
+	}
+	return variablesCopy
+}
+
+// SetVariable declares a variable with a default value; an empty default
+// means the value must be supplied when a task is created
+func (t *TaskTemplate) SetVariable(name string, defaultValue string) {
+	t.variables[name] = defaultValue
+}
+
+// RenderedTask holds the values a task is created with
+type RenderedTask struct {
+	Title       string
+	Description string
+	Fields      map[string]string
+}
+
+// Render fills in the placeholders. Values come from values, then variable
+// defaults, then the built-in date variable (YYYY-MM-DD of now).
+func (t *TaskTemplate) Render(values map[string]string, now time.Time) (*RenderedTask, error) {
+	missing := make(map[string]bool)
+	lookup := func(name string) (string, bool) {
+		if value, ok := values[name]; ok && value != "" {
+			return value, true
+		}
+		if value := t.variables[name]; value != "" {
+			return value, true
+		}
+		if name == "date" {
+			return now.Format(FieldDateFormat), true
+		}
+		missing[name] = true
+		return "", false
+	}
+	expand := func(text string) string {
+		return templateVariablePattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+			name := templateVariablePattern.FindStringSubmatch(placeholder)[1]
+			value, _ := lookup(name)
+			return value
+		})
+	}
+
+	rendered := &RenderedTask{
+		Title:       expand(t.title),
+		Description: expand(t.description),
+		Fields:      make(map[string]string, len(t.fields)),
+	}
+	for name, value := range t.fields {
+		rendered.Fields[name] = expand(value)
+	}
+
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("%w: %s", ErrMissingTemplateVariable, strings.Join(names, ", "))
+	}
+
+	return rendered, nil
+}
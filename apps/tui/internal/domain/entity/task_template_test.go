@@ -0,0 +1,102 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTaskTemplateRender(t *testing.T) {
+	template, err := NewTaskTemplate("bug", "Fix {{ component }} bug {{id}}", "Reported {{date}} by {{reporter}}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	template.SetVariable("reporter", "support")
+	template.SetVariable("id", "")
+	template.SetFields(map[string]string{"component": "{{component}}", "points": "3"})
+	now := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		values          map[string]string
+		wantTitle       string
+		wantDescription string
+		wantComponent   string
+		wantMissing     string // the missing variables the error lists
+	}{
+		{
+			name:            "values and defaults",
+			values:          map[string]string{"component": "api", "id": "42"},
+			wantTitle:       "Fix api bug 42",
+			wantDescription: "Reported 2026-03-04 by support",
+			wantComponent:   "api",
+		},
+		{
+			name:            "value over default",
+			values:          map[string]string{"component": "ui", "id": "7", "reporter": "ana"},
+			wantTitle:       "Fix ui bug 7",
+			wantDescription: "Reported 2026-03-04 by ana",
+			wantComponent:   "ui",
+		},
+		{
+			name:            "empty value falls back to the default",
+			values:          map[string]string{"component": "cli", "id": "1", "reporter": ""},
+			wantTitle:       "Fix cli bug 1",
+			wantDescription: "Reported 2026-03-04 by support",
+			wantComponent:   "cli",
+		},
+		{
+			name:            "value for the date",
+			values:          map[string]string{"component": "api", "id": "2", "date": "yesterday"},
+			wantTitle:       "Fix api bug 2",
+			wantDescription: "Reported yesterday by support",
+			wantComponent:   "api",
+		},
+		{
+			name:        "missing variables",
+			values:      map[string]string{"id": ""},
+			wantMissing: "component, id",
+		},
+		{
+			name:        "no values",
+			values:      nil,
+			wantMissing: "component, id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered, err := template.Render(tt.values, now)
+			if tt.wantMissing != "" {
+				if !errors.Is(err, ErrMissingTemplateVariable) || !strings.HasSuffix(err.Error(), ": "+tt.wantMissing) {
+					t.Fatalf("expected %s to be reported missing, got %v", tt.wantMissing, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("render failed: %v", err)
+			}
+			if rendered.Title != tt.wantTitle || rendered.Description != tt.wantDescription {
+				t.Errorf("expected %q and %q, got %q and %q", tt.wantTitle, tt.wantDescription, rendered.Title, rendered.Description)
+			}
+			if rendered.Fields["component"] != tt.wantComponent || rendered.Fields["points"] != "3" {
+				t.Errorf("expected component %s and 3 points, got %v", tt.wantComponent, rendered.Fields)
+			}
+		})
+	}
+
+	// A variable used only in a field value is required too
+	fieldOnly, err := NewTaskTemplate("review", "Review", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fieldOnly.SetFields(map[string]string{"reviewer": "{{reviewer}}"})
+	if _, err := fieldOnly.Render(nil, now); !errors.Is(err, ErrMissingTemplateVariable) {
+		t.Errorf("expected the field variable to be reported missing, got %v", err)
+	}
+
+	// Rendering leaves the template alone
+	if got := template.Fields()["component"]; got != "{{component}}" {
+		t.Errorf("expected the template field to keep its placeholder, got %q", got)
+	}
+}
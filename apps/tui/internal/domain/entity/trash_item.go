@@ -0,0 +1,133 @@
+package entity
+
+import (
+	"time"
+)
+
+// TrashItemType identifies what kind of entity a trash item holds
+type TrashItemType string
+
+const (
+	TrashItemTask   TrashItemType = "task"
+	TrashItemColumn TrashItemType = "column"
+	TrashItemNote   TrashItemType = "note"
+)
+
+// IsValid checks if the trash item type is known
+func (t TrashItemType) IsValid() bool {
+	switch t {
+	case TrashItemTask, TrashItemColumn, TrashItemNote:
+		return true
+	}
+	return false
+}
+
+// TrashedTask records a task held by a trash item and the column it was deleted from
+type TrashedTask struct {
+	TaskID string
+	Column string
+}
+
+// TrashItem is a deleted task, column or note kept until it is restored or purged
+type TrashItem struct {
+	id          string
+	itemType    TrashItemType
+	title       string
+	originalID  string
+	projectSlug string
+	boardID     string
+	columnName  string
+	tasks       []TrashedTask
+	deletedAt   time.Time
+}
+
+// NewTrashItem creates a new TrashItem
+func NewTrashItem(id string, itemType TrashItemType, title string, originalID string) (*TrashItem, error) {
+	if id == "" {
+		return nil, ErrInvalidTrashItemID
+	}
+	if !itemType.IsValid() {
+		return nil, ErrInvalidTrashItemType
+	}
+
+	return &TrashItem{
+		id:         id,
+		itemType:   itemType,
+		title:      title,
+		originalID: originalID,
+		tasks:      make([]TrashedTask, 0),
+		deletedAt:  time.Now(),
+	}, nil
+}
+
+// ID returns the trash item ID
+func (t *TrashItem) ID() string {
+	return t.id
+}
+
+// Type returns what kind of entity was deleted
+func (t *TrashItem) Type() TrashItemType {
+	return t.itemType
+}
+
+// Title returns a human readable label for the deleted entity
+func (t *TrashItem) Title() string {
+	return t.title
+}
+
+// OriginalID returns the ID the entity had before it was deleted
+func (t *TrashItem) OriginalID() string {
+	return t.originalID
+}
+
+// ProjectSlug returns the slug of the project whose trash holds the item (empty for global notes)
+func (t *TrashItem) ProjectSlug() string {
+	return t.projectSlug
+}
+
+// BoardID returns the board the entity was deleted from
+func (t *TrashItem) BoardID() string {
+	return t.boardID
+}
+
+// ColumnName returns the column the entity was deleted from
+func (t *TrashItem) ColumnName() string {
+	return t.columnName
+}
+
+// Tasks returns the tasks held by the item
+func (t *TrashItem) Tasks() []TrashedTask {
+	tasks := make([]TrashedTask, len(t.tasks))
+	copy(tasks, t.tasks)
+	return tasks
+}
+
+// DeletedAt returns when the entity was deleted
+func (t *TrashItem) DeletedAt() time.Time {
+	return t.deletedAt
+}
+
+// SetLocation records where the entity was deleted from
+func (t *TrashItem) SetLocation(projectSlug, boardID, columnName string) {
+	t.projectSlug = projectSlug
+	t.boardID = boardID
+	t.columnName = columnName
+}
+
+// AddTask records a task held by the item
+func (t *TrashItem) AddTask(taskID, column string) {
+	t.tasks = append(t.tasks, TrashedTask{TaskID: taskID, Column: column})
+}
+
+// SetDeletedAt sets the deletion time (used during loading from storage)
+func (t *TrashItem) SetDeletedAt(deletedAt time.Time) {
+	t.deletedAt = deletedAt
+}
+
+// IsExpired checks if the item has been in the trash longer than the retention period
+func (t *TrashItem) IsExpired(retention time.Duration, now time.Time) bool {
+	if retention <= 0 {
+		return false
+	}
+	return now.Sub(t.deletedAt) > retention
+}
@@ -2,6 +2,7 @@ package entity
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -21,6 +22,22 @@ func (w Weekday) String() string {
 	return [...]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}[w]
 }
 
+// ParseWeekday parses a weekday name such as "monday" or "Mon"
+func ParseWeekday(name string) (Weekday, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if len(name) >= 3 {
+		for day := WeekdaySunday; day <= WeekdaySaturday; day++ {
+			if strings.HasPrefix(strings.ToLower(day.String()), name) {
+				return day, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("%w: %q", ErrInvalidWeekday, name)
+}
+
+// DefaultWorkScheduleID identifies the schedule of projects without their own
+const DefaultWorkScheduleID = "default"
+
 type WorkSchedule struct {
 	id          string
 	name        string
@@ -49,6 +66,15 @@ func (t TimeOfDay) String() string {
 	return fmt.Sprintf("%02d:%02d", t.Hour, t.Minute)
 }
 
+// ParseTimeOfDay parses a time of day written as HH:MM
+func ParseTimeOfDay(value string) (TimeOfDay, error) {
+	parsed, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return TimeOfDay{}, fmt.Errorf("%w: %q, use HH:MM", ErrInvalidTimeOfDay, value)
+	}
+	return TimeOfDay{Hour: parsed.Hour(), Minute: parsed.Minute()}, nil
+}
+
 func (t TimeOfDay) ToMinutes() int {
 	return t.Hour*60 + t.Minute
 }
@@ -77,6 +103,32 @@ const (
 	ExceptionTypeOverride ExceptionType = "override"
 )
 
+// IsValid checks if the exception type is known
+func (e ExceptionType) IsValid() bool {
+	switch e {
+	case ExceptionTypeHoliday, ExceptionTypeTimeOff, ExceptionTypeOverride:
+		return true
+	}
+	return false
+}
+
+// Validate checks that an exception has a date and a known type; an override
+// needs working hours that end after they start
+func (e ScheduleException) Validate() error {
+	if e.Date.IsZero() {
+		return fmt.Errorf("%w: missing date", ErrInvalidScheduleException)
+	}
+	if !e.Type.IsValid() {
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidScheduleException, e.Type)
+	}
+	if e.Type == ExceptionTypeOverride {
+		if e.StartTime == nil || e.EndTime == nil || !e.EndTime.After(*e.StartTime) {
+			return fmt.Errorf("%w: an override needs a start and a later end time", ErrInvalidScheduleException)
+		}
+	}
+	return nil
+}
+
 func NewWorkSchedule(id, name string) (*WorkSchedule, error) {
 	if id == "" {
 		return nil, fmt.Errorf("work schedule id cannot be empty")
@@ -122,6 +174,35 @@ func NewDefaultWorkSchedule(id string) *WorkSchedule {
 	return schedule
 }
 
+// NewDaySchedule creates the working hours of a day, with an optional break
+// that must lie within them
+func NewDaySchedule(start, end TimeOfDay, breakStart, breakEnd *TimeOfDay) (*DaySchedule, error) {
+	if !end.After(start) {
+		return nil, fmt.Errorf("%w: %s-%s ends before it starts", ErrInvalidDaySchedule, start, end)
+	}
+	if (breakStart == nil) != (breakEnd == nil) {
+		return nil, fmt.Errorf("%w: a break needs a start and an end", ErrInvalidDaySchedule)
+	}
+	if breakStart != nil {
+		if !breakEnd.After(*breakStart) || breakStart.Before(start) || breakEnd.After(end) {
+			return nil, fmt.Errorf("%w: break %s-%s is not within %s-%s", ErrInvalidDaySchedule, breakStart, breakEnd, start, end)
+		}
+	}
+
+	return &DaySchedule{
+		enabled:    true,
+		startTime:  start,
+		endTime:    end,
+		breakStart: breakStart,
+		breakEnd:   breakEnd,
+	}, nil
+}
+
+// NewDayOff creates a day without working hours
+func NewDayOff() *DaySchedule {
+	return &DaySchedule{enabled: false}
+}
+
 func (w *WorkSchedule) ID() string {
 	return w.id
 }
@@ -146,6 +227,30 @@ func (w *WorkSchedule) ModifiedAt() time.Time {
 	return w.modifiedAt
 }
 
+// Location returns the time zone of the schedule, the local one if it is
+// unknown
+func (w *WorkSchedule) Location() *time.Location {
+	if w.timezone == "" {
+		return time.Local
+	}
+	location, err := time.LoadLocation(w.timezone)
+	if err != nil {
+		return time.Local
+	}
+	return location
+}
+
+// RestoreTimestamps sets the creation and modification times (used during
+// loading from storage)
+func (w *WorkSchedule) RestoreTimestamps(createdAt, modifiedAt time.Time) {
+	if !createdAt.IsZero() {
+		w.createdAt = createdAt
+	}
+	if !modifiedAt.IsZero() {
+		w.modifiedAt = modifiedAt
+	}
+}
+
 func (w *WorkSchedule) SetName(name string) error {
 	if name == "" {
 		return fmt.Errorf("name cannot be empty")
@@ -238,7 +343,10 @@ func (w *WorkSchedule) GetWorkingHours(date time.Time) (*TimeOfDay, *TimeOfDay)
 	return nil, nil
 }
 
+// IsWithinWorkingHours checks if a moment, taken in the schedule's time zone,
+// falls within the working hours of its day and outside the day's break
 func (w *WorkSchedule) IsWithinWorkingHours(t time.Time) bool {
+	t = t.In(w.Location())
 	if !w.IsWorkingDay(t) {
 		return false
 	}
@@ -249,9 +357,20 @@ func (w *WorkSchedule) IsWithinWorkingHours(t time.Time) bool {
 	}
 
 	currentTime := TimeOfDay{Hour: t.Hour(), Minute: t.Minute()}
-	return !currentTime.Before(*start) && currentTime.Before(*end)
+	if currentTime.Before(*start) || !currentTime.Before(*end) {
+		return false
+	}
+
+	weekday := Weekday(t.Weekday())
+	if schedule, ok := w.workDays[weekday]; ok && schedule.breakStart != nil && schedule.breakEnd != nil {
+		return currentTime.Before(*schedule.breakStart) || !currentTime.Before(*schedule.breakEnd)
+	}
+	return true
 }
 
+// GetAvailableMinutes returns the working minutes of a day less the part of
+// the weekday's break that falls within them, which an override of the day's
+// hours may leave out
 func (w *WorkSchedule) GetAvailableMinutes(date time.Time) int {
 	if !w.IsWorkingDay(date) {
 		return 0
@@ -266,8 +385,11 @@ func (w *WorkSchedule) GetAvailableMinutes(date time.Time) int {
 
 	weekday := Weekday(date.Weekday())
 	if schedule, ok := w.workDays[weekday]; ok && schedule.breakStart != nil && schedule.breakEnd != nil {
-		breakMinutes := schedule.breakEnd.ToMinutes() - schedule.breakStart.ToMinutes()
-		totalMinutes -= breakMinutes
+		breakStart := max(schedule.breakStart.ToMinutes(), start.ToMinutes())
+		breakEnd := min(schedule.breakEnd.ToMinutes(), end.ToMinutes())
+		if breakEnd > breakStart {
+			totalMinutes -= breakEnd - breakStart
+		}
 	}
 
 	return totalMinutes
@@ -0,0 +1,90 @@
+package entity
+
+import (
+	"testing"
+	"time"
+)
+
+// newNewYorkSchedule is the default schedule in New York time, with Christmas
+// off and a late shift on a Saturday
+func newNewYorkSchedule(t *testing.T) *WorkSchedule {
+	t.Helper()
+	schedule := NewDefaultWorkSchedule(DefaultWorkScheduleID)
+	schedule.SetTimezone("America/New_York")
+	newYork := schedule.Location()
+	if newYork.String() != "America/New_York" {
+		t.Skip("time zone data for America/New_York is not available")
+	}
+
+	schedule.AddException(ScheduleException{Date: time.Date(2026, 12, 25, 0, 0, 0, 0, newYork), Type: ExceptionTypeHoliday})
+	schedule.AddException(ScheduleException{Date: time.Date(2026, 12, 24, 0, 0, 0, 0, newYork), Type: ExceptionTypeTimeOff})
+	lateStart, lateEnd := TimeOfDay{Hour: 11}, TimeOfDay{Hour: 20}
+	schedule.AddException(ScheduleException{Date: time.Date(2026, 3, 14, 0, 0, 0, 0, newYork), Type: ExceptionTypeOverride, StartTime: &lateStart, EndTime: &lateEnd})
+	return schedule
+}
+
+func TestIsWithinWorkingHours(t *testing.T) {
+	schedule := newNewYorkSchedule(t)
+	newYork := schedule.Location()
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{"working hours", time.Date(2026, 3, 4, 10, 0, 0, 0, newYork), true},
+		{"start is included", time.Date(2026, 3, 4, 9, 0, 0, 0, newYork), true},
+		{"end is excluded", time.Date(2026, 3, 4, 17, 0, 0, 0, newYork), false},
+		{"before work", time.Date(2026, 3, 4, 8, 59, 0, 0, newYork), false},
+		{"lunch break", time.Date(2026, 3, 4, 12, 30, 0, 0, newYork), false},
+		{"end of lunch break", time.Date(2026, 3, 4, 13, 0, 0, 0, newYork), true},
+		{"weekend", time.Date(2026, 3, 7, 10, 0, 0, 0, newYork), false},
+		// 13:30 UTC is 8:30 in New York before daylight saving time starts on
+		// March 8, and 9:30 after
+		{"UTC before the DST change", time.Date(2026, 3, 6, 13, 30, 0, 0, time.UTC), false},
+		{"UTC after the DST change", time.Date(2026, 3, 9, 13, 30, 0, 0, time.UTC), true},
+		{"UTC end of day after the DST change", time.Date(2026, 3, 9, 21, 30, 0, 0, time.UTC), false},
+		{"other zone mapped to New York", time.Date(2026, 3, 4, 16, 30, 0, 0, time.FixedZone("CET", 3600)), true},
+		{"holiday", time.Date(2026, 12, 25, 10, 0, 0, 0, newYork), false},
+		{"time off", time.Date(2026, 12, 24, 10, 0, 0, 0, newYork), false},
+		{"override on a weekend", time.Date(2026, 3, 14, 19, 0, 0, 0, newYork), true},
+		{"before the override", time.Date(2026, 3, 14, 10, 0, 0, 0, newYork), false},
+		{"weekend break does not apply", time.Date(2026, 3, 14, 12, 30, 0, 0, newYork), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := schedule.IsWithinWorkingHours(tt.at); got != tt.want {
+				t.Errorf("expected %v at %v, got %v", tt.want, tt.at.In(newYork), got)
+			}
+		})
+	}
+}
+
+func TestGetAvailableMinutes(t *testing.T) {
+	schedule := newNewYorkSchedule(t)
+	newYork := schedule.Location()
+	shortStart, shortEnd := TimeOfDay{Hour: 13}, TimeOfDay{Hour: 15}
+	schedule.AddException(ScheduleException{Date: time.Date(2026, 3, 5, 0, 0, 0, 0, newYork), Type: ExceptionTypeOverride, StartTime: &shortStart, EndTime: &shortEnd})
+	halfStart, halfEnd := TimeOfDay{Hour: 9}, TimeOfDay{Hour: 12, Minute: 30}
+	schedule.AddException(ScheduleException{Date: time.Date(2026, 3, 6, 0, 0, 0, 0, newYork), Type: ExceptionTypeOverride, StartTime: &halfStart, EndTime: &halfEnd})
+
+	tests := []struct {
+		name string
+		day  time.Time
+		want int
+	}{
+		{"working day less lunch", time.Date(2026, 3, 4, 0, 0, 0, 0, newYork), 7 * 60},
+		{"weekend", time.Date(2026, 3, 7, 0, 0, 0, 0, newYork), 0},
+		{"holiday", time.Date(2026, 12, 25, 0, 0, 0, 0, newYork), 0},
+		{"override on a weekend", time.Date(2026, 3, 14, 0, 0, 0, 0, newYork), 9 * 60},
+		{"override after lunch", time.Date(2026, 3, 5, 0, 0, 0, 0, newYork), 2 * 60},
+		{"override into lunch", time.Date(2026, 3, 6, 0, 0, 0, 0, newYork), 3 * 60},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := schedule.GetAvailableMinutes(tt.day); got != tt.want {
+				t.Errorf("expected %d minutes, got %d", tt.want, got)
+			}
+		})
+	}
+}
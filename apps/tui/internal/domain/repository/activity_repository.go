@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/valueobject"
+)
+
+// ActivityRepository defines the interface for the activity log kept in task folders
+type ActivityRepository interface {
+	// Append adds an entry to the end of a task's activity log
+	Append(ctx context.Context, boardID string, columnName string, taskID *valueobject.TaskID, entry *entity.ActivityEntry) error
+
+	// FindByTask returns a task's activity log, oldest entry first
+	FindByTask(ctx context.Context, boardID string, columnName string, taskID *valueobject.TaskID) ([]*entity.ActivityEntry, error)
+}
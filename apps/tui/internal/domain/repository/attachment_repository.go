@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/valueobject"
+)
+
+// AttachmentRepository defines the interface for files kept in task folders
+type AttachmentRepository interface {
+	// Store copies a file into the task folder under name and describes the stored copy
+	Store(ctx context.Context, boardID string, columnName string, taskID *valueobject.TaskID, sourcePath string, name string) (entity.Attachment, error)
+
+	// Remove deletes an attachment file from the task folder
+	Remove(ctx context.Context, boardID string, columnName string, taskID *valueobject.TaskID, name string) error
+
+	// Path returns the location of an attachment file
+	Path(boardID string, columnName string, taskID *valueobject.TaskID, name string) (string, error)
+}
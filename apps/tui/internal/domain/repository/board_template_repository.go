@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"mkanban/internal/domain/entity"
+)
+
+// BoardTemplateRepository defines the interface for board templates, which are
+// shared by all projects
+type BoardTemplateRepository interface {
+	// Save creates or replaces a template
+	Save(ctx context.Context, template *entity.BoardTemplate) error
+
+	// FindByName retrieves a template by name
+	FindByName(ctx context.Context, name string) (*entity.BoardTemplate, error)
+
+	// FindAll retrieves all templates, sorted by name
+	FindAll(ctx context.Context) ([]*entity.BoardTemplate, error)
+
+	// Delete removes a template
+	Delete(ctx context.Context, name string) error
+}
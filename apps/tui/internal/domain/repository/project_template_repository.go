@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"mkanban/internal/domain/entity"
+)
+
+// ProjectTemplateRepository defines the interface for project templates
+type ProjectTemplateRepository interface {
+	// Save creates or replaces a template
+	Save(ctx context.Context, template *entity.ProjectTemplate) error
+
+	// FindByName retrieves a template by name
+	FindByName(ctx context.Context, name string) (*entity.ProjectTemplate, error)
+
+	// FindAll retrieves all templates, sorted by name
+	FindAll(ctx context.Context) ([]*entity.ProjectTemplate, error)
+
+	// Delete removes a template
+	Delete(ctx context.Context, name string) error
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"mkanban/internal/domain/entity"
+)
+
+// SprintRepository defines the interface for the sprints of projects
+type SprintRepository interface {
+	// Save creates or replaces a sprint
+	Save(ctx context.Context, sprint *entity.Sprint) error
+
+	// FindByID retrieves a sprint of a project
+	FindByID(ctx context.Context, projectID string, sprintID string) (*entity.Sprint, error)
+
+	// FindByProject retrieves the sprints of a project, sorted by start date
+	FindByProject(ctx context.Context, projectID string) ([]*entity.Sprint, error)
+}
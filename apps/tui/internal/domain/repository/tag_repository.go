@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"mkanban/internal/domain/entity"
+)
+
+// TagRepository defines the interface for the tag registries of projects
+type TagRepository interface {
+	// FindByProject retrieves the tag registry of a project; a project without
+	// registered tags has an empty registry
+	FindByProject(ctx context.Context, projectID string) (*entity.TagRegistry, error)
+
+	// Save persists the tag registry of a project
+	Save(ctx context.Context, registry *entity.TagRegistry) error
+}
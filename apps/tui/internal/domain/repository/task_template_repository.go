@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"mkanban/internal/domain/entity"
+)
+
+// TaskTemplateRepository defines the interface for task templates, which are
+// kept per project or globally
+type TaskTemplateRepository interface {
+	// Save creates or replaces a template in its project, or globally
+	Save(ctx context.Context, template *entity.TaskTemplate) error
+
+	// FindByName retrieves a template available to a project; a project
+	// template takes precedence over a global one with the same name
+	FindByName(ctx context.Context, projectID string, name string) (*entity.TaskTemplate, error)
+
+	// FindAll retrieves the templates available to a project, sorted by name.
+	// An empty projectID returns only the global templates.
+	FindAll(ctx context.Context, projectID string) ([]*entity.TaskTemplate, error)
+
+	// Delete removes a template from a project, or globally for an empty projectID
+	Delete(ctx context.Context, projectID string, name string) error
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/valueobject"
+)
+
+// TrashRepository defines the interface for moving deleted entities aside and back
+type TrashRepository interface {
+	// TrashTasks moves task folders of a board into the trash as a single item
+	TrashTasks(ctx context.Context, boardID string, title string, tasks []entity.TrashedTask) (*entity.TrashItem, error)
+
+	// TrashColumn moves a column folder, including its tasks, into the trash
+	TrashColumn(ctx context.Context, boardID string, column *entity.Column) (*entity.TrashItem, error)
+
+	// TrashNote moves a note folder into the trash
+	TrashNote(ctx context.Context, note *entity.Note) (*entity.TrashItem, error)
+
+	// FindByID retrieves a trash item by its ID or a unique ID prefix
+	FindByID(ctx context.Context, id string) (*entity.TrashItem, error)
+
+	// FindAll retrieves all trash items, most recently deleted first
+	FindAll(ctx context.Context) ([]*entity.TrashItem, error)
+
+	// RestoreColumn moves a trashed column folder, without its tasks, back onto the board
+	RestoreColumn(ctx context.Context, item *entity.TrashItem) error
+
+	// RestoreTask moves one trashed task into a column under the given ID.
+	// renamed maps original short IDs to new short IDs so parent links can follow renumbered tasks.
+	RestoreTask(ctx context.Context, item *entity.TrashItem, trashedTaskID string, columnName string, taskID *valueobject.TaskID, renamed map[string]string) error
+
+	// RestoreNote moves a trashed note folder back to where it was deleted from
+	RestoreNote(ctx context.Context, item *entity.TrashItem) error
+
+	// Delete permanently removes a trash item
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"mkanban/internal/domain/entity"
+)
+
+// WorkScheduleRepository defines the interface for work schedules: one default
+// schedule plus optional per-project overrides
+type WorkScheduleRepository interface {
+	// Save creates or replaces the schedule of a project, or the default
+	// schedule for an empty projectID
+	Save(ctx context.Context, projectID string, schedule *entity.WorkSchedule) error
+
+	// Find retrieves the schedule of a project, or the default schedule for an
+	// empty projectID; a project without an override is not found
+	Find(ctx context.Context, projectID string) (*entity.WorkSchedule, error)
+}
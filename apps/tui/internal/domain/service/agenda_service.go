@@ -0,0 +1,316 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+)
+
+// agendaMaxDays bounds the range of a single agenda query
+const agendaMaxDays = 92
+
+// AgendaItemKind identifies why a task is on the agenda
+type AgendaItemKind string
+
+const (
+	// AgendaItemMeeting is a meeting task
+	AgendaItemMeeting AgendaItemKind = "meeting"
+	// AgendaItemBlock is a task scheduled at a time of day
+	AgendaItemBlock AgendaItemKind = "block"
+	// AgendaItemScheduled is a task scheduled for a day but not a time
+	AgendaItemScheduled AgendaItemKind = "scheduled"
+	// AgendaItemDue is an unfinished task due on the day
+	AgendaItemDue AgendaItemKind = "due"
+)
+
+// AgendaItem is a task on a day of the agenda
+type AgendaItem struct {
+	Kind       AgendaItemKind
+	ProjectID  string
+	BoardID    string
+	ColumnName string
+	Task       *entity.Task
+	Start      time.Time // midnight of the day for all-day items
+	Duration   time.Duration
+	AllDay     bool
+	Occurrence bool // a later occurrence of a recurring task
+	Done       bool
+}
+
+// End returns when the item ends
+func (i AgendaItem) End() time.Time {
+	return i.Start.Add(i.Duration)
+}
+
+// TimeSlot is a span of time on the agenda
+type TimeSlot struct {
+	Start time.Time
+	End   time.Time
+}
+
+// AgendaDay is the agenda of a single day with its busy and free working time
+type AgendaDay struct {
+	Date         time.Time
+	WorkingStart *time.Time // nil on days off
+	WorkingEnd   *time.Time
+	Items        []AgendaItem
+	Busy         []TimeSlot
+	Free         []TimeSlot // working time outside breaks and busy slots
+}
+
+// Agenda is the agenda of a range of days
+type Agenda struct {
+	ProjectID string
+	From      time.Time
+	To        time.Time // last day of the range
+	Days      []AgendaDay
+	Overdue   []AgendaItem // unfinished tasks due before now, oldest first
+}
+
+// AgendaService builds day and week agendas from the scheduled, meeting, due
+// and recurring tasks of every board of a project, or of every board
+type AgendaService struct {
+	boardRepo           repository.BoardRepository
+	workScheduleService *WorkScheduleService
+}
+
+// NewAgendaService creates a new AgendaService
+func NewAgendaService(
+	boardRepo repository.BoardRepository,
+	workScheduleService *WorkScheduleService,
+) *AgendaService {
+	return &AgendaService{
+		boardRepo:           boardRepo,
+		workScheduleService: workScheduleService,
+	}
+}
+
+// Agenda returns the agenda of the days from from to to, both included, in
+// the time zone of the project's work schedule. Free and busy time follows
+// the working hours of that schedule; an empty projectID covers every board
+// against the default schedule.
+func (s *AgendaService) Agenda(ctx context.Context, projectID string, from, to time.Time) (*Agenda, error) {
+	schedule, err := s.workScheduleService.Schedule(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	location := schedule.Location()
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, location)
+	to = time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, location)
+	if to.Before(from) {
+		return nil, fmt.Errorf("%w: %s is before %s", entity.ErrInvalidAgendaRange, to.Format("2006-01-02"), from.Format("2006-01-02"))
+	}
+	until := to.AddDate(0, 0, 1)
+	if until.After(from.AddDate(0, 0, agendaMaxDays)) {
+		return nil, fmt.Errorf("%w: at most %d days", entity.ErrInvalidAgendaRange, agendaMaxDays)
+	}
+
+	boards, err := s.boardRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load boards: %w", err)
+	}
+
+	now := time.Now()
+	items := make([]AgendaItem, 0)
+	overdue := make([]AgendaItem, 0)
+	for _, board := range boards {
+		if projectID != "" && board.ProjectID() != projectID {
+			continue
+		}
+		for _, column := range board.Columns() {
+			for _, task := range column.Tasks() {
+				items = append(items, taskAgendaItems(board, column, task, from, until, location)...)
+
+				due := task.DueDate()
+				if due != nil && due.Before(now) && !task.IsMeeting() && !board.IsTaskDone(task.ID()) {
+					overdue = append(overdue, AgendaItem{
+						Kind:       AgendaItemDue,
+						ProjectID:  board.ProjectID(),
+						BoardID:    board.ID(),
+						ColumnName: column.Name(),
+						Task:       task,
+						Start:      due.In(location),
+					})
+				}
+			}
+		}
+	}
+	sort.SliceStable(overdue, func(i, j int) bool {
+		return overdue[i].Start.Before(overdue[j].Start)
+	})
+
+	agenda := &Agenda{
+		ProjectID: projectID,
+		From:      from,
+		To:        to,
+		Days:      make([]AgendaDay, 0, int(until.Sub(from).Hours()/24)+1),
+		Overdue:   overdue,
+	}
+	for day := from; day.Before(until); day = day.AddDate(0, 0, 1) {
+		next := day.AddDate(0, 0, 1)
+		dayItems := make([]AgendaItem, 0)
+		for _, item := range items {
+			if !item.Start.Before(day) && item.Start.Before(next) {
+				dayItems = append(dayItems, item)
+			}
+		}
+		agenda.Days = append(agenda.Days, agendaDay(schedule, day, dayItems))
+	}
+	return agenda, nil
+}
+
+// taskAgendaItems returns the agenda items of a task within [from, until)
+func taskAgendaItems(board *entity.Board, column *entity.Column, task *entity.Task, from, until time.Time, location *time.Location) []AgendaItem {
+	items := make([]AgendaItem, 0)
+	done := board.IsTaskDone(task.ID())
+
+	kind := AgendaItemScheduled
+	if task.IsMeeting() {
+		kind = AgendaItemMeeting
+	}
+
+	var start time.Time
+	allDay := false
+	switch {
+	case task.ScheduledTime() != nil:
+		start = task.ScheduledTime().In(location)
+		if kind == AgendaItemScheduled {
+			kind = AgendaItemBlock
+		}
+	case task.ScheduledDate() != nil:
+		date := task.ScheduledDate()
+		start = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, location)
+		allDay = true
+	}
+
+	if !start.IsZero() {
+		item := AgendaItem{
+			Kind:       kind,
+			ProjectID:  board.ProjectID(),
+			BoardID:    board.ID(),
+			ColumnName: column.Name(),
+			Task:       task,
+			Start:      start,
+			AllDay:     allDay,
+			Done:       done,
+		}
+		if !allDay {
+			item.Duration = plannedDuration(task)
+		}
+
+		if rule := task.Recurrence(); rule != nil {
+			for _, at := range rule.Occurrences(start, from, until) {
+				occurrence := item
+				occurrence.Start = at
+				occurrence.Occurrence = !at.Equal(start)
+				occurrence.Done = done && !occurrence.Occurrence
+				items = append(items, occurrence)
+			}
+		} else if !start.Before(from) && start.Before(until) {
+			items = append(items, item)
+		}
+	}
+
+	if due := task.DueDate(); due != nil && !done && !task.IsMeeting() {
+		dueAt := due.In(location)
+		if !dueAt.Before(from) && dueAt.Before(until) {
+			midnight := time.Date(dueAt.Year(), dueAt.Month(), dueAt.Day(), 0, 0, 0, 0, location)
+			items = append(items, AgendaItem{
+				Kind:       AgendaItemDue,
+				ProjectID:  board.ProjectID(),
+				BoardID:    board.ID(),
+				ColumnName: column.Name(),
+				Task:       task,
+				Start:      dueAt,
+				AllDay:     dueAt.Equal(midnight),
+			})
+		}
+	}
+	return items
+}
+
+// agendaDay sorts the items of a day and works out its busy and free time
+func agendaDay(schedule *entity.WorkSchedule, day time.Time, items []AgendaItem) AgendaDay {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.AllDay != b.AllDay {
+			return a.AllDay
+		}
+		if !a.Start.Equal(b.Start) {
+			return a.Start.Before(b.Start)
+		}
+		return a.Task.Title() < b.Task.Title()
+	})
+
+	agendaDay := AgendaDay{
+		Date:  day,
+		Items: items,
+		Busy:  make([]TimeSlot, 0),
+		Free:  make([]TimeSlot, 0),
+	}
+
+	busy := make([]timeInterval, 0)
+	for _, item := range items {
+		if item.AllDay || item.Kind == AgendaItemDue || item.Duration <= 0 {
+			continue
+		}
+		busy = append(busy, timeInterval{start: item.Start, end: item.End()})
+	}
+	busy = mergeIntervals(busy)
+	for _, interval := range busy {
+		agendaDay.Busy = append(agendaDay.Busy, TimeSlot{Start: interval.start, End: interval.end})
+	}
+
+	if !schedule.IsWorkingDay(day) {
+		return agendaDay
+	}
+	start, end := schedule.GetWorkingHours(day)
+	if start == nil || end == nil {
+		return agendaDay
+	}
+
+	at := func(t entity.TimeOfDay) time.Time {
+		return time.Date(day.Year(), day.Month(), day.Day(), t.Hour, t.Minute, 0, 0, day.Location())
+	}
+	workingStart, workingEnd := at(*start), at(*end)
+	agendaDay.WorkingStart = &workingStart
+	agendaDay.WorkingEnd = &workingEnd
+
+	free := []timeInterval{{start: workingStart, end: workingEnd}}
+	daySchedule := schedule.GetDaySchedule(entity.Weekday(day.Weekday()))
+	if daySchedule.BreakStart() != nil && daySchedule.BreakEnd() != nil {
+		free = subtractInterval(free, timeInterval{start: at(*daySchedule.BreakStart()), end: at(*daySchedule.BreakEnd())})
+	}
+	for _, interval := range busy {
+		free = subtractInterval(free, interval)
+	}
+	for _, interval := range free {
+		agendaDay.Free = append(agendaDay.Free, TimeSlot{Start: interval.start, End: interval.end})
+	}
+	return agendaDay
+}
+
+// mergeIntervals sorts intervals and merges the ones that overlap or touch
+func mergeIntervals(intervals []timeInterval) []timeInterval {
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].start.Before(intervals[j].start)
+	})
+
+	merged := make([]timeInterval, 0, len(intervals))
+	for _, interval := range intervals {
+		last := len(merged) - 1
+		if last >= 0 && !interval.start.After(merged[last].end) {
+			if interval.end.After(merged[last].end) {
+				merged[last].end = interval.end
+			}
+			continue
+		}
+		merged = append(merged, interval)
+	}
+	return merged
+}
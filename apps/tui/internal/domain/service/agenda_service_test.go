@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/valueobject"
+	"mkanban/internal/infrastructure/persistence/filesystem"
+)
+
+// newAgendaTestService returns an agenda service under the default schedule,
+// Monday to Friday 9:00-17:00 with a break from 12:00 to 13:00, and an empty
+// tracker board to add tasks to
+func newAgendaTestService(t *testing.T) (*AgendaService, repository.BoardRepository, *entity.Board) {
+	t.Helper()
+	root := t.TempDir()
+	boardRepo := filesystem.NewBoardRepository(root)
+	s := NewAgendaService(boardRepo, NewWorkScheduleService(filesystem.NewWorkScheduleRepository(root)))
+	return s, boardRepo, newMilestoneTestBoard(t, "work/tracker", "Tracker")
+}
+
+// saveAgendaBoard saves the board with the tasks added to it
+func saveAgendaBoard(t *testing.T, boardRepo repository.BoardRepository, board *entity.Board) {
+	t.Helper()
+	if err := boardRepo.Save(context.Background(), board); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// addAgendaBlock adds a task scheduled at start for duration
+func addAgendaBlock(t *testing.T, board *entity.Board, column, title string, start time.Time, duration time.Duration) *entity.Task {
+	t.Helper()
+	task := addMilestoneTestTask(t, board, column, title, 0)
+	task.SetScheduledDate(startOfDay(start))
+	task.SetScheduledTime(start)
+	task.SetTimeBlock(duration)
+	return task
+}
+
+// slots formats time slots as HH:MM-HH:MM
+func slots(timeSlots []TimeSlot) []string {
+	formatted := make([]string, 0, len(timeSlots))
+	for _, slot := range timeSlots {
+		formatted = append(formatted, slot.Start.Format("15:04")+"-"+slot.End.Format("15:04"))
+	}
+	return formatted
+}
+
+func TestAgendaFreeAndBusy(t *testing.T) {
+	ctx := context.Background()
+	s, boardRepo, board := newAgendaTestService(t)
+	monday := schedulerTestMonday()
+
+	addSchedulerMeeting(t, board, "standup", onDay(monday, 10, 0), time.Hour)
+	addAgendaBlock(t, board, "To Do", "review", onDay(monday, 10, 30), time.Hour)
+	addAgendaBlock(t, board, "To Do", "write", onDay(monday, 14, 0), 30*time.Minute)
+	allDay := addMilestoneTestTask(t, board, "To Do", "plan", 0)
+	allDay.SetScheduledDate(monday)
+	due := addMilestoneTestTask(t, board, "To Do", "report", 0)
+	if err := due.SetDueDate(onDay(monday, 15, 0)); err != nil {
+		t.Fatal(err)
+	}
+	addAgendaBlock(t, board, "To Do", "weekend", onDay(monday.AddDate(0, 0, 5), 10, 0), time.Hour)
+	saveAgendaBoard(t, boardRepo, board)
+
+	agenda, err := s.Agenda(ctx, "work", monday, monday.AddDate(0, 0, 6))
+	if err != nil {
+		t.Fatalf("agenda failed: %v", err)
+	}
+	if len(agenda.Days) != 7 {
+		t.Fatalf("expected 7 days, got %d", len(agenda.Days))
+	}
+
+	day := agenda.Days[0]
+	titles := make([]string, 0, len(day.Items))
+	for _, item := range day.Items {
+		titles = append(titles, item.Task.Title())
+	}
+	if want := []string{"plan", "standup", "review", "write", "report"}; !reflect.DeepEqual(titles, want) {
+		t.Errorf("expected all-day items first, then by start: %v, got %v", want, titles)
+	}
+	// Due tasks and all-day items take no time; overlapping items merge
+	if got, want := slots(day.Busy), []string{"10:00-11:30", "14:00-14:30"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected busy %v, got %v", want, got)
+	}
+	if got, want := slots(day.Free), []string{"09:00-10:00", "11:30-12:00", "13:00-14:00", "14:30-17:00"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected free %v outside the break, got %v", want, got)
+	}
+
+	// A weekend day has busy time but no working hours to be free in
+	saturday := agenda.Days[5]
+	if saturday.WorkingStart != nil || len(saturday.Free) != 0 {
+		t.Errorf("expected no working time on Saturday, got %v and %v", saturday.WorkingStart, slots(saturday.Free))
+	}
+	if got, want := slots(saturday.Busy), []string{"10:00-11:00"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected busy %v on Saturday, got %v", want, got)
+	}
+}
+
+func TestAgendaRecurringTasks(t *testing.T) {
+	ctx := context.Background()
+	s, boardRepo, board := newAgendaTestService(t)
+	monday := schedulerTestMonday()
+
+	daily, err := valueobject.NewRecurrenceRule(valueobject.FrequencyDaily, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	daily.SetCount(3)
+	done := addAgendaBlock(t, board, "Done", "check-mail", onDay(monday, 9, 0), 15*time.Minute)
+	done.SetRecurrence(daily)
+
+	weekly, err := valueobject.NewRecurrenceRule(valueobject.FrequencyWeekly, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	weekly.SetDaysOfWeek([]time.Weekday{time.Tuesday, time.Thursday})
+	// Started the week before the agenda
+	started := addAgendaBlock(t, board, "To Do", "sync", onDay(monday.AddDate(0, 0, -6), 16, 0), 30*time.Minute)
+	started.SetRecurrence(weekly)
+	saveAgendaBoard(t, boardRepo, board)
+
+	agenda, err := s.Agenda(ctx, "work", monday, monday.AddDate(0, 0, 6))
+	if err != nil {
+		t.Fatalf("agenda failed: %v", err)
+	}
+
+	type occurrence struct {
+		title      string
+		day        int
+		occurrence bool
+		done       bool
+	}
+	got := make([]occurrence, 0)
+	for i, day := range agenda.Days {
+		for _, item := range day.Items {
+			got = append(got, occurrence{item.Task.Title(), i, item.Occurrence, item.Done})
+		}
+	}
+	want := []occurrence{
+		{"check-mail", 0, false, true}, // the task itself, done
+		{"check-mail", 1, true, false}, // later occurrences are still to do
+		{"sync", 1, true, false},
+		{"check-mail", 2, true, false},
+		{"sync", 3, true, false},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestAgendaOverdue(t *testing.T) {
+	ctx := context.Background()
+	s, boardRepo, board := newAgendaTestService(t)
+	now := time.Now()
+
+	for _, task := range []struct {
+		title  string
+		column string
+		ago    time.Duration
+	}{
+		{"three-days", "To Do", 72 * time.Hour},
+		{"ten-days", "To Do", 240 * time.Hour},
+		{"one-day", "To Do", 24 * time.Hour},
+		{"finished", "Done", 48 * time.Hour},
+	} {
+		added := addMilestoneTestTask(t, board, task.column, task.title, 0)
+		due := now.Add(-task.ago)
+		added.RestoreDates(now.AddDate(0, -1, 0), now, &due, nil)
+	}
+	meeting := addMilestoneTestTask(t, board, "To Do", "meeting", 0)
+	meeting.SetTaskType(entity.TaskTypeMeeting)
+	meetingDue := now.Add(-time.Hour)
+	meeting.RestoreDates(now.AddDate(0, -1, 0), now, &meetingDue, nil)
+	saveAgendaBoard(t, boardRepo, board)
+
+	agenda, err := s.Agenda(ctx, "work", now, now)
+	if err != nil {
+		t.Fatalf("agenda failed: %v", err)
+	}
+	titles := make([]string, 0, len(agenda.Overdue))
+	for _, item := range agenda.Overdue {
+		titles = append(titles, item.Task.Title())
+	}
+	if want := []string{"ten-days", "three-days", "one-day"}; !reflect.DeepEqual(titles, want) {
+		t.Errorf("expected unfinished tasks oldest first %v, got %v", want, titles)
+	}
+}
+
+func TestAgendaRange(t *testing.T) {
+	ctx := context.Background()
+	s, _, _ := newAgendaTestService(t)
+	from := schedulerTestMonday()
+
+	agenda, err := s.Agenda(ctx, "", from, from.AddDate(0, 0, agendaMaxDays-1))
+	if err != nil {
+		t.Fatalf("expected %d days to be allowed, got %v", agendaMaxDays, err)
+	}
+	if len(agenda.Days) != agendaMaxDays {
+		t.Errorf("expected %d days, got %d", agendaMaxDays, len(agenda.Days))
+	}
+	if _, err := s.Agenda(ctx, "", from, from.AddDate(0, 0, agendaMaxDays)); !errors.Is(err, entity.ErrInvalidAgendaRange) {
+		t.Errorf("expected %d days to be rejected, got %v", agendaMaxDays+1, err)
+	}
+	if _, err := s.Agenda(ctx, "", from, from.AddDate(0, 0, -1)); !errors.Is(err, entity.ErrInvalidAgendaRange) {
+		t.Errorf("expected a range ending before it starts to be rejected, got %v", err)
+	}
+
+	// A single day, given at any time of that day
+	agenda, err = s.Agenda(ctx, "", onDay(from, 18, 0), onDay(from, 8, 0))
+	if err != nil || len(agenda.Days) != 1 {
+		t.Errorf("expected one day, got %v (%v)", agenda, err)
+	}
+}
@@ -0,0 +1,430 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+)
+
+const (
+	// schedulerSlotStep is the granularity block start times are rounded up to
+	schedulerSlotStep = 15 * time.Minute
+	// schedulerReplanDays is how far ahead missed blocks are re-planned
+	schedulerReplanDays = 14
+	// defaultMeetingDuration is how long a meeting without a time block or
+	// estimate keeps its slot busy
+	defaultMeetingDuration = 30 * time.Minute
+)
+
+// PlannedBlock is a time block proposed for a task
+type PlannedBlock struct {
+	BoardID    string
+	ColumnName string
+	Task       *entity.Task
+	Start      time.Time // in the time zone of the project's work schedule
+	Duration   time.Duration
+	MissedAt   *time.Time // start of the block the task missed, if it is re-planned
+	Late       bool       // the block ends after the task's due date
+}
+
+// UnplannedTask is a task the planner could not fit into a block
+type UnplannedTask struct {
+	BoardID string
+	Task    *entity.Task
+	Reason  string
+}
+
+// SchedulePlan is a proposed set of time blocks
+type SchedulePlan struct {
+	From      time.Time
+	Until     time.Time
+	Blocks    []PlannedBlock
+	Unplanned []UnplannedTask
+}
+
+// AutoSchedulerService time-blocks tasks into the free working hours of their
+// project's work schedule. Tasks without a block, and tasks whose block has
+// passed without them being done, are planned by priority and due date into
+// the first free slot long enough for their estimate; meetings and other
+// scheduled blocks, breaks and schedule exceptions are kept free.
+type AutoSchedulerService struct {
+	boardRepo           repository.BoardRepository
+	workScheduleService *WorkScheduleService
+}
+
+// NewAutoSchedulerService creates a new AutoSchedulerService
+func NewAutoSchedulerService(
+	boardRepo repository.BoardRepository,
+	workScheduleService *WorkScheduleService,
+) *AutoSchedulerService {
+	return &AutoSchedulerService{
+		boardRepo:           boardRepo,
+		workScheduleService: workScheduleService,
+	}
+}
+
+// Plan proposes blocks for the tasks of boards, or of every board if boardIDs
+// is empty, over the days starting at from. Nothing is saved.
+func (s *AutoSchedulerService) Plan(ctx context.Context, boardIDs []string, from time.Time, days int) (*SchedulePlan, error) {
+	if days <= 0 {
+		return nil, fmt.Errorf("plan must cover at least one day: %w", entity.ErrInvalidDate)
+	}
+
+	planner, err := s.newPlanner(ctx, from, days)
+	if err != nil {
+		return nil, err
+	}
+
+	boards := planner.boards
+	if len(boardIDs) > 0 {
+		boards = make([]*entity.Board, 0, len(boardIDs))
+		for _, boardID := range boardIDs {
+			board := planner.board(boardID)
+			if board == nil {
+				return nil, fmt.Errorf("board %s: %w", boardID, entity.ErrBoardNotFound)
+			}
+			boards = append(boards, board)
+		}
+	}
+
+	return planner.plan(ctx, planner.candidates(boards, false))
+}
+
+// ReplanMissed moves every block that has passed without its task being done
+// to the next free slot and saves the tasks. It returns the moved blocks and
+// the tasks left without one.
+func (s *AutoSchedulerService) ReplanMissed(ctx context.Context) (*SchedulePlan, error) {
+	planner, err := s.newPlanner(ctx, time.Now(), schedulerReplanDays)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := planner.plan(ctx, planner.candidates(planner.boards, true))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, block := range plan.Blocks {
+		task := block.Task
+		task.SetScheduledDate(startOfDay(block.Start))
+		task.SetScheduledTime(block.Start)
+		task.SetTimeBlock(block.Duration)
+		if err := s.boardRepo.SaveTask(ctx, block.BoardID, block.ColumnName, task); err != nil {
+			return nil, fmt.Errorf("failed to save task %s: %w", task.ID().ShortID(), err)
+		}
+	}
+	return plan, nil
+}
+
+// timeInterval is a half-open span of time [start, end)
+type timeInterval struct {
+	start time.Time
+	end   time.Time
+}
+
+// planCandidate is a task waiting for a block
+type planCandidate struct {
+	board    *entity.Board
+	column   *entity.Column
+	task     *entity.Task
+	duration time.Duration
+	earliest time.Time  // the task's scheduled date, if it has one
+	missedAt *time.Time // start of the missed block
+}
+
+// schedulePlanner holds the state of one planning run
+type schedulePlanner struct {
+	service   *AutoSchedulerService
+	now       time.Time
+	from      time.Time
+	days      int
+	boards    []*entity.Board
+	busy      []timeInterval
+	schedules map[string]*entity.WorkSchedule // projectID -> schedule
+}
+
+// newPlanner loads every board and collects the blocks that are already taken
+func (s *AutoSchedulerService) newPlanner(ctx context.Context, from time.Time, days int) (*schedulePlanner, error) {
+	boards, err := s.boardRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load boards: %w", err)
+	}
+
+	now := time.Now()
+	if from.Before(now) {
+		from = now
+	}
+
+	planner := &schedulePlanner{
+		service:   s,
+		now:       now,
+		from:      from,
+		days:      days,
+		boards:    boards,
+		busy:      make([]timeInterval, 0),
+		schedules: make(map[string]*entity.WorkSchedule),
+	}
+
+	for _, board := range boards {
+		for _, column := range board.Columns() {
+			for _, task := range column.Tasks() {
+				block, ok := taskBlock(task)
+				if !ok {
+					continue
+				}
+				// Meetings always take their time; a missed block is freed
+				// for re-planning
+				if task.TaskType() == entity.TaskTypeMeeting || block.end.After(now) {
+					planner.busy = append(planner.busy, block)
+				}
+			}
+		}
+	}
+	return planner, nil
+}
+
+// board returns a loaded board by ID
+func (p *schedulePlanner) board(boardID string) *entity.Board {
+	for _, board := range p.boards {
+		if board.ID() == boardID {
+			return board
+		}
+	}
+	return nil
+}
+
+// schedule returns the work schedule of a project once per run
+func (p *schedulePlanner) schedule(ctx context.Context, projectID string) (*entity.WorkSchedule, error) {
+	if schedule, ok := p.schedules[projectID]; ok {
+		return schedule, nil
+	}
+	schedule, err := p.service.workScheduleService.Schedule(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	p.schedules[projectID] = schedule
+	return schedule, nil
+}
+
+// candidates collects the unfinished tasks of boards that need a block: tasks
+// without one and tasks whose block was missed, or only the latter
+func (p *schedulePlanner) candidates(boards []*entity.Board, missedOnly bool) []planCandidate {
+	candidates := make([]planCandidate, 0)
+	for _, board := range boards {
+		for _, column := range board.Columns() {
+			for _, task := range column.Tasks() {
+				if task.TaskType() != entity.TaskTypeRegular || board.IsTaskDone(task.ID()) {
+					continue
+				}
+
+				candidate := planCandidate{
+					board:    board,
+					column:   column,
+					task:     task,
+					duration: plannedDuration(task),
+				}
+				if block, ok := taskBlock(task); ok {
+					if block.end.After(p.now) {
+						continue
+					}
+					missedAt := block.start
+					candidate.missedAt = &missedAt
+				} else if missedOnly {
+					continue
+				} else if date := task.ScheduledDate(); date != nil {
+					candidate.earliest = *date
+				}
+				candidates = append(candidates, candidate)
+			}
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i].task, candidates[j].task
+		if a.Priority() != b.Priority() {
+			return a.Priority() > b.Priority()
+		}
+		dueA, dueB := a.DueDate(), b.DueDate()
+		switch {
+		case dueA != nil && dueB != nil && !dueA.Equal(*dueB):
+			return dueA.Before(*dueB)
+		case (dueA == nil) != (dueB == nil):
+			return dueA != nil
+		}
+		return a.CreatedAt().Before(b.CreatedAt())
+	})
+	return candidates
+}
+
+// plan places each candidate, in order, into the first free slot that fits it
+func (p *schedulePlanner) plan(ctx context.Context, candidates []planCandidate) (*SchedulePlan, error) {
+	from := startOfDay(p.from)
+	plan := &SchedulePlan{
+		From:      p.from,
+		Until:     from.AddDate(0, 0, p.days),
+		Blocks:    make([]PlannedBlock, 0),
+		Unplanned: make([]UnplannedTask, 0),
+	}
+
+	for _, candidate := range candidates {
+		if candidate.duration <= 0 {
+			plan.Unplanned = append(plan.Unplanned, UnplannedTask{
+				BoardID: candidate.board.ID(),
+				Task:    candidate.task,
+				Reason:  "no time estimate",
+			})
+			continue
+		}
+
+		schedule, err := p.schedule(ctx, candidate.board.ProjectID())
+		if err != nil {
+			return nil, err
+		}
+
+		slot, ok := p.findSlot(schedule, candidate)
+		if !ok {
+			plan.Unplanned = append(plan.Unplanned, UnplannedTask{
+				BoardID: candidate.board.ID(),
+				Task:    candidate.task,
+				Reason:  fmt.Sprintf("no free slot of %s before %s", candidate.duration, plan.Until.Format("2006-01-02")),
+			})
+			continue
+		}
+
+		p.busy = append(p.busy, slot)
+		block := PlannedBlock{
+			BoardID:    candidate.board.ID(),
+			ColumnName: candidate.column.Name(),
+			Task:       candidate.task,
+			Start:      slot.start,
+			Duration:   candidate.duration,
+			MissedAt:   candidate.missedAt,
+		}
+		if due := candidate.task.DueDate(); due != nil && slot.end.After(*due) {
+			block.Late = true
+		}
+		plan.Blocks = append(plan.Blocks, block)
+	}
+	return plan, nil
+}
+
+// findSlot returns the first free interval of the candidate's length within
+// the working hours of the planned days
+func (p *schedulePlanner) findSlot(schedule *entity.WorkSchedule, candidate planCandidate) (timeInterval, bool) {
+	location := schedule.Location()
+	from := p.from.In(location)
+	notBefore := roundUp(from, schedulerSlotStep)
+	if !candidate.earliest.IsZero() {
+		earliest := candidate.earliest
+		earliest = time.Date(earliest.Year(), earliest.Month(), earliest.Day(), 0, 0, 0, 0, location)
+		if earliest.After(notBefore) {
+			notBefore = earliest
+		}
+	}
+
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, location)
+	for i := 0; i < p.days; i, day = i+1, day.AddDate(0, 0, 1) {
+		for _, free := range p.freeSlots(schedule, day, notBefore) {
+			if free.end.Sub(free.start) >= candidate.duration {
+				return timeInterval{start: free.start, end: free.start.Add(candidate.duration)}, true
+			}
+		}
+	}
+	return timeInterval{}, false
+}
+
+// freeSlots returns the working hours of a day, outside its break, that are
+// not taken and not before notBefore
+func (p *schedulePlanner) freeSlots(schedule *entity.WorkSchedule, day time.Time, notBefore time.Time) []timeInterval {
+	if !schedule.IsWorkingDay(day) {
+		return nil
+	}
+	start, end := schedule.GetWorkingHours(day)
+	if start == nil || end == nil {
+		return nil
+	}
+
+	at := func(t entity.TimeOfDay) time.Time {
+		return time.Date(day.Year(), day.Month(), day.Day(), t.Hour, t.Minute, 0, 0, day.Location())
+	}
+	free := []timeInterval{{start: at(*start), end: at(*end)}}
+
+	taken := make([]timeInterval, 0, len(p.busy)+2)
+	taken = append(taken, timeInterval{start: at(*start).Add(-24 * time.Hour), end: notBefore})
+	daySchedule := schedule.GetDaySchedule(entity.Weekday(day.Weekday()))
+	if daySchedule.BreakStart() != nil && daySchedule.BreakEnd() != nil {
+		taken = append(taken, timeInterval{start: at(*daySchedule.BreakStart()), end: at(*daySchedule.BreakEnd())})
+	}
+	taken = append(taken, p.busy...)
+
+	for _, interval := range taken {
+		free = subtractInterval(free, interval)
+	}
+
+	// Blocks start on the slot step so plans read as round times
+	slots := make([]timeInterval, 0, len(free))
+	for _, slot := range free {
+		slot.start = roundUp(slot.start, schedulerSlotStep)
+		if slot.end.After(slot.start) {
+			slots = append(slots, slot)
+		}
+	}
+	return slots
+}
+
+// subtractInterval removes taken from each of the free intervals
+func subtractInterval(free []timeInterval, taken timeInterval) []timeInterval {
+	result := make([]timeInterval, 0, len(free)+1)
+	for _, interval := range free {
+		if !taken.start.Before(interval.end) || !taken.end.After(interval.start) {
+			result = append(result, interval)
+			continue
+		}
+		if taken.start.After(interval.start) {
+			result = append(result, timeInterval{start: interval.start, end: taken.start})
+		}
+		if taken.end.Before(interval.end) {
+			result = append(result, timeInterval{start: taken.end, end: interval.end})
+		}
+	}
+	return result
+}
+
+// taskBlock returns the time block a task is scheduled for, if it has one
+func taskBlock(task *entity.Task) (timeInterval, bool) {
+	start := task.ScheduledTime()
+	if start == nil {
+		return timeInterval{}, false
+	}
+	duration := plannedDuration(task)
+	if duration == 0 && task.IsMeeting() {
+		duration = defaultMeetingDuration
+	}
+	return timeInterval{start: *start, end: start.Add(duration)}, true
+}
+
+// plannedDuration is the length of a task's block: its time block if set,
+// else its estimate
+func plannedDuration(task *entity.Task) time.Duration {
+	if block := task.TimeBlock(); block != nil {
+		return *block
+	}
+	if estimate := task.EstimatedTime(); estimate != nil {
+		return *estimate
+	}
+	return 0
+}
+
+// roundUp rounds a time up to a multiple of step within its day
+func roundUp(t time.Time, step time.Duration) time.Time {
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	offset := t.Sub(midnight)
+	if rest := offset % step; rest != 0 {
+		offset += step - rest
+	}
+	return midnight.Add(offset)
+}
@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/valueobject"
+	"mkanban/internal/infrastructure/persistence/filesystem"
+)
+
+// schedulerTestMonday returns midnight of a Monday at least a week ahead, so
+// plans are not cut short by the current time
+func schedulerTestMonday() time.Time {
+	day := startOfDay(time.Now()).AddDate(0, 0, 7)
+	for day.Weekday() != time.Monday {
+		day = day.AddDate(0, 0, 1)
+	}
+	return day
+}
+
+// onDay returns a time of day on day
+func onDay(day time.Time, hour, minute int) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, day.Location())
+}
+
+// newSchedulerTestBoard creates a board under the default schedule, Monday
+// to Friday 9:00-17:00 with a break from 12:00 to 13:00
+func newSchedulerTestBoard(t *testing.T) (*AutoSchedulerService, repository.BoardRepository, *entity.Board) {
+	t.Helper()
+	root := t.TempDir()
+	boardRepo := filesystem.NewBoardRepository(root)
+
+	board, err := entity.NewBoard("work/tracker", "Tracker", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, name := range []string{"To Do", "Done"} {
+		column, err := entity.NewColumn(name, "", i, 0, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := board.AddColumn(column); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := NewAutoSchedulerService(boardRepo, NewWorkScheduleService(filesystem.NewWorkScheduleRepository(root)))
+	return s, boardRepo, board
+}
+
+// addSchedulerTask adds a task to the To Do column of the board
+func addSchedulerTask(t *testing.T, board *entity.Board, title string, priority valueobject.Priority) *entity.Task {
+	t.Helper()
+	taskID, err := board.GenerateNextTaskID(title)
+	if err != nil {
+		t.Fatal(err)
+	}
+	task, err := entity.NewTask(taskID, title, "", priority, valueobject.StatusTodo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	column, err := board.GetColumn("To Do")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := column.AddTask(task); err != nil {
+		t.Fatal(err)
+	}
+	return task
+}
+
+// addSchedulerEstimate adds a task with a time estimate
+func addSchedulerEstimate(t *testing.T, board *entity.Board, title string, priority valueobject.Priority, estimate time.Duration) {
+	t.Helper()
+	addSchedulerTask(t, board, title, priority).SetEstimatedTime(estimate)
+}
+
+// addSchedulerMeeting adds a meeting starting at start, lasting duration if
+// it is not zero
+func addSchedulerMeeting(t *testing.T, board *entity.Board, title string, start time.Time, duration time.Duration) {
+	t.Helper()
+	task := addSchedulerTask(t, board, title, valueobject.PriorityMedium)
+	task.SetTaskType(entity.TaskTypeMeeting)
+	task.SetScheduledDate(startOfDay(start))
+	task.SetScheduledTime(start)
+	if duration > 0 {
+		task.SetTimeBlock(duration)
+	}
+}
+
+// plannedStarts returns the planned start of each task by title
+func plannedStarts(plan *SchedulePlan) map[string]time.Time {
+	starts := make(map[string]time.Time, len(plan.Blocks))
+	for _, block := range plan.Blocks {
+		starts[block.Task.Title()] = block.Start
+	}
+	return starts
+}
+
+func TestPlan(t *testing.T) {
+	monday := schedulerTestMonday()
+	tuesday := monday.AddDate(0, 0, 1)
+
+	tests := []struct {
+		name string
+		days int
+		// setup adds tasks and meetings to the board
+		setup         func(t *testing.T, board *entity.Board)
+		wantStarts    map[string]time.Time
+		wantUnplanned map[string]string // title -> part of the reason
+	}{
+		{
+			name: "break kept free",
+			days: 1,
+			setup: func(t *testing.T, board *entity.Board) {
+				addSchedulerEstimate(t, board, "morning", valueobject.PriorityHigh, 3*time.Hour)
+				addSchedulerEstimate(t, board, "across-break", valueobject.PriorityMedium, 2*time.Hour)
+				addSchedulerEstimate(t, board, "afternoon", valueobject.PriorityLow, 2*time.Hour)
+			},
+			wantStarts: map[string]time.Time{
+				"morning":      onDay(monday, 9, 0),
+				"across-break": onDay(monday, 13, 0),
+				"afternoon":    onDay(monday, 15, 0),
+			},
+		},
+		{
+			name: "overlapping meetings",
+			days: 1,
+			setup: func(t *testing.T, board *entity.Board) {
+				// Together busy from 10:00 to 11:30
+				addSchedulerMeeting(t, board, "standup", onDay(monday, 10, 0), time.Hour)
+				addSchedulerMeeting(t, board, "review", onDay(monday, 10, 30), time.Hour)
+				// Without a time block, busy for the default 30 minutes
+				addSchedulerMeeting(t, board, "sync", onDay(monday, 14, 0), 0)
+
+				addSchedulerEstimate(t, board, "first", valueobject.PriorityHigh, time.Hour)
+				addSchedulerEstimate(t, board, "too-long-before-break", valueobject.PriorityMedium, 45*time.Minute)
+				addSchedulerEstimate(t, board, "fits-before-break", valueobject.PriorityLow, 30*time.Minute)
+				addSchedulerEstimate(t, board, "after-sync", valueobject.PriorityLow, time.Hour)
+			},
+			wantStarts: map[string]time.Time{
+				"first":                 onDay(monday, 9, 0),
+				"too-long-before-break": onDay(monday, 13, 0),
+				"fits-before-break":     onDay(monday, 11, 30),
+				"after-sync":            onDay(monday, 14, 30),
+			},
+		},
+		{
+			name: "day with no room",
+			days: 2,
+			setup: func(t *testing.T, board *entity.Board) {
+				addSchedulerMeeting(t, board, "workshop", onDay(monday, 9, 0), 3*time.Hour)
+				addSchedulerMeeting(t, board, "offsite", onDay(monday, 13, 0), 4*time.Hour)
+
+				addSchedulerEstimate(t, board, "next-day", valueobject.PriorityHigh, 30*time.Minute)
+				addSchedulerEstimate(t, board, "longer-than-a-day", valueobject.PriorityMedium, 8*time.Hour)
+				addSchedulerTask(t, board, "no-estimate", valueobject.PriorityLow)
+			},
+			wantStarts: map[string]time.Time{
+				"next-day": onDay(tuesday, 9, 0),
+			},
+			wantUnplanned: map[string]string{
+				"longer-than-a-day": "no free slot",
+				"no-estimate":       "no time estimate",
+			},
+		},
+		{
+			name: "no room at all",
+			days: 1,
+			setup: func(t *testing.T, board *entity.Board) {
+				addSchedulerMeeting(t, board, "workshop", onDay(monday, 9, 0), 8*time.Hour)
+				addSchedulerEstimate(t, board, "waiting", valueobject.PriorityHigh, 15*time.Minute)
+			},
+			wantStarts: map[string]time.Time{},
+			wantUnplanned: map[string]string{
+				"waiting": "no free slot",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s, boardRepo, board := newSchedulerTestBoard(t)
+			tt.setup(t, board)
+			if err := boardRepo.Save(ctx, board); err != nil {
+				t.Fatal(err)
+			}
+
+			plan, err := s.Plan(ctx, nil, monday, tt.days)
+			if err != nil {
+				t.Fatalf("plan failed: %v", err)
+			}
+
+			starts := plannedStarts(plan)
+			if len(starts) != len(tt.wantStarts) {
+				t.Errorf("expected %d blocks, got %v", len(tt.wantStarts), starts)
+			}
+			for title, want := range tt.wantStarts {
+				if got, ok := starts[title]; !ok || !got.Equal(want) {
+					t.Errorf("%s: expected a block at %s, got %s", title, want.Format("Mon 15:04"), got.Format("Mon 15:04"))
+				}
+			}
+
+			if len(plan.Unplanned) != len(tt.wantUnplanned) {
+				t.Errorf("expected %d unplanned tasks, got %+v", len(tt.wantUnplanned), plan.Unplanned)
+			}
+			for _, unplanned := range plan.Unplanned {
+				reason, ok := tt.wantUnplanned[unplanned.Task.Title()]
+				if !ok || !strings.Contains(unplanned.Reason, reason) {
+					t.Errorf("%s: expected to be unplanned for %q, got %q", unplanned.Task.Title(), reason, unplanned.Reason)
+				}
+			}
+		})
+	}
+}
+
+func TestReplanMissed(t *testing.T) {
+	ctx := context.Background()
+	s, boardRepo, board := newSchedulerTestBoard(t)
+
+	missedAt := onDay(startOfDay(time.Now()).AddDate(0, 0, -3), 9, 0)
+	missed := addSchedulerTask(t, board, "missed", valueobject.PriorityHigh)
+	missed.SetScheduledDate(startOfDay(missedAt))
+	missed.SetScheduledTime(missedAt)
+	missed.SetTimeBlock(time.Hour)
+	// Neither a past meeting nor a task without a block is re-planned
+	addSchedulerMeeting(t, board, "past-meeting", missedAt, time.Hour)
+	addSchedulerEstimate(t, board, "unplanned", valueobject.PriorityHigh, time.Hour)
+	if err := boardRepo.Save(ctx, board); err != nil {
+		t.Fatal(err)
+	}
+
+	before := time.Now()
+	plan, err := s.ReplanMissed(ctx)
+	if err != nil {
+		t.Fatalf("replan failed: %v", err)
+	}
+	if len(plan.Blocks) != 1 || plan.Blocks[0].Task.Title() != "missed" {
+		t.Fatalf("expected only the missed block to be moved, got %+v", plan.Blocks)
+	}
+	block := plan.Blocks[0]
+	if block.MissedAt == nil || !block.MissedAt.Equal(missedAt) {
+		t.Errorf("expected the missed block at %v to be reported, got %v", missedAt, block.MissedAt)
+	}
+	if block.Start.Before(before) || block.Duration != time.Hour {
+		t.Errorf("expected an hour from now on, got %v for %v", block.Start, block.Duration)
+	}
+
+	loaded, err := boardRepo.FindByID(ctx, board.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, task := range loaded.Columns()[0].Tasks() {
+		if task.Title() != "missed" {
+			continue
+		}
+		if task.ScheduledTime() == nil || !task.ScheduledTime().Equal(block.Start) {
+			t.Errorf("expected the new block to be saved, got %v", task.ScheduledTime())
+		}
+	}
+}
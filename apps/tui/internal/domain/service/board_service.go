@@ -8,6 +8,7 @@ import (
 	"mkanban/internal/domain/valueobject"
 	"mkanban/internal/infrastructure/config"
 	"mkanban/pkg/slug"
+	"time"
 )
 
 // BoardService provides high-level domain operations for boards
@@ -129,7 +130,27 @@ func (s *BoardService) AddColumnToBoard(
 	return board, nil
 }
 
-// CreateTask creates a new task in a specific column
+// SetBoardFields replaces the custom field schema of a board
+func (s *BoardService) SetBoardFields(ctx context.Context, boardID string, fields []entity.FieldDefinition) (*entity.Board, error) {
+	board, err := s.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := board.SetFields(fields); err != nil {
+		return nil, err
+	}
+
+	if err := s.boardRepo.Save(ctx, board); err != nil {
+		return nil, fmt.Errorf("failed to save board: %w", err)
+	}
+
+	return board, nil
+}
+
+// CreateTask creates a new task in a specific column. The board's required
+// fields are not enforced, so tasks created on the user's behalf (action
+// items, seed tasks, imports) don't fail on a schema they know nothing about.
 func (s *BoardService) CreateTask(
 	ctx context.Context,
 	boardID string,
@@ -137,6 +158,35 @@ func (s *BoardService) CreateTask(
 	title string,
 	description string,
 	priority valueobject.Priority,
+	fields map[string]string,
+) (*entity.Board, *entity.Task, error) {
+	return s.createTask(ctx, boardID, columnName, title, description, priority, fields, false)
+}
+
+// CreateTaskWithRequiredFields creates a new task in a specific column like
+// CreateTask, and requires every required field of the board to be set. It is
+// used when the user creates a task.
+func (s *BoardService) CreateTaskWithRequiredFields(
+	ctx context.Context,
+	boardID string,
+	columnName string,
+	title string,
+	description string,
+	priority valueobject.Priority,
+	fields map[string]string,
+) (*entity.Board, *entity.Task, error) {
+	return s.createTask(ctx, boardID, columnName, title, description, priority, fields, true)
+}
+
+func (s *BoardService) createTask(
+	ctx context.Context,
+	boardID string,
+	columnName string,
+	title string,
+	description string,
+	priority valueobject.Priority,
+	fields map[string]string,
+	requireFields bool,
 ) (*entity.Board, *entity.Task, error) {
 	// Load board
 	board, err := s.boardRepo.FindByID(ctx, boardID)
@@ -160,6 +210,17 @@ func (s *BoardService) CreateTask(
 		return nil, nil, entity.ErrWIPLimitExceeded
 	}
 
+	// Validate custom fields against the board schema
+	resolvedFields, err := board.ResolveFields(nil, fields)
+	if err != nil {
+		return nil, nil, err
+	}
+	if requireFields {
+		if err := board.CheckRequiredFields(resolvedFields); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	// Generate task ID
 	taskSlug := slug.Generate(title)
 	taskID, err := board.GenerateNextTaskID(taskSlug)
@@ -172,6 +233,7 @@ func (s *BoardService) CreateTask(
 	if err != nil {
 		return nil, nil, err
 	}
+	task.SetFields(resolvedFields)
 
 	// Add task to column
 	if err := column.AddTask(task); err != nil {
@@ -256,36 +318,20 @@ func (s *BoardService) MoveTask(
 
 	// If this task has a parent, update the parent's checkbox state
 	if task.IsSubtask() {
-		parentTask, _, err := board.FindTask(task.ParentID())
-		if err == nil {
-			// Determine checkbox state based on target column
-			var checkboxState CheckboxState
-			switch targetColumnName {
-			case "Done":
-				checkboxState = CheckboxDone
-			case "In Progress":
-				checkboxState = CheckboxInProgress
-			default:
-				checkboxState = CheckboxTodo
-			}
-
-			// Update the parent's description
-			updatedDescription := UpdateCheckboxState(
-				parentTask.Description(),
-				taskID.String(),
-				checkboxState,
-			)
-			parentTask.UpdateDescription(updatedDescription)
-
-			// Check if all subtasks are complete
-			if AllCheckboxesComplete(parentTask.Description()) {
-				// Move parent to Done column
-				doneColumn, err := board.GetColumn("Done")
-				if err == nil && doneColumn.CanAddTask() {
-					_ = board.MoveTask(task.ParentID(), "Done")
-				}
-			}
+		// Determine checkbox state based on target column
+		var checkboxState CheckboxState
+		switch targetColumnName {
+		case "Done":
+			checkboxState = CheckboxDone
+		case "In Progress":
+			checkboxState = CheckboxInProgress
+		default:
+			checkboxState = CheckboxTodo
 		}
+		updateParentCheckbox(board, task, checkboxState)
+
+		// Complete the parent, and its own ancestors, once all subtasks are done
+		_ = s.CheckAndCompleteParent(ctx, board, task.ParentID())
 	}
 
 	// Save board
@@ -327,8 +373,9 @@ func (s *BoardService) DeleteTask(
 	return board, nil
 }
 
-// CheckAndCompleteParent checks if all subtasks of a parent are complete
-// and moves the parent to Done if so
+// CheckAndCompleteParent checks if all subtasks of a parent are done, or all
+// checkboxes of its description are checked, and moves the parent to Done if
+// so, then does the same for the parent's parent
 func (s *BoardService) CheckAndCompleteParent(
 	ctx context.Context,
 	board *entity.Board,
@@ -339,19 +386,73 @@ func (s *BoardService) CheckAndCompleteParent(
 		return err
 	}
 
-	// Check if all subtasks are complete
-	if AllCheckboxesComplete(parentTask.Description()) {
-		// Move parent to Done column
-		doneColumn, err := board.GetColumn("Done")
-		if err == nil && doneColumn.CanAddTask() {
-			return board.MoveTask(parentTaskID, "Done")
-		}
+	if board.IsTaskDone(parentTask.ID()) {
+		return nil
+	}
+	if !board.SubtasksDone(parentTask.ID()) && !AllCheckboxesComplete(parentTask.Description()) {
+		return nil
 	}
 
+	// Move parent to Done column
+	doneColumn, err := board.GetColumn("Done")
+	if err != nil || !doneColumn.CanAddTask() {
+		return nil
+	}
+	if err := board.MoveTask(parentTask.ID(), "Done"); err != nil {
+		return err
+	}
+
+	if parentTask.IsSubtask() {
+		updateParentCheckbox(board, parentTask, CheckboxDone)
+		return s.CheckAndCompleteParent(ctx, board, parentTask.ParentID())
+	}
 	return nil
 }
 
-// UpdateTask updates task details
+// SetTaskParent nests a task under another task of the board, or makes it a
+// top-level task if parentID is nil
+func (s *BoardService) SetTaskParent(
+	ctx context.Context,
+	boardID string,
+	taskID *valueobject.TaskID,
+	parentID *valueobject.TaskID,
+) (*entity.Board, *entity.Task, error) {
+	board, err := s.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task, _, err := board.FindTask(taskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := board.SetTaskParent(task.ID(), parentID); err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.boardRepo.Save(ctx, board); err != nil {
+		return nil, nil, fmt.Errorf("failed to save board: %w", err)
+	}
+
+	return board, task, nil
+}
+
+// updateParentCheckbox sets the state of the checkbox linking a subtask from
+// the description of its parent, if there is one
+func updateParentCheckbox(board *entity.Board, task *entity.Task, state CheckboxState) {
+	parentTask, _, err := board.FindTask(task.ParentID())
+	if err != nil {
+		return
+	}
+
+	updatedDescription := UpdateCheckboxState(parentTask.Description(), task.ID().String(), state)
+	if updatedDescription != parentTask.Description() {
+		parentTask.UpdateDescription(updatedDescription)
+	}
+}
+
+// UpdateTask updates task details; nil arguments leave the value unchanged and
+// fields only holds the custom fields to change
 func (s *BoardService) UpdateTask(
 	ctx context.Context,
 	boardID string,
@@ -360,6 +461,8 @@ func (s *BoardService) UpdateTask(
 	description *string,
 	priority *valueobject.Priority,
 	status *valueobject.Status,
+	estimatedTime *time.Duration,
+	fields map[string]string,
 ) (*entity.Board, *entity.Task, error) {
 	// Load board
 	board, err := s.boardRepo.FindByID(ctx, boardID)
@@ -399,6 +502,18 @@ func (s *BoardService) UpdateTask(
 		}
 	}
 
+	if estimatedTime != nil {
+		task.SetEstimatedTime(*estimatedTime)
+	}
+
+	if len(fields) > 0 {
+		resolvedFields, err := board.ResolveFields(task.Fields(), fields)
+		if err != nil {
+			return nil, nil, err
+		}
+		task.SetFields(resolvedFields)
+	}
+
 	// Save board
 	if err := s.boardRepo.Save(ctx, board); err != nil {
 		return nil, nil, fmt.Errorf("failed to save board: %w", err)
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/valueobject"
+	"mkanban/internal/infrastructure/config"
+	"mkanban/internal/infrastructure/persistence/filesystem"
+)
+
+// newRequiredFieldTestService saves a tracker board whose "points" field is
+// required and returns a board service over it
+func newRequiredFieldTestService(t *testing.T) *BoardService {
+	t.Helper()
+	boardRepo := filesystem.NewBoardRepository(t.TempDir())
+	board := newMilestoneTestBoard(t, "work/tracker", "Tracker")
+	err := board.SetFields([]entity.FieldDefinition{
+		{Name: "points", Type: entity.FieldTypeNumber, Required: true},
+		{Name: "note", Type: entity.FieldTypeString},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := boardRepo.Save(context.Background(), board); err != nil {
+		t.Fatal(err)
+	}
+	return NewBoardService(boardRepo, NewValidationService(boardRepo), &config.Config{})
+}
+
+func TestCreateTaskRequiredFields(t *testing.T) {
+	ctx := context.Background()
+	s := newRequiredFieldTestService(t)
+
+	// Internal callers (action items, seed tasks, imports) pass no fields
+	if _, _, err := s.CreateTask(ctx, "work/tracker", "To Do", "Follow up", "", valueobject.PriorityNone, nil); err != nil {
+		t.Errorf("expected an internal caller to create a task without the required field, got %v", err)
+	}
+
+	if _, _, err := s.CreateTaskWithRequiredFields(ctx, "work/tracker", "To Do", "Estimate me", "", valueobject.PriorityNone, map[string]string{"note": "x"}); !errors.Is(err, entity.ErrMissingField) {
+		t.Errorf("expected the user to be asked for the required field, got %v", err)
+	}
+	_, task, err := s.CreateTaskWithRequiredFields(ctx, "work/tracker", "To Do", "Estimated", "", valueobject.PriorityNone, map[string]string{"points": "3"})
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if got := task.Fields()["points"]; got != "3" {
+		t.Errorf("expected points 3, got %q", got)
+	}
+}
+
+func TestUpdateTaskWithoutRequiredField(t *testing.T) {
+	ctx := context.Background()
+	s := newRequiredFieldTestService(t)
+	_, task, err := s.CreateTask(ctx, "work/tracker", "To Do", "Older task", "", valueobject.PriorityNone, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	priority := valueobject.PriorityHigh
+	if _, _, err := s.UpdateTask(ctx, "work/tracker", task.ID(), nil, nil, &priority, nil, nil, nil); err != nil {
+		t.Errorf("expected a task without the required field to be updated, got %v", err)
+	}
+	_, updated, err := s.UpdateTask(ctx, "work/tracker", task.ID(), nil, nil, nil, nil, nil, map[string]string{"note": "waiting"})
+	if err != nil {
+		t.Fatalf("expected another field of the task to be set, got %v", err)
+	}
+	if got := updated.Fields()["note"]; got != "waiting" {
+		t.Errorf("expected the note to be set, got %q", got)
+	}
+
+	if _, _, err := s.UpdateTask(ctx, "work/tracker", task.ID(), nil, nil, nil, nil, nil, map[string]string{"points": "5"}); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if _, _, err := s.UpdateTask(ctx, "work/tracker", task.ID(), nil, nil, nil, nil, nil, map[string]string{"points": ""}); !errors.Is(err, entity.ErrMissingField) {
+		t.Errorf("expected the required field not to be cleared, got %v", err)
+	}
+}
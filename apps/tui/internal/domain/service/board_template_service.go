@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/valueobject"
+	"mkanban/pkg/slug"
+)
+
+// BoardTemplateService creates boards from board templates and templates from boards
+type BoardTemplateService struct {
+	boardRepo    repository.BoardRepository
+	actionRepo   repository.ActionRepository
+	templateRepo repository.BoardTemplateRepository
+	boardService *BoardService
+}
+
+// NewBoardTemplateService creates a new BoardTemplateService
+func NewBoardTemplateService(
+	boardRepo repository.BoardRepository,
+	actionRepo repository.ActionRepository,
+	templateRepo repository.BoardTemplateRepository,
+	boardService *BoardService,
+) *BoardTemplateService {
+	return &BoardTemplateService{
+		boardRepo:    boardRepo,
+		actionRepo:   actionRepo,
+		templateRepo: templateRepo,
+		boardService: boardService,
+	}
+}
+
+// FindTemplate retrieves a board template. The default template is built in
+// unless a saved template replaces it.
+func (s *BoardTemplateService) FindTemplate(ctx context.Context, name string) (*entity.BoardTemplate, error) {
+	template, err := s.templateRepo.FindByName(ctx, name)
+	if err == entity.ErrTemplateNotFound && name == entity.DefaultBoardTemplateName {
+		return entity.NewDefaultBoardTemplate(), nil
+	}
+	return template, err
+}
+
+// CreateBoard creates a new board laid out by the named template
+func (s *BoardTemplateService) CreateBoard(ctx context.Context, projectID, name, description, templateName string) (*entity.Board, error) {
+	// Resolve the template first so a bad name does not leave an empty board behind
+	template, err := s.FindTemplate(ctx, templateName)
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := s.boardService.CreateBoard(ctx, projectID, name, description)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ApplyTemplate(ctx, board, template)
+}
+
+// ApplyTemplate sets up a new, empty board from a template: its columns and
+// field schema, then its seed tasks and board-scoped actions
+func (s *BoardTemplateService) ApplyTemplate(ctx context.Context, board *entity.Board, template *entity.BoardTemplate) (*entity.Board, error) {
+	for _, blueprint := range template.Columns() {
+		var color *valueobject.Color
+		if blueprint.Color != "" {
+			parsed, err := valueobject.NewColor(blueprint.Color)
+			if err != nil {
+				return nil, err
+			}
+			color = parsed
+		}
+
+		column, err := entity.NewColumnWithDisplayName(
+			slug.Generate(blueprint.Name),
+			blueprint.Name,
+			blueprint.Description,
+			blueprint.Order,
+			blueprint.WIPLimit,
+			color,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create column %s: %w", blueprint.Name, err)
+		}
+
+		if err := board.AddColumn(column); err != nil {
+			return nil, fmt.Errorf("failed to add column %s to board: %w", blueprint.Name, err)
+		}
+	}
+	board.ReorderColumns()
+
+	if err := board.SetFields(template.Fields()); err != nil {
+		return nil, err
+	}
+
+	if err := s.boardRepo.Save(ctx, board); err != nil {
+		return nil, fmt.Errorf("failed to save board with columns: %w", err)
+	}
+
+	for _, blueprint := range template.Tasks() {
+		if err := s.createSeedTask(ctx, board, blueprint); err != nil {
+			return nil, fmt.Errorf("failed to create task %q: %w", blueprint.Title, err)
+		}
+	}
+
+	for _, blueprint := range template.Actions() {
+		actionID := slug.Generate(board.ID() + "-" + blueprint.Name)
+		action, err := blueprint.Build(actionID, board.ID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create action %q: %w", blueprint.Name, err)
+		}
+		if err := s.actionRepo.Create(ctx, action); err != nil {
+			return nil, fmt.Errorf("failed to create action %q: %w", blueprint.Name, err)
+		}
+	}
+
+	return s.boardRepo.FindByID(ctx, board.ID())
+}
+
+// createSeedTask creates one seed task of a template on the board
+func (s *BoardTemplateService) createSeedTask(ctx context.Context, board *entity.Board, blueprint entity.TaskBlueprint) error {
+	columnName := blueprint.Column
+	if columnName == "" {
+		firstColumn, err := board.GetColumnByIndex(0)
+		if err != nil {
+			return err
+		}
+		columnName = firstColumn.Name()
+	}
+
+	updatedBoard, task, err := s.boardService.CreateTask(
+		ctx,
+		board.ID(),
+		columnName,
+		blueprint.Title,
+		blueprint.Description,
+		blueprint.Priority,
+		blueprint.Fields,
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(blueprint.Tags) == 0 {
+		return nil
+	}
+
+	for _, tag := range blueprint.Tags {
+		task.AddTag(tag)
+	}
+	_, column, err := updatedBoard.FindTask(task.ID())
+	if err != nil {
+		return err
+	}
+	return s.boardRepo.SaveTask(ctx, board.ID(), column.Name(), task)
+}
+
+// ExportTemplate describes an existing board as a template. With includeTasks,
+// its top-level tasks become seed tasks; subtask links in their descriptions
+// are turned back into plain checkboxes, so subtasks are created afresh.
+func (s *BoardTemplateService) ExportTemplate(ctx context.Context, boardID, name, description string, includeTasks bool) (*entity.BoardTemplate, error) {
+	board, err := s.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := entity.NewBoardTemplate(name, description)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]entity.ColumnBlueprint, 0, board.ColumnCount())
+	tasks := make([]entity.TaskBlueprint, 0)
+	for _, column := range board.Columns() {
+		blueprint := entity.ColumnBlueprint{
+			Name:        column.DisplayName(),
+			Description: column.Description(),
+			Order:       column.Order(),
+			WIPLimit:    column.WIPLimit(),
+		}
+		if column.Color() != nil {
+			blueprint.Color = column.Color().String()
+		}
+		columns = append(columns, blueprint)
+
+		if !includeTasks {
+			continue
+		}
+		for _, task := range column.Tasks() {
+			if task.IsSubtask() {
+				continue
+			}
+			tasks = append(tasks, entity.TaskBlueprint{
+				Title:       task.Title(),
+				Description: RemoveSubtaskLinks(task.Description()),
+				Column:      column.DisplayName(),
+				Priority:    task.Priority(),
+				Tags:        task.Tags(),
+				Fields:      task.Fields(),
+			})
+		}
+	}
+
+	if err := template.SetColumns(columns); err != nil {
+		return nil, err
+	}
+	if err := template.SetFields(board.Fields()); err != nil {
+		return nil, err
+	}
+	if err := template.SetTasks(tasks); err != nil {
+		return nil, err
+	}
+
+	actions, err := s.actionRepo.ListByBoard(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list board actions: %w", err)
+	}
+	blueprints := make([]entity.ActionBlueprint, 0, len(actions))
+	for _, action := range actions {
+		if blueprint, ok := entity.ActionBlueprintFromAction(action); ok {
+			blueprints = append(blueprints, blueprint)
+		}
+	}
+	if err := template.SetActions(blueprints); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/infrastructure/config"
+	"mkanban/internal/infrastructure/persistence/filesystem"
+)
+
+// newBoardTemplateTestService returns a board template service over a data
+// root with a work project
+func newBoardTemplateTestService(t *testing.T) (*BoardTemplateService, repository.BoardTemplateRepository, repository.BoardRepository) {
+	t.Helper()
+	root := t.TempDir()
+	writeTestProject(t, root)
+	cfg := &config.Config{}
+	cfg.Storage.DataPath = root
+
+	boardRepo := filesystem.NewBoardRepository(root)
+	templateRepo := filesystem.NewBoardTemplateRepository(root)
+	boardService := NewBoardService(boardRepo, NewValidationService(boardRepo), cfg)
+	return NewBoardTemplateService(boardRepo, filesystem.NewActionRepository(cfg), templateRepo, boardService), templateRepo, boardRepo
+}
+
+func TestCreateBoardFromTemplate(t *testing.T) {
+	ctx := context.Background()
+	s, templateRepo, _ := newBoardTemplateTestService(t)
+
+	template, err := entity.NewBoardTemplate("scrum", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = template.SetColumns([]entity.ColumnBlueprint{
+		{Name: "Done", Order: 1},
+		{Name: "Backlog", Order: 0, WIPLimit: 20},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := template.SetFields([]entity.FieldDefinition{{Name: "points", Type: entity.FieldTypeNumber, Required: true}}); err != nil {
+		t.Fatal(err)
+	}
+	// Seed tasks need not set the required field
+	err = template.SetTasks([]entity.TaskBlueprint{
+		{Title: "Set up CI", Tags: []string{"infra"}},
+		{Title: "Estimated", Column: "Done", Fields: map[string]string{"points": "2"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := templateRepo.Save(ctx, template); err != nil {
+		t.Fatal(err)
+	}
+
+	board, err := s.CreateBoard(ctx, "work", "Sprint", "", "scrum")
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	columns := board.Columns()
+	if len(columns) != 2 || columns[0].Name() != "backlog" || columns[0].WIPLimit() != 20 || columns[1].Name() != "done" {
+		t.Fatalf("expected the backlog then done columns, got %v", columns)
+	}
+	backlog := columns[0].Tasks()
+	if len(backlog) != 1 || backlog[0].Title() != "Set up CI" || len(backlog[0].Tags()) != 1 || backlog[0].Tags()[0] != "infra" {
+		t.Errorf("expected the tagged seed task in the first column, got %v", backlog)
+	}
+	done := columns[1].Tasks()
+	if len(done) != 1 || done[0].Fields()["points"] != "2" {
+		t.Errorf("expected the estimated seed task in done, got %v", done)
+	}
+}
+
+func TestFindBoardTemplate(t *testing.T) {
+	ctx := context.Background()
+	s, templateRepo, boardRepo := newBoardTemplateTestService(t)
+
+	builtIn, err := s.FindTemplate(ctx, entity.DefaultBoardTemplateName)
+	if err != nil || len(builtIn.Columns()) != 3 {
+		t.Fatalf("expected the built-in default template, got %v (%v)", builtIn, err)
+	}
+
+	saved, err := entity.NewBoardTemplate(entity.DefaultBoardTemplateName, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := saved.SetColumns([]entity.ColumnBlueprint{{Name: "Inbox"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := templateRepo.Save(ctx, saved); err != nil {
+		t.Fatal(err)
+	}
+	found, err := s.FindTemplate(ctx, entity.DefaultBoardTemplateName)
+	if err != nil || len(found.Columns()) != 1 {
+		t.Errorf("expected the saved default template to replace the built-in one, got %v (%v)", found, err)
+	}
+
+	// An unknown template leaves no board behind
+	if _, err := s.CreateBoard(ctx, "work", "Sprint", "", "kanban"); !errors.Is(err, entity.ErrTemplateNotFound) {
+		t.Fatalf("expected an unknown template to be reported, got %v", err)
+	}
+	if exists, err := boardRepo.Exists(ctx, "work/sprint"); err != nil || exists {
+		t.Errorf("expected no board to be created, got %v (%v)", exists, err)
+	}
+}
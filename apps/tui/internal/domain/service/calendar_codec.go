@@ -0,0 +1,12 @@
+package service
+
+// CalendarCodec defines the interface for reading and writing calendar files
+// such as RFC 5545 iCalendar (.ics) feeds
+type CalendarCodec interface {
+	// Encode writes events as a calendar with the display name name
+	Encode(name string, events []CalendarEvent) ([]byte, error)
+
+	// Decode reads the events of a calendar. Events overriding an occurrence
+	// of a recurring event have RecurringID set to the UID of their series.
+	Decode(data []byte) ([]CalendarEvent, error)
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"mkanban/internal/domain/valueobject"
+)
+
+// CalendarEvent describes an event of an external calendar
+type CalendarEvent struct {
+	ID          string // provider-specific, stable for the life of the event
+	UID         string // iCalendar UID, shared by every copy of the event
+	Title       string
+	Description string
+	StartTime   time.Time
+	EndTime     time.Time // exclusive; the day after the last day for all-day events
+	Location    string
+	Attendees   []string // email addresses
+	MeetingLink string
+	IsAllDay    bool
+	Recurring   bool
+	RecurringID string                      // ID of the recurring event this is an instance of
+	Recurrence  *valueobject.RecurrenceRule // rule of a recurring series, when known
+	Updated     time.Time
+}
+
+// CalendarChanges lists what changed in a calendar since a sync token was issued
+type CalendarChanges struct {
+	Events    []CalendarEvent // created or updated events
+	Deleted   []string        // IDs of deleted events
+	SyncToken string          // token to pass to the next Changes call
+}
+
+// CalendarProvider defines the interface for reading and writing events of an
+// external calendar. This abstraction allows for different calendar services
+// (Google Calendar, CalDAV servers, etc.)
+type CalendarProvider interface {
+	// Name returns a short name of the provider, e.g. google or caldav
+	Name() string
+
+	// ListEvents returns the events overlapping [start, end), recurring events
+	// expanded into their instances
+	ListEvents(ctx context.Context, start, end time.Time) ([]CalendarEvent, error)
+
+	// CreateEvent adds an event to the calendar and returns it with its ID set
+	CreateEvent(ctx context.Context, event CalendarEvent) (*CalendarEvent, error)
+
+	// UpdateEvent replaces the event with event.ID
+	UpdateEvent(ctx context.Context, event CalendarEvent) error
+
+	// DeleteEvent removes an event from the calendar
+	DeleteEvent(ctx context.Context, eventID string) error
+
+	// Changes returns the events changed since syncToken was issued. An empty
+	// token returns every event along with a token to start from. Returns
+	// entity.ErrCalendarSyncTokenExpired when the calendar no longer accepts
+	// the token; callers then start over with an empty token.
+	Changes(ctx context.Context, syncToken string) (*CalendarChanges, error)
+}
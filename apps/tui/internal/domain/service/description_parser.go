@@ -174,3 +174,96 @@ func AddSubtaskLink(description, subtaskTitle, taskID, columnName string) string
 
 	return strings.Join(updatedLines, "\n")
 }
+
+// RemoveSubtaskLinks turns linked checkboxes back into plain, unchecked ones,
+// replacing "- [x] [{title}]({taskLink})" with "- [ ] {title}"
+func RemoveSubtaskLinks(description string) string {
+	lines := strings.Split(description, "\n")
+	updatedLines := make([]string, 0, len(lines))
+
+	for _, line := range lines {
+		matches := linkedCheckboxPattern.FindStringSubmatch(line)
+		if matches != nil && len(matches) >= 6 {
+			indent := matches[1]
+			title := matches[3]
+			trailing := matches[5]
+			line = strings.TrimRight(fmt.Sprintf("%s- [ ] %s %s", indent, title, trailing), " ")
+		}
+		updatedLines = append(updatedLines, line)
+	}
+
+	return strings.Join(updatedLines, "\n")
+}
+
+// ActionItemsHeading is the heading of the section of a meeting note that
+// lists its action items
+const ActionItemsHeading = "Action items"
+
+// headingPattern matches markdown headings: # Title, ## Title ##
+var headingPattern = regexp.MustCompile(`^(#{1,6})\s+(.*?)[\s#]*$`)
+
+// ParseActionItems extracts the checked checkboxes of the action items section
+// of a meeting note that are not linked to a task yet
+func ParseActionItems(content string) []string {
+	lines := strings.Split(content, "\n")
+	items := make([]string, 0)
+
+	for i, inSection := range actionItemsSection(lines) {
+		if !inSection {
+			continue
+		}
+		matches := checkboxPattern.FindStringSubmatch(lines[i])
+		if matches == nil {
+			continue
+		}
+		title := strings.TrimSpace(matches[3])
+		if matches[2] == "x" && title != "" && !strings.Contains(title, "](") {
+			items = append(items, title)
+		}
+	}
+
+	return items
+}
+
+// LinkActionItem links the first checked, unlinked action item with the title
+// to the task created for it, replacing "- [x] {title}" with
+// "- [x] [{title}]({taskID})"
+func LinkActionItem(content, title, taskID string) string {
+	lines := strings.Split(content, "\n")
+
+	for i, inSection := range actionItemsSection(lines) {
+		if !inSection {
+			continue
+		}
+		matches := checkboxPattern.FindStringSubmatch(lines[i])
+		if matches != nil && matches[2] == "x" && strings.TrimSpace(matches[3]) == title {
+			lines[i] = fmt.Sprintf("%s- [x] [%s](%s)", matches[1], title, taskID)
+			break
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// actionItemsSection reports for each line whether it belongs to the action
+// items section, which ends at the next heading of the same or a higher level
+func actionItemsSection(lines []string) []bool {
+	inSection := make([]bool, len(lines))
+	level := 0 // level of the section heading, 0 outside of the section
+
+	for i, line := range lines {
+		matches := headingPattern.FindStringSubmatch(line)
+		if matches == nil {
+			inSection[i] = level > 0
+			continue
+		}
+		if level > 0 && len(matches[1]) <= level {
+			level = 0
+		}
+		if level == 0 && strings.EqualFold(matches[2], ActionItemsHeading) {
+			level = len(matches[1])
+		}
+	}
+
+	return inSection
+}
@@ -191,3 +191,49 @@ func TestAddSubtaskLink(t *testing.T) {
 		t.Errorf("expected:\n%s\n\ngot:\n%s", expected, updated)
 	}
 }
+
+func TestParseActionItems(t *testing.T) {
+	content := `# Planning
+
+## Agenda
+- [x] Review last sprint
+
+## Action items
+- [x] Send the slides
+- [ ] Book a room
+  - [x] Update the roadmap
+- [x] [Write minutes](BOA-7-write-minutes)
+### Follow-up
+- [x] Ask legal
+
+## Notes
+- [x] Not an action item`
+
+	expected := []string{"Send the slides", "Update the roadmap", "Ask legal"}
+	result := ParseActionItems(content)
+	if len(result) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, result)
+	}
+	for i := range expected {
+		if result[i] != expected[i] {
+			t.Errorf("expected %q at %d, got %q", expected[i], i, result[i])
+		}
+	}
+
+	if items := ParseActionItems("- [x] No section\n"); len(items) != 0 {
+		t.Errorf("expected no items outside of the section, got %v", items)
+	}
+}
+
+func TestLinkActionItem(t *testing.T) {
+	content := "## Agenda\n- [x] Send the slides\n\n## Action items\n- [x] Send the slides\n- [x] Send the slides"
+	expected := "## Agenda\n- [x] Send the slides\n\n## Action items\n- [x] [Send the slides](BOA-8-send-the-slides)\n- [x] Send the slides"
+
+	result := LinkActionItem(content, "Send the slides", "BOA-8-send-the-slides")
+	if result != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, result)
+	}
+	if items := ParseActionItems(result); len(items) != 1 {
+		t.Errorf("expected the linked item to be skipped, got %v", items)
+	}
+}
@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+)
+
+// DurationStats summarizes a set of durations
+type DurationStats struct {
+	Count   int
+	Average time.Duration
+	P50     time.Duration
+	P85     time.Duration
+	P95     time.Duration
+	Max     time.Duration
+}
+
+// WeeklyThroughput is the number of tasks completed in a week
+type WeeklyThroughput struct {
+	WeekStart time.Time // Monday
+	Completed int
+}
+
+// CumulativeFlowDay is the number of tasks in each column at the end of a day
+type CumulativeFlowDay struct {
+	Date    time.Time
+	Columns map[string]int // column name -> tasks
+}
+
+// CompletedTaskFlow is a task completed within the metrics range
+type CompletedTaskFlow struct {
+	Task        *entity.Task
+	CreatedAt   time.Time
+	StartedAt   *time.Time // nil if the task never left the first column
+	CompletedAt time.Time
+	LeadTime    time.Duration  // created to completed
+	CycleTime   *time.Duration // started to completed
+}
+
+// FlowMetrics are the Kanban flow metrics of a board over a date range
+type FlowMetrics struct {
+	BoardID        string
+	From           time.Time
+	To             time.Time
+	Columns        []string // column names, in board order
+	LeadTime       DurationStats
+	CycleTime      DurationStats
+	Throughput     []WeeklyThroughput
+	CumulativeFlow []CumulativeFlowDay
+	Completed      []CompletedTaskFlow
+}
+
+// FlowMetricsService computes flow metrics from the column history of tasks
+type FlowMetricsService struct {
+	boardRepo repository.BoardRepository
+}
+
+// NewFlowMetricsService creates a new FlowMetricsService
+func NewFlowMetricsService(boardRepo repository.BoardRepository) *FlowMetricsService {
+	return &FlowMetricsService{
+		boardRepo: boardRepo,
+	}
+}
+
+// Calculate computes the flow metrics of a board for the days from and to,
+// both included. A task's cycle starts when it first enters a column after
+// the board's first column and ends when it last entered a Done column.
+func (s *FlowMetricsService) Calculate(ctx context.Context, boardID string, from, to time.Time) (*FlowMetrics, error) {
+	from = startOfDay(from)
+	to = startOfDay(to)
+	if to.Before(from) {
+		return nil, fmt.Errorf("range ends before it starts: %w", entity.ErrInvalidDate)
+	}
+
+	board, err := s.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := &FlowMetrics{
+		BoardID:   board.ID(),
+		From:      from,
+		To:        to,
+		Columns:   make([]string, 0),
+		Completed: make([]CompletedTaskFlow, 0),
+	}
+
+	columns := board.Columns()
+	firstColumn := ""
+	for i, column := range columns {
+		if i == 0 {
+			firstColumn = column.Name()
+		}
+		metrics.Columns = append(metrics.Columns, column.Name())
+	}
+
+	end := to.AddDate(0, 0, 1)
+	visitsByTask := make([][]entity.ColumnVisit, 0)
+	for _, column := range columns {
+		for _, task := range column.Tasks() {
+			visits := task.ColumnVisits(column.Name())
+			visitsByTask = append(visitsByTask, visits)
+
+			if !board.IsTaskDone(task.ID()) {
+				continue
+			}
+			flow, ok := completedTaskFlow(board, task, visits, firstColumn)
+			if ok && !flow.CompletedAt.Before(from) && flow.CompletedAt.Before(end) {
+				metrics.Completed = append(metrics.Completed, flow)
+			}
+		}
+	}
+
+	sort.Slice(metrics.Completed, func(i, j int) bool {
+		return metrics.Completed[i].CompletedAt.Before(metrics.Completed[j].CompletedAt)
+	})
+
+	leadTimes := make([]time.Duration, 0, len(metrics.Completed))
+	cycleTimes := make([]time.Duration, 0, len(metrics.Completed))
+	for _, flow := range metrics.Completed {
+		leadTimes = append(leadTimes, flow.LeadTime)
+		if flow.CycleTime != nil {
+			cycleTimes = append(cycleTimes, *flow.CycleTime)
+		}
+	}
+	metrics.LeadTime = durationStats(leadTimes)
+	metrics.CycleTime = durationStats(cycleTimes)
+
+	metrics.Throughput = weeklyThroughput(metrics.Completed, from, to)
+	metrics.CumulativeFlow = cumulativeFlow(visitsByTask, metrics, from, to)
+
+	return metrics, nil
+}
+
+// completedTaskFlow dates the start and completion of a done task
+func completedTaskFlow(board *entity.Board, task *entity.Task, visits []entity.ColumnVisit, firstColumn string) (CompletedTaskFlow, bool) {
+	flow := CompletedTaskFlow{
+		Task:      task,
+		CreatedAt: task.CreatedAt(),
+	}
+
+	completed := false
+	for i := len(visits) - 1; i >= 0; i-- {
+		if board.IsDoneColumn(visits[i].Column) {
+			flow.CompletedAt = visits[i].EnteredAt
+			completed = true
+			break
+		}
+	}
+	if !completed {
+		// Done by status without being moved to a Done column
+		if task.CompletedDate() == nil {
+			return flow, false
+		}
+		flow.CompletedAt = *task.CompletedDate()
+	}
+
+	for _, visit := range visits {
+		if visit.Column == firstColumn {
+			continue
+		}
+		if !visit.EnteredAt.After(flow.CompletedAt) {
+			startedAt := visit.EnteredAt
+			flow.StartedAt = &startedAt
+		}
+		break
+	}
+
+	flow.LeadTime = flow.CompletedAt.Sub(flow.CreatedAt)
+	if flow.StartedAt != nil {
+		cycleTime := flow.CompletedAt.Sub(*flow.StartedAt)
+		flow.CycleTime = &cycleTime
+	}
+	return flow, true
+}
+
+// weeklyThroughput counts completed tasks per week, Monday to Sunday
+func weeklyThroughput(completed []CompletedTaskFlow, from, to time.Time) []WeeklyThroughput {
+	weeks := make([]WeeklyThroughput, 0)
+	indexes := make(map[int64]int) // week start (unix) -> index in weeks
+	for week := startOfWeek(from); !week.After(to); week = week.AddDate(0, 0, 7) {
+		indexes[week.Unix()] = len(weeks)
+		weeks = append(weeks, WeeklyThroughput{WeekStart: week})
+	}
+
+	for _, flow := range completed {
+		if index, ok := indexes[startOfWeek(flow.CompletedAt.In(from.Location())).Unix()]; ok {
+			weeks[index].Completed++
+		}
+	}
+	return weeks
+}
+
+// cumulativeFlow counts the tasks in each column at the end of every day.
+// Columns that no longer exist but held tasks are appended to metrics.Columns.
+func cumulativeFlow(visitsByTask [][]entity.ColumnVisit, metrics *FlowMetrics, from, to time.Time) []CumulativeFlowDay {
+	known := make(map[string]bool)
+	for _, column := range metrics.Columns {
+		known[column] = true
+	}
+
+	now := time.Now()
+	days := make([]CumulativeFlowDay, 0)
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		point := day.AddDate(0, 0, 1)
+		if point.After(now) {
+			point = now
+		}
+
+		counts := make(map[string]int)
+		for _, column := range metrics.Columns {
+			counts[column] = 0
+		}
+		for _, visits := range visitsByTask {
+			for _, visit := range visits {
+				if visit.EnteredAt.After(point) || (visit.ExitedAt != nil && !visit.ExitedAt.After(point)) {
+					continue
+				}
+				counts[visit.Column]++
+				if !known[visit.Column] {
+					known[visit.Column] = true
+					metrics.Columns = append(metrics.Columns, visit.Column)
+				}
+				break
+			}
+		}
+
+		days = append(days, CumulativeFlowDay{Date: day, Columns: counts})
+	}
+	return days
+}
+
+// durationStats computes the average and nearest-rank percentiles of durations
+func durationStats(durations []time.Duration) DurationStats {
+	stats := DurationStats{Count: len(durations)}
+	if len(durations) == 0 {
+		return stats
+	}
+
+	sorted := make([]time.Duration, len(durations))
+	copy(sorted, durations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var total time.Duration
+	for _, d := range sorted {
+		total += d
+	}
+	stats.Average = total / time.Duration(len(sorted))
+	stats.P50 = percentile(sorted, 50)
+	stats.P85 = percentile(sorted, 85)
+	stats.P95 = percentile(sorted, 95)
+	stats.Max = sorted[len(sorted)-1]
+	return stats
+}
+
+// percentile returns the nearest-rank percentile of sorted durations
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// startOfDay returns midnight of the day of t
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// startOfWeek returns midnight of the Monday of the week of t
+func startOfWeek(t time.Time) time.Time {
+	day := startOfDay(t)
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
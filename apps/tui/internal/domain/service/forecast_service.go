@@ -0,0 +1,289 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+)
+
+const (
+	// forecastHistoryWeeks is how many past weeks of throughput are sampled
+	forecastHistoryWeeks = 12
+	// forecastTrials is the number of Monte Carlo simulations per forecast
+	forecastTrials = 10000
+	// forecastHorizonDays bounds how far a simulation looks ahead
+	forecastHorizonDays = 5 * 365
+)
+
+// CompletionForecast answers when a number of tasks will be done. A date is
+// nil when too few simulations finished within the forecast horizon.
+type CompletionForecast struct {
+	BoardIDs     []string
+	Tasks        int
+	HistoryWeeks int // past weeks with working days that were sampled
+	Trials       int
+	P50          *time.Time // done by this date in 50% of simulations
+	P85          *time.Time
+	P95          *time.Time
+}
+
+// ThroughputForecast answers how many tasks will be done by a date
+type ThroughputForecast struct {
+	BoardIDs     []string
+	Date         time.Time
+	HistoryWeeks int
+	Trials       int
+	P50          int // at least this many tasks in 50% of simulations
+	P85          int
+	P95          int
+}
+
+// ForecastService forecasts delivery with Monte Carlo simulations over the
+// past weekly throughput of boards. Only working days of the schedule count:
+// each simulated day adds the per-working-day rate of a sampled past week.
+type ForecastService struct {
+	boardRepo           repository.BoardRepository
+	flowMetricsService  *FlowMetricsService
+	workScheduleService *WorkScheduleService
+	seed                func() int64 // seeds the simulations of each forecast
+}
+
+// NewForecastService creates a new ForecastService
+func NewForecastService(
+	boardRepo repository.BoardRepository,
+	flowMetricsService *FlowMetricsService,
+	workScheduleService *WorkScheduleService,
+) *ForecastService {
+	return &ForecastService{
+		boardRepo:           boardRepo,
+		flowMetricsService:  flowMetricsService,
+		workScheduleService: workScheduleService,
+		seed:                func() int64 { return time.Now().UnixNano() },
+	}
+}
+
+// ForecastCompletion forecasts when a number of tasks on boards will be done.
+// A tasks count of zero or less forecasts the unfinished tasks of the boards.
+// A nil schedule means the schedule of the first board's project.
+func (s *ForecastService) ForecastCompletion(ctx context.Context, boardIDs []string, tasks int, schedule *entity.WorkSchedule) (*CompletionForecast, error) {
+	if schedule == nil {
+		var err error
+		if schedule, err = s.boardSchedule(ctx, boardIDs); err != nil {
+			return nil, err
+		}
+	}
+	if tasks <= 0 {
+		unfinished, err := s.unfinishedTasks(ctx, boardIDs)
+		if err != nil {
+			return nil, err
+		}
+		tasks = unfinished
+	}
+
+	rates, err := s.weeklyRates(ctx, boardIDs, schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	forecast := &CompletionForecast{
+		BoardIDs:     boardIDs,
+		Tasks:        tasks,
+		HistoryWeeks: len(rates),
+		Trials:       forecastTrials,
+	}
+
+	today := startOfDay(time.Now())
+	if tasks == 0 {
+		forecast.P50, forecast.P85, forecast.P95 = &today, &today, &today
+		return forecast, nil
+	}
+
+	working := workingDayMask(schedule, today, forecastHorizonDays)
+	rng := rand.New(rand.NewSource(s.seed()))
+	finished := make([]int, 0, forecastTrials) // days from today
+	for trial := 0; trial < forecastTrials; trial++ {
+		done := 0.0
+		rate := 0.0
+		for day := 0; day < forecastHorizonDays; day++ {
+			if day%7 == 0 {
+				rate = rates[rng.Intn(len(rates))]
+			}
+			if !working[day] {
+				continue
+			}
+			done += rate
+			if done >= float64(tasks)-1e-9 {
+				finished = append(finished, day)
+				break
+			}
+		}
+	}
+	sort.Ints(finished)
+
+	dateAt := func(confidence int) *time.Time {
+		// Simulations that never finished count as later than any that did
+		index := int(math.Ceil(float64(confidence*forecastTrials)/100)) - 1
+		if index >= len(finished) {
+			return nil
+		}
+		date := today.AddDate(0, 0, finished[index])
+		return &date
+	}
+	forecast.P50, forecast.P85, forecast.P95 = dateAt(50), dateAt(85), dateAt(95)
+	return forecast, nil
+}
+
+// ForecastThroughput forecasts how many tasks of boards will be done from
+// today up to and including date. A nil schedule means the schedule of the
+// first board's project.
+func (s *ForecastService) ForecastThroughput(ctx context.Context, boardIDs []string, date time.Time, schedule *entity.WorkSchedule) (*ThroughputForecast, error) {
+	if schedule == nil {
+		var err error
+		if schedule, err = s.boardSchedule(ctx, boardIDs); err != nil {
+			return nil, err
+		}
+	}
+	today := startOfDay(time.Now())
+	date = startOfDay(date)
+	if date.Before(today) {
+		return nil, fmt.Errorf("forecast date is in the past: %w", entity.ErrInvalidDate)
+	}
+
+	rates, err := s.weeklyRates(ctx, boardIDs, schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	days := 0
+	for day := today; !day.After(date); day = day.AddDate(0, 0, 1) {
+		days++
+	}
+	if days > forecastHorizonDays {
+		return nil, fmt.Errorf("forecast date is more than %d days ahead: %w", forecastHorizonDays, entity.ErrInvalidDate)
+	}
+
+	working := workingDayMask(schedule, today, days)
+	rng := rand.New(rand.NewSource(s.seed()))
+	counts := make([]int, 0, forecastTrials)
+	for trial := 0; trial < forecastTrials; trial++ {
+		done := 0.0
+		rate := 0.0
+		for day := 0; day < days; day++ {
+			if day%7 == 0 {
+				rate = rates[rng.Intn(len(rates))]
+			}
+			if working[day] {
+				done += rate
+			}
+		}
+		counts = append(counts, int(math.Floor(done+1e-9)))
+	}
+	sort.Ints(counts)
+
+	countAt := func(confidence int) int {
+		// At least this many tasks are done in confidence% of simulations
+		index := (100 - confidence) * forecastTrials / 100
+		return counts[index]
+	}
+
+	return &ThroughputForecast{
+		BoardIDs:     boardIDs,
+		Date:         date,
+		HistoryWeeks: len(rates),
+		Trials:       forecastTrials,
+		P50:          countAt(50),
+		P85:          countAt(85),
+		P95:          countAt(95),
+	}, nil
+}
+
+// workingDayMask tells for each of the days from start whether it is a
+// working day, so simulations don't look the schedule up on every trial
+func workingDayMask(schedule *entity.WorkSchedule, start time.Time, days int) []bool {
+	working := make([]bool, days)
+	for day := range working {
+		working[day] = schedule.IsWorkingDay(start.AddDate(0, 0, day))
+	}
+	return working
+}
+
+// weeklyRates returns the tasks completed per working day in each of the past
+// weeks, counting back from today. Weeks without working days are left out.
+func (s *ForecastService) weeklyRates(ctx context.Context, boardIDs []string, schedule *entity.WorkSchedule) ([]float64, error) {
+	if len(boardIDs) == 0 {
+		return nil, entity.ErrBoardNotFound
+	}
+
+	today := startOfDay(time.Now())
+	from := today.AddDate(0, 0, -7*forecastHistoryWeeks)
+	completed := make([]int, forecastHistoryWeeks)
+	total := 0
+	for _, boardID := range boardIDs {
+		metrics, err := s.flowMetricsService.Calculate(ctx, boardID, from, today.AddDate(0, 0, -1))
+		if err != nil {
+			return nil, err
+		}
+		for _, flow := range metrics.Completed {
+			days := int(math.Round(startOfDay(flow.CompletedAt).Sub(from).Hours() / 24))
+			week := days / 7
+			if week >= 0 && week < forecastHistoryWeeks {
+				completed[week]++
+				total++
+			}
+		}
+	}
+	if total == 0 {
+		return nil, entity.ErrNoThroughputHistory
+	}
+
+	rates := make([]float64, 0, forecastHistoryWeeks)
+	for week := 0; week < forecastHistoryWeeks; week++ {
+		start := from.AddDate(0, 0, 7*week)
+		workingDays := len(schedule.GetWorkingDaysInRange(start, start.AddDate(0, 0, 6)))
+		if workingDays == 0 {
+			continue
+		}
+		rates = append(rates, float64(completed[week])/float64(workingDays))
+	}
+	if len(rates) == 0 {
+		return nil, entity.ErrNoThroughputHistory
+	}
+	return rates, nil
+}
+
+// boardSchedule returns the work schedule of the first board's project
+func (s *ForecastService) boardSchedule(ctx context.Context, boardIDs []string) (*entity.WorkSchedule, error) {
+	if len(boardIDs) == 0 {
+		return nil, entity.ErrBoardNotFound
+	}
+	board, err := s.boardRepo.FindByID(ctx, boardIDs[0])
+	if err != nil {
+		return nil, err
+	}
+	return s.workScheduleService.Schedule(ctx, board.ProjectID())
+}
+
+// unfinishedTasks counts the tasks of boards that are not done
+func (s *ForecastService) unfinishedTasks(ctx context.Context, boardIDs []string) (int, error) {
+	count := 0
+	for _, boardID := range boardIDs {
+		board, err := s.boardRepo.FindByID(ctx, boardID)
+		if err != nil {
+			return 0, err
+		}
+		for _, column := range board.Columns() {
+			for _, task := range column.Tasks() {
+				if !board.IsTaskDone(task.ID()) {
+					count++
+				}
+			}
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/valueobject"
+	"mkanban/internal/infrastructure/persistence/filesystem"
+)
+
+// newForecastTestService creates a forecast service over a board that
+// completed completedPerWeek(week) tasks in each of the sampled past weeks,
+// with simulations seeded by 1
+func newForecastTestService(t *testing.T, completedPerWeek func(week int) int) (*ForecastService, string) {
+	t.Helper()
+	ctx := context.Background()
+	boardRepo := filesystem.NewBoardRepository(t.TempDir())
+
+	board, err := entity.NewBoard("work/tracker", "Tracker", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, name := range []string{"To Do", "Done"} {
+		column, err := entity.NewColumn(name, "", i, 0, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := board.AddColumn(column); err != nil {
+			t.Fatal(err)
+		}
+	}
+	done, err := board.GetColumn("Done")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	from := startOfDay(time.Now()).AddDate(0, 0, -7*forecastHistoryWeeks)
+	for week := 0; week < forecastHistoryWeeks; week++ {
+		completedAt := from.AddDate(0, 0, 7*week+2).Add(12 * time.Hour)
+		for i := 0; i < completedPerWeek(week); i++ {
+			taskID, err := board.GenerateNextTaskID("task")
+			if err != nil {
+				t.Fatal(err)
+			}
+			task, err := entity.NewTask(taskID, "task", "", valueobject.PriorityMedium, valueobject.StatusTodo)
+			if err != nil {
+				t.Fatal(err)
+			}
+			created := from.AddDate(0, 0, -1)
+			task.RestoreDates(created, created, nil, nil)
+			task.RecordMove("to-do", "done", completedAt)
+			if err := done.AddTask(task); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := boardRepo.Save(ctx, board); err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewForecastService(boardRepo, NewFlowMetricsService(boardRepo), nil)
+	s.seed = func() int64 { return 1 }
+	return s, board.ID()
+}
+
+// varyingThroughput completes 1, 2 or 3 tasks a week
+func varyingThroughput(week int) int {
+	return week%3 + 1
+}
+
+// forecastTestSchedule is a Monday to Friday schedule
+func forecastTestSchedule() *entity.WorkSchedule {
+	return entity.NewDefaultWorkSchedule("default")
+}
+
+func TestForecastWithoutThroughputHistory(t *testing.T) {
+	ctx := context.Background()
+	s, boardID := newForecastTestService(t, func(int) int { return 0 })
+
+	if _, err := s.ForecastCompletion(ctx, []string{boardID}, 5, forecastTestSchedule()); !errors.Is(err, entity.ErrNoThroughputHistory) {
+		t.Errorf("expected no throughput history for a completion forecast, got %v", err)
+	}
+	date := time.Now().AddDate(0, 0, 30)
+	if _, err := s.ForecastThroughput(ctx, []string{boardID}, date, forecastTestSchedule()); !errors.Is(err, entity.ErrNoThroughputHistory) {
+		t.Errorf("expected no throughput history for a throughput forecast, got %v", err)
+	}
+}
+
+func TestForecastCompletionPercentiles(t *testing.T) {
+	ctx := context.Background()
+	s, boardID := newForecastTestService(t, varyingThroughput)
+
+	forecast, err := s.ForecastCompletion(ctx, []string{boardID}, 20, forecastTestSchedule())
+	if err != nil {
+		t.Fatalf("forecast failed: %v", err)
+	}
+	if forecast.HistoryWeeks != forecastHistoryWeeks || forecast.Trials != forecastTrials {
+		t.Errorf("expected %d weeks sampled in %d trials, got %d in %d", forecastHistoryWeeks, forecastTrials, forecast.HistoryWeeks, forecast.Trials)
+	}
+	if forecast.P50 == nil || forecast.P85 == nil || forecast.P95 == nil {
+		t.Fatalf("expected every confidence level to finish, got %+v", forecast)
+	}
+	if forecast.P85.Before(*forecast.P50) || forecast.P95.Before(*forecast.P85) {
+		t.Errorf("expected P50 <= P85 <= P95, got %v, %v, %v", forecast.P50, forecast.P85, forecast.P95)
+	}
+	// 20 tasks at 0.2 to 0.6 tasks a working day take 34 to 100 working days
+	today := startOfDay(time.Now())
+	if forecast.P50.Before(today.AddDate(0, 0, 34)) || forecast.P95.After(today.AddDate(0, 0, 150)) {
+		t.Errorf("expected the forecast within 34 working days and 150 days, got %v to %v", forecast.P50, forecast.P95)
+	}
+
+	again, err := s.ForecastCompletion(ctx, []string{boardID}, 20, forecastTestSchedule())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(again, forecast) {
+		t.Errorf("expected the same seed to give the same forecast, got %+v and %+v", forecast, again)
+	}
+}
+
+func TestForecastCompletionBeyondHorizon(t *testing.T) {
+	ctx := context.Background()
+	s, boardID := newForecastTestService(t, varyingThroughput)
+
+	// At most 3 tasks a week, far more than 5 years of work
+	forecast, err := s.ForecastCompletion(ctx, []string{boardID}, 3*52*forecastHorizonDays/365+1, forecastTestSchedule())
+	if err != nil {
+		t.Fatalf("forecast failed: %v", err)
+	}
+	if forecast.P50 != nil || forecast.P85 != nil || forecast.P95 != nil {
+		t.Errorf("expected no dates when no simulation finishes within %d days, got %+v", forecastHorizonDays, forecast)
+	}
+}
+
+func TestForecastThroughputPercentiles(t *testing.T) {
+	ctx := context.Background()
+	s, boardID := newForecastTestService(t, varyingThroughput)
+
+	date := time.Now().AddDate(0, 0, 60)
+	forecast, err := s.ForecastThroughput(ctx, []string{boardID}, date, forecastTestSchedule())
+	if err != nil {
+		t.Fatalf("forecast failed: %v", err)
+	}
+	if forecast.P95 > forecast.P85 || forecast.P85 > forecast.P50 {
+		t.Errorf("expected P50 >= P85 >= P95, got %d, %d, %d", forecast.P50, forecast.P85, forecast.P95)
+	}
+	// About 43 working days at 0.2 to 0.6 tasks a day
+	if forecast.P95 < 8 || forecast.P50 > 26 {
+		t.Errorf("expected between 8 and 26 tasks, got %d to %d", forecast.P95, forecast.P50)
+	}
+
+	again, err := s.ForecastThroughput(ctx, []string{boardID}, date, forecastTestSchedule())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(again, forecast) {
+		t.Errorf("expected the same seed to give the same forecast, got %+v and %+v", forecast, again)
+	}
+}
+
+func TestForecastThroughputHorizon(t *testing.T) {
+	ctx := context.Background()
+	s, boardID := newForecastTestService(t, varyingThroughput)
+	today := startOfDay(time.Now())
+
+	tests := []struct {
+		name    string
+		date    time.Time
+		wantErr bool
+	}{
+		{"today", today, false},
+		{"last day of the horizon", today.AddDate(0, 0, forecastHorizonDays-1), false},
+		{"past the horizon", today.AddDate(0, 0, forecastHorizonDays), true},
+		{"in the past", today.AddDate(0, 0, -1), true},
+	}
+	for _, tt := range tests {
+		_, err := s.ForecastThroughput(ctx, []string{boardID}, tt.date, forecastTestSchedule())
+		if tt.wantErr && !errors.Is(err, entity.ErrInvalidDate) {
+			t.Errorf("%s: expected an invalid date error, got %v", tt.name, err)
+		}
+		if !tt.wantErr && err != nil {
+			t.Errorf("%s: expected a forecast, got %v", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,442 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/valueobject"
+)
+
+// milestoneThroughputWindow is how far back completed tasks count toward the
+// throughput milestone completion is projected from
+const milestoneThroughputWindow = 14 * 24 * time.Hour
+
+// MilestoneMemberTask is a milestone member resolved to its task
+type MilestoneMemberTask struct {
+	Member entity.MilestoneMember
+	Task   *entity.Task // nil if the member no longer exists
+	Column *entity.Column
+	Done   bool
+}
+
+// MilestoneReport is a milestone with its resolved members and progress
+type MilestoneReport struct {
+	BoardID   string
+	Column    *entity.Column
+	Milestone *entity.Task
+	Members   []MilestoneMemberTask
+	Progress  entity.MilestoneProgress
+	Forecast  *CompletionForecast // nil in risk checks, without remaining work or throughput history
+	// ForecastErr is why the forecast failed, other than a lack of throughput history
+	ForecastErr error
+}
+
+// MilestoneService tracks the member tasks of milestones and projects when
+// milestones will be completed
+type MilestoneService struct {
+	boardRepo       repository.BoardRepository
+	activityRepo    repository.ActivityRepository
+	forecastService *ForecastService
+}
+
+// NewMilestoneService creates a new MilestoneService
+func NewMilestoneService(
+	boardRepo repository.BoardRepository,
+	activityRepo repository.ActivityRepository,
+	forecastService *ForecastService,
+) *MilestoneService {
+	return &MilestoneService{
+		boardRepo:       boardRepo,
+		activityRepo:    activityRepo,
+		forecastService: forecastService,
+	}
+}
+
+// Report resolves the members of a milestone and computes its progress
+func (s *MilestoneService) Report(ctx context.Context, boardID string, milestoneID *valueobject.TaskID) (*MilestoneReport, error) {
+	scan := s.newScan(true)
+	board, err := scan.board(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	milestone, column, err := board.FindTask(milestoneID)
+	if err != nil {
+		return nil, err
+	}
+	if !milestone.IsMilestone() {
+		return nil, entity.ErrNotMilestone
+	}
+
+	return scan.report(ctx, board, column, milestone), nil
+}
+
+// AddMembers adds tasks, given by full or short ID, to a milestone. A regular
+// task becomes a milestone when tasks are added to it.
+func (s *MilestoneService) AddMembers(ctx context.Context, boardID string, milestoneID *valueobject.TaskID, members []entity.MilestoneMember) (*MilestoneReport, error) {
+	scan := s.newScan(true)
+	board, err := scan.board(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	milestone, column, err := board.FindTask(milestoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, member := range members {
+		if member.BoardID == "" {
+			member.BoardID = boardID
+		}
+		memberBoard, err := scan.board(ctx, member.BoardID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load board %s: %w", member.BoardID, err)
+		}
+		task, _ := findTaskByID(memberBoard, member.TaskID)
+		if task == nil {
+			return nil, fmt.Errorf("task %s not found on board %s: %w", member.TaskID, member.BoardID, entity.ErrTaskNotFound)
+		}
+		if member.BoardID == boardID && task.ID().Equal(milestone.ID()) {
+			return nil, entity.ErrInvalidMilestoneMember
+		}
+
+		member.TaskID = task.ID().String()
+		if _, err := milestone.AddMilestoneMember(member); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.boardRepo.SaveTask(ctx, boardID, column.Name(), milestone); err != nil {
+		return nil, fmt.Errorf("failed to save milestone: %w", err)
+	}
+
+	return scan.report(ctx, board, column, milestone), nil
+}
+
+// RemoveMembers removes tasks, given by full or short ID, from a milestone
+func (s *MilestoneService) RemoveMembers(ctx context.Context, boardID string, milestoneID *valueobject.TaskID, members []entity.MilestoneMember) (*MilestoneReport, error) {
+	scan := s.newScan(true)
+	board, err := scan.board(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	milestone, column, err := board.FindTask(milestoneID)
+	if err != nil {
+		return nil, err
+	}
+	if !milestone.IsMilestone() {
+		return nil, entity.ErrNotMilestone
+	}
+
+	for _, member := range members {
+		if member.BoardID == "" {
+			member.BoardID = boardID
+		}
+		removed := false
+		for _, existing := range milestone.MilestoneMembers() {
+			if existing.BoardID == member.BoardID && sameTaskID(existing.TaskID, member.TaskID) {
+				removed = milestone.RemoveMilestoneMember(existing) || removed
+			}
+		}
+		if !removed {
+			return nil, fmt.Errorf("task %s is not part of the milestone: %w", member.TaskID, entity.ErrTaskNotFound)
+		}
+	}
+
+	if err := s.boardRepo.SaveTask(ctx, boardID, column.Name(), milestone); err != nil {
+		return nil, fmt.Errorf("failed to save milestone: %w", err)
+	}
+
+	return scan.report(ctx, board, column, milestone), nil
+}
+
+// FindMilestones reports on the milestones of a project, or of all projects
+// if projectID is empty
+func (s *MilestoneService) FindMilestones(ctx context.Context, projectID string) ([]*MilestoneReport, error) {
+	return s.findMilestones(ctx, projectID, true)
+}
+
+// findMilestones reports on the milestones of a project, forecasting their
+// completion when forecast is set
+func (s *MilestoneService) findMilestones(ctx context.Context, projectID string, forecast bool) ([]*MilestoneReport, error) {
+	boards, err := s.boardRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load boards: %w", err)
+	}
+
+	scan := s.newScan(forecast)
+	for _, board := range boards {
+		scan.boards[board.ID()] = board
+	}
+
+	reports := make([]*MilestoneReport, 0)
+	for _, board := range boards {
+		if projectID != "" && board.ProjectID() != projectID {
+			continue
+		}
+		for _, column := range board.Columns() {
+			for _, task := range column.Tasks() {
+				if task.IsMilestone() {
+					reports = append(reports, scan.report(ctx, board, column, task))
+				}
+			}
+		}
+	}
+	return reports, nil
+}
+
+// CheckRisk re-evaluates every milestone, records which are at risk and
+// returns the milestones that have become at risk since the last check
+func (s *MilestoneService) CheckRisk(ctx context.Context) ([]*MilestoneReport, error) {
+	// Risk only depends on the projection, the forecast would be thrown away
+	reports, err := s.findMilestones(ctx, "", false)
+	if err != nil {
+		return nil, err
+	}
+
+	atRisk := make([]*MilestoneReport, 0)
+	for _, report := range reports {
+		becameAtRisk, err := s.recordRisk(ctx, report)
+		if err != nil {
+			return nil, err
+		}
+		if becameAtRisk {
+			atRisk = append(atRisk, report)
+		}
+	}
+	return atRisk, nil
+}
+
+// CheckMilestoneRisk re-evaluates a single milestone, records whether it is at
+// risk and returns it if it has become at risk since the last check, or nil
+func (s *MilestoneService) CheckMilestoneRisk(ctx context.Context, boardID string, milestoneID *valueobject.TaskID) (*MilestoneReport, error) {
+	scan := s.newScan(false)
+	board, err := scan.board(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	milestone, column, err := board.FindTask(milestoneID)
+	if err != nil {
+		return nil, err
+	}
+	if !milestone.IsMilestone() {
+		return nil, nil
+	}
+
+	report := scan.report(ctx, board, column, milestone)
+	becameAtRisk, err := s.recordRisk(ctx, report)
+	if err != nil || !becameAtRisk {
+		return nil, err
+	}
+	return report, nil
+}
+
+// recordRisk saves the at-risk flag of a milestone when it changed and
+// reports whether the milestone has become at risk
+func (s *MilestoneService) recordRisk(ctx context.Context, report *MilestoneReport) (bool, error) {
+	milestone := report.Milestone
+	if milestone.MilestoneAtRisk() == report.Progress.AtRisk {
+		return false, nil
+	}
+
+	milestone.SetMilestoneAtRisk(report.Progress.AtRisk)
+	if err := s.boardRepo.SaveTask(ctx, report.BoardID, report.Column.Name(), milestone); err != nil {
+		return false, fmt.Errorf("failed to save milestone %s: %w", milestone.ID().ShortID(), err)
+	}
+	return report.Progress.AtRisk, nil
+}
+
+// milestoneScan caches the boards and board throughput looked up while
+// reporting on milestones
+type milestoneScan struct {
+	service    *MilestoneService
+	now        time.Time
+	forecast   bool // forecast the completion of the milestones reported on
+	boards     map[string]*entity.Board
+	throughput map[string]int // boardID -> tasks completed within the window
+}
+
+// newScan starts a scan with empty caches
+func (s *MilestoneService) newScan(forecast bool) *milestoneScan {
+	return &milestoneScan{
+		service:    s,
+		now:        time.Now(),
+		forecast:   forecast,
+		boards:     make(map[string]*entity.Board),
+		throughput: make(map[string]int),
+	}
+}
+
+// board loads a board once per scan
+func (sc *milestoneScan) board(ctx context.Context, boardID string) (*entity.Board, error) {
+	if board, ok := sc.boards[boardID]; ok {
+		return board, nil
+	}
+	board, err := sc.service.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	sc.boards[boardID] = board
+	return board, nil
+}
+
+// report resolves the members of a milestone and computes its progress
+func (sc *milestoneScan) report(ctx context.Context, board *entity.Board, column *entity.Column, milestone *entity.Task) *MilestoneReport {
+	report := &MilestoneReport{
+		BoardID:   board.ID(),
+		Column:    column,
+		Milestone: milestone,
+		Members:   make([]MilestoneMemberTask, 0),
+	}
+	progress := &report.Progress
+
+	boardIDs := []string{board.ID()}
+	for _, member := range milestone.MilestoneMembers() {
+		resolved := MilestoneMemberTask{Member: member}
+		progress.Members++
+
+		memberBoard, err := sc.board(ctx, member.BoardID)
+		if err == nil {
+			resolved.Task, resolved.Column = findTaskByID(memberBoard, member.TaskID)
+		}
+		if resolved.Task == nil {
+			progress.MissingMembers++
+			report.Members = append(report.Members, resolved)
+			continue
+		}
+		boardIDs = appendUnique(boardIDs, member.BoardID)
+
+		resolved.Done = memberBoard.IsTaskDone(resolved.Task.ID())
+		if resolved.Done {
+			progress.DoneMembers++
+		} else {
+			progress.RemainingEstimate += remainingEstimate(memberBoard, resolved.Task)
+		}
+		report.Members = append(report.Members, resolved)
+	}
+
+	if counted := progress.Members - progress.MissingMembers; counted > 0 {
+		progress.PercentComplete = float64(progress.DoneMembers) / float64(counted) * 100
+	}
+
+	completed := 0
+	for _, boardID := range boardIDs {
+		completed += sc.completedTasks(ctx, boardID)
+	}
+	progress.Throughput = float64(completed) / milestoneThroughputWindow.Hours() * 24
+
+	remaining := progress.Members - progress.MissingMembers - progress.DoneMembers
+	if remaining > 0 && progress.Throughput > 0 {
+		days := float64(remaining) / progress.Throughput
+		projected := sc.now.Add(time.Duration(days * float64(24*time.Hour)))
+		progress.ProjectedCompletion = &projected
+	}
+
+	dueDate := milestone.DueDate()
+	if dueDate != nil && progress.ProjectedCompletion != nil && !board.IsTaskDone(milestone.ID()) {
+		// Without recent throughput there is no projection and no risk
+		progress.AtRisk = progress.ProjectedCompletion.After(*dueDate)
+	}
+
+	if sc.forecast && remaining > 0 {
+		forecast, err := sc.service.forecastService.ForecastCompletion(ctx, boardIDs, remaining, nil)
+		if err != nil && !errors.Is(err, entity.ErrNoThroughputHistory) {
+			report.ForecastErr = err
+		}
+		report.Forecast = forecast
+	}
+
+	return report
+}
+
+// completedTasks counts the tasks of a board completed within the throughput
+// window. Tasks moved to Done without a completion date are dated by their
+// activity log.
+func (sc *milestoneScan) completedTasks(ctx context.Context, boardID string) int {
+	if count, ok := sc.throughput[boardID]; ok {
+		return count
+	}
+
+	count := 0
+	board, err := sc.board(ctx, boardID)
+	if err == nil {
+		since := sc.now.Add(-milestoneThroughputWindow)
+		for _, column := range board.Columns() {
+			for _, task := range column.Tasks() {
+				if !board.IsTaskDone(task.ID()) {
+					continue
+				}
+				completedAt := task.CompletedDate()
+				if completedAt == nil {
+					entries, err := sc.service.activityRepo.FindByTask(ctx, boardID, column.Name(), task.ID())
+					if err != nil {
+						continue
+					}
+					completedAt = entity.CompletedAt(entries)
+				}
+				if completedAt != nil && completedAt.After(since) {
+					count++
+				}
+			}
+		}
+	}
+
+	sc.throughput[boardID] = count
+	return count
+}
+
+// remainingEstimate sums the estimates of a task and its subtasks that are not done
+func remainingEstimate(board *entity.Board, task *entity.Task) time.Duration {
+	var remaining time.Duration
+	for _, t := range append([]*entity.Task{task}, board.Descendants(task.ID())...) {
+		if estimate := t.EstimatedTime(); estimate != nil && !board.IsTaskDone(t.ID()) {
+			remaining += *estimate
+		}
+	}
+	return remaining
+}
+
+// findTaskByID looks a task up by its full or short ID
+func findTaskByID(board *entity.Board, taskID string) (*entity.Task, *entity.Column) {
+	for _, column := range board.Columns() {
+		for _, task := range column.Tasks() {
+			if sameTaskID(task.ID().String(), taskID) {
+				return task, column
+			}
+		}
+	}
+	return nil, nil
+}
+
+// sameTaskID checks if two task IDs, full or short, refer to the same task
+func sameTaskID(a, b string) bool {
+	if a == b {
+		return true
+	}
+	return shortTaskID(a) == shortTaskID(b)
+}
+
+// shortTaskID returns the PREFIX-NUMBER part of a full or short task ID
+func shortTaskID(taskID string) string {
+	if parsed, err := valueobject.ParseTaskID(taskID); err == nil {
+		return parsed.ShortID()
+	}
+	return taskID
+}
+
+// appendUnique appends a value unless values already has it
+func appendUnique(values []string, value string) []string {
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
@@ -0,0 +1,334 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/valueobject"
+	"mkanban/internal/infrastructure/persistence/filesystem"
+)
+
+// newMilestoneTestService creates a milestone service over an empty data root
+func newMilestoneTestService(t *testing.T) (*MilestoneService, repository.BoardRepository) {
+	t.Helper()
+	root := t.TempDir()
+	boardRepo := filesystem.NewBoardRepository(root)
+	forecastService := NewForecastService(boardRepo, NewFlowMetricsService(boardRepo), NewWorkScheduleService(filesystem.NewWorkScheduleRepository(root)))
+	return NewMilestoneService(boardRepo, filesystem.NewActivityRepository(root), forecastService), boardRepo
+}
+
+// newMilestoneTestBoard creates a board with a To Do and a Done column
+func newMilestoneTestBoard(t *testing.T, id, name string) *entity.Board {
+	t.Helper()
+	board, err := entity.NewBoard(id, name, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, column := range []string{"To Do", "Done"} {
+		col, err := entity.NewColumn(column, "", i, 0, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := board.AddColumn(col); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return board
+}
+
+// addMilestoneTestTask adds a task to a column, estimated at estimate if it
+// is not zero
+func addMilestoneTestTask(t *testing.T, board *entity.Board, columnName, title string, estimate time.Duration) *entity.Task {
+	t.Helper()
+	taskID, err := board.GenerateNextTaskID(title)
+	if err != nil {
+		t.Fatal(err)
+	}
+	task, err := entity.NewTask(taskID, title, "", valueobject.PriorityMedium, valueobject.StatusTodo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if estimate > 0 {
+		task.SetEstimatedTime(estimate)
+	}
+	column, err := board.GetColumn(columnName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := column.AddTask(task); err != nil {
+		t.Fatal(err)
+	}
+	return task
+}
+
+// addCompletedTask adds a task to the Done column, completed ago
+func addCompletedTask(t *testing.T, board *entity.Board, title string, ago time.Duration) *entity.Task {
+	t.Helper()
+	task := addMilestoneTestTask(t, board, "Done", title, 0)
+	completed := time.Now().Add(-ago)
+	task.RestoreDates(completed.Add(-time.Hour), completed, nil, &completed)
+	return task
+}
+
+// milestoneFixture is a milestone on the tracker board tracking a task with a
+// subtask there, a completed task there and a task on the api board. Three
+// tasks were completed on the two boards over the last two weeks.
+type milestoneFixture struct {
+	service   *MilestoneService
+	boardRepo repository.BoardRepository
+	tracker   *entity.Board
+	api       *entity.Board
+	milestone *entity.Task
+	open      *entity.Task
+	done      *entity.Task
+	remote    *entity.Task
+}
+
+// newMilestoneFixture saves the boards of the fixture, with the milestone
+// due in dueIn, before any members are added
+func newMilestoneFixture(t *testing.T, dueIn time.Duration) *milestoneFixture {
+	t.Helper()
+	service, boardRepo := newMilestoneTestService(t)
+	f := &milestoneFixture{service: service, boardRepo: boardRepo}
+
+	f.tracker = newMilestoneTestBoard(t, "work/tracker", "Tracker")
+	f.milestone = addMilestoneTestTask(t, f.tracker, "To Do", "release", 0)
+	f.milestone.SetTaskType(entity.TaskTypeMilestone)
+	if err := f.milestone.SetDueDate(time.Now().Add(dueIn)); err != nil {
+		t.Fatal(err)
+	}
+	f.open = addMilestoneTestTask(t, f.tracker, "To Do", "open", 2*time.Hour)
+	subtask := addMilestoneTestTask(t, f.tracker, "To Do", "subtask", time.Hour)
+	doneSubtask := addCompletedTask(t, f.tracker, "done-subtask", 20*24*time.Hour)
+	doneSubtask.SetEstimatedTime(5 * time.Hour)
+	for _, child := range []*entity.Task{subtask, doneSubtask} {
+		if err := f.tracker.SetTaskParent(child.ID(), f.open.ID()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	f.done = addCompletedTask(t, f.tracker, "done", 24*time.Hour)
+	addCompletedTask(t, f.tracker, "other", 2*24*time.Hour)
+
+	f.api = newMilestoneTestBoard(t, "work/api", "Api")
+	f.remote = addMilestoneTestTask(t, f.api, "To Do", "remote", 3*time.Hour)
+	addCompletedTask(t, f.api, "recent", 3*24*time.Hour)
+	addCompletedTask(t, f.api, "old", 20*24*time.Hour) // before the throughput window
+
+	for _, board := range []*entity.Board{f.tracker, f.api} {
+		if err := boardRepo.Save(context.Background(), board); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return f
+}
+
+// addMembers adds the open and done tasks by short ID and the remote task
+// across boards
+func (f *milestoneFixture) addMembers(t *testing.T) *MilestoneReport {
+	t.Helper()
+	report, err := f.service.AddMembers(context.Background(), f.tracker.ID(), f.milestone.ID(), []entity.MilestoneMember{
+		{TaskID: f.open.ID().ShortID()},
+		{TaskID: f.done.ID().String()},
+		{BoardID: f.api.ID(), TaskID: f.remote.ID().ShortID()},
+	})
+	if err != nil {
+		t.Fatalf("add members failed: %v", err)
+	}
+	return report
+}
+
+func TestMilestoneAddMembers(t *testing.T) {
+	ctx := context.Background()
+	f := newMilestoneFixture(t, 30*24*time.Hour)
+	f.addMembers(t)
+
+	report, err := f.service.Report(ctx, f.tracker.ID(), f.milestone.ID())
+	if err != nil {
+		t.Fatalf("report failed: %v", err)
+	}
+	want := []entity.MilestoneMember{
+		{BoardID: f.tracker.ID(), TaskID: f.open.ID().String()},
+		{BoardID: f.tracker.ID(), TaskID: f.done.ID().String()},
+		{BoardID: f.api.ID(), TaskID: f.remote.ID().String()},
+	}
+	members := report.Milestone.MilestoneMembers()
+	if len(members) != len(want) {
+		t.Fatalf("expected %d members saved, got %+v", len(want), members)
+	}
+	for i, member := range members {
+		if member != want[i] {
+			t.Errorf("member %d: expected %+v saved by full ID, got %+v", i, want[i], member)
+		}
+	}
+	if report.Members[2].Task == nil || report.Members[2].Task.Title() != "remote" {
+		t.Errorf("expected the member on another board to be resolved, got %+v", report.Members[2])
+	}
+
+	tests := []struct {
+		name    string
+		member  entity.MilestoneMember
+		wantErr error
+	}{
+		{"the milestone itself", entity.MilestoneMember{TaskID: f.milestone.ID().ShortID()}, entity.ErrInvalidMilestoneMember},
+		{"unknown task", entity.MilestoneMember{TaskID: "TRK-999"}, entity.ErrTaskNotFound},
+		{"task on another board looked up on this one", entity.MilestoneMember{TaskID: f.remote.ID().ShortID()}, entity.ErrTaskNotFound},
+	}
+	for _, tt := range tests {
+		if _, err := f.service.AddMembers(ctx, f.tracker.ID(), f.milestone.ID(), []entity.MilestoneMember{tt.member}); !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.wantErr, err)
+		}
+	}
+}
+
+func TestMilestoneRemoveMembers(t *testing.T) {
+	ctx := context.Background()
+	f := newMilestoneFixture(t, 30*24*time.Hour)
+	f.addMembers(t)
+
+	report, err := f.service.RemoveMembers(ctx, f.tracker.ID(), f.milestone.ID(), []entity.MilestoneMember{
+		{BoardID: f.api.ID(), TaskID: f.remote.ID().ShortID()},
+	})
+	if err != nil {
+		t.Fatalf("remove members failed: %v", err)
+	}
+	if report.Progress.Members != 2 {
+		t.Errorf("expected 2 members left, got %d", report.Progress.Members)
+	}
+
+	saved, err := f.service.Report(ctx, f.tracker.ID(), f.milestone.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(saved.Milestone.MilestoneMembers()) != 2 {
+		t.Errorf("expected the removal to be saved, got %+v", saved.Milestone.MilestoneMembers())
+	}
+
+	// Removed already, and a task on this board by the remote task's number
+	for _, member := range []entity.MilestoneMember{
+		{BoardID: f.api.ID(), TaskID: f.remote.ID().ShortID()},
+		{TaskID: "TRK-999"},
+	} {
+		if _, err := f.service.RemoveMembers(ctx, f.tracker.ID(), f.milestone.ID(), []entity.MilestoneMember{member}); !errors.Is(err, entity.ErrTaskNotFound) {
+			t.Errorf("%+v: expected a task not part of the milestone to be rejected, got %v", member, err)
+		}
+	}
+
+	if _, err := f.service.RemoveMembers(ctx, f.tracker.ID(), f.open.ID(), []entity.MilestoneMember{{TaskID: f.done.ID().ShortID()}}); !errors.Is(err, entity.ErrNotMilestone) {
+		t.Errorf("expected removing from a regular task to be rejected, got %v", err)
+	}
+}
+
+func TestMilestoneReport(t *testing.T) {
+	ctx := context.Background()
+	f := newMilestoneFixture(t, 30*24*time.Hour)
+	added := f.addMembers(t)
+
+	// A member whose board is gone
+	if _, err := added.Milestone.AddMilestoneMember(entity.MilestoneMember{BoardID: "work/gone", TaskID: "GON-001-gone"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.boardRepo.SaveTask(ctx, f.tracker.ID(), added.Column.Name(), added.Milestone); err != nil {
+		t.Fatal(err)
+	}
+
+	before := time.Now()
+	report, err := f.service.Report(ctx, f.tracker.ID(), f.milestone.ID())
+	if err != nil {
+		t.Fatalf("report failed: %v", err)
+	}
+	progress := report.Progress
+
+	if progress.Members != 4 || progress.DoneMembers != 1 || progress.MissingMembers != 1 {
+		t.Errorf("expected 4 members with 1 done and 1 missing, got %+v", progress)
+	}
+	if math.Abs(progress.PercentComplete-100.0/3) > 0.01 {
+		t.Errorf("expected missing members not to count toward progress, got %.2f%%", progress.PercentComplete)
+	}
+	// The open task, its open subtask and the remote task; not the done subtask
+	if progress.RemainingEstimate != 6*time.Hour {
+		t.Errorf("expected 6h of remaining estimate, got %v", progress.RemainingEstimate)
+	}
+	// done, other and recent within the last two weeks
+	if math.Abs(progress.Throughput-3.0/14) > 1e-9 {
+		t.Errorf("expected 3 tasks in 14 days, got %v a day", progress.Throughput)
+	}
+	// 2 tasks left at 3/14 a day
+	want := before.Add(time.Duration(2.0 / (3.0 / 14) * float64(24*time.Hour)))
+	if progress.ProjectedCompletion == nil || progress.ProjectedCompletion.Sub(want).Abs() > time.Minute {
+		t.Errorf("expected completion projected around %v, got %v", want, progress.ProjectedCompletion)
+	}
+	if progress.AtRisk {
+		t.Error("expected a milestone due after its projection not to be at risk")
+	}
+	if report.ForecastErr != nil {
+		t.Errorf("expected the forecast not to fail, got %v", report.ForecastErr)
+	}
+
+	if _, err := f.service.Report(ctx, f.tracker.ID(), f.open.ID()); !errors.Is(err, entity.ErrNotMilestone) {
+		t.Errorf("expected a regular task to be rejected, got %v", err)
+	}
+}
+
+func TestMilestoneRisk(t *testing.T) {
+	tests := []struct {
+		name       string
+		dueIn      time.Duration
+		throughput bool // tasks were completed recently
+		wantAtRisk bool
+	}{
+		{"projection before the due date", 30 * 24 * time.Hour, true, false},
+		{"projection past the due date", 3 * 24 * time.Hour, true, true},
+		{"overdue", -24 * time.Hour, true, true},
+		{"overdue without a projection", -24 * time.Hour, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			service, boardRepo := newMilestoneTestService(t)
+			board := newMilestoneTestBoard(t, "work/tracker", "Tracker")
+			milestone := addMilestoneTestTask(t, board, "To Do", "release", 0)
+			due := time.Now().Add(tt.dueIn)
+			milestone.RestoreDates(milestone.CreatedAt(), milestone.ModifiedAt(), &due, nil)
+			open := addMilestoneTestTask(t, board, "To Do", "open", 0)
+			if _, err := milestone.AddMilestoneMember(entity.MilestoneMember{BoardID: board.ID(), TaskID: open.ID().String()}); err != nil {
+				t.Fatal(err)
+			}
+			if tt.throughput {
+				addCompletedTask(t, board, "recent", 24*time.Hour)
+			}
+			if err := boardRepo.Save(ctx, board); err != nil {
+				t.Fatal(err)
+			}
+
+			atRisk, err := service.CheckRisk(ctx)
+			if err != nil {
+				t.Fatalf("risk check failed: %v", err)
+			}
+			if got := len(atRisk) == 1; got != tt.wantAtRisk || len(atRisk) > 1 {
+				t.Fatalf("expected at risk: %v, got %d milestones", tt.wantAtRisk, len(atRisk))
+			}
+
+			report, err := service.Report(ctx, board.ID(), milestone.ID())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if report.Milestone.MilestoneAtRisk() != tt.wantAtRisk {
+				t.Errorf("expected the at-risk flag %v to be saved", tt.wantAtRisk)
+			}
+
+			// Only a change is reported
+			again, err := service.CheckMilestoneRisk(ctx, board.ID(), milestone.ID())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if again != nil {
+				t.Error("expected a milestone already at risk not to be reported again")
+			}
+		})
+	}
+}
@@ -0,0 +1,370 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+)
+
+// SprintTaskStatus is a sprint task resolved to its task
+type SprintTaskStatus struct {
+	SprintTask     entity.SprintTask
+	Task           *entity.Task // nil if the task no longer exists
+	Column         *entity.Column
+	Done           bool
+	AddedMidSprint bool
+}
+
+// SprintReport is a sprint with its tasks resolved
+type SprintReport struct {
+	Sprint   *entity.Sprint
+	Tasks    []SprintTaskStatus
+	Forecast *CompletionForecast // nil for closed sprints, without remaining work or throughput history
+	// ForecastErr is why the forecast failed, other than a lack of throughput history
+	ForecastErr error
+}
+
+// SprintService plans tasks into the sprints of a project and closes sprints
+type SprintService struct {
+	sprintRepo      repository.SprintRepository
+	boardRepo       repository.BoardRepository
+	timeLogRepo     repository.TimeLogRepository
+	forecastService *ForecastService
+}
+
+// NewSprintService creates a new SprintService
+func NewSprintService(
+	sprintRepo repository.SprintRepository,
+	boardRepo repository.BoardRepository,
+	timeLogRepo repository.TimeLogRepository,
+	forecastService *ForecastService,
+) *SprintService {
+	return &SprintService{
+		sprintRepo:      sprintRepo,
+		boardRepo:       boardRepo,
+		timeLogRepo:     timeLogRepo,
+		forecastService: forecastService,
+	}
+}
+
+// Plan creates a sprint in a project
+func (s *SprintService) Plan(ctx context.Context, projectID, name, goal string, startDate, endDate time.Time) (*SprintReport, error) {
+	sprint, err := entity.NewSprint(projectID, name, goal, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.sprintRepo.FindByID(ctx, projectID, sprint.ID()); err == nil {
+		return nil, entity.ErrSprintAlreadyExists
+	} else if err != entity.ErrSprintNotFound {
+		return nil, err
+	}
+
+	if err := s.sprintRepo.Save(ctx, sprint); err != nil {
+		return nil, fmt.Errorf("failed to save sprint: %w", err)
+	}
+	return s.report(ctx, sprint, true), nil
+}
+
+// Report resolves the tasks of a sprint
+func (s *SprintService) Report(ctx context.Context, projectID, sprintID string) (*SprintReport, error) {
+	sprint, err := s.sprintRepo.FindByID(ctx, projectID, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	return s.report(ctx, sprint, true), nil
+}
+
+// FindSprints resolves the tasks of every sprint of a project
+func (s *SprintService) FindSprints(ctx context.Context, projectID string) ([]*SprintReport, error) {
+	sprints, err := s.sprintRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]*SprintReport, 0, len(sprints))
+	for _, sprint := range sprints {
+		reports = append(reports, s.report(ctx, sprint, true))
+	}
+	return reports, nil
+}
+
+// AddTasks plans tasks, given by full or short ID, in a sprint. The tasks must
+// be on boards of the sprint's project and not planned in another open sprint.
+func (s *SprintService) AddTasks(ctx context.Context, projectID, sprintID string, tasks []entity.SprintTask) (*SprintReport, error) {
+	sprint, err := s.sprintRepo.FindByID(ctx, projectID, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	if sprint.IsClosed() {
+		return nil, entity.ErrSprintClosed
+	}
+
+	sprints, err := s.sprintRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	boards := make(map[string]*entity.Board)
+	now := time.Now()
+	for _, sprintTask := range tasks {
+		board, ok := boards[sprintTask.BoardID]
+		if !ok {
+			board, err = s.boardRepo.FindByID(ctx, sprintTask.BoardID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load board %s: %w", sprintTask.BoardID, err)
+			}
+			if board.ProjectID() != projectID {
+				return nil, fmt.Errorf("board %s is not part of project %s: %w", sprintTask.BoardID, projectID, entity.ErrInvalidSprintTask)
+			}
+			boards[sprintTask.BoardID] = board
+		}
+
+		task, _ := findTaskByID(board, sprintTask.TaskID)
+		if task == nil {
+			return nil, fmt.Errorf("task %s not found on board %s: %w", sprintTask.TaskID, sprintTask.BoardID, entity.ErrTaskNotFound)
+		}
+		sprintTask.TaskID = task.ID().String()
+		sprintTask.AddedAt = now
+
+		for _, other := range sprints {
+			if other.ID() != sprint.ID() && !other.IsClosed() && hasSprintTask(other, sprintTask.BoardID, sprintTask.TaskID) {
+				return nil, fmt.Errorf("task %s is planned in sprint %s: %w", task.ID().ShortID(), other.ID(), entity.ErrTaskInOtherSprint)
+			}
+		}
+
+		if hasSprintTask(sprint, sprintTask.BoardID, sprintTask.TaskID) {
+			continue
+		}
+		if _, err := sprint.AddTask(sprintTask); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.sprintRepo.Save(ctx, sprint); err != nil {
+		return nil, fmt.Errorf("failed to save sprint: %w", err)
+	}
+	return s.report(ctx, sprint, true), nil
+}
+
+// RemoveTasks takes tasks, given by full or short ID, out of a sprint
+func (s *SprintService) RemoveTasks(ctx context.Context, projectID, sprintID string, tasks []entity.SprintTask) (*SprintReport, error) {
+	sprint, err := s.sprintRepo.FindByID(ctx, projectID, sprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sprintTask := range tasks {
+		removed := false
+		for _, existing := range sprint.Tasks() {
+			if existing.BoardID != sprintTask.BoardID || !sameTaskID(existing.TaskID, sprintTask.TaskID) {
+				continue
+			}
+			ok, err := sprint.RemoveTask(existing.BoardID, existing.TaskID)
+			if err != nil {
+				return nil, err
+			}
+			removed = ok || removed
+		}
+		if !removed {
+			return nil, fmt.Errorf("task %s is not part of the sprint: %w", sprintTask.TaskID, entity.ErrTaskNotFound)
+		}
+	}
+
+	if err := s.sprintRepo.Save(ctx, sprint); err != nil {
+		return nil, fmt.Errorf("failed to save sprint: %w", err)
+	}
+	return s.report(ctx, sprint, true), nil
+}
+
+// Close closes a sprint and stores its summary. Unfinished tasks roll over to
+// nextSprintID or, if it is empty, to the next open sprint of the project; with
+// no open sprint left they go back to the backlog.
+func (s *SprintService) Close(ctx context.Context, projectID, sprintID, nextSprintID string) (*SprintReport, error) {
+	sprint, err := s.sprintRepo.FindByID(ctx, projectID, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	if sprint.IsClosed() {
+		return nil, entity.ErrSprintClosed
+	}
+
+	next, err := s.nextSprint(ctx, sprint, nextSprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	// A closed sprint has nothing left to forecast
+	report := s.report(ctx, sprint, false)
+	summary := entity.SprintSummary{
+		ClosedAt:   now,
+		RolledOver: make([]entity.SprintTask, 0),
+	}
+
+	for _, status := range report.Tasks {
+		if status.AddedMidSprint {
+			summary.Added++
+		} else {
+			summary.Committed++
+		}
+
+		switch {
+		case status.Done && status.AddedMidSprint:
+			summary.AddedDone++
+		case status.Done:
+			summary.CommittedDone++
+		case status.Task != nil:
+			summary.RolledOver = append(summary.RolledOver, status.SprintTask)
+		}
+	}
+
+	trackedTime, err := s.trackedTime(ctx, sprint, now)
+	if err != nil {
+		return nil, err
+	}
+	summary.TrackedTime = trackedTime
+
+	if next != nil && len(summary.RolledOver) > 0 {
+		summary.RolledOverTo = next.ID()
+		// Rolled over tasks are part of the next sprint's commitment
+		addedAt := now
+		if next.StartDate().Before(now) {
+			addedAt = next.StartDate()
+		}
+		for _, task := range summary.RolledOver {
+			task.AddedAt = addedAt
+			if _, err := next.AddTask(task); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := sprint.Close(summary); err != nil {
+		return nil, err
+	}
+	if err := s.sprintRepo.Save(ctx, sprint); err != nil {
+		return nil, fmt.Errorf("failed to save sprint: %w", err)
+	}
+	if next != nil && summary.RolledOverTo != "" {
+		if err := s.sprintRepo.Save(ctx, next); err != nil {
+			return nil, fmt.Errorf("failed to save sprint %s: %w", next.ID(), err)
+		}
+	}
+
+	return report, nil
+}
+
+// nextSprint returns the sprint unfinished tasks roll over to, nil if there is none
+func (s *SprintService) nextSprint(ctx context.Context, sprint *entity.Sprint, nextSprintID string) (*entity.Sprint, error) {
+	if nextSprintID != "" {
+		if nextSprintID == sprint.ID() {
+			return nil, fmt.Errorf("cannot roll over to the sprint being closed: %w", entity.ErrInvalidSprintTask)
+		}
+		next, err := s.sprintRepo.FindByID(ctx, sprint.ProjectID(), nextSprintID)
+		if err != nil {
+			return nil, err
+		}
+		if next.IsClosed() {
+			return nil, entity.ErrSprintClosed
+		}
+		return next, nil
+	}
+
+	sprints, err := s.sprintRepo.FindByProject(ctx, sprint.ProjectID())
+	if err != nil {
+		return nil, err
+	}
+	var next *entity.Sprint
+	for _, other := range sprints {
+		if other.ID() == sprint.ID() || other.IsClosed() || !other.StartDate().After(sprint.StartDate()) {
+			continue
+		}
+		if next == nil || other.StartDate().Before(next.StartDate()) {
+			next = other
+		}
+	}
+	return next, nil
+}
+
+// trackedTime sums the time logged on the tasks of a sprint from its start to
+// the end of its last day, or until if the sprint is closed early
+func (s *SprintService) trackedTime(ctx context.Context, sprint *entity.Sprint, until time.Time) (time.Duration, error) {
+	year, month, day := sprint.EndDate().Date()
+	end := time.Date(year, month, day+1, 0, 0, 0, 0, sprint.EndDate().Location()).Add(-time.Nanosecond)
+	if until.Before(end) {
+		end = until
+	}
+
+	logs, err := s.timeLogRepo.FindByDateRange(ctx, sprint.ProjectID(), sprint.StartDate(), end)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load time logs: %w", err)
+	}
+
+	trackedTimes := entity.TrackedTimeByTask(logs)
+	var total time.Duration
+	for _, task := range sprint.Tasks() {
+		total += trackedTimes[shortTaskID(task.TaskID)]
+	}
+	return total, nil
+}
+
+// report resolves the tasks of a sprint against their boards, forecasting the
+// completion of the unfinished ones when forecast is set
+func (s *SprintService) report(ctx context.Context, sprint *entity.Sprint, forecast bool) *SprintReport {
+	report := &SprintReport{
+		Sprint: sprint,
+		Tasks:  make([]SprintTaskStatus, 0),
+	}
+
+	boards := make(map[string]*entity.Board)
+	boardIDs := make([]string, 0)
+	remaining := 0
+	for _, sprintTask := range sprint.Tasks() {
+		status := SprintTaskStatus{
+			SprintTask:     sprintTask,
+			AddedMidSprint: sprint.IsAddedMidSprint(sprintTask),
+		}
+
+		board, ok := boards[sprintTask.BoardID]
+		if !ok {
+			board, _ = s.boardRepo.FindByID(ctx, sprintTask.BoardID)
+			boards[sprintTask.BoardID] = board
+		}
+		if board != nil {
+			status.Task, status.Column = findTaskByID(board, sprintTask.TaskID)
+			if status.Task != nil {
+				status.Done = board.IsTaskDone(status.Task.ID())
+				boardIDs = appendUnique(boardIDs, sprintTask.BoardID)
+				if !status.Done {
+					remaining++
+				}
+			}
+		}
+
+		report.Tasks = append(report.Tasks, status)
+	}
+
+	if forecast && !sprint.IsClosed() && remaining > 0 {
+		forecast, err := s.forecastService.ForecastCompletion(ctx, boardIDs, remaining, nil)
+		if err != nil && !errors.Is(err, entity.ErrNoThroughputHistory) {
+			report.ForecastErr = err
+		}
+		report.Forecast = forecast
+	}
+	return report
+}
+
+// hasSprintTask checks if a sprint has a task, given by full or short ID
+func hasSprintTask(sprint *entity.Sprint, boardID, taskID string) bool {
+	for _, task := range sprint.Tasks() {
+		if task.BoardID == boardID && sameTaskID(task.TaskID, taskID) {
+			return true
+		}
+	}
+	return false
+}
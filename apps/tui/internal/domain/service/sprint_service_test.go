@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/infrastructure/persistence/filesystem"
+)
+
+// sprintFixture is a work project with a tracker board and a sprint that ran
+// over the two weeks before yesterday
+type sprintFixture struct {
+	service     *SprintService
+	sprintRepo  repository.SprintRepository
+	timeLogRepo repository.TimeLogRepository
+	board       *entity.Board
+	sprint      *entity.Sprint
+	tasks       map[string]*entity.Task // by title
+}
+
+// newSprintFixture plans committed and mid-sprint tasks, done or not, and a
+// committed task that has since been deleted, in the sprint
+func newSprintFixture(t *testing.T) *sprintFixture {
+	t.Helper()
+	ctx := context.Background()
+	root := t.TempDir()
+	writeTestProject(t, root)
+
+	boardRepo := filesystem.NewBoardRepository(root)
+	f := &sprintFixture{
+		sprintRepo:  filesystem.NewSprintRepository(root),
+		timeLogRepo: filesystem.NewTimeLogRepository(root),
+		board:       newMilestoneTestBoard(t, "work/tracker", "Tracker"),
+		tasks:       make(map[string]*entity.Task),
+	}
+	forecastService := NewForecastService(boardRepo, NewFlowMetricsService(boardRepo), NewWorkScheduleService(filesystem.NewWorkScheduleRepository(root)))
+	f.service = NewSprintService(f.sprintRepo, boardRepo, f.timeLogRepo, forecastService)
+
+	today := startOfDay(time.Now())
+	sprint, err := entity.NewSprint("work", "Sprint 1", "", today.AddDate(0, 0, -16), today.AddDate(0, 0, -2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.sprint = sprint
+	midSprint := sprint.StartDate().AddDate(0, 0, 3)
+
+	plan := []struct {
+		title   string
+		column  string
+		addedAt time.Time
+	}{
+		{"committed-done", "Done", sprint.StartDate()},
+		{"committed-open", "To Do", sprint.StartDate().Add(20 * time.Hour)},
+		{"added-done", "Done", midSprint},
+		{"added-open", "To Do", midSprint},
+		{"deleted", "To Do", sprint.StartDate()},
+	}
+	for _, p := range plan {
+		task := addMilestoneTestTask(t, f.board, p.column, p.title, 0)
+		f.tasks[p.title] = task
+		if _, err := sprint.AddTask(entity.SprintTask{BoardID: f.board.ID(), TaskID: task.ID().String(), AddedAt: p.addedAt}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	f.tasks["unplanned"] = addMilestoneTestTask(t, f.board, "To Do", "unplanned", 0)
+
+	_, column, err := f.board.FindTask(f.tasks["deleted"].ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := column.RemoveTask(f.tasks["deleted"].ID()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := boardRepo.Save(ctx, f.board); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.sprintRepo.Save(ctx, sprint); err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+// writeTestProject creates the work project
+func writeTestProject(t *testing.T, root string) {
+	t.Helper()
+	dir := filepath.Join(root, "projects", "work")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "project.md"), []byte("---\nid: work\nname: Work\n---\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// planSprint saves an open sprint starting days from the fixture sprint's start
+func (f *sprintFixture) planSprint(t *testing.T, name string, days int) *entity.Sprint {
+	t.Helper()
+	start := f.sprint.StartDate().AddDate(0, 0, days)
+	sprint, err := entity.NewSprint("work", name, "", start, start.AddDate(0, 0, 14))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.sprintRepo.Save(context.Background(), sprint); err != nil {
+		t.Fatal(err)
+	}
+	return sprint
+}
+
+// logTime saves a time log on a task
+func (f *sprintFixture) logTime(t *testing.T, title string, start time.Time, duration time.Duration) {
+	t.Helper()
+	taskID := f.tasks[title].ID().String()
+	log := entity.NewTimeLogWithDuration(fmt.Sprintf("log-%s-%d", title, start.Unix()), "work", &taskID, entity.TimeLogSourceManual, start, start.Add(duration), "")
+	if err := f.timeLogRepo.Save(context.Background(), log); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSprintClose(t *testing.T) {
+	tests := []struct {
+		name string
+		// setup plans other sprints and returns the sprint to roll over to, if given
+		setup          func(t *testing.T, f *sprintFixture) string
+		wantRolledOver string // the sprint unfinished tasks roll over to
+	}{
+		{
+			name: "next open sprint",
+			setup: func(t *testing.T, f *sprintFixture) string {
+				f.planSprint(t, "Sprint 3", 28)
+				f.planSprint(t, "Sprint 2", 14)
+				f.planSprint(t, "Sprint 0", -14)
+				return ""
+			},
+			wantRolledOver: "sprint-2",
+		},
+		{
+			name: "given sprint",
+			setup: func(t *testing.T, f *sprintFixture) string {
+				f.planSprint(t, "Sprint 2", 14)
+				f.planSprint(t, "Sprint 3", 28)
+				return "sprint-3"
+			},
+			wantRolledOver: "sprint-3",
+		},
+		{
+			name: "no next sprint",
+			setup: func(t *testing.T, f *sprintFixture) string {
+				f.planSprint(t, "Sprint 0", -14)
+				return ""
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			f := newSprintFixture(t)
+			nextSprintID := tt.setup(t, f)
+
+			if _, err := f.service.Close(ctx, "work", f.sprint.ID(), nextSprintID); err != nil {
+				t.Fatalf("close failed: %v", err)
+			}
+
+			closed, err := f.sprintRepo.FindByID(ctx, "work", f.sprint.ID())
+			if err != nil {
+				t.Fatal(err)
+			}
+			summary := closed.Summary()
+			if summary == nil {
+				t.Fatal("expected the summary to be saved")
+			}
+			// The deleted task counts toward the commitment
+			if summary.Committed != 3 || summary.CommittedDone != 1 || summary.Added != 2 || summary.AddedDone != 1 {
+				t.Errorf("expected 1 of 3 committed and 1 of 2 added tasks done, got %+v", summary)
+			}
+			rolledOver := make(map[string]bool)
+			for _, task := range summary.RolledOver {
+				rolledOver[task.TaskID] = true
+			}
+			if len(rolledOver) != 2 || !rolledOver[f.tasks["committed-open"].ID().String()] || !rolledOver[f.tasks["added-open"].ID().String()] {
+				t.Errorf("expected the 2 open tasks to roll over, got %+v", summary.RolledOver)
+			}
+			if summary.RolledOverTo != tt.wantRolledOver {
+				t.Errorf("expected the open tasks to roll over to %q, got %q", tt.wantRolledOver, summary.RolledOverTo)
+			}
+
+			sprints, err := f.sprintRepo.FindByProject(ctx, "work")
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, sprint := range sprints {
+				if sprint.ID() == f.sprint.ID() {
+					continue
+				}
+				tasks := sprint.Tasks()
+				if sprint.ID() != tt.wantRolledOver {
+					if len(tasks) != 0 {
+						t.Errorf("expected %s to be left alone, got %+v", sprint.ID(), tasks)
+					}
+					continue
+				}
+				if len(tasks) != 2 {
+					t.Fatalf("expected the 2 open tasks in %s, got %+v", sprint.ID(), tasks)
+				}
+				for _, task := range tasks {
+					if sprint.IsAddedMidSprint(task) {
+						t.Errorf("expected %s to be committed in %s, got added at %v", task.TaskID, sprint.ID(), task.AddedAt)
+					}
+				}
+			}
+
+			if _, err := f.service.Close(ctx, "work", f.sprint.ID(), ""); !errors.Is(err, entity.ErrSprintClosed) {
+				t.Errorf("expected closing twice to be rejected, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSprintCloseTrackedTime(t *testing.T) {
+	ctx := context.Background()
+	f := newSprintFixture(t)
+	start := f.sprint.StartDate()
+	end := f.sprint.EndDate()
+
+	f.logTime(t, "committed-done", start.Add(10*time.Hour), 2*time.Hour)
+	f.logTime(t, "added-open", end.Add(23*time.Hour), 30*time.Minute) // on the last day
+	f.logTime(t, "committed-done", start.Add(-time.Hour), time.Hour)  // before the start
+	f.logTime(t, "committed-open", end.Add(30*time.Hour), time.Hour)  // after the last day
+	f.logTime(t, "unplanned", start.Add(10*time.Hour), 3*time.Hour)   // not in the sprint
+
+	if _, err := f.service.Close(ctx, "work", f.sprint.ID(), ""); err != nil {
+		t.Fatalf("close failed: %v", err)
+	}
+	closed, err := f.sprintRepo.FindByID(ctx, "work", f.sprint.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := closed.Summary().TrackedTime; got != 150*time.Minute {
+		t.Errorf("expected 2h30m tracked on the sprint's tasks within the sprint, got %v", got)
+	}
+}
+
+func TestSprintCloseRejectsRollingOverIntoItself(t *testing.T) {
+	f := newSprintFixture(t)
+	if _, err := f.service.Close(context.Background(), "work", f.sprint.ID(), f.sprint.ID()); !errors.Is(err, entity.ErrInvalidSprintTask) {
+		t.Errorf("expected rolling over into the sprint being closed to be rejected, got %v", err)
+	}
+}
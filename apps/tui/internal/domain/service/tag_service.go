@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+)
+
+// TagUsage counts the tasks and notes of a project that carry a tag
+type TagUsage struct {
+	Tasks int
+	Notes int
+}
+
+// TagRewriteResult reports what a tag rewrite changed
+type TagRewriteResult struct {
+	TasksUpdated int
+	NotesUpdated int
+	BoardIDs     []string // boards with at least one updated task
+}
+
+// TagService manages the tag registries of projects and keeps the tags of
+// their tasks and notes in line with them
+type TagService struct {
+	boardRepo repository.BoardRepository
+	noteRepo  repository.NoteRepository
+	tagRepo   repository.TagRepository
+}
+
+// NewTagService creates a new TagService
+func NewTagService(
+	boardRepo repository.BoardRepository,
+	noteRepo repository.NoteRepository,
+	tagRepo repository.TagRepository,
+) *TagService {
+	return &TagService{
+		boardRepo: boardRepo,
+		noteRepo:  noteRepo,
+		tagRepo:   tagRepo,
+	}
+}
+
+// SaveTag registers or updates a tag, rewriting tasks and notes tagged with
+// an alias or another spelling of a registered tag to its name.
+//
+// Like the other changes to the registry, tasks and notes are rewritten
+// before the registry is saved: if a rewrite fails, the registry still
+// describes the tags on disk and the change can be run again.
+func (s *TagService) SaveTag(ctx context.Context, projectID string, tag *entity.Tag) (*TagRewriteResult, error) {
+	registry, err := s.tagRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := registry.Set(tag); err != nil {
+		return nil, err
+	}
+
+	result, err := s.rewrite(ctx, projectID, func(existing string) (string, bool) {
+		resolved := registry.Resolve(existing)
+		return resolved, resolved != existing
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.tagRepo.Save(ctx, registry); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RenameTag renames a tag on every task and note of the project, and in the
+// registry if it is registered
+func (s *TagService) RenameTag(ctx context.Context, projectID, oldName, newName string) (*TagRewriteResult, error) {
+	registry, err := s.tagRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Match the old spellings, the registered tag changes with the rename
+	spellings := []string{oldName}
+	if tag, ok := registry.Find(oldName); ok {
+		spellings = append([]string{tag.Name()}, tag.Aliases()...)
+	}
+	matches := func(existing string) bool {
+		for _, spelling := range spellings {
+			if strings.EqualFold(existing, spelling) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if err := registry.Rename(oldName, newName); err != nil {
+		return nil, err
+	}
+
+	newName = registry.Resolve(strings.TrimSpace(newName))
+	result, err := s.rewrite(ctx, projectID, func(existing string) (string, bool) {
+		return newName, matches(existing) && existing != newName
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.tagRepo.Save(ctx, registry); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// MergeTags replaces the source tags with the target tag on every task and
+// note of the project; the sources become aliases of the target
+func (s *TagService) MergeTags(ctx context.Context, projectID string, sources []string, target string) (*TagRewriteResult, error) {
+	registry, err := s.tagRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := registry.Merge(sources, target); err != nil {
+		return nil, err
+	}
+
+	targetTag, _ := registry.Find(target)
+	result, err := s.rewrite(ctx, projectID, func(existing string) (string, bool) {
+		return targetTag.Name(), targetTag.Matches(existing) && existing != targetTag.Name()
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.tagRepo.Save(ctx, registry); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DeleteTag removes a tag, with any of its aliases, from every task and note
+// of the project and from the registry
+func (s *TagService) DeleteTag(ctx context.Context, projectID, name string) (*TagRewriteResult, error) {
+	registry, err := s.tagRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := func(existing string) bool { return strings.EqualFold(existing, name) }
+	tag, registered := registry.Find(name)
+	if registered {
+		matches = tag.Matches
+		if err := registry.Remove(name); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := s.rewrite(ctx, projectID, func(existing string) (string, bool) {
+		return "", matches(existing)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !registered {
+		if result.TasksUpdated == 0 && result.NotesUpdated == 0 {
+			return nil, entity.ErrTagNotFound
+		}
+		return result, nil
+	}
+	if err := s.tagRepo.Save(ctx, registry); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Usage counts, for every tag used in the project, the tasks and notes that carry it
+func (s *TagService) Usage(ctx context.Context, projectID string) (map[string]TagUsage, error) {
+	usage := make(map[string]TagUsage)
+
+	boards, err := s.projectBoards(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	for _, board := range boards {
+		for _, column := range board.Columns() {
+			for _, task := range column.Tasks() {
+				for _, tag := range task.Tags() {
+					counts := usage[tag]
+					counts.Tasks++
+					usage[tag] = counts
+				}
+			}
+		}
+	}
+
+	notes, err := s.noteRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load notes: %w", err)
+	}
+	for _, note := range notes {
+		for _, tag := range note.Tags() {
+			counts := usage[tag]
+			counts.Notes++
+			usage[tag] = counts
+		}
+	}
+
+	return usage, nil
+}
+
+// rewrite passes every tag of the project's tasks and notes through replace,
+// which returns the new tag (empty to remove it) and whether to change it,
+// and saves what changed
+func (s *TagService) rewrite(ctx context.Context, projectID string, replace func(tag string) (string, bool)) (*TagRewriteResult, error) {
+	result := &TagRewriteResult{BoardIDs: make([]string, 0)}
+
+	boards, err := s.projectBoards(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	for _, board := range boards {
+		boardUpdated := false
+		for _, column := range board.Columns() {
+			for _, task := range column.Tasks() {
+				changed := false
+				for _, tag := range task.Tags() {
+					if newTag, ok := replace(tag); ok {
+						changed = task.ReplaceTag(tag, newTag) || changed
+					}
+				}
+				if !changed {
+					continue
+				}
+				if err := s.boardRepo.SaveTask(ctx, board.ID(), column.Name(), task); err != nil {
+					return nil, fmt.Errorf("failed to save task %s: %w", task.ID().ShortID(), err)
+				}
+				result.TasksUpdated++
+				boardUpdated = true
+			}
+		}
+		if boardUpdated {
+			result.BoardIDs = append(result.BoardIDs, board.ID())
+		}
+	}
+
+	notes, err := s.noteRepo.FindByProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load notes: %w", err)
+	}
+	for _, note := range notes {
+		changed := false
+		for _, tag := range note.Tags() {
+			if newTag, ok := replace(tag); ok {
+				changed = note.ReplaceTag(tag, newTag) || changed
+			}
+		}
+		if !changed {
+			continue
+		}
+		if err := s.noteRepo.Save(ctx, note); err != nil {
+			return nil, fmt.Errorf("failed to save note %s: %w", note.ID(), err)
+		}
+		result.NotesUpdated++
+	}
+
+	return result, nil
+}
+
+// projectBoards loads the boards of a project
+func (s *TagService) projectBoards(ctx context.Context, projectID string) ([]*entity.Board, error) {
+	boards, err := s.boardRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load boards: %w", err)
+	}
+
+	projectBoards := make([]*entity.Board, 0)
+	for _, board := range boards {
+		if board.ProjectID() == projectID {
+			projectBoards = append(projectBoards, board)
+		}
+	}
+	return projectBoards, nil
+}
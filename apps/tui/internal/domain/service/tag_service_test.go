@@ -0,0 +1,265 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/infrastructure/persistence/filesystem"
+)
+
+// tagFixture is a work project with a tracker board and a note, tagged with
+// the tags they were created with
+type tagFixture struct {
+	service   *TagService
+	boardRepo repository.BoardRepository
+	noteRepo  repository.NoteRepository
+	tagRepo   repository.TagRepository
+	task      *entity.Task
+	other     *entity.Task
+	note      *entity.Note
+}
+
+// newTagFixture saves a task, another task and a note with the given tags
+func newTagFixture(t *testing.T, taskTags, otherTags, noteTags []string) *tagFixture {
+	t.Helper()
+	ctx := context.Background()
+	root := t.TempDir()
+	writeTestProject(t, root)
+
+	f := &tagFixture{
+		boardRepo: filesystem.NewBoardRepository(root),
+		noteRepo:  filesystem.NewNoteRepository(root),
+		tagRepo:   filesystem.NewTagRepository(root),
+	}
+	f.service = NewTagService(f.boardRepo, f.noteRepo, f.tagRepo)
+
+	board := newMilestoneTestBoard(t, "work/tracker", "Tracker")
+	f.task = addMilestoneTestTask(t, board, "To Do", "task", 0)
+	for _, tag := range taskTags {
+		f.task.AddTag(tag)
+	}
+	f.other = addMilestoneTestTask(t, board, "Done", "other", 0)
+	for _, tag := range otherTags {
+		f.other.AddTag(tag)
+	}
+	if err := f.boardRepo.Save(ctx, board); err != nil {
+		t.Fatal(err)
+	}
+
+	note, err := entity.NewNote("standup-0001", "Standup", entity.NoteTypeStandup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	note.SetProjectID("work")
+	for _, tag := range noteTags {
+		note.AddTag(tag)
+	}
+	if err := f.noteRepo.Save(ctx, note); err != nil {
+		t.Fatal(err)
+	}
+	f.note = note
+	return f
+}
+
+// register saves a tag with aliases in the registry
+func (f *tagFixture) register(t *testing.T, name string, aliases ...string) {
+	t.Helper()
+	ctx := context.Background()
+	registry, err := f.tagRepo.FindByProject(ctx, "work")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tag, err := entity.NewTag(name, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tag.SetAliases(aliases)
+	if err := registry.Set(tag); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.tagRepo.Save(ctx, registry); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// tags reloads the tags of the task, the other task and the note
+func (f *tagFixture) tags(t *testing.T) (task, other, note []string) {
+	t.Helper()
+	ctx := context.Background()
+	board, err := f.boardRepo.FindByID(ctx, "work/tracker")
+	if err != nil {
+		t.Fatal(err)
+	}
+	loadedTask, _, err := board.FindTask(f.task.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	loadedOther, _, err := board.FindTask(f.other.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	notes, err := f.noteRepo.FindByProject(ctx, "work")
+	if err != nil || len(notes) != 1 {
+		t.Fatalf("expected the note, got %v (%v)", notes, err)
+	}
+	return sorted(loadedTask.Tags()), sorted(loadedOther.Tags()), sorted(notes[0].Tags())
+}
+
+// registered returns the registered tags with their aliases
+func (f *tagFixture) registered(t *testing.T) map[string][]string {
+	t.Helper()
+	registry, err := f.tagRepo.FindByProject(context.Background(), "work")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tags := make(map[string][]string)
+	for _, tag := range registry.Tags() {
+		tags[tag.Name()] = sorted(tag.Aliases())
+	}
+	return tags
+}
+
+// sorted returns a sorted copy of tags, empty rather than nil
+func sorted(tags []string) []string {
+	sortedTags := append(make([]string, 0, len(tags)), tags...)
+	sort.Strings(sortedTags)
+	return sortedTags
+}
+
+func TestSaveTagResolvesAliases(t *testing.T) {
+	f := newTagFixture(t, []string{"K8s", "urgent"}, []string{"kube"}, []string{"kubernetes"})
+	tag, err := entity.NewTag("kubernetes", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tag.SetAliases([]string{"k8s", "kube"})
+
+	result, err := f.service.SaveTag(context.Background(), "work", tag)
+	if err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+	if result.TasksUpdated != 2 || result.NotesUpdated != 0 {
+		t.Errorf("expected 2 tasks and no notes updated, got %+v", result)
+	}
+	task, other, note := f.tags(t)
+	if !reflect.DeepEqual(task, []string{"kubernetes", "urgent"}) || !reflect.DeepEqual(other, []string{"kubernetes"}) || !reflect.DeepEqual(note, []string{"kubernetes"}) {
+		t.Errorf("expected the aliases to resolve to kubernetes, got %v, %v and %v", task, other, note)
+	}
+}
+
+func TestRenameTag(t *testing.T) {
+	f := newTagFixture(t, []string{"bug"}, []string{"defect"}, []string{"Bug"})
+	f.register(t, "bug", "defect")
+
+	result, err := f.service.RenameTag(context.Background(), "work", "BUG", "issue")
+	if err != nil {
+		t.Fatalf("rename failed: %v", err)
+	}
+	if result.TasksUpdated != 2 || result.NotesUpdated != 1 || !reflect.DeepEqual(result.BoardIDs, []string{"work/tracker"}) {
+		t.Errorf("expected 2 tasks and a note on the tracker updated, got %+v", result)
+	}
+	task, other, note := f.tags(t)
+	if !reflect.DeepEqual(task, []string{"issue"}) || !reflect.DeepEqual(other, []string{"issue"}) || !reflect.DeepEqual(note, []string{"issue"}) {
+		t.Errorf("expected every spelling of bug to be renamed, got %v, %v and %v", task, other, note)
+	}
+	if got := f.registered(t); !reflect.DeepEqual(got, map[string][]string{"issue": {"defect"}}) {
+		t.Errorf("expected the registered tag to be renamed with its aliases, got %v", got)
+	}
+}
+
+func TestMergeTagsIntoExistingTarget(t *testing.T) {
+	f := newTagFixture(t, []string{"server", "api"}, []string{"be", "ui"}, []string{"srv"})
+	f.register(t, "backend", "be")
+	f.register(t, "server", "srv")
+
+	result, err := f.service.MergeTags(context.Background(), "work", []string{"server", "api"}, "backend")
+	if err != nil {
+		t.Fatalf("merge failed: %v", err)
+	}
+	if result.TasksUpdated != 2 || result.NotesUpdated != 1 {
+		t.Errorf("expected 2 tasks and a note updated, got %+v", result)
+	}
+	task, other, note := f.tags(t)
+	if !reflect.DeepEqual(task, []string{"backend"}) || !reflect.DeepEqual(other, []string{"backend", "ui"}) || !reflect.DeepEqual(note, []string{"backend"}) {
+		t.Errorf("expected the sources to be merged into backend, got %v, %v and %v", task, other, note)
+	}
+	want := map[string][]string{"backend": {"api", "be", "server", "srv"}}
+	if got := f.registered(t); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestDeleteTag(t *testing.T) {
+	ctx := context.Background()
+
+	// A tag that is not registered is removed wherever it is used
+	f := newTagFixture(t, []string{"wip", "urgent"}, []string{"WIP"}, []string{"urgent"})
+	f.register(t, "urgent")
+	result, err := f.service.DeleteTag(ctx, "work", "wip")
+	if err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if result.TasksUpdated != 2 || result.NotesUpdated != 0 {
+		t.Errorf("expected 2 tasks updated, got %+v", result)
+	}
+	task, other, note := f.tags(t)
+	if !reflect.DeepEqual(task, []string{"urgent"}) || len(other) != 0 || !reflect.DeepEqual(note, []string{"urgent"}) {
+		t.Errorf("expected only wip to be removed, got %v, %v and %v", task, other, note)
+	}
+	if got := f.registered(t); !reflect.DeepEqual(got, map[string][]string{"urgent": {}}) {
+		t.Errorf("expected the registry to be left alone, got %v", got)
+	}
+
+	if _, err := f.service.DeleteTag(ctx, "work", "wip"); !errors.Is(err, entity.ErrTagNotFound) {
+		t.Errorf("expected a tag used nowhere to be reported, got %v", err)
+	}
+}
+
+// failingTaskSaves fails to save any task
+type failingTaskSaves struct {
+	repository.BoardRepository
+}
+
+func (r failingTaskSaves) SaveTask(ctx context.Context, boardID string, columnName string, task *entity.Task) error {
+	return errors.New("disk full")
+}
+
+func TestTagChangesKeepRegistryWhenRewriteFails(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name   string
+		change func(s *TagService) error
+	}{
+		{"rename", func(s *TagService) error {
+			_, err := s.RenameTag(ctx, "work", "bug", "issue")
+			return err
+		}},
+		{"merge", func(s *TagService) error {
+			_, err := s.MergeTags(ctx, "work", []string{"defect"}, "issue")
+			return err
+		}},
+		{"delete", func(s *TagService) error {
+			_, err := s.DeleteTag(ctx, "work", "bug")
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTagFixture(t, []string{"bug"}, nil, nil)
+			f.register(t, "bug", "defect")
+			failing := NewTagService(failingTaskSaves{f.boardRepo}, f.noteRepo, f.tagRepo)
+
+			if err := tt.change(failing); err == nil {
+				t.Fatal("expected the failed task save to be reported")
+			}
+			if got := f.registered(t); !reflect.DeepEqual(got, map[string][]string{"bug": {"defect"}}) {
+				t.Errorf("expected the registry to be left alone, got %v", got)
+			}
+		})
+	}
+}
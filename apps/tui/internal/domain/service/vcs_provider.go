@@ -1,5 +1,16 @@
 package service
 
+import "time"
+
+// VCSCommit describes a single commit in a repository's history
+type VCSCommit struct {
+	Hash    string
+	Message string
+	Author  string
+	Date    time.Time
+	Files   []string
+}
+
 // VCSProvider defines the interface for interacting with version control systems
 // This abstraction allows for different VCS implementations (git, svn, hg, etc.)
 type VCSProvider interface {
@@ -28,4 +39,34 @@ type VCSProvider interface {
 
 	// CreateAndCheckoutBranch creates a new branch and checks it out
 	CreateAndCheckoutBranch(repoPath, branchName string) error
+
+	// InitRepository creates a repository at path if one does not exist yet
+	InitRepository(path string) error
+
+	// CommitAll stages every change in the working tree and commits it.
+	// Returns an empty hash when there was nothing to commit.
+	CommitAll(repoPath, message string) (string, error)
+
+	// AmendCommit stages every change in the working tree and folds it into
+	// the last commit, replacing its message
+	AmendCommit(repoPath, message string) (string, error)
+
+	// Log returns commits touching pathspec, newest first. A limit of 0 means no limit.
+	Log(repoPath, pathspec string, limit int) ([]VCSCommit, error)
+
+	// ResolveRevision returns the commit hash a revision names. Revisions that
+	// could be taken for options or don't name a commit are rejected.
+	ResolveRevision(repoPath, revision string) (string, error)
+
+	// ListFiles returns the files under pathspec as of the given revision
+	ListFiles(repoPath, revision, pathspec string) ([]string, error)
+
+	// ShowFile returns the contents of a file as of the given revision
+	ShowFile(repoPath, revision, path string) ([]byte, error)
+
+	// RevisionAt returns the last commit made at or before the given time
+	RevisionAt(repoPath string, at time.Time) (string, error)
+
+	// RestorePath replaces path in the working tree with its contents at revision
+	RestorePath(repoPath, revision, path string) error
 }
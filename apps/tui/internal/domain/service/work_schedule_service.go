@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+)
+
+// WorkScheduleService resolves the working hours that apply to a project and
+// keeps the default schedule and project overrides up to date
+type WorkScheduleService struct {
+	scheduleRepo repository.WorkScheduleRepository
+}
+
+// NewWorkScheduleService creates a new WorkScheduleService
+func NewWorkScheduleService(scheduleRepo repository.WorkScheduleRepository) *WorkScheduleService {
+	return &WorkScheduleService{
+		scheduleRepo: scheduleRepo,
+	}
+}
+
+// Schedule returns the schedule of a project: its override if it has one, else
+// the default schedule. An empty projectID returns the default schedule, which
+// is Monday to Friday 9:00-17:00 with a lunch break until it is changed.
+func (s *WorkScheduleService) Schedule(ctx context.Context, projectID string) (*entity.WorkSchedule, error) {
+	if projectID != "" {
+		schedule, err := s.scheduleRepo.Find(ctx, projectID)
+		if err == nil {
+			return schedule, nil
+		}
+		if err != entity.ErrWorkScheduleNotFound {
+			return nil, err
+		}
+	}
+
+	schedule, err := s.scheduleRepo.Find(ctx, "")
+	if err == entity.ErrWorkScheduleNotFound {
+		return entity.NewDefaultWorkSchedule(entity.DefaultWorkScheduleID), nil
+	}
+	return schedule, err
+}
+
+// Update applies change to the schedule of a project and saves it as the
+// project's override; a project without one starts from the default schedule.
+// An empty projectID updates the default schedule.
+func (s *WorkScheduleService) Update(ctx context.Context, projectID string, change func(*entity.WorkSchedule) error) (*entity.WorkSchedule, error) {
+	schedule, err := s.Schedule(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := change(schedule); err != nil {
+		return nil, err
+	}
+
+	if err := s.scheduleRepo.Save(ctx, projectID, schedule); err != nil {
+		return nil, fmt.Errorf("failed to save work schedule: %w", err)
+	}
+	return s.Schedule(ctx, projectID)
+}
+
+// IsWorkingTime checks if a moment falls within the working hours of a
+// project, or of the default schedule for an empty projectID
+func (s *WorkScheduleService) IsWorkingTime(ctx context.Context, projectID string, t time.Time) (bool, error) {
+	schedule, err := s.Schedule(ctx, projectID)
+	if err != nil {
+		return false, err
+	}
+	return schedule.IsWithinWorkingHours(t), nil
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/infrastructure/persistence/filesystem"
+)
+
+// newWorkScheduleTestService returns a schedule service over a data root with
+// a work project only
+func newWorkScheduleTestService(t *testing.T) *WorkScheduleService {
+	t.Helper()
+	root := t.TempDir()
+	writeTestProject(t, root)
+	return NewWorkScheduleService(filesystem.NewWorkScheduleRepository(root))
+}
+
+// berlinMondays changes a schedule to Berlin time with Mondays from 10:00 to 18:00
+func berlinMondays(schedule *entity.WorkSchedule) error {
+	schedule.SetTimezone("Europe/Berlin")
+	monday, err := entity.NewDaySchedule(entity.TimeOfDay{Hour: 10}, entity.TimeOfDay{Hour: 18}, nil, nil)
+	if err != nil {
+		return err
+	}
+	schedule.SetDaySchedule(entity.WeekdayMonday, monday)
+	return nil
+}
+
+// fridaysOff changes a schedule to have Fridays off
+func fridaysOff(schedule *entity.WorkSchedule) error {
+	schedule.SetDaySchedule(entity.WeekdayFriday, entity.NewDayOff())
+	return nil
+}
+
+func TestWorkScheduleResolution(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		// setup changes the default schedule and the work project's override
+		setup        func(t *testing.T, s *WorkScheduleService)
+		projectID    string
+		wantTimezone string
+		wantMonday   entity.TimeOfDay // start of the working hours on Mondays
+		wantFriday   bool             // whether Friday is a working day
+	}{
+		{
+			name:         "built-in default",
+			setup:        func(t *testing.T, s *WorkScheduleService) {},
+			projectID:    "work",
+			wantTimezone: time.Local.String(),
+			wantMonday:   entity.TimeOfDay{Hour: 9},
+			wantFriday:   true,
+		},
+		{
+			name: "saved default for a project without an override",
+			setup: func(t *testing.T, s *WorkScheduleService) {
+				if _, err := s.Update(ctx, "", berlinMondays); err != nil {
+					t.Fatal(err)
+				}
+			},
+			projectID:    "work",
+			wantTimezone: "Europe/Berlin",
+			wantMonday:   entity.TimeOfDay{Hour: 10},
+			wantFriday:   true,
+		},
+		{
+			name: "project override starts from the default",
+			setup: func(t *testing.T, s *WorkScheduleService) {
+				if _, err := s.Update(ctx, "", berlinMondays); err != nil {
+					t.Fatal(err)
+				}
+				if _, err := s.Update(ctx, "work", fridaysOff); err != nil {
+					t.Fatal(err)
+				}
+			},
+			projectID:    "work",
+			wantTimezone: "Europe/Berlin",
+			wantMonday:   entity.TimeOfDay{Hour: 10},
+			wantFriday:   false,
+		},
+		{
+			name: "default left alone by a project override",
+			setup: func(t *testing.T, s *WorkScheduleService) {
+				if _, err := s.Update(ctx, "work", fridaysOff); err != nil {
+					t.Fatal(err)
+				}
+			},
+			projectID:    "",
+			wantTimezone: time.Local.String(),
+			wantMonday:   entity.TimeOfDay{Hour: 9},
+			wantFriday:   true,
+		},
+		{
+			name: "later default changes do not reach an override",
+			setup: func(t *testing.T, s *WorkScheduleService) {
+				if _, err := s.Update(ctx, "work", fridaysOff); err != nil {
+					t.Fatal(err)
+				}
+				if _, err := s.Update(ctx, "", berlinMondays); err != nil {
+					t.Fatal(err)
+				}
+			},
+			projectID:    "work",
+			wantTimezone: time.Local.String(),
+			wantMonday:   entity.TimeOfDay{Hour: 9},
+			wantFriday:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newWorkScheduleTestService(t)
+			tt.setup(t, s)
+
+			schedule, err := s.Schedule(ctx, tt.projectID)
+			if err != nil {
+				t.Fatalf("schedule failed: %v", err)
+			}
+			if got := schedule.Timezone(); got != tt.wantTimezone {
+				t.Errorf("expected time zone %s, got %s", tt.wantTimezone, got)
+			}
+			if got := schedule.GetDaySchedule(entity.WeekdayMonday).StartTime(); got != tt.wantMonday {
+				t.Errorf("expected Mondays to start at %s, got %s", tt.wantMonday, got)
+			}
+			if got := schedule.GetDaySchedule(entity.WeekdayFriday).Enabled(); got != tt.wantFriday {
+				t.Errorf("expected Friday working %v, got %v", tt.wantFriday, got)
+			}
+		})
+	}
+}
+
+func TestWorkScheduleUpdateUnknownProject(t *testing.T) {
+	s := newWorkScheduleTestService(t)
+	if _, err := s.Update(context.Background(), "home", fridaysOff); !errors.Is(err, entity.ErrProjectNotFound) {
+		t.Errorf("expected an unknown project to be reported, got %v", err)
+	}
+}
+
+func TestIsWorkingTimeInProjectTimezone(t *testing.T) {
+	ctx := context.Background()
+	s := newWorkScheduleTestService(t)
+	if _, err := s.Update(ctx, "work", berlinMondays); err != nil {
+		t.Fatal(err)
+	}
+
+	// Monday March 2, 2026: 9:30 UTC is 10:30 in Berlin, 8:30 UTC is 9:30
+	for _, tt := range []struct {
+		projectID string
+		at        time.Time
+		want      bool
+	}{
+		{"work", time.Date(2026, 3, 2, 9, 30, 0, 0, time.UTC), true},
+		{"work", time.Date(2026, 3, 2, 8, 30, 0, 0, time.UTC), false},
+		{"work", time.Date(2026, 3, 2, 16, 30, 0, 0, time.UTC), true},
+	} {
+		got, err := s.IsWorkingTime(ctx, tt.projectID, tt.at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("expected %s working %v at %v, got %v", tt.projectID, tt.want, tt.at, got)
+		}
+	}
+}
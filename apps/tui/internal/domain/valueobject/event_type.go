@@ -16,6 +16,12 @@ const (
 	EventTaskDueDateSet      EventType = "task.due_date_set"
 	EventTaskDueDateChanged  EventType = "task.due_date_changed"
 	EventTaskCompleted       EventType = "task.completed"
+	EventTaskTagsChanged     EventType = "task.tags_changed"
+
+	// Task activity events
+	EventTaskTimeLogged   EventType = "task.time_logged"
+	EventTaskCommitLinked EventType = "task.commit_linked"
+	EventTaskCommented    EventType = "task.commented"
 
 	// Due date events
 	EventTaskDueApproaching EventType = "task.due_approaching"
@@ -26,6 +32,20 @@ const (
 	EventColumnCreated      EventType = "column.created"
 	EventColumnDeleted      EventType = "column.deleted"
 	EventColumnWIPReached   EventType = "column.wip_reached"
+
+	// Milestone events
+	EventMilestoneAtRisk EventType = "milestone.at_risk"
+
+	// Schedule events
+	EventTaskBlockMissed EventType = "task.block_missed"
+
+	// Board events
+	EventBoardCreated EventType = "board.created"
+
+	// Project events
+	EventProjectCreated EventType = "project.created"
+	EventProjectUpdated EventType = "project.updated"
+	EventProjectDeleted EventType = "project.deleted"
 )
 
 // IsValid checks if the event type is valid
@@ -33,9 +53,13 @@ func (e EventType) IsValid() bool {
 	switch e {
 	case EventTaskCreated, EventTaskUpdated, EventTaskDeleted, EventTaskMoved,
 		EventTaskStatusChanged, EventTaskPriorityChanged, EventTaskDueDateSet,
-		EventTaskDueDateChanged, EventTaskCompleted, EventTaskDueApproaching,
+		EventTaskDueDateChanged, EventTaskCompleted, EventTaskTagsChanged,
+		EventTaskTimeLogged, EventTaskCommitLinked, EventTaskCommented,
+		EventTaskDueApproaching,
 		EventTaskOverdue, EventTaskCompletedOnTime, EventColumnCreated,
-		EventColumnDeleted, EventColumnWIPReached:
+		EventColumnDeleted, EventColumnWIPReached, EventBoardCreated,
+		EventProjectCreated, EventProjectUpdated, EventProjectDeleted,
+		EventMilestoneAtRisk, EventTaskBlockMissed:
 		return true
 	default:
 		return false
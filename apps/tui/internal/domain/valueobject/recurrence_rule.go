@@ -2,6 +2,8 @@ package valueobject
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -132,3 +134,118 @@ func (r *RecurrenceRule) String() string {
 	}
 	return ""
 }
+
+// ParseRecurrenceRule parses a rule in the form String returns, such as
+// "weekly" or "every 2 weeks"
+func ParseRecurrenceRule(s string) (*RecurrenceRule, error) {
+	fields := strings.Fields(strings.ToLower(strings.TrimSpace(s)))
+	switch len(fields) {
+	case 1:
+		if frequency := RecurrenceFrequency(fields[0]); frequency.IsValid() {
+			return NewRecurrenceRule(frequency, 1)
+		}
+	case 3:
+		if fields[0] != "every" {
+			break
+		}
+		interval, err := strconv.Atoi(fields[1])
+		if err != nil || interval < 1 {
+			break
+		}
+		units := map[string]RecurrenceFrequency{
+			"day": FrequencyDaily, "days": FrequencyDaily,
+			"week": FrequencyWeekly, "weeks": FrequencyWeekly,
+			"month": FrequencyMonthly, "months": FrequencyMonthly,
+			"year": FrequencyYearly, "years": FrequencyYearly,
+		}
+		if frequency, ok := units[fields[2]]; ok {
+			return NewRecurrenceRule(frequency, interval)
+		}
+	}
+	return nil, fmt.Errorf("invalid recurrence %q, use e.g. daily, weekly or every 2 weeks", s)
+}
+
+// Occurrences returns the occurrences of a series that starts at start and
+// fall within [from, to), honoring the days of the week of a weekly rule,
+// the day of the month of a monthly rule, the end date and the count
+func (r *RecurrenceRule) Occurrences(start, from, to time.Time) []time.Time {
+	occurrences := make([]time.Time, 0)
+	if !r.frequency.IsValid() {
+		return occurrences
+	}
+
+	seen := 0
+	for n := 0; ; n++ {
+		period := r.period(start, n)
+		if !period.Before(to) {
+			return occurrences
+		}
+		for _, at := range r.periodOccurrences(start, period) {
+			if at.Before(start) {
+				continue
+			}
+			seen++
+			if (r.count > 0 && seen > r.count) || (r.endDate != nil && at.After(*r.endDate)) {
+				return occurrences
+			}
+			if !at.Before(from) && at.Before(to) {
+				occurrences = append(occurrences, at)
+			}
+		}
+	}
+}
+
+// period returns the start of the nth period of a series that starts at
+// start. Monthly and yearly periods start on the first of their month, so
+// that a series starting on the 31st does not drift into the next month.
+func (r *RecurrenceRule) period(start time.Time, n int) time.Time {
+	switch r.frequency {
+	case FrequencyDaily:
+		return start.AddDate(0, 0, n*r.interval)
+	case FrequencyWeekly:
+		return start.AddDate(0, 0, 7*n*r.interval)
+	}
+
+	months := n * r.interval
+	if r.frequency == FrequencyYearly {
+		months *= 12
+	}
+	first := time.Date(start.Year(), start.Month(), 1,
+		start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+	return first.AddDate(0, months, 0)
+}
+
+// periodOccurrences returns the occurrences within the period starting at
+// period, in order. A monthly or yearly occurrence falls on the rule's day of
+// the month, or else the start's, and is skipped in months without that day.
+func (r *RecurrenceRule) periodOccurrences(start, period time.Time) []time.Time {
+	switch r.frequency {
+	case FrequencyWeekly:
+		if len(r.daysOfWeek) == 0 {
+			break
+		}
+		occurrences := make([]time.Time, 0, len(r.daysOfWeek))
+		for offset := 0; offset < 7; offset++ {
+			day := period.AddDate(0, 0, offset)
+			for _, weekday := range r.daysOfWeek {
+				if day.Weekday() == weekday {
+					occurrences = append(occurrences, day)
+					break
+				}
+			}
+		}
+		return occurrences
+	case FrequencyMonthly, FrequencyYearly:
+		dayOfMonth := start.Day()
+		if r.frequency == FrequencyMonthly && r.dayOfMonth > 0 {
+			dayOfMonth = r.dayOfMonth
+		}
+		day := time.Date(period.Year(), period.Month(), dayOfMonth,
+			period.Hour(), period.Minute(), period.Second(), period.Nanosecond(), period.Location())
+		if day.Month() != period.Month() {
+			return nil
+		}
+		return []time.Time{day}
+	}
+	return []time.Time{period}
+}
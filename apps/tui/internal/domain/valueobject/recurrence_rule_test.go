@@ -0,0 +1,216 @@
+package valueobject
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseRecurrenceRule(t *testing.T) {
+	tests := []struct {
+		input        string
+		wantFreq     RecurrenceFrequency
+		wantInterval int
+		wantErr      bool
+	}{
+		{"daily", FrequencyDaily, 1, false},
+		{" Weekly ", FrequencyWeekly, 1, false},
+		{"monthly", FrequencyMonthly, 1, false},
+		{"yearly", FrequencyYearly, 1, false},
+		{"every 2 weeks", FrequencyWeekly, 2, false},
+		{"every 1 day", FrequencyDaily, 1, false},
+		{"Every 3 Months", FrequencyMonthly, 3, false},
+		{"every 0 days", "", 0, true},
+		{"every two weeks", "", 0, true},
+		{"every 2 fortnights", "", 0, true},
+		{"each 2 weeks", "", 0, true},
+		{"hourly", "", 0, true},
+		{"", "", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			rule, err := ParseRecurrenceRule(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected %q to be rejected, got %v", tt.input, rule)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parse failed: %v", err)
+			}
+			if rule.Frequency() != tt.wantFreq || rule.Interval() != tt.wantInterval {
+				t.Errorf("expected %s every %d, got %s every %d", tt.wantFreq, tt.wantInterval, rule.Frequency(), rule.Interval())
+			}
+			// String returns the form ParseRecurrenceRule reads
+			again, err := ParseRecurrenceRule(rule.String())
+			if err != nil || again.Frequency() != rule.Frequency() || again.Interval() != rule.Interval() {
+				t.Errorf("expected %q to parse back to the same rule, got %v (%v)", rule.String(), again, err)
+			}
+		})
+	}
+}
+
+// on returns 10:00 UTC on a day
+func on(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 10, 0, 0, 0, time.UTC)
+}
+
+func TestOccurrences(t *testing.T) {
+	tests := []struct {
+		name string
+		// rule builds the rule of the series
+		rule      func(t *testing.T) *RecurrenceRule
+		start     time.Time
+		from, to  time.Time
+		wantDates []time.Time
+	}{
+		{
+			name:      "every 2 days",
+			rule:      newRule(FrequencyDaily, 2, nil),
+			start:     on(2026, 3, 1),
+			from:      on(2026, 3, 1),
+			to:        on(2026, 3, 8),
+			wantDates: []time.Time{on(2026, 3, 1), on(2026, 3, 3), on(2026, 3, 5), on(2026, 3, 7)},
+		},
+		{
+			name: "weekly on Monday, Wednesday and Friday",
+			rule: newRule(FrequencyWeekly, 1, func(r *RecurrenceRule) {
+				r.SetDaysOfWeek([]time.Weekday{time.Friday, time.Monday, time.Wednesday})
+			}),
+			start:     on(2026, 3, 2), // a Monday
+			from:      on(2026, 3, 1),
+			to:        on(2026, 3, 14),
+			wantDates: []time.Time{on(2026, 3, 2), on(2026, 3, 4), on(2026, 3, 6), on(2026, 3, 9), on(2026, 3, 11), on(2026, 3, 13)},
+		},
+		{
+			name: "every 2 weeks on Tuesday and Thursday",
+			rule: newRule(FrequencyWeekly, 2, func(r *RecurrenceRule) {
+				r.SetDaysOfWeek([]time.Weekday{time.Tuesday, time.Thursday})
+			}),
+			start:     on(2026, 3, 2),
+			from:      on(2026, 3, 1),
+			to:        on(2026, 3, 21),
+			wantDates: []time.Time{on(2026, 3, 3), on(2026, 3, 5), on(2026, 3, 17), on(2026, 3, 19)},
+		},
+		{
+			name:      "weekly day before the start skipped",
+			rule:      newRule(FrequencyWeekly, 1, func(r *RecurrenceRule) { r.SetDaysOfWeek([]time.Weekday{time.Monday}) }),
+			start:     on(2026, 3, 4), // a Wednesday
+			from:      on(2026, 3, 1),
+			to:        on(2026, 3, 17),
+			wantDates: []time.Time{on(2026, 3, 9), on(2026, 3, 16)},
+		},
+		{
+			name:      "monthly on the 31st skips shorter months",
+			rule:      newRule(FrequencyMonthly, 1, func(r *RecurrenceRule) { r.SetDayOfMonth(31) }),
+			start:     on(2026, 1, 31),
+			from:      on(2026, 1, 1),
+			to:        on(2026, 7, 1),
+			wantDates: []time.Time{on(2026, 1, 31), on(2026, 3, 31), on(2026, 5, 31)},
+		},
+		{
+			name:      "monthly from the 31st does not drift",
+			rule:      newRule(FrequencyMonthly, 1, nil),
+			start:     on(2026, 1, 31),
+			from:      on(2026, 1, 1),
+			to:        on(2026, 9, 1),
+			wantDates: []time.Time{on(2026, 1, 31), on(2026, 3, 31), on(2026, 5, 31), on(2026, 7, 31), on(2026, 8, 31)},
+		},
+		{
+			name:      "monthly on an earlier day than the start",
+			rule:      newRule(FrequencyMonthly, 1, func(r *RecurrenceRule) { r.SetDayOfMonth(28) }),
+			start:     on(2026, 1, 31),
+			from:      on(2026, 1, 1),
+			to:        on(2026, 5, 1),
+			wantDates: []time.Time{on(2026, 2, 28), on(2026, 3, 28), on(2026, 4, 28)},
+		},
+		{
+			name:      "yearly on February 29",
+			rule:      newRule(FrequencyYearly, 1, nil),
+			start:     on(2024, 2, 29),
+			from:      on(2024, 1, 1),
+			to:        on(2029, 1, 1),
+			wantDates: []time.Time{on(2024, 2, 29), on(2028, 2, 29)},
+		},
+		{
+			name:      "count",
+			rule:      newRule(FrequencyDaily, 1, func(r *RecurrenceRule) { r.SetCount(5) }),
+			start:     on(2026, 3, 1),
+			from:      on(2026, 3, 4),
+			to:        on(2026, 4, 1),
+			wantDates: []time.Time{on(2026, 3, 4), on(2026, 3, 5)},
+		},
+		{
+			name:      "count reached before the range",
+			rule:      newRule(FrequencyDaily, 1, func(r *RecurrenceRule) { r.SetCount(3) }),
+			start:     on(2026, 3, 1),
+			from:      on(2026, 3, 10),
+			to:        on(2026, 4, 1),
+			wantDates: []time.Time{},
+		},
+		{
+			name:      "count of weekly days",
+			rule:      newRule(FrequencyWeekly, 1, func(r *RecurrenceRule) { r.SetDaysOfWeek([]time.Weekday{time.Monday, time.Thursday}); r.SetCount(3) }),
+			start:     on(2026, 3, 2),
+			from:      on(2026, 3, 1),
+			to:        on(2026, 4, 1),
+			wantDates: []time.Time{on(2026, 3, 2), on(2026, 3, 5), on(2026, 3, 9)},
+		},
+		{
+			name:      "end date included",
+			rule:      newRule(FrequencyDaily, 1, func(r *RecurrenceRule) { r.SetEndDate(on(2026, 3, 3)) }),
+			start:     on(2026, 3, 1),
+			from:      on(2026, 3, 1),
+			to:        on(2026, 4, 1),
+			wantDates: []time.Time{on(2026, 3, 1), on(2026, 3, 2), on(2026, 3, 3)},
+		},
+		{
+			name:      "end date before the range",
+			rule:      newRule(FrequencyWeekly, 1, func(r *RecurrenceRule) { r.SetEndDate(on(2026, 3, 20)) }),
+			start:     on(2026, 3, 2),
+			from:      on(2026, 4, 1),
+			to:        on(2026, 5, 1),
+			wantDates: []time.Time{},
+		},
+		{
+			name:      "range end excluded",
+			rule:      newRule(FrequencyDaily, 1, nil),
+			start:     on(2026, 3, 1),
+			from:      on(2026, 3, 2),
+			to:        on(2026, 3, 4),
+			wantDates: []time.Time{on(2026, 3, 2), on(2026, 3, 3)},
+		},
+		{
+			name:      "series starting after the range",
+			rule:      newRule(FrequencyDaily, 1, nil),
+			start:     on(2026, 6, 1),
+			from:      on(2026, 3, 1),
+			to:        on(2026, 4, 1),
+			wantDates: []time.Time{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.rule(t).Occurrences(tt.start, tt.from, tt.to)
+			if !reflect.DeepEqual(got, tt.wantDates) {
+				t.Errorf("expected %v, got %v", tt.wantDates, got)
+			}
+		})
+	}
+}
+
+// newRule returns a builder of a rule, changed by configure if it is not nil
+func newRule(frequency RecurrenceFrequency, interval int, configure func(r *RecurrenceRule)) func(t *testing.T) *RecurrenceRule {
+	return func(t *testing.T) *RecurrenceRule {
+		t.Helper()
+		rule, err := NewRecurrenceRule(frequency, interval)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if configure != nil {
+			configure(rule)
+		}
+		return rule
+	}
+}
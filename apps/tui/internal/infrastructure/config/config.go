@@ -25,12 +25,18 @@ type Config struct {
 	Actions         ActionsConfig         `yaml:"actions"`
 	TimeTracking    TimeTrackingConfig    `yaml:"time_tracking"`
 	Calendar        CalendarConfig        `yaml:"calendar"`
+	MeetingNotes    MeetingNotesConfig    `yaml:"meeting_notes"`
+	History         HistoryConfig         `yaml:"history"`
+	Backup          BackupConfig          `yaml:"backup"`
+	Trash           TrashConfig           `yaml:"trash"`
 }
 
 // StorageConfig holds storage-related configuration
 type StorageConfig struct {
-	BoardsPath string `yaml:"boards_path"`
-	DataPath   string `yaml:"data_path"`
+	BoardsPath       string `yaml:"boards_path"`
+	DataPath         string `yaml:"data_path"`
+	ExportsPath      string `yaml:"exports_path"`      // directory iCalendar exports are written to, <data_path>/exports by default
+	ManualMigrations bool   `yaml:"manual_migrations"` // leave pending migrations for mkanban migrate instead of running them at daemon start
 }
 
 // DaemonConfig holds daemon-related configuration
@@ -105,14 +111,17 @@ type DueDateColors struct {
 
 // KeybindingsConfig holds keybinding configuration
 type KeybindingsConfig struct {
-	Up     []string `yaml:"up"`
-	Down   []string `yaml:"down"`
-	Left   []string `yaml:"left"`
-	Right  []string `yaml:"right"`
-	Move   []string `yaml:"move"`
-	Add    []string `yaml:"add"`
-	Delete []string `yaml:"delete"`
-	Quit   []string `yaml:"quit"`
+	Up        []string `yaml:"up"`
+	Down      []string `yaml:"down"`
+	Left      []string `yaml:"left"`
+	Right     []string `yaml:"right"`
+	Move      []string `yaml:"move"`
+	Add       []string `yaml:"add"`
+	Delete    []string `yaml:"delete"`
+	Details   []string `yaml:"details"`
+	Agenda    []string `yaml:"agenda"`
+	Conflicts []string `yaml:"conflicts"`
+	Quit      []string `yaml:"quit"`
 }
 
 // SessionTrackingConfig holds session tracking configuration
@@ -122,6 +131,15 @@ type SessionTrackingConfig struct {
 	TrackerType      string `yaml:"tracker_type"`  // "tmux", "zellij", etc.
 	GeneralBoardName string `yaml:"general_board_name"`
 	GitSync          GitSyncConfig `yaml:"git_sync"`
+	BoardTemplates   []SessionBoardTemplate `yaml:"board_templates"`
+}
+
+// SessionBoardTemplate picks the board template for boards created for sessions
+// whose working directory, or one of its parents, matches Path (a glob like "~/work/*").
+// The first matching entry wins; without a match the default template is used.
+type SessionBoardTemplate struct {
+	Path     string `yaml:"path"`
+	Template string `yaml:"template"`
 }
 
 // GitSyncConfig holds git synchronization configuration
@@ -139,6 +157,7 @@ type ActionsConfig struct {
 	NotificationsEnabled bool             `yaml:"notifications_enabled"`
 	ScriptsEnabled   bool                 `yaml:"scripts_enabled"`
 	ScriptsDir       string               `yaml:"scripts_dir"`
+	WorkingHoursOnly bool                 `yaml:"working_hours_only"` // time-based actions wait for working hours of the default schedule
 	Templates        []ActionTemplate     `yaml:"templates"`
 }
 
@@ -229,9 +248,11 @@ type TimeTrackingTmuxConfig struct {
 	TrackActiveOnly bool `yaml:"track_active_only"`
 }
 
-// CalendarConfig holds Google Calendar integration settings
+// CalendarConfig holds calendar integration settings
 type CalendarConfig struct {
 	Enabled         bool              `yaml:"enabled"`
+	Provider        string            `yaml:"provider"` // google or caldav
+	CalDAV          CalDAVConfig      `yaml:"caldav"`
 	CredentialsPath string            `yaml:"credentials_path"`
 	TokenPath       string            `yaml:"token_path"`
 	CalendarID      string            `yaml:"calendar_id"`
@@ -243,6 +264,40 @@ type CalendarConfig struct {
 	CallbackPort    int               `yaml:"callback_port"`
 }
 
+// CalDAVConfig holds settings for syncing with a calendar on a CalDAV server
+type CalDAVConfig struct {
+	URL      string `yaml:"url"` // URL of the calendar collection
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+}
+
+// MeetingNotesConfig holds settings for the notes created for meeting tasks
+type MeetingNotesConfig struct {
+	Enabled  bool   `yaml:"enabled"`  // create a note for each created or imported meeting
+	Template string `yaml:"template"` // task template the note is rendered from; a built-in one when missing
+}
+
+// HistoryConfig holds settings for the git-backed history of the data directory
+type HistoryConfig struct {
+	Enabled          bool `yaml:"enabled"`
+	BatchWindow      int  `yaml:"batch_window"`      // seconds; changes to one task within the window share a commit
+	SnapshotInterval int  `yaml:"snapshot_interval"` // seconds between commits of changes made outside the daemon
+}
+
+// BackupConfig holds settings for scheduled data directory backups and their retention
+type BackupConfig struct {
+	Enabled    bool `yaml:"enabled"`
+	Interval   int  `yaml:"interval"`    // hours between scheduled backups
+	KeepLast   int  `yaml:"keep_last"`   // most recent backups to keep
+	KeepDaily  int  `yaml:"keep_daily"`  // days for which the newest backup is kept
+	KeepWeekly int  `yaml:"keep_weekly"` // weeks for which the newest backup is kept
+}
+
+// TrashConfig holds settings for the trash bin of deleted tasks, columns and notes
+type TrashConfig struct {
+	RetentionDays int `yaml:"retention_days"` // days before trashed items are purged, 0 keeps them forever
+}
+
 // Loader handles loading and saving configuration
 type Loader struct {
 	configPath string
@@ -406,14 +461,17 @@ func (l *Loader) createDefaultConfig() (*Config, error) {
 			},
 		},
 		Keybindings: KeybindingsConfig{
-			Up:     []string{"up", "k"},
-			Down:   []string{"down", "j"},
-			Left:   []string{"left", "h"},
-			Right:  []string{"right", "l"},
-			Move:   []string{"m", "enter"},
-			Add:    []string{"a"},
-			Delete: []string{"d"},
-			Quit:   []string{"q", "ctrl+c"},
+			Up:        []string{"up", "k"},
+			Down:      []string{"down", "j"},
+			Left:      []string{"left", "h"},
+			Right:     []string{"right", "l"},
+			Move:      []string{"m", "enter"},
+			Add:       []string{"a"},
+			Delete:    []string{"d"},
+			Details:   []string{"i"},
+			Agenda:    []string{"c"},
+			Conflicts: []string{"x"},
+			Quit:      []string{"q", "ctrl+c"},
 		},
 		SessionTracking: SessionTrackingConfig{
 			Enabled:          true,
@@ -472,6 +530,7 @@ func (l *Loader) createDefaultConfig() (*Config, error) {
 		},
 		Calendar: CalendarConfig{
 			Enabled:         false,
+			Provider:        "google",
 			CredentialsPath: filepath.Join(homeDir, ".config", "mkanban", "google_credentials.json"),
 			TokenPath:       filepath.Join(homeDir, ".config", "mkanban", "google_token.json"),
 			CalendarID:      "primary",
@@ -482,6 +541,25 @@ func (l *Loader) createDefaultConfig() (*Config, error) {
 			ConflictPolicy:  "newer_wins",
 			CallbackPort:    8085,
 		},
+		MeetingNotes: MeetingNotesConfig{
+			Enabled:  true,
+			Template: "meeting-note",
+		},
+		History: HistoryConfig{
+			Enabled:          true,
+			BatchWindow:      60,
+			SnapshotInterval: 300,
+		},
+		Backup: BackupConfig{
+			Enabled:    true,
+			Interval:   24,
+			KeepLast:   5,
+			KeepDaily:  7,
+			KeepWeekly: 4,
+		},
+		Trash: TrashConfig{
+			RetentionDays: 30,
+		},
 	}
 
 	// Save the default config
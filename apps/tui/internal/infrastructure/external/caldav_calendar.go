@@ -0,0 +1,347 @@
+package external
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/service"
+)
+
+const (
+	caldavRequestTimeout = 30 * time.Second
+	caldavUTCFormat      = "20060102T150405Z"
+)
+
+// CalDAVCalendarClient implements CalendarProvider for a calendar collection
+// on a CalDAV server (RFC 4791). Event IDs are the paths of the calendar
+// object resources; incremental changes use WebDAV sync (RFC 6578).
+type CalDAVCalendarClient struct {
+	httpClient  *http.Client
+	calendarURL *url.URL
+	username    string
+	password    string
+}
+
+// NewCalDAVCalendarClient creates a client for the calendar collection at
+// calendarURL, authenticating with HTTP basic auth when username is set
+func NewCalDAVCalendarClient(calendarURL, username, password string) (*CalDAVCalendarClient, error) {
+	parsed, err := url.Parse(calendarURL)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid CalDAV calendar URL %q", calendarURL)
+	}
+	if !strings.HasSuffix(parsed.Path, "/") {
+		parsed.Path += "/"
+	}
+
+	return &CalDAVCalendarClient{
+		httpClient:  &http.Client{Timeout: caldavRequestTimeout},
+		calendarURL: parsed,
+		username:    username,
+		password:    password,
+	}, nil
+}
+
+// Name returns the provider name
+func (c *CalDAVCalendarClient) Name() string {
+	return "caldav"
+}
+
+// ListEvents runs a calendar-query for the VEVENTs overlapping [start, end),
+// asking the server to expand recurring events into their instances
+func (c *CalDAVCalendarClient) ListEvents(ctx context.Context, start, end time.Time) ([]service.CalendarEvent, error) {
+	rangeStart := start.UTC().Format(caldavUTCFormat)
+	rangeEnd := end.UTC().Format(caldavUTCFormat)
+	body := `<?xml version="1.0" encoding="utf-8"?>
+<C:calendar-query xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">
+  <D:prop>
+    <D:getetag/>
+    <C:calendar-data><C:expand start="` + rangeStart + `" end="` + rangeEnd + `"/></C:calendar-data>
+  </D:prop>
+  <C:filter>
+    <C:comp-filter name="VCALENDAR">
+      <C:comp-filter name="VEVENT">
+        <C:time-range start="` + rangeStart + `" end="` + rangeEnd + `"/>
+      </C:comp-filter>
+    </C:comp-filter>
+  </C:filter>
+</C:calendar-query>`
+
+	multistatus, _, err := c.report(ctx, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch events: %w", err)
+	}
+
+	result := make([]service.CalendarEvent, 0)
+	for _, response := range multistatus.Responses {
+		data, ok := response.calendarData()
+		if !ok {
+			continue
+		}
+		events, err := icalEvents(data, c.eventID(response.Href))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", response.Href, err)
+		}
+		for _, event := range events {
+			// Not every server filters by time range, or expands recurrences
+			if event.StartTime.Before(end) && (event.EndTime.After(start) || event.Recurring) {
+				result = append(result, event)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// CreateEvent stores the event as a new calendar object resource named after its UID
+func (c *CalDAVCalendarClient) CreateEvent(ctx context.Context, event service.CalendarEvent) (*service.CalendarEvent, error) {
+	if event.UID == "" {
+		event.UID = uuid.New().String()
+	}
+	event.ID = c.calendarURL.Path + strings.ReplaceAll(event.UID, "/", "_") + ".ics"
+
+	// Refuse to overwrite an existing resource with the same name
+	resp, err := c.do(ctx, http.MethodPut, event.ID, strings.NewReader(formatICalEvent(event)), map[string]string{
+		"Content-Type":  "text/calendar; charset=utf-8",
+		"If-None-Match": "*",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to create event: %s", resp.Status)
+	}
+
+	event.Updated = time.Now()
+	return &event, nil
+}
+
+// UpdateEvent replaces the calendar object resource of the event
+func (c *CalDAVCalendarClient) UpdateEvent(ctx context.Context, event service.CalendarEvent) error {
+	if strings.Contains(event.ID, "#") {
+		return fmt.Errorf("failed to update event: single occurrences of a recurring event cannot be updated")
+	}
+	if event.UID == "" {
+		event.UID = strings.TrimSuffix(event.ID[strings.LastIndex(event.ID, "/")+1:], ".ics")
+	}
+
+	// Only replace a resource that still exists
+	resp, err := c.do(ctx, http.MethodPut, event.ID, strings.NewReader(formatICalEvent(event)), map[string]string{
+		"Content-Type": "text/calendar; charset=utf-8",
+		"If-Match":     "*",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update event: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+		return nil
+	case http.StatusNotFound, http.StatusPreconditionFailed:
+		return fmt.Errorf("%w: %s", entity.ErrCalendarEventNotFound, event.ID)
+	default:
+		return fmt.Errorf("failed to update event: %s", resp.Status)
+	}
+}
+
+// DeleteEvent removes the calendar object resource of the event
+func (c *CalDAVCalendarClient) DeleteEvent(ctx context.Context, eventID string) error {
+	if strings.Contains(eventID, "#") {
+		return fmt.Errorf("failed to delete event: single occurrences of a recurring event cannot be deleted")
+	}
+
+	resp, err := c.do(ctx, http.MethodDelete, eventID, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete event: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent:
+		return nil
+	case http.StatusNotFound, http.StatusGone:
+		return fmt.Errorf("%w: %s", entity.ErrCalendarEventNotFound, eventID)
+	default:
+		return fmt.Errorf("failed to delete event: %s", resp.Status)
+	}
+}
+
+// Changes runs a sync-collection report. Members reported without calendar
+// data, which some servers leave out of sync reports, are fetched one by one.
+func (c *CalDAVCalendarClient) Changes(ctx context.Context, syncToken string) (*service.CalendarChanges, error) {
+	var token bytes.Buffer
+	if err := xml.EscapeText(&token, []byte(syncToken)); err != nil {
+		return nil, err
+	}
+	body := `<?xml version="1.0" encoding="utf-8"?>
+<D:sync-collection xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">
+  <D:sync-token>` + token.String() + `</D:sync-token>
+  <D:sync-level>1</D:sync-level>
+  <D:prop>
+    <D:getetag/>
+    <C:calendar-data/>
+  </D:prop>
+</D:sync-collection>`
+
+	multistatus, status, err := c.report(ctx, body)
+	if err != nil {
+		// An invalid token fails the valid-sync-token precondition
+		if (status == http.StatusForbidden || status == http.StatusConflict) && syncToken != "" {
+			return nil, entity.ErrCalendarSyncTokenExpired
+		}
+		return nil, fmt.Errorf("failed to fetch event changes: %w", err)
+	}
+
+	changes := &service.CalendarChanges{
+		Events:    make([]service.CalendarEvent, 0),
+		Deleted:   make([]string, 0),
+		SyncToken: multistatus.SyncToken,
+	}
+	for _, response := range multistatus.Responses {
+		id := c.eventID(response.Href)
+		if id == c.calendarURL.Path {
+			continue
+		}
+		if statusCode(response.Status) == http.StatusNotFound {
+			changes.Deleted = append(changes.Deleted, id)
+			continue
+		}
+
+		data, ok := response.calendarData()
+		if !ok {
+			data, err = c.get(ctx, id)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch event changes: %w", err)
+			}
+		}
+		events, err := icalEvents(data, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", response.Href, err)
+		}
+		changes.Events = append(changes.Events, events...)
+	}
+
+	return changes, nil
+}
+
+// report sends a REPORT request to the calendar collection and decodes its
+// multistatus response, returning the HTTP status alongside any error
+func (c *CalDAVCalendarClient) report(ctx context.Context, body string) (*davMultistatus, int, error) {
+	resp, err := c.do(ctx, "REPORT", c.calendarURL.Path, strings.NewReader(body), map[string]string{
+		"Content-Type": "application/xml; charset=utf-8",
+		"Depth":        "1",
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusMultiStatus {
+		return nil, resp.StatusCode, fmt.Errorf("unexpected response %s", resp.Status)
+	}
+
+	var multistatus davMultistatus
+	if err := xml.NewDecoder(resp.Body).Decode(&multistatus); err != nil {
+		return nil, resp.StatusCode, fmt.Errorf("failed to decode multistatus: %w", err)
+	}
+	return &multistatus, resp.StatusCode, nil
+}
+
+// get fetches the iCalendar data of a calendar object resource
+func (c *CalDAVCalendarClient) get(ctx context.Context, path string) (string, error) {
+	resp, err := c.do(ctx, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to fetch %s: %s", path, resp.Status)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return string(data), nil
+}
+
+// do sends a request for a path on the calendar's server
+func (c *CalDAVCalendarClient) do(ctx context.Context, method, path string, body io.Reader, headers map[string]string) (*http.Response, error) {
+	target := c.calendarURL.ResolveReference(&url.URL{Path: path})
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	return c.httpClient.Do(req)
+}
+
+// eventID turns a response href, which may be a full URL, into a path
+func (c *CalDAVCalendarClient) eventID(href string) string {
+	parsed, err := url.Parse(href)
+	if err != nil {
+		return href
+	}
+	return parsed.Path
+}
+
+// davMultistatus is a WebDAV multistatus response body
+type davMultistatus struct {
+	XMLName   xml.Name      `xml:"DAV: multistatus"`
+	Responses []davResponse `xml:"DAV: response"`
+	SyncToken string        `xml:"DAV: sync-token"`
+}
+
+type davResponse struct {
+	Href     string        `xml:"DAV: href"`
+	Status   string        `xml:"DAV: status"`
+	Propstat []davPropstat `xml:"DAV: propstat"`
+}
+
+type davPropstat struct {
+	Status string  `xml:"DAV: status"`
+	Prop   davProp `xml:"DAV: prop"`
+}
+
+type davProp struct {
+	ETag         string `xml:"DAV: getetag"`
+	CalendarData string `xml:"urn:ietf:params:xml:ns:caldav calendar-data"`
+}
+
+// calendarData returns the calendar data of a response, if it has any
+func (r davResponse) calendarData() (string, bool) {
+	for _, propstat := range r.Propstat {
+		if statusCode(propstat.Status) == http.StatusOK && strings.TrimSpace(propstat.Prop.CalendarData) != "" {
+			return propstat.Prop.CalendarData, true
+		}
+	}
+	return "", false
+}
+
+// statusCode parses the code of a status line such as "HTTP/1.1 404 Not Found"
+func statusCode(status string) int {
+	fields := strings.Fields(status)
+	if len(fields) < 2 {
+		return 0
+	}
+	var code int
+	fmt.Sscanf(fields[1], "%d", &code)
+	return code
+}
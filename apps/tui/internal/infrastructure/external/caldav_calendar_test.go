@@ -0,0 +1,421 @@
+package external
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/service"
+)
+
+const (
+	standInCollection  = "/calendars/alice/work/"
+	standInTokenPrefix = "http://stand-in/sync/"
+)
+
+// caldavStandIn is an in-memory CalDAV server holding a single calendar
+// collection. It answers calendar-query reports with every object, leaving
+// time-range filtering to the client, and sync-collection reports from a
+// change log.
+type caldavStandIn struct {
+	mu              sync.Mutex
+	objects         map[string]string // path -> iCalendar data
+	changes         []string          // changed paths, a sync token is the log length
+	omitSyncData    bool              // leave calendar data out of sync reports
+	username        string
+	password        string
+	rejectedReports int
+}
+
+func newCalDAVStandIn(t *testing.T) (*caldavStandIn, *CalDAVCalendarClient) {
+	t.Helper()
+
+	standIn := &caldavStandIn{
+		objects:  make(map[string]string),
+		username: "alice",
+		password: "secret",
+	}
+	server := httptest.NewServer(standIn)
+	t.Cleanup(server.Close)
+
+	client, err := NewCalDAVCalendarClient(server.URL+strings.TrimSuffix(standInCollection, "/"), "alice", "secret")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return standIn, client
+}
+
+func (s *caldavStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if user, password, ok := r.BasicAuth(); !ok || user != s.username || password != s.password {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	path := r.URL.Path
+	_, exists := s.objects[path]
+	switch r.Method {
+	case http.MethodPut:
+		if (r.Header.Get("If-None-Match") == "*" && exists) || (r.Header.Get("If-Match") == "*" && !exists) {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		s.objects[path] = string(body)
+		s.changes = append(s.changes, path)
+		if exists {
+			w.WriteHeader(http.StatusNoContent)
+		} else {
+			w.WriteHeader(http.StatusCreated)
+		}
+
+	case http.MethodGet:
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "text/calendar")
+		io.WriteString(w, s.objects[path])
+
+	case http.MethodDelete:
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(s.objects, path)
+		s.changes = append(s.changes, path)
+		w.WriteHeader(http.StatusNoContent)
+
+	case "REPORT":
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "sync-collection") {
+			s.syncCollection(w, string(body))
+		} else {
+			s.calendarQuery(w)
+		}
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *caldavStandIn) calendarQuery(w http.ResponseWriter) {
+	paths := make([]string, 0, len(s.objects))
+	for path := range s.objects {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var responses strings.Builder
+	for _, path := range paths {
+		responses.WriteString(standInObjectResponse(path, s.objects[path], true))
+	}
+	writeMultistatus(w, responses.String(), "")
+}
+
+func (s *caldavStandIn) syncCollection(w http.ResponseWriter, body string) {
+	token := body[strings.Index(body, "<D:sync-token>")+len("<D:sync-token>") : strings.Index(body, "</D:sync-token>")]
+
+	since := 0
+	if token != "" {
+		n, err := strconv.Atoi(strings.TrimPrefix(token, standInTokenPrefix))
+		if !strings.HasPrefix(token, standInTokenPrefix) || err != nil || n > len(s.changes) {
+			s.rejectedReports++
+			w.WriteHeader(http.StatusForbidden)
+			io.WriteString(w, `<?xml version="1.0"?><D:error xmlns:D="DAV:"><D:valid-sync-token/></D:error>`)
+			return
+		}
+		since = n
+	}
+
+	seen := make(map[string]bool)
+	var responses strings.Builder
+	for _, path := range s.changes[since:] {
+		if seen[path] {
+			continue
+		}
+		seen[path] = true
+		if data, ok := s.objects[path]; ok {
+			responses.WriteString(standInObjectResponse(path, data, !s.omitSyncData))
+		} else if token != "" {
+			responses.WriteString("<D:response><D:href>" + path + "</D:href><D:status>HTTP/1.1 404 Not Found</D:status></D:response>")
+		}
+	}
+	writeMultistatus(w, responses.String(), standInTokenPrefix+strconv.Itoa(len(s.changes)))
+}
+
+func standInObjectResponse(path, data string, withData bool) string {
+	prop := fmt.Sprintf(`<D:getetag>"%d"</D:getetag>`, len(data))
+	if withData {
+		var escaped strings.Builder
+		xml.EscapeText(&escaped, []byte(data))
+		prop += "<C:calendar-data>" + escaped.String() + "</C:calendar-data>"
+	}
+	return "<D:response><D:href>" + path + "</D:href><D:propstat><D:prop>" + prop +
+		"</D:prop><D:status>HTTP/1.1 200 OK</D:status></D:propstat></D:response>"
+}
+
+func writeMultistatus(w http.ResponseWriter, responses, syncToken string) {
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(http.StatusMultiStatus)
+	io.WriteString(w, `<?xml version="1.0" encoding="utf-8"?>`+
+		`<D:multistatus xmlns:D="DAV:" xmlns:C="urn:ietf:params:xml:ns:caldav">`+responses)
+	if syncToken != "" {
+		io.WriteString(w, "<D:sync-token>"+syncToken+"</D:sync-token>")
+	}
+	io.WriteString(w, "</D:multistatus>")
+}
+
+func TestCalDAVCalendarClientEventLifecycle(t *testing.T) {
+	_, client := newCalDAVStandIn(t)
+	ctx := context.Background()
+
+	start := time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC)
+	event := service.CalendarEvent{
+		Title:       "Planning; sprint 12, with a title long enough to be folded over more than one content line",
+		Description: "Agenda:\n- review\n- plan",
+		StartTime:   start,
+		EndTime:     start.Add(45 * time.Minute),
+		Location:    "Room 4",
+		Attendees:   []string{"alice@example.com", "bob@example.com"},
+		MeetingLink: "https://meet.example.com/abc",
+	}
+
+	created, err := client.CreateEvent(ctx, event)
+	if err != nil {
+		t.Fatalf("CreateEvent failed: %v", err)
+	}
+	if created.UID == "" || !strings.HasPrefix(created.ID, standInCollection) {
+		t.Fatalf("expected a UID and an ID in the collection, got %q and %q", created.UID, created.ID)
+	}
+
+	events, err := client.ListEvents(ctx, start.Add(-time.Hour), start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("ListEvents failed: %v", err)
+	}
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(events))
+	}
+	got := events[0]
+	if got.ID != created.ID || got.UID != created.UID {
+		t.Errorf("expected ID %q and UID %q, got %q and %q", created.ID, created.UID, got.ID, got.UID)
+	}
+	if got.Title != event.Title || got.Description != event.Description || got.Location != event.Location {
+		t.Errorf("text fields did not round-trip: %+v", got)
+	}
+	if !got.StartTime.Equal(event.StartTime) || !got.EndTime.Equal(event.EndTime) {
+		t.Errorf("expected %s-%s, got %s-%s", event.StartTime, event.EndTime, got.StartTime, got.EndTime)
+	}
+	if strings.Join(got.Attendees, ",") != "alice@example.com,bob@example.com" || got.MeetingLink != event.MeetingLink {
+		t.Errorf("expected attendees and meeting link to round-trip, got %v and %q", got.Attendees, got.MeetingLink)
+	}
+
+	// Events outside the range are filtered out even if the server returns them
+	later, err := client.ListEvents(ctx, start.AddDate(0, 0, 1), start.AddDate(0, 0, 2))
+	if err != nil {
+		t.Fatalf("ListEvents failed: %v", err)
+	}
+	if len(later) != 0 {
+		t.Errorf("expected no events the next day, got %d", len(later))
+	}
+
+	got.Title = "Planning"
+	if err := client.UpdateEvent(ctx, got); err != nil {
+		t.Fatalf("UpdateEvent failed: %v", err)
+	}
+	events, _ = client.ListEvents(ctx, start.Add(-time.Hour), start.Add(time.Hour))
+	if len(events) != 1 || events[0].Title != "Planning" || events[0].UID != created.UID {
+		t.Fatalf("expected the updated event, got %+v", events)
+	}
+
+	if err := client.DeleteEvent(ctx, created.ID); err != nil {
+		t.Fatalf("DeleteEvent failed: %v", err)
+	}
+	events, _ = client.ListEvents(ctx, start.Add(-time.Hour), start.Add(time.Hour))
+	if len(events) != 0 {
+		t.Errorf("expected no events after delete, got %d", len(events))
+	}
+
+	if err := client.UpdateEvent(ctx, got); !errors.Is(err, entity.ErrCalendarEventNotFound) {
+		t.Errorf("expected ErrCalendarEventNotFound updating a deleted event, got %v", err)
+	}
+	if err := client.DeleteEvent(ctx, created.ID); !errors.Is(err, entity.ErrCalendarEventNotFound) {
+		t.Errorf("expected ErrCalendarEventNotFound deleting a deleted event, got %v", err)
+	}
+}
+
+func TestCalDAVCalendarClientChanges(t *testing.T) {
+	for _, omitSyncData := range []bool{false, true} {
+		t.Run(fmt.Sprintf("omit sync data %v", omitSyncData), func(t *testing.T) {
+			standIn, client := newCalDAVStandIn(t)
+			standIn.omitSyncData = omitSyncData
+			ctx := context.Background()
+
+			day := time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)
+			first, err := client.CreateEvent(ctx, service.CalendarEvent{UID: "first", Title: "Offsite", StartTime: day, EndTime: day.AddDate(0, 0, 1), IsAllDay: true})
+			if err != nil {
+				t.Fatalf("CreateEvent failed: %v", err)
+			}
+
+			initial, err := client.Changes(ctx, "")
+			if err != nil {
+				t.Fatalf("initial Changes failed: %v", err)
+			}
+			if len(initial.Events) != 1 || initial.Events[0].ID != first.ID || !initial.Events[0].IsAllDay || initial.SyncToken == "" {
+				t.Fatalf("expected the all-day event and a token, got %+v", initial)
+			}
+
+			second, err := client.CreateEvent(ctx, service.CalendarEvent{UID: "second", Title: "Review", StartTime: day.Add(14 * time.Hour), EndTime: day.Add(15 * time.Hour)})
+			if err != nil {
+				t.Fatalf("CreateEvent failed: %v", err)
+			}
+			if err := client.DeleteEvent(ctx, first.ID); err != nil {
+				t.Fatalf("DeleteEvent failed: %v", err)
+			}
+
+			changes, err := client.Changes(ctx, initial.SyncToken)
+			if err != nil {
+				t.Fatalf("Changes failed: %v", err)
+			}
+			if len(changes.Events) != 1 || changes.Events[0].ID != second.ID || changes.Events[0].Title != "Review" {
+				t.Errorf("expected the new event, got %+v", changes.Events)
+			}
+			if len(changes.Deleted) != 1 || changes.Deleted[0] != first.ID {
+				t.Errorf("expected %q deleted, got %v", first.ID, changes.Deleted)
+			}
+			if changes.SyncToken == initial.SyncToken {
+				t.Errorf("expected a new sync token")
+			}
+
+			unchanged, err := client.Changes(ctx, changes.SyncToken)
+			if err != nil {
+				t.Fatalf("Changes failed: %v", err)
+			}
+			if len(unchanged.Events) != 0 || len(unchanged.Deleted) != 0 {
+				t.Errorf("expected no changes, got %+v", unchanged)
+			}
+		})
+	}
+}
+
+func TestCalDAVCalendarClientExpiredSyncToken(t *testing.T) {
+	standIn, client := newCalDAVStandIn(t)
+
+	_, err := client.Changes(context.Background(), "http://stand-in/sync/99")
+	if !errors.Is(err, entity.ErrCalendarSyncTokenExpired) {
+		t.Errorf("expected ErrCalendarSyncTokenExpired, got %v", err)
+	}
+	if standIn.rejectedReports != 1 {
+		t.Errorf("expected the stand-in to reject 1 report, got %d", standIn.rejectedReports)
+	}
+}
+
+func TestCalDAVCalendarClientAuthentication(t *testing.T) {
+	standIn, _ := newCalDAVStandIn(t)
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	client, err := NewCalDAVCalendarClient(server.URL+standInCollection, "alice", "wrong")
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	if _, err := client.ListEvents(context.Background(), time.Now(), time.Now().Add(time.Hour)); err == nil {
+		t.Errorf("expected an error with a wrong password")
+	}
+
+	if _, err := NewCalDAVCalendarClient("not a url", "", ""); err == nil {
+		t.Errorf("expected an error for an invalid calendar URL")
+	}
+}
+
+func TestICalEvents(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("time zone data not available")
+	}
+
+	tests := []struct {
+		name     string
+		data     string
+		expected service.CalendarEvent
+	}{
+		{
+			name: "all-day event without DTEND",
+			data: "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:a\r\nDTSTART;VALUE=DATE:20261019\r\nSUMMARY:Holiday\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+			expected: service.CalendarEvent{
+				ID: "a", UID: "a", Title: "Holiday", IsAllDay: true,
+				StartTime: time.Date(2026, 10, 19, 0, 0, 0, 0, time.Local),
+				EndTime:   time.Date(2026, 10, 20, 0, 0, 0, 0, time.Local),
+			},
+		},
+		{
+			name: "time zone and duration",
+			data: "BEGIN:VCALENDAR\nBEGIN:VEVENT\nUID:b\nDTSTART;TZID=Europe/Berlin:20261019T093000\nDURATION:PT1H15M\nSUMMARY:Standup\nEND:VEVENT\nEND:VCALENDAR\n",
+			expected: service.CalendarEvent{
+				ID: "b", UID: "b", Title: "Standup",
+				StartTime: time.Date(2026, 10, 19, 9, 30, 0, 0, berlin),
+				EndTime:   time.Date(2026, 10, 19, 10, 45, 0, 0, berlin),
+			},
+		},
+		{
+			name: "folded and escaped text with attendees",
+			data: "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:c\r\nDTSTART:20261019T120000Z\r\nDTEND:20261019T130000Z\r\nSUMMARY:Lunch\\, then\r\n  review\r\nDESCRIPTION:one\\ntwo\\;three\r\nATTENDEE;CN=\"Bob: Builder\":MAILTO:bob@example.com\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+			expected: service.CalendarEvent{
+				ID: "c", UID: "c", Title: "Lunch, then review", Description: "one\ntwo;three",
+				StartTime: time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+				EndTime:   time.Date(2026, 10, 19, 13, 0, 0, 0, time.UTC),
+				Attendees: []string{"bob@example.com"},
+			},
+		},
+		{
+			name: "overridden occurrence of a recurring event",
+			data: "BEGIN:VCALENDAR\nBEGIN:VEVENT\nUID:d\nRECURRENCE-ID:20261021T080000Z\nDTSTART:20261021T090000Z\nDTEND:20261021T093000Z\nSUMMARY:Moved\nEND:VEVENT\nEND:VCALENDAR\n",
+			expected: service.CalendarEvent{
+				ID: "d#20261021T080000Z", UID: "d", Title: "Moved", Recurring: true, RecurringID: "d",
+				StartTime: time.Date(2026, 10, 21, 9, 0, 0, 0, time.UTC),
+				EndTime:   time.Date(2026, 10, 21, 9, 30, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := icalEvents(tt.data, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(events) != 1 {
+				t.Fatalf("expected 1 event, got %d", len(events))
+			}
+			got := events[0]
+			if got.ID != tt.expected.ID || got.UID != tt.expected.UID || got.Title != tt.expected.Title ||
+				got.Description != tt.expected.Description || got.IsAllDay != tt.expected.IsAllDay ||
+				got.Recurring != tt.expected.Recurring || got.RecurringID != tt.expected.RecurringID {
+				t.Errorf("expected %+v, got %+v", tt.expected, got)
+			}
+			if !got.StartTime.Equal(tt.expected.StartTime) || !got.EndTime.Equal(tt.expected.EndTime) {
+				t.Errorf("expected %s-%s, got %s-%s", tt.expected.StartTime, tt.expected.EndTime, got.StartTime, got.EndTime)
+			}
+			if strings.Join(got.Attendees, ",") != strings.Join(tt.expected.Attendees, ",") {
+				t.Errorf("expected attendees %v, got %v", tt.expected.Attendees, got.Attendees)
+			}
+		})
+	}
+
+	if _, err := icalEvents("BEGIN:VCALENDAR\nBEGIN:VEVENT\nUID:x\n", ""); err == nil {
+		t.Errorf("expected an error for an unterminated component")
+	}
+}
@@ -6,9 +6,26 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"strings"
+	"time"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/service"
+)
+
+const (
+	// Identity used for commits in repositories that have none configured
+	defaultCommitName  = "mkanban"
+	defaultCommitEmail = "mkanban@localhost"
+
+	logRecordSeparator = "\x1e"
+	logFieldSeparator  = "\x1f"
 )
 
+// commitHashRegex matches full commit hashes, which need no resolving
+var commitHashRegex = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
 // GitVCSProvider implements VCSProvider for Git
 type GitVCSProvider struct{}
 
@@ -194,3 +211,244 @@ func (g *GitVCSProvider) CreateAndCheckoutBranch(repoPath, branchName string) er
 
 	return nil
 }
+
+// InitRepository creates a git repository at path if one does not exist yet
+func (g *GitVCSProvider) InitRepository(path string) error {
+	if _, err := os.Stat(filepath.Join(path, ".git")); err == nil {
+		return nil
+	}
+
+	if err := os.MkdirAll(path, 0755); err != nil {
+		return fmt.Errorf("failed to create repository directory: %w", err)
+	}
+
+	if _, err := g.run(path, "init", "--quiet"); err != nil {
+		return fmt.Errorf("failed to initialize repository: %w", err)
+	}
+
+	// Make sure commits work even without a global git identity
+	if email, _ := g.run(path, "config", "user.email"); strings.TrimSpace(email) == "" {
+		if _, err := g.run(path, "config", "user.email", defaultCommitEmail); err != nil {
+			return fmt.Errorf("failed to configure repository: %w", err)
+		}
+		if _, err := g.run(path, "config", "user.name", defaultCommitName); err != nil {
+			return fmt.Errorf("failed to configure repository: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// CommitAll stages every change in the working tree and commits it
+func (g *GitVCSProvider) CommitAll(repoPath, message string) (string, error) {
+	changed, err := g.stageAll(repoPath)
+	if err != nil || !changed {
+		return "", err
+	}
+
+	if _, err := g.run(repoPath, "commit", "--quiet", "--no-verify", "-m", message); err != nil {
+		return "", fmt.Errorf("failed to commit: %w", err)
+	}
+
+	return g.headHash(repoPath)
+}
+
+// AmendCommit stages every change in the working tree and folds it into the last commit
+func (g *GitVCSProvider) AmendCommit(repoPath, message string) (string, error) {
+	if _, err := g.stageAll(repoPath); err != nil {
+		return "", err
+	}
+
+	if _, err := g.run(repoPath, "commit", "--quiet", "--no-verify", "--amend", "--allow-empty", "-m", message); err != nil {
+		return "", fmt.Errorf("failed to amend commit: %w", err)
+	}
+
+	return g.headHash(repoPath)
+}
+
+// Log returns commits touching pathspec, newest first
+func (g *GitVCSProvider) Log(repoPath, pathspec string, limit int) ([]service.VCSCommit, error) {
+	args := []string{
+		"log",
+		"--name-only",
+		"--format=" + logRecordSeparator + "%H" + logFieldSeparator + "%an" + logFieldSeparator + "%aI" + logFieldSeparator + "%B" + logFieldSeparator,
+	}
+	if limit > 0 {
+		args = append(args, fmt.Sprintf("--max-count=%d", limit))
+	}
+	if pathspec != "" {
+		args = append(args, "--", pathspec)
+	}
+
+	out, err := g.run(repoPath, args...)
+	if err != nil {
+		// A repository without commits has no history
+		if !g.hasCommits(repoPath) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read log: %w", err)
+	}
+
+	records := strings.Split(out, logRecordSeparator)
+	commits := make([]service.VCSCommit, 0, len(records))
+
+	for _, record := range records {
+		fields := strings.SplitN(record, logFieldSeparator, 5)
+		if len(fields) < 5 {
+			continue
+		}
+
+		date, _ := time.Parse(time.RFC3339, fields[2])
+		commit := service.VCSCommit{
+			Hash:    fields[0],
+			Author:  fields[1],
+			Date:    date,
+			Message: strings.TrimSpace(fields[3]),
+			Files:   make([]string, 0),
+		}
+
+		for _, line := range strings.Split(fields[4], "\n") {
+			if file := strings.TrimSpace(line); file != "" {
+				commit.Files = append(commit.Files, file)
+			}
+		}
+
+		commits = append(commits, commit)
+	}
+
+	return commits, nil
+}
+
+// ResolveRevision returns the commit hash a revision names. Revisions that
+// could be taken for options or don't name a commit are rejected.
+func (g *GitVCSProvider) ResolveRevision(repoPath, revision string) (string, error) {
+	if commitHashRegex.MatchString(revision) {
+		return revision, nil
+	}
+	if revision == "" || strings.HasPrefix(revision, "-") {
+		return "", fmt.Errorf("%w: %q", entity.ErrInvalidRevision, revision)
+	}
+
+	out, err := g.run(repoPath, "rev-parse", "--verify", "--quiet", "--end-of-options", revision+"^{commit}")
+	if err != nil {
+		return "", fmt.Errorf("%w: %q", entity.ErrInvalidRevision, revision)
+	}
+
+	return strings.TrimSpace(out), nil
+}
+
+// ListFiles returns the files under pathspec as of the given revision
+func (g *GitVCSProvider) ListFiles(repoPath, revision, pathspec string) ([]string, error) {
+	hash, err := g.ResolveRevision(repoPath, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := g.run(repoPath, "ls-tree", "-r", "--name-only", hash, "--", pathspec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files at %s: %w", revision, err)
+	}
+
+	files := make([]string, 0)
+	for _, line := range strings.Split(out, "\n") {
+		if file := strings.TrimSpace(line); file != "" {
+			files = append(files, file)
+		}
+	}
+
+	return files, nil
+}
+
+// ShowFile returns the contents of a file as of the given revision
+func (g *GitVCSProvider) ShowFile(repoPath, revision, path string) ([]byte, error) {
+	hash, err := g.ResolveRevision(repoPath, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := g.run(repoPath, "show", hash+":"+filepath.ToSlash(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s at %s: %w", path, revision, err)
+	}
+
+	return []byte(out), nil
+}
+
+// RevisionAt returns the last commit made at or before the given time
+func (g *GitVCSProvider) RevisionAt(repoPath string, at time.Time) (string, error) {
+	out, err := g.run(repoPath, "rev-list", "-1", "--before="+at.Format(time.RFC3339), "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve revision: %w", err)
+	}
+
+	revision := strings.TrimSpace(out)
+	if revision == "" {
+		return "", fmt.Errorf("no history before %s", at.Format(time.RFC3339))
+	}
+
+	return revision, nil
+}
+
+// RestorePath replaces path in the working tree with its contents at revision
+func (g *GitVCSProvider) RestorePath(repoPath, revision, path string) error {
+	hash, err := g.ResolveRevision(repoPath, revision)
+	if err != nil {
+		return err
+	}
+
+	if _, err := g.run(repoPath, "checkout", hash, "--", filepath.ToSlash(path)); err != nil {
+		return fmt.Errorf("failed to restore %s from %s: %w", path, revision, err)
+	}
+
+	return nil
+}
+
+// stageAll stages every change and reports whether anything is staged
+func (g *GitVCSProvider) stageAll(repoPath string) (bool, error) {
+	if _, err := g.run(repoPath, "add", "--all"); err != nil {
+		return false, fmt.Errorf("failed to stage changes: %w", err)
+	}
+
+	cmd := exec.Command("git", "diff", "--cached", "--quiet")
+	cmd.Dir = repoPath
+	if err := cmd.Run(); err != nil {
+		if _, ok := err.(*exec.ExitError); ok {
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to check staged changes: %w", err)
+	}
+
+	return false, nil
+}
+
+// headHash returns the hash of the current commit
+func (g *GitVCSProvider) headHash(repoPath string) (string, error) {
+	out, err := g.run(repoPath, "rev-parse", "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve HEAD: %w", err)
+	}
+
+	return strings.TrimSpace(out), nil
+}
+
+// hasCommits reports whether the repository has at least one commit
+func (g *GitVCSProvider) hasCommits(repoPath string) bool {
+	_, err := g.run(repoPath, "rev-parse", "--verify", "--quiet", "HEAD")
+	return err == nil
+}
+
+// run executes a git command in repoPath and returns its standard output
+func (g *GitVCSProvider) run(repoPath string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = repoPath
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git %s: %w - %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.String(), nil
+}
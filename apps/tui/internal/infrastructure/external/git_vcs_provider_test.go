@@ -0,0 +1,88 @@
+package external
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"mkanban/internal/domain/entity"
+)
+
+// newTestRepository creates a repository with one commit of task.md
+func newTestRepository(t *testing.T) (*GitVCSProvider, string, string) {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	vcs := NewGitVCSProvider()
+	repo := t.TempDir()
+	if err := vcs.InitRepository(repo); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(repo, "task.md"), []byte("first\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	hash, err := vcs.CommitAll(repo, "First")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return vcs, repo, hash
+}
+
+func TestResolveRevision(t *testing.T) {
+	vcs, repo, hash := newTestRepository(t)
+
+	valid := []string{hash, hash[:7], "HEAD"}
+	for _, revision := range valid {
+		resolved, err := vcs.ResolveRevision(repo, revision)
+		if err != nil {
+			t.Errorf("%q: %v", revision, err)
+			continue
+		}
+		if resolved != hash {
+			t.Errorf("%q: expected %s, got %s", revision, hash, resolved)
+		}
+	}
+
+	invalid := []string{"", "-p", "--output=" + filepath.Join(repo, "out"), "missing", "HEAD~5", hash + "^{tree}:task.md"}
+	for _, revision := range invalid {
+		if _, err := vcs.ResolveRevision(repo, revision); !errors.Is(err, entity.ErrInvalidRevision) {
+			t.Errorf("%q: expected %v, got %v", revision, entity.ErrInvalidRevision, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(repo, "out")); !os.IsNotExist(err) {
+		t.Error("an option passed as revision was run by git")
+	}
+}
+
+func TestRestorePathRejectsOptions(t *testing.T) {
+	vcs, repo, hash := newTestRepository(t)
+
+	if err := os.WriteFile(filepath.Join(repo, "task.md"), []byte("second\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := vcs.RestorePath(repo, "--force", "task.md"); !errors.Is(err, entity.ErrInvalidRevision) {
+		t.Fatalf("expected %v, got %v", entity.ErrInvalidRevision, err)
+	}
+	if _, err := vcs.ListFiles(repo, "--all", "."); !errors.Is(err, entity.ErrInvalidRevision) {
+		t.Errorf("expected %v from ListFiles, got %v", entity.ErrInvalidRevision, err)
+	}
+	if _, err := vcs.ShowFile(repo, "-h", "task.md"); !errors.Is(err, entity.ErrInvalidRevision) {
+		t.Errorf("expected %v from ShowFile, got %v", entity.ErrInvalidRevision, err)
+	}
+
+	if err := vcs.RestorePath(repo, hash, "task.md"); err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(repo, "task.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "first\n" {
+		t.Errorf("expected the committed version, got %q", data)
+	}
+}
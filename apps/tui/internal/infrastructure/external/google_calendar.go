@@ -3,6 +3,7 @@ package external
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -12,7 +13,11 @@ import (
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
 	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/googleapi"
 	"google.golang.org/api/option"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/service"
 )
 
 type GoogleCalendarClient struct {
@@ -22,20 +27,6 @@ type GoogleCalendarClient struct {
 	calendarID  string
 }
 
-type CalendarEvent struct {
-	ID          string
-	Title       string
-	Description string
-	StartTime   time.Time
-	EndTime     time.Time
-	Location    string
-	Attendees   []string
-	MeetingLink string
-	IsAllDay    bool
-	Recurring   bool
-	RecurringID string
-}
-
 func NewGoogleCalendarClient(credentialsPath, tokenPath, calendarID string) (*GoogleCalendarClient, error) {
 	credentials, err := os.ReadFile(credentialsPath)
 	if err != nil {
@@ -92,40 +83,89 @@ func (c *GoogleCalendarClient) Connect(ctx context.Context) error {
 	return nil
 }
 
-func (c *GoogleCalendarClient) GetEvents(ctx context.Context, start, end time.Time) ([]CalendarEvent, error) {
+// Name returns the provider name
+func (c *GoogleCalendarClient) Name() string {
+	return "google"
+}
+
+func (c *GoogleCalendarClient) ListEvents(ctx context.Context, start, end time.Time) ([]service.CalendarEvent, error) {
 	if c.service == nil {
 		if err := c.Connect(ctx); err != nil {
 			return nil, err
 		}
 	}
 
-	events, err := c.service.Events.List(c.calendarID).
+	result := make([]service.CalendarEvent, 0)
+	err := c.service.Events.List(c.calendarID).
 		TimeMin(start.Format(time.RFC3339)).
 		TimeMax(end.Format(time.RFC3339)).
 		SingleEvents(true).
 		OrderBy("startTime").
-		Do()
+		Pages(ctx, func(events *calendar.Events) error {
+			for _, item := range events.Items {
+				result = append(result, c.parseEvent(item))
+			}
+			return nil
+		})
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch events: %w", err)
 	}
 
-	result := make([]CalendarEvent, 0, len(events.Items))
-	for _, item := range events.Items {
-		event := c.parseEvent(item)
-		result = append(result, event)
+	return result, nil
+}
+
+// Changes returns the events changed since syncToken using the Events API's
+// incremental sync. Cancelled events are reported as deleted.
+func (c *GoogleCalendarClient) Changes(ctx context.Context, syncToken string) (*service.CalendarChanges, error) {
+	if c.service == nil {
+		if err := c.Connect(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	changes := &service.CalendarChanges{
+		Events:  make([]service.CalendarEvent, 0),
+		Deleted: make([]string, 0),
 	}
 
-	return result, nil
+	call := c.service.Events.List(c.calendarID).
+		ShowDeleted(true).
+		SingleEvents(true)
+	if syncToken != "" {
+		call = call.SyncToken(syncToken)
+	}
+	err := call.Pages(ctx, func(events *calendar.Events) error {
+		for _, item := range events.Items {
+			if item.Status == "cancelled" {
+				changes.Deleted = append(changes.Deleted, item.Id)
+				continue
+			}
+			changes.Events = append(changes.Events, c.parseEvent(item))
+		}
+		if events.NextSyncToken != "" {
+			changes.SyncToken = events.NextSyncToken
+		}
+		return nil
+	})
+	if err != nil {
+		// Google answers 410 Gone once a sync token is no longer valid
+		if isGoogleAPIStatus(err, http.StatusGone) {
+			return nil, entity.ErrCalendarSyncTokenExpired
+		}
+		return nil, fmt.Errorf("failed to fetch event changes: %w", err)
+	}
+
+	return changes, nil
 }
 
-func (c *GoogleCalendarClient) GetTodayEvents(ctx context.Context) ([]CalendarEvent, error) {
+func (c *GoogleCalendarClient) GetTodayEvents(ctx context.Context) ([]service.CalendarEvent, error) {
 	now := time.Now()
 	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
 	end := start.AddDate(0, 0, 1)
-	return c.GetEvents(ctx, start, end)
+	return c.ListEvents(ctx, start, end)
 }
 
-func (c *GoogleCalendarClient) GetWeekEvents(ctx context.Context) ([]CalendarEvent, error) {
+func (c *GoogleCalendarClient) GetWeekEvents(ctx context.Context) ([]service.CalendarEvent, error) {
 	now := time.Now()
 	weekday := int(now.Weekday())
 	if weekday == 0 {
@@ -133,54 +173,62 @@ func (c *GoogleCalendarClient) GetWeekEvents(ctx context.Context) ([]CalendarEve
 	}
 	start := time.Date(now.Year(), now.Month(), now.Day()-weekday+1, 0, 0, 0, 0, now.Location())
 	end := start.AddDate(0, 0, 7)
-	return c.GetEvents(ctx, start, end)
+	return c.ListEvents(ctx, start, end)
 }
 
-func (c *GoogleCalendarClient) CreateEvent(ctx context.Context, event CalendarEvent) (*CalendarEvent, error) {
+func (c *GoogleCalendarClient) CreateEvent(ctx context.Context, event service.CalendarEvent) (*service.CalendarEvent, error) {
 	if c.service == nil {
 		if err := c.Connect(ctx); err != nil {
 			return nil, err
 		}
 	}
 
-	gEvent := &calendar.Event{
-		Summary:     event.Title,
-		Description: event.Description,
-		Location:    event.Location,
+	created, err := c.service.Events.Insert(c.calendarID, c.toGoogleEvent(event)).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event: %w", err)
 	}
 
-	if event.IsAllDay {
-		gEvent.Start = &calendar.EventDateTime{Date: event.StartTime.Format("2006-01-02")}
-		gEvent.End = &calendar.EventDateTime{Date: event.EndTime.Format("2006-01-02")}
-	} else {
-		gEvent.Start = &calendar.EventDateTime{DateTime: event.StartTime.Format(time.RFC3339)}
-		gEvent.End = &calendar.EventDateTime{DateTime: event.EndTime.Format(time.RFC3339)}
-	}
+	result := c.parseEvent(created)
+	return &result, nil
+}
 
-	if len(event.Attendees) > 0 {
-		attendees := make([]*calendar.EventAttendee, len(event.Attendees))
-		for i, email := range event.Attendees {
-			attendees[i] = &calendar.EventAttendee{Email: email}
+func (c *GoogleCalendarClient) UpdateEvent(ctx context.Context, event service.CalendarEvent) error {
+	if c.service == nil {
+		if err := c.Connect(ctx); err != nil {
+			return err
 		}
-		gEvent.Attendees = attendees
 	}
 
-	created, err := c.service.Events.Insert(c.calendarID, gEvent).Do()
+	_, err := c.service.Events.Update(c.calendarID, event.ID, c.toGoogleEvent(event)).Context(ctx).Do()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create event: %w", err)
+		if isGoogleAPIStatus(err, http.StatusNotFound) || isGoogleAPIStatus(err, http.StatusGone) {
+			return fmt.Errorf("%w: %s", entity.ErrCalendarEventNotFound, event.ID)
+		}
+		return fmt.Errorf("failed to update event: %w", err)
 	}
 
-	result := c.parseEvent(created)
-	return &result, nil
+	return nil
 }
 
-func (c *GoogleCalendarClient) UpdateEvent(ctx context.Context, event CalendarEvent) error {
+func (c *GoogleCalendarClient) DeleteEvent(ctx context.Context, eventID string) error {
 	if c.service == nil {
 		if err := c.Connect(ctx); err != nil {
 			return err
 		}
 	}
 
+	if err := c.service.Events.Delete(c.calendarID, eventID).Context(ctx).Do(); err != nil {
+		if isGoogleAPIStatus(err, http.StatusNotFound) || isGoogleAPIStatus(err, http.StatusGone) {
+			return fmt.Errorf("%w: %s", entity.ErrCalendarEventNotFound, eventID)
+		}
+		return fmt.Errorf("failed to delete event: %w", err)
+	}
+
+	return nil
+}
+
+// toGoogleEvent converts an event into the Events API representation
+func (c *GoogleCalendarClient) toGoogleEvent(event service.CalendarEvent) *calendar.Event {
 	gEvent := &calendar.Event{
 		Summary:     event.Title,
 		Description: event.Description,
@@ -195,31 +243,21 @@ func (c *GoogleCalendarClient) UpdateEvent(ctx context.Context, event CalendarEv
 		gEvent.End = &calendar.EventDateTime{DateTime: event.EndTime.Format(time.RFC3339)}
 	}
 
-	_, err := c.service.Events.Update(c.calendarID, event.ID, gEvent).Do()
-	if err != nil {
-		return fmt.Errorf("failed to update event: %w", err)
-	}
-
-	return nil
-}
-
-func (c *GoogleCalendarClient) DeleteEvent(ctx context.Context, eventID string) error {
-	if c.service == nil {
-		if err := c.Connect(ctx); err != nil {
-			return err
+	if len(event.Attendees) > 0 {
+		attendees := make([]*calendar.EventAttendee, len(event.Attendees))
+		for i, email := range event.Attendees {
+			attendees[i] = &calendar.EventAttendee{Email: email}
 		}
+		gEvent.Attendees = attendees
 	}
 
-	if err := c.service.Events.Delete(c.calendarID, eventID).Do(); err != nil {
-		return fmt.Errorf("failed to delete event: %w", err)
-	}
-
-	return nil
+	return gEvent
 }
 
-func (c *GoogleCalendarClient) parseEvent(item *calendar.Event) CalendarEvent {
-	event := CalendarEvent{
+func (c *GoogleCalendarClient) parseEvent(item *calendar.Event) service.CalendarEvent {
+	event := service.CalendarEvent{
 		ID:          item.Id,
+		UID:         item.ICalUID,
 		Title:       item.Summary,
 		Description: item.Description,
 		Location:    item.Location,
@@ -256,9 +294,19 @@ func (c *GoogleCalendarClient) parseEvent(item *calendar.Event) CalendarEvent {
 		event.EndTime, _ = time.Parse(time.RFC3339, item.End.DateTime)
 	}
 
+	if item.Updated != "" {
+		event.Updated, _ = time.Parse(time.RFC3339, item.Updated)
+	}
+
 	return event
 }
 
+// isGoogleAPIStatus reports whether err is a Google API error with the HTTP status code
+func isGoogleAPIStatus(err error, code int) bool {
+	var apiErr *googleapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == code
+}
+
 func (c *GoogleCalendarClient) loadToken() (*oauth2.Token, error) {
 	f, err := os.Open(c.tokenPath)
 	if err != nil {
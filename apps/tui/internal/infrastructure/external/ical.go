@@ -0,0 +1,529 @@
+package external
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"mkanban/internal/domain/service"
+	"mkanban/internal/domain/valueobject"
+)
+
+const (
+	icalProductID   = "-//mkanban//mkanban//EN"
+	icalDateFormat  = "20060102"
+	icalTimeFormat  = "20060102T150405"
+	icalLineMaxSize = 75 // octets per content line before folding
+)
+
+// icalProperty is a content line of an iCalendar object, e.g.
+// DTSTART;TZID=Europe/Berlin:20261019T090000
+type icalProperty struct {
+	Name   string
+	Params map[string]string
+	Value  string
+}
+
+// icalComponent is a BEGIN/END block of an iCalendar object
+type icalComponent struct {
+	Name       string
+	Properties []icalProperty
+	Components []*icalComponent
+}
+
+// property returns the first property with the name, nil if there is none
+func (c *icalComponent) property(name string) *icalProperty {
+	for i := range c.Properties {
+		if c.Properties[i].Name == name {
+			return &c.Properties[i]
+		}
+	}
+	return nil
+}
+
+// text returns the unescaped value of the first property with the name
+func (c *icalComponent) text(name string) string {
+	if p := c.property(name); p != nil {
+		return unescapeICalText(p.Value)
+	}
+	return ""
+}
+
+// parseICalendar parses an iCalendar stream into its top-level components
+func parseICalendar(data string) ([]*icalComponent, error) {
+	var roots []*icalComponent
+	var stack []*icalComponent
+
+	for n, line := range unfoldICalLines(data) {
+		if line == "" {
+			continue
+		}
+		prop, err := parseICalProperty(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n+1, err)
+		}
+
+		switch prop.Name {
+		case "BEGIN":
+			component := &icalComponent{Name: strings.ToUpper(prop.Value)}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.Components = append(parent.Components, component)
+			} else {
+				roots = append(roots, component)
+			}
+			stack = append(stack, component)
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1].Name != strings.ToUpper(prop.Value) {
+				return nil, fmt.Errorf("line %d: unexpected END:%s", n+1, prop.Value)
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("line %d: property %s outside of a component", n+1, prop.Name)
+			}
+			current := stack[len(stack)-1]
+			current.Properties = append(current.Properties, prop)
+		}
+	}
+	if len(stack) > 0 {
+		return nil, fmt.Errorf("missing END:%s", stack[len(stack)-1].Name)
+	}
+	return roots, nil
+}
+
+// unfoldICalLines splits data into content lines, joining folded lines
+func unfoldICalLines(data string) []string {
+	data = strings.ReplaceAll(data, "\r\n", "\n")
+	var lines []string
+	for _, line := range strings.Split(data, "\n") {
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// parseICalProperty parses a content line into its name, parameters and value
+func parseICalProperty(line string) (icalProperty, error) {
+	prop := icalProperty{Params: make(map[string]string)}
+
+	// The value starts at the first colon outside of a quoted parameter value
+	quoted := false
+	colon := -1
+	for i, r := range line {
+		if r == '"' {
+			quoted = !quoted
+		} else if r == ':' && !quoted {
+			colon = i
+			break
+		}
+	}
+	if colon < 0 {
+		return prop, fmt.Errorf("invalid content line %q", line)
+	}
+	prop.Value = line[colon+1:]
+
+	parts := strings.Split(line[:colon], ";")
+	prop.Name = strings.ToUpper(parts[0])
+	for _, param := range parts[1:] {
+		key, value, _ := strings.Cut(param, "=")
+		prop.Params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+	}
+	return prop, nil
+}
+
+// icalEvents returns the events of an iCalendar stream. Instances overriding
+// an occurrence of a recurring event get the ID idPrefix#RECURRENCE-ID, the
+// others idPrefix itself, or their UID when idPrefix is empty.
+func icalEvents(data, idPrefix string) ([]service.CalendarEvent, error) {
+	roots, err := parseICalendar(data)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]service.CalendarEvent, 0)
+	for _, root := range roots {
+		for _, component := range root.Components {
+			if component.Name != "VEVENT" {
+				continue
+			}
+			event, err := icalEvent(component)
+			if err != nil {
+				return nil, err
+			}
+
+			event.ID = idPrefix
+			if event.ID == "" {
+				event.ID = event.UID
+			}
+			if recurrenceID := component.property("RECURRENCE-ID"); recurrenceID != nil {
+				at, _, err := parseICalTime(*recurrenceID)
+				if err != nil {
+					return nil, fmt.Errorf("invalid RECURRENCE-ID: %w", err)
+				}
+				event.RecurringID = event.ID
+				event.ID += "#" + at.UTC().Format(icalTimeFormat+"Z")
+				event.Recurring = true
+			}
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+// icalEvent converts a VEVENT component into a calendar event
+func icalEvent(component *icalComponent) (service.CalendarEvent, error) {
+	event := service.CalendarEvent{
+		UID:         component.text("UID"),
+		Title:       component.text("SUMMARY"),
+		Description: component.text("DESCRIPTION"),
+		Location:    component.text("LOCATION"),
+		MeetingLink: component.text("URL"),
+		Recurring:   component.property("RRULE") != nil,
+	}
+	if rrule := component.property("RRULE"); rrule != nil {
+		if rule, ok := parseICalRecurrence(rrule.Value); ok {
+			event.Recurrence = rule
+		}
+	}
+	if event.MeetingLink == "" {
+		event.MeetingLink = component.text("X-GOOGLE-CONFERENCE")
+	}
+
+	start := component.property("DTSTART")
+	if start == nil {
+		return event, fmt.Errorf("event %q has no DTSTART", event.UID)
+	}
+	var err error
+	event.StartTime, event.IsAllDay, err = parseICalTime(*start)
+	if err != nil {
+		return event, fmt.Errorf("invalid DTSTART of event %q: %w", event.UID, err)
+	}
+
+	switch {
+	case component.property("DTEND") != nil:
+		event.EndTime, _, err = parseICalTime(*component.property("DTEND"))
+		if err != nil {
+			return event, fmt.Errorf("invalid DTEND of event %q: %w", event.UID, err)
+		}
+	case component.property("DURATION") != nil:
+		duration, err := parseICalDuration(component.property("DURATION").Value)
+		if err != nil {
+			return event, fmt.Errorf("invalid DURATION of event %q: %w", event.UID, err)
+		}
+		event.EndTime = event.StartTime.Add(duration)
+	case event.IsAllDay:
+		event.EndTime = event.StartTime.AddDate(0, 0, 1)
+	default:
+		event.EndTime = event.StartTime
+	}
+
+	for _, prop := range component.Properties {
+		if prop.Name != "ATTENDEE" {
+			continue
+		}
+		address := prop.Value
+		if len(address) > len("mailto:") && strings.EqualFold(address[:len("mailto:")], "mailto:") {
+			address = address[len("mailto:"):]
+		}
+		event.Attendees = append(event.Attendees, address)
+	}
+
+	for _, name := range []string{"LAST-MODIFIED", "DTSTAMP"} {
+		if prop := component.property(name); prop != nil {
+			if updated, _, err := parseICalTime(*prop); err == nil {
+				event.Updated = updated
+				break
+			}
+		}
+	}
+
+	return event, nil
+}
+
+// parseICalTime parses a DATE or DATE-TIME property value. UTC values end in
+// Z, others are in their TZID or, when floating, in the local time zone.
+func parseICalTime(prop icalProperty) (time.Time, bool, error) {
+	location := time.Local
+	if tzid := prop.Params["TZID"]; tzid != "" {
+		if loaded, err := time.LoadLocation(tzid); err == nil {
+			location = loaded
+		}
+	}
+
+	value := prop.Value
+	if prop.Params["VALUE"] == "DATE" || len(value) == len(icalDateFormat) {
+		t, err := time.ParseInLocation(icalDateFormat, value, location)
+		return t, true, err
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(icalTimeFormat+"Z", value)
+		return t, false, err
+	}
+	t, err := time.ParseInLocation(icalTimeFormat, value, location)
+	return t, false, err
+}
+
+// parseICalDuration parses an RFC 5545 duration such as PT1H30M, P1D or -PT15M
+func parseICalDuration(value string) (time.Duration, error) {
+	rest := value
+	sign := time.Duration(1)
+	if strings.HasPrefix(rest, "-") {
+		sign = -1
+		rest = rest[1:]
+	} else if strings.HasPrefix(rest, "+") {
+		rest = rest[1:]
+	}
+	if !strings.HasPrefix(rest, "P") || len(rest) < 3 {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	rest = rest[1:]
+
+	var total time.Duration
+	inTime := false
+	number := ""
+	for _, r := range rest {
+		switch {
+		case r == 'T':
+			inTime = true
+			continue
+		case r >= '0' && r <= '9':
+			number += string(r)
+			continue
+		}
+
+		n, err := strconv.Atoi(number)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+		number = ""
+
+		switch {
+		case r == 'W' && !inTime:
+			total += time.Duration(n) * 7 * 24 * time.Hour
+		case r == 'D' && !inTime:
+			total += time.Duration(n) * 24 * time.Hour
+		case r == 'H' && inTime:
+			total += time.Duration(n) * time.Hour
+		case r == 'M' && inTime:
+			total += time.Duration(n) * time.Minute
+		case r == 'S' && inTime:
+			total += time.Duration(n) * time.Second
+		default:
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+	}
+	if number != "" {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	return sign * total, nil
+}
+
+// formatICalEvent writes an event as a VCALENDAR holding a single VEVENT
+func formatICalEvent(event service.CalendarEvent) string {
+	var b strings.Builder
+	writeICalLine(&b, "BEGIN:VCALENDAR")
+	writeICalLine(&b, "VERSION:2.0")
+	writeICalLine(&b, "PRODID:"+icalProductID)
+	writeICalEvent(&b, event)
+	writeICalLine(&b, "END:VCALENDAR")
+	return b.String()
+}
+
+// writeICalEvent writes an event as a VEVENT component
+func writeICalEvent(b *strings.Builder, event service.CalendarEvent) {
+	writeICalLine(b, "BEGIN:VEVENT")
+	writeICalLine(b, "UID:"+escapeICalText(event.UID))
+	writeICalLine(b, "DTSTAMP:"+time.Now().UTC().Format(icalTimeFormat+"Z"))
+	if !event.Updated.IsZero() {
+		writeICalLine(b, "LAST-MODIFIED:"+event.Updated.UTC().Format(icalTimeFormat+"Z"))
+	}
+
+	if event.IsAllDay {
+		end := event.EndTime
+		if !end.After(event.StartTime) {
+			end = event.StartTime.AddDate(0, 0, 1)
+		}
+		writeICalLine(b, "DTSTART;VALUE=DATE:"+event.StartTime.Format(icalDateFormat))
+		writeICalLine(b, "DTEND;VALUE=DATE:"+end.Format(icalDateFormat))
+	} else {
+		recurring := event.Recurrence != nil
+		writeICalLine(b, formatICalDateTime("DTSTART", event.StartTime, recurring))
+		writeICalLine(b, formatICalDateTime("DTEND", event.EndTime, recurring))
+	}
+	if event.Recurrence != nil {
+		writeICalLine(b, "RRULE:"+formatICalRecurrence(event.Recurrence, event.IsAllDay))
+	}
+
+	writeICalLine(b, "SUMMARY:"+escapeICalText(event.Title))
+	if event.Description != "" {
+		writeICalLine(b, "DESCRIPTION:"+escapeICalText(event.Description))
+	}
+	if event.Location != "" {
+		writeICalLine(b, "LOCATION:"+escapeICalText(event.Location))
+	}
+	if event.MeetingLink != "" {
+		writeICalLine(b, "URL:"+event.MeetingLink)
+	}
+	for _, attendee := range event.Attendees {
+		writeICalLine(b, "ATTENDEE:mailto:"+attendee)
+	}
+
+	writeICalLine(b, "END:VEVENT")
+}
+
+// formatICalDateTime formats a DATE-TIME property in UTC. Recurring events
+// keep their wall-clock time across daylight saving changes instead, in their
+// time zone or, when it has no name, as floating local time.
+func formatICalDateTime(name string, t time.Time, recurring bool) string {
+	if !recurring || t.Location() == time.UTC {
+		return name + ":" + t.UTC().Format(icalTimeFormat+"Z")
+	}
+	if zone := t.Location().String(); zone != "" && zone != "Local" {
+		return name + ";TZID=" + zone + ":" + t.Format(icalTimeFormat)
+	}
+	return name + ":" + t.In(time.Local).Format(icalTimeFormat)
+}
+
+// icalWeekdays maps the two-letter weekdays of RRULE BYDAY values
+var icalWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// formatICalRecurrence formats a recurrence rule as an RRULE value. The end
+// date is a DATE for all-day events, as RFC 5545 requires UNTIL to match
+// DTSTART.
+func formatICalRecurrence(rule *valueobject.RecurrenceRule, allDay bool) string {
+	parts := []string{"FREQ=" + strings.ToUpper(string(rule.Frequency()))}
+	if rule.Interval() > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(rule.Interval()))
+	}
+	if days := rule.DaysOfWeek(); len(days) > 0 {
+		names := make([]string, 0, len(days))
+		for _, day := range days {
+			names = append(names, strings.ToUpper(day.String()[:2]))
+		}
+		parts = append(parts, "BYDAY="+strings.Join(names, ","))
+	}
+	if rule.DayOfMonth() > 0 {
+		parts = append(parts, "BYMONTHDAY="+strconv.Itoa(rule.DayOfMonth()))
+	}
+
+	// COUNT and UNTIL are mutually exclusive
+	if rule.Count() > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(rule.Count()))
+	} else if end := rule.EndDate(); end != nil {
+		if allDay {
+			parts = append(parts, "UNTIL="+end.Format(icalDateFormat))
+		} else {
+			parts = append(parts, "UNTIL="+end.UTC().Format(icalTimeFormat+"Z"))
+		}
+	}
+	return strings.Join(parts, ";")
+}
+
+// parseICalRecurrence parses an RRULE value. It returns false for rules a
+// RecurrenceRule cannot express, such as hourly ones or ones on the first
+// Monday of the month.
+func parseICalRecurrence(value string) (*valueobject.RecurrenceRule, bool) {
+	parts := make(map[string]string)
+	for _, part := range strings.Split(value, ";") {
+		key, val, _ := strings.Cut(part, "=")
+		switch key = strings.ToUpper(key); key {
+		case "FREQ", "INTERVAL", "BYDAY", "BYMONTHDAY", "COUNT", "UNTIL", "WKST":
+			parts[key] = strings.ToUpper(val)
+		default:
+			return nil, false
+		}
+	}
+
+	interval := 1
+	if parts["INTERVAL"] != "" {
+		n, err := strconv.Atoi(parts["INTERVAL"])
+		if err != nil || n < 1 {
+			return nil, false
+		}
+		interval = n
+	}
+	rule, err := valueobject.NewRecurrenceRule(valueobject.RecurrenceFrequency(strings.ToLower(parts["FREQ"])), interval)
+	if err != nil {
+		return nil, false
+	}
+
+	if byDay := parts["BYDAY"]; byDay != "" {
+		if rule.Frequency() != valueobject.FrequencyWeekly {
+			return nil, false
+		}
+		days := make([]time.Weekday, 0)
+		for _, day := range strings.Split(byDay, ",") {
+			// Weekly rules have no ordinals such as the 1 of 1MO
+			weekday, ok := icalWeekdays[day]
+			if !ok {
+				return nil, false
+			}
+			days = append(days, weekday)
+		}
+		rule.SetDaysOfWeek(days)
+	}
+	if byMonthDay := parts["BYMONTHDAY"]; byMonthDay != "" {
+		day, err := strconv.Atoi(byMonthDay)
+		if err != nil || day < 1 || rule.Frequency() != valueobject.FrequencyMonthly {
+			return nil, false
+		}
+		rule.SetDayOfMonth(day)
+	}
+	if count := parts["COUNT"]; count != "" {
+		n, err := strconv.Atoi(count)
+		if err != nil || n < 1 {
+			return nil, false
+		}
+		rule.SetCount(n)
+	}
+	if until := parts["UNTIL"]; until != "" {
+		end, _, err := parseICalTime(icalProperty{Value: until})
+		if err != nil {
+			return nil, false
+		}
+		rule.SetEndDate(end)
+	}
+	return rule, true
+}
+
+// writeICalLine writes a content line, folding it at 75 octets without
+// splitting UTF-8 sequences
+func writeICalLine(b *strings.Builder, line string) {
+	limit := icalLineMaxSize
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines start with the folding space
+		limit = icalLineMaxSize - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+// escapeICalText escapes a TEXT property value
+func escapeICalText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// unescapeICalText reverses escapeICalText
+func unescapeICalText(s string) string {
+	return strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n").Replace(s)
+}
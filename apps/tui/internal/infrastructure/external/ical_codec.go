@@ -0,0 +1,38 @@
+package external
+
+import (
+	"strings"
+
+	"mkanban/internal/domain/service"
+)
+
+// ICalCodec reads and writes RFC 5545 iCalendar (.ics) files
+type ICalCodec struct{}
+
+// NewICalCodec creates a new ICalCodec
+func NewICalCodec() *ICalCodec {
+	return &ICalCodec{}
+}
+
+// Encode writes events as a VCALENDAR published for subscribers
+func (c *ICalCodec) Encode(name string, events []service.CalendarEvent) ([]byte, error) {
+	var b strings.Builder
+	writeICalLine(&b, "BEGIN:VCALENDAR")
+	writeICalLine(&b, "VERSION:2.0")
+	writeICalLine(&b, "PRODID:"+icalProductID)
+	writeICalLine(&b, "CALSCALE:GREGORIAN")
+	writeICalLine(&b, "METHOD:PUBLISH")
+	if name != "" {
+		writeICalLine(&b, "X-WR-CALNAME:"+escapeICalText(name))
+	}
+	for _, event := range events {
+		writeICalEvent(&b, event)
+	}
+	writeICalLine(&b, "END:VCALENDAR")
+	return []byte(b.String()), nil
+}
+
+// Decode reads the VEVENTs of an iCalendar stream, identified by their UID
+func (c *ICalCodec) Decode(data []byte) ([]service.CalendarEvent, error) {
+	return icalEvents(string(data), "")
+}
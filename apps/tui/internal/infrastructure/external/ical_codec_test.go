@@ -0,0 +1,91 @@
+package external
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"mkanban/internal/domain/service"
+	"mkanban/internal/domain/valueobject"
+)
+
+func TestICalCodecRoundTrip(t *testing.T) {
+	weekly, _ := valueobject.NewRecurrenceRule(valueobject.FrequencyWeekly, 2)
+	weekly.SetDaysOfWeek([]time.Weekday{time.Monday, time.Thursday})
+	weekly.SetCount(6)
+
+	monthly, _ := valueobject.NewRecurrenceRule(valueobject.FrequencyMonthly, 1)
+	monthly.SetDayOfMonth(15)
+	monthly.SetEndDate(time.Date(2027, 3, 15, 0, 0, 0, 0, time.Local))
+
+	events := []service.CalendarEvent{
+		{
+			UID: "work/meetings/1-planning@mkanban", Title: "Planning, round 2", Description: "Agenda:\n- scope",
+			StartTime: time.Date(2026, 10, 19, 9, 30, 0, 0, time.Local),
+			EndTime:   time.Date(2026, 10, 19, 10, 30, 0, 0, time.Local),
+			Location:  "Room 4", MeetingLink: "https://meet.example.com/abc",
+			Attendees:  []string{"alice@example.com", "bob@example.com"},
+			Recurrence: weekly,
+		},
+		{
+			UID: "work/main/2-report/due@mkanban", Title: "Due: Report", IsAllDay: true,
+			StartTime:  time.Date(2026, 10, 15, 0, 0, 0, 0, time.Local),
+			EndTime:    time.Date(2026, 10, 16, 0, 0, 0, 0, time.Local),
+			Recurrence: monthly,
+		},
+	}
+
+	codec := NewICalCodec()
+	data, err := codec.Encode("work", events)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, line := range []string{"X-WR-CALNAME:work", "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH;COUNT=6", "RRULE:FREQ=MONTHLY;BYMONTHDAY=15;UNTIL=20270315"} {
+		if !strings.Contains(string(data), line+"\r\n") {
+			t.Errorf("expected line %q in\n%s", line, data)
+		}
+	}
+
+	decoded, err := codec.Decode(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(decoded) != len(events) {
+		t.Fatalf("expected %d events, got %d", len(events), len(decoded))
+	}
+	for i, got := range decoded {
+		expected := events[i]
+		if got.UID != expected.UID || got.Title != expected.Title || got.Description != expected.Description ||
+			got.Location != expected.Location || got.MeetingLink != expected.MeetingLink || got.IsAllDay != expected.IsAllDay {
+			t.Errorf("expected %+v, got %+v", expected, got)
+		}
+		if !got.StartTime.Equal(expected.StartTime) || !got.EndTime.Equal(expected.EndTime) {
+			t.Errorf("expected %s-%s, got %s-%s", expected.StartTime, expected.EndTime, got.StartTime, got.EndTime)
+		}
+		if strings.Join(got.Attendees, ",") != strings.Join(expected.Attendees, ",") {
+			t.Errorf("expected attendees %v, got %v", expected.Attendees, got.Attendees)
+		}
+		if got.Recurrence == nil {
+			t.Fatalf("expected the recurrence of %q to survive", expected.UID)
+		}
+		rule := got.Recurrence
+		if rule.Frequency() != expected.Recurrence.Frequency() || rule.Interval() != expected.Recurrence.Interval() ||
+			rule.DayOfMonth() != expected.Recurrence.DayOfMonth() || rule.Count() != expected.Recurrence.Count() {
+			t.Errorf("expected rule %+v, got %+v", expected.Recurrence, rule)
+		}
+	}
+	if days := decoded[0].Recurrence.DaysOfWeek(); len(days) != 2 || days[0] != time.Monday || days[1] != time.Thursday {
+		t.Errorf("expected days MO,TH, got %v", days)
+	}
+	if end := decoded[1].Recurrence.EndDate(); end == nil || !end.Equal(*events[1].Recurrence.EndDate()) {
+		t.Errorf("expected end date %s, got %v", events[1].Recurrence.EndDate(), end)
+	}
+}
+
+func TestParseICalRecurrenceUnsupported(t *testing.T) {
+	for _, value := range []string{"FREQ=HOURLY", "FREQ=WEEKLY;BYDAY=1MO", "FREQ=DAILY;INTERVAL=0", "FREQ=MONTHLY;BYDAY=1MO", "FREQ=YEARLY;BYMONTH=3"} {
+		if _, ok := parseICalRecurrence(value); ok {
+			t.Errorf("expected %q to be unsupported", value)
+		}
+	}
+}
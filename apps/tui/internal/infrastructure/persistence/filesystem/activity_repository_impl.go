@@ -0,0 +1,119 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/valueobject"
+	"mkanban/internal/infrastructure/persistence/mapper"
+	"mkanban/internal/infrastructure/serialization"
+	"mkanban/pkg/filesystem"
+)
+
+// ActivityRepositoryImpl implements ActivityRepository with an activity.yml
+// file inside the task folder, so the log moves with the task
+type ActivityRepositoryImpl struct {
+	pathBuilder *PathBuilder
+}
+
+// NewActivityRepository creates a new filesystem-based activity repository
+func NewActivityRepository(rootPath string) repository.ActivityRepository {
+	return &ActivityRepositoryImpl{
+		pathBuilder: NewPathBuilder(rootPath),
+	}
+}
+
+// Append adds an entry to the end of a task's activity log
+func (r *ActivityRepositoryImpl) Append(
+	ctx context.Context,
+	boardID string,
+	columnName string,
+	taskID *valueobject.TaskID,
+	entry *entity.ActivityEntry,
+) error {
+	taskDir, err := r.pathBuilder.TaskDir(boardID, columnName, taskID.String())
+	if err != nil {
+		return err
+	}
+
+	// Never create a folder for a task that is not on disk
+	exists, err := filesystem.Exists(taskDir)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return entity.ErrTaskNotFound
+	}
+
+	path := filepath.Join(taskDir, taskActivityYamlFile)
+	storage, err := r.load(path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to load activity log: %w", err)
+	}
+
+	storage.Entries = append(storage.Entries, mapper.ActivityEntryToStorage(entry))
+
+	data, err := serialization.SerializeYaml(storage)
+	if err != nil {
+		return fmt.Errorf("failed to serialize activity log: %w", err)
+	}
+
+	return filesystem.SafeWrite(path, data, 0644)
+}
+
+// FindByTask returns a task's activity log, oldest entry first
+func (r *ActivityRepositoryImpl) FindByTask(
+	ctx context.Context,
+	boardID string,
+	columnName string,
+	taskID *valueobject.TaskID,
+) ([]*entity.ActivityEntry, error) {
+	path, err := r.pathBuilder.TaskActivityYaml(boardID, columnName, taskID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	storage, err := r.load(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []*entity.ActivityEntry{}, nil
+		}
+		return nil, fmt.Errorf("failed to load activity log: %w", err)
+	}
+
+	entries := make([]*entity.ActivityEntry, 0, len(storage.Entries))
+	for _, s := range storage.Entries {
+		entry, err := mapper.ActivityEntryFromStorage(s)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	// Events are recorded as they arrive, which is not always the order they happened in
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp().Before(entries[j].Timestamp())
+	})
+
+	return entries, nil
+}
+
+func (r *ActivityRepositoryImpl) load(path string) (*mapper.ActivityLogStorage, error) {
+	storage := &mapper.ActivityLogStorage{}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return storage, err
+	}
+
+	if err := serialization.ParseYaml(data, storage); err != nil {
+		return storage, err
+	}
+
+	return storage, nil
+}
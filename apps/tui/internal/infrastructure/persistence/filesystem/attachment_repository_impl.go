@@ -0,0 +1,147 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/valueobject"
+	"mkanban/pkg/filesystem"
+)
+
+// AttachmentRepositoryImpl implements AttachmentRepository by keeping files in an
+// attachments/ folder inside the task folder, so they move with the task
+type AttachmentRepositoryImpl struct {
+	pathBuilder *PathBuilder
+}
+
+// NewAttachmentRepository creates a new filesystem-based attachment repository
+func NewAttachmentRepository(rootPath string) repository.AttachmentRepository {
+	return &AttachmentRepositoryImpl{
+		pathBuilder: NewPathBuilder(rootPath),
+	}
+}
+
+// Store copies a file into the task folder under name and describes the stored copy
+func (r *AttachmentRepositoryImpl) Store(
+	ctx context.Context,
+	boardID string,
+	columnName string,
+	taskID *valueobject.TaskID,
+	sourcePath string,
+	name string,
+) (entity.Attachment, error) {
+	target, err := r.Path(boardID, columnName, taskID, name)
+	if err != nil {
+		return entity.Attachment{}, err
+	}
+
+	src, err := os.Open(sourcePath)
+	if err != nil {
+		return entity.Attachment{}, fmt.Errorf("failed to open %s: %w", sourcePath, err)
+	}
+	defer src.Close()
+
+	info, err := src.Stat()
+	if err != nil {
+		return entity.Attachment{}, err
+	}
+	if info.IsDir() {
+		return entity.Attachment{}, fmt.Errorf("%s is a directory", sourcePath)
+	}
+
+	if err := filesystem.EnsureDir(filepath.Dir(target), 0755); err != nil {
+		return entity.Attachment{}, fmt.Errorf("failed to create attachments directory: %w", err)
+	}
+
+	// Copy through a temp file so a failed copy never leaves a truncated attachment
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".attachment-*.tmp")
+	if err != nil {
+		return entity.Attachment{}, err
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath)
+
+	head := make([]byte, 512)
+	n, _ := io.ReadFull(src, head)
+	head = head[:n]
+
+	if _, err := tmp.Write(head); err != nil {
+		tmp.Close()
+		return entity.Attachment{}, fmt.Errorf("failed to copy attachment: %w", err)
+	}
+	size, err := io.Copy(tmp, src)
+	if err != nil {
+		tmp.Close()
+		return entity.Attachment{}, fmt.Errorf("failed to copy attachment: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return entity.Attachment{}, err
+	}
+	if err := os.Rename(tmpPath, target); err != nil {
+		return entity.Attachment{}, fmt.Errorf("failed to store attachment: %w", err)
+	}
+
+	return entity.Attachment{
+		Name:     name,
+		Size:     size + int64(n),
+		MimeType: detectMimeType(name, head),
+		AddedAt:  time.Now(),
+	}, nil
+}
+
+// Remove deletes an attachment file from the task folder
+func (r *AttachmentRepositoryImpl) Remove(ctx context.Context, boardID string, columnName string, taskID *valueobject.TaskID, name string) error {
+	path, err := r.Path(boardID, columnName, taskID, name)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove attachment: %w", err)
+	}
+
+	// Drop the attachments folder once it is empty
+	_ = os.Remove(filepath.Dir(path))
+	return nil
+}
+
+// Path returns the location of an attachment file
+func (r *AttachmentRepositoryImpl) Path(boardID string, columnName string, taskID *valueobject.TaskID, name string) (string, error) {
+	if !isValidAttachmentName(name) {
+		return "", entity.ErrInvalidAttachmentName
+	}
+
+	dir, err := r.pathBuilder.TaskAttachmentsDir(boardID, columnName, taskID.String())
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name), nil
+}
+
+// isValidAttachmentName accepts plain file names that stay inside the attachments folder
+func isValidAttachmentName(name string) bool {
+	if name == "" || name == "." || name == ".." {
+		return false
+	}
+	if strings.ContainsAny(name, `/\`) || strings.HasPrefix(name, ".") {
+		return false
+	}
+	return filepath.Base(name) == name
+}
+
+// detectMimeType prefers the extension and falls back to sniffing the content
+func detectMimeType(name string, head []byte) string {
+	if mimeType := mime.TypeByExtension(strings.ToLower(filepath.Ext(name))); mimeType != "" {
+		return mimeType
+	}
+	return http.DetectContentType(head)
+}
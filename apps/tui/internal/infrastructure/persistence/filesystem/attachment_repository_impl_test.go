@@ -0,0 +1,157 @@
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/valueobject"
+)
+
+// attachmentTestTaskID is the task attachments are stored for
+func attachmentTestTaskID(t *testing.T) *valueobject.TaskID {
+	t.Helper()
+	taskID, err := valueobject.ParseTaskID("TRK-001-report")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return taskID
+}
+
+func TestAttachmentPathRejectsNamesOutsideTheFolder(t *testing.T) {
+	repo := NewAttachmentRepository(t.TempDir())
+	taskID := attachmentTestTaskID(t)
+
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{"report.pdf", false},
+		{"report v2.pdf", false},
+		{"../report.pdf", true},
+		{"../../../etc/passwd", true},
+		{"/etc/passwd", true},
+		{"notes/report.pdf", true},
+		{`notes\report.pdf`, true},
+		{".hidden", true},
+		{"..", true},
+		{".", true},
+		{"", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, err := repo.Path("work/tracker", "to-do", taskID, tt.name)
+			if tt.wantErr {
+				if !errors.Is(err, entity.ErrInvalidAttachmentName) {
+					t.Errorf("expected %q to be rejected, got %q (%v)", tt.name, path, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("path failed: %v", err)
+			}
+			if filepath.Base(path) != tt.name || filepath.Base(filepath.Dir(path)) != "attachments" {
+				t.Errorf("expected %q in the attachments folder, got %q", tt.name, path)
+			}
+		})
+	}
+}
+
+func TestAttachmentStore(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	repo := NewAttachmentRepository(root)
+	taskID := attachmentTestTaskID(t)
+	source := t.TempDir()
+
+	png := append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 600)...)
+	tests := []struct {
+		name     string
+		content  []byte
+		wantMime string
+	}{
+		{"report.pdf", []byte("%PDF-1.7 not really"), "application/pdf"},
+		{"notes.TXT", []byte("plain notes"), "text/plain; charset=utf-8"},
+		// Without a known extension the content is sniffed, past the first 512 bytes
+		{"screenshot", png, "image/png"},
+		{"empty.unknownext", []byte{}, "text/plain; charset=utf-8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sourcePath := filepath.Join(source, "source-"+tt.name)
+			if err := os.WriteFile(sourcePath, tt.content, 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			attachment, err := repo.Store(ctx, "work/tracker", "to-do", taskID, sourcePath, tt.name)
+			if err != nil {
+				t.Fatalf("store failed: %v", err)
+			}
+			if attachment.Name != tt.name || attachment.Size != int64(len(tt.content)) || attachment.MimeType != tt.wantMime {
+				t.Errorf("expected %s of %d bytes as %s, got %+v", tt.name, len(tt.content), tt.wantMime, attachment)
+			}
+
+			path, err := repo.Path("work/tracker", "to-do", taskID, tt.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, err := os.ReadFile(path); err != nil || string(got) != string(tt.content) {
+				t.Errorf("expected the stored copy to match the source, got %d bytes (%v)", len(got), err)
+			}
+		})
+	}
+
+	// Only the attachments are left in the folder, no temp files
+	path, err := repo.Path("work/tracker", "to-do", taskID, "report.pdf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != len(tests) {
+		t.Errorf("expected %d files in the attachments folder, got %v", len(tests), entries)
+	}
+}
+
+func TestAttachmentStoreRejectsBadSources(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	repo := NewAttachmentRepository(root)
+	taskID := attachmentTestTaskID(t)
+	source := t.TempDir()
+	if err := os.WriteFile(filepath.Join(source, "secret.txt"), []byte("secret"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := repo.Store(ctx, "work/tracker", "to-do", taskID, source, "folder"); err == nil {
+		t.Error("expected a directory to be rejected")
+	}
+	if _, err := repo.Store(ctx, "work/tracker", "to-do", taskID, filepath.Join(source, "missing.txt"), "missing.txt"); err == nil {
+		t.Error("expected a missing file to be rejected")
+	}
+	if _, err := repo.Store(ctx, "work/tracker", "to-do", taskID, filepath.Join(source, "secret.txt"), "../secret.txt"); !errors.Is(err, entity.ErrInvalidAttachmentName) {
+		t.Errorf("expected a name outside the attachments folder to be rejected, got %v", err)
+	}
+
+	path, err := repo.Path("work/tracker", "to-do", taskID, "folder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertNotExists(t, root, mustRel(t, root, filepath.Dir(path)))
+	assertNotExists(t, root, mustRel(t, root, filepath.Join(filepath.Dir(filepath.Dir(path)), "secret.txt")))
+}
+
+// mustRel returns path relative to root
+func mustRel(t *testing.T, root, path string) string {
+	t.Helper()
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return filepath.ToSlash(rel)
+}
@@ -0,0 +1,660 @@
+package filesystem
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"mkanban/internal/infrastructure/serialization"
+	"mkanban/pkg/filesystem"
+	"mkanban/pkg/slug"
+)
+
+const (
+	backupArchiveExt    = ".tar.gz"
+	backupArchivePrefix = "mkanban-"
+	backupManifestName  = "manifest.yml"
+	backupDataDir       = "data"
+	backupConfigFile    = "config/config.yaml"
+	backupTimeFormat    = "20060102-150405"
+	historyRepoDir      = ".git"
+)
+
+// Backup reasons recorded in archive manifests
+const (
+	BackupReasonManual     = "manual"
+	BackupReasonScheduled  = "scheduled"
+	BackupReasonPreRestore = "pre-restore"
+	BackupReasonPreImport  = "pre-import"
+)
+
+var backupNameRegex = regexp.MustCompile(`^mkanban-(\d{8}-\d{6})-([a-z0-9-]+)\.tar\.gz$`)
+
+// BackupManifest is written as the first entry of every backup archive
+type BackupManifest struct {
+	SchemaVersion int               `yaml:"schema_version"`
+	Created       time.Time         `yaml:"created"`
+	Reason        string            `yaml:"reason"`
+	Files         int               `yaml:"files"`
+	HasConfig     bool              `yaml:"has_config"`
+	ConfigSum     string            `yaml:"config_checksum,omitempty"`
+	Checksums     map[string]string `yaml:"checksums,omitempty"` // data file -> SHA-256, paths relative to the data root
+}
+
+// BackupInfo describes a backup archive in the backups directory
+type BackupInfo struct {
+	Name          string
+	Path          string
+	Created       time.Time
+	Reason        string
+	SchemaVersion int
+	Size          int64
+}
+
+// BackupRetention controls which archives Prune keeps. An archive is kept
+// when any rule selects it; zero disables a rule.
+type BackupRetention struct {
+	KeepLast   int // most recent archives
+	KeepDaily  int // newest archive of each of the last N days with backups
+	KeepWeekly int // newest archive of each of the last N weeks with backups
+}
+
+// BackupRestoreReport describes the outcome of restoring a backup archive
+type BackupRestoreReport struct {
+	Backup         BackupInfo
+	SafetyBackup   string
+	FromVersion    int
+	Files          int
+	ConfigRestored bool
+}
+
+// BackupStore creates, lists, prunes and restores compressed point-in-time
+// archives of the data root (and the config file) in backups/
+type BackupStore struct {
+	pathBuilder *ProjectPathBuilder
+	configPath  string
+}
+
+// NewBackupStore creates a backup store for a data root. configPath may be
+// empty, in which case the config file is not archived.
+func NewBackupStore(rootPath, configPath string) *BackupStore {
+	return &BackupStore{
+		pathBuilder: NewProjectPathBuilder(rootPath),
+		configPath:  configPath,
+	}
+}
+
+// Create writes a new archive of the data root, tagged with reason
+func (b *BackupStore) Create(reason string) (*BackupInfo, error) {
+	version, err := ReadSchemaVersion(b.rootPath())
+	if err != nil {
+		return nil, err
+	}
+
+	reason = slug.Generate(reason)
+	if reason == "" {
+		reason = BackupReasonManual
+	}
+
+	created := time.Now()
+	name := fmt.Sprintf("%s%s-%s%s", backupArchivePrefix, created.Format(backupTimeFormat), reason, backupArchiveExt)
+	path := filepath.Join(b.pathBuilder.BackupsDir(), name)
+
+	// Two backups within the same second must not overwrite each other
+	for i := 2; ; i++ {
+		if exists, _ := filesystem.Exists(path); !exists {
+			break
+		}
+		name = fmt.Sprintf("%s%s-%s-%d%s", backupArchivePrefix, created.Format(backupTimeFormat), reason, i, backupArchiveExt)
+		path = filepath.Join(b.pathBuilder.BackupsDir(), name)
+	}
+
+	files, err := b.dataFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := BackupManifest{
+		SchemaVersion: version,
+		Created:       created,
+		Reason:        reason,
+		Files:         len(files),
+		Checksums:     make(map[string]string, len(files)),
+	}
+	for _, rel := range files {
+		sum, err := fileChecksum(filepath.Join(b.rootPath(), rel))
+		if err != nil {
+			return nil, err
+		}
+		manifest.Checksums[filepath.ToSlash(rel)] = sum
+	}
+	if b.configPath != "" {
+		if exists, _ := filesystem.Exists(b.configPath); exists {
+			sum, err := fileChecksum(b.configPath)
+			if err != nil {
+				return nil, err
+			}
+			manifest.HasConfig = true
+			manifest.ConfigSum = sum
+		}
+	}
+
+	if err := filesystem.EnsureDir(b.pathBuilder.BackupsDir(), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create backups directory: %w", err)
+	}
+
+	// Write to a temp file so a failed backup never looks like a valid archive
+	tempPath := path + ".tmp"
+	if err := b.writeArchive(tempPath, manifest, files); err != nil {
+		os.Remove(tempPath)
+		return nil, err
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		os.Remove(tempPath)
+		return nil, fmt.Errorf("failed to finalize backup: %w", err)
+	}
+
+	return b.info(path)
+}
+
+// List returns the archives in the backups directory, newest first
+func (b *BackupStore) List() ([]BackupInfo, error) {
+	entries, err := os.ReadDir(b.pathBuilder.BackupsDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []BackupInfo{}, nil
+		}
+		return nil, fmt.Errorf("failed to read backups directory: %w", err)
+	}
+
+	backups := make([]BackupInfo, 0)
+	for _, entry := range entries {
+		if entry.IsDir() || !backupNameRegex.MatchString(entry.Name()) {
+			continue
+		}
+
+		info, err := b.info(filepath.Join(b.pathBuilder.BackupsDir(), entry.Name()))
+		if err != nil {
+			continue
+		}
+		backups = append(backups, *info)
+	}
+
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].Created.After(backups[j].Created)
+	})
+
+	return backups, nil
+}
+
+// Latest returns the newest archive, or nil when there are none
+func (b *BackupStore) Latest() (*BackupInfo, error) {
+	backups, err := b.List()
+	if err != nil || len(backups) == 0 {
+		return nil, err
+	}
+	return &backups[0], nil
+}
+
+// Prune deletes archives not selected by the retention rules and returns their names
+func (b *BackupStore) Prune(retention BackupRetention) ([]string, error) {
+	backups, err := b.List()
+	if err != nil {
+		return nil, err
+	}
+
+	keep := make(map[string]bool)
+	days := make(map[string]bool)
+	weeks := make(map[string]bool)
+
+	for i, backup := range backups {
+		if i < retention.KeepLast {
+			keep[backup.Name] = true
+		}
+
+		day := backup.Created.Format("2006-01-02")
+		if !days[day] && len(days) < retention.KeepDaily {
+			days[day] = true
+			keep[backup.Name] = true
+		}
+
+		year, week := backup.Created.ISOWeek()
+		weekKey := fmt.Sprintf("%d-%02d", year, week)
+		if !weeks[weekKey] && len(weeks) < retention.KeepWeekly {
+			weeks[weekKey] = true
+			keep[backup.Name] = true
+		}
+	}
+
+	removed := make([]string, 0)
+	for _, backup := range backups {
+		if keep[backup.Name] {
+			continue
+		}
+		if err := os.Remove(backup.Path); err != nil {
+			return removed, fmt.Errorf("failed to remove backup %s: %w", backup.Name, err)
+		}
+		removed = append(removed, backup.Name)
+	}
+
+	return removed, nil
+}
+
+// Restore replaces the data root with the contents of an archive. The archive
+// must not be newer than supportedVersion. Unless force is set, restoring is
+// refused when the current data has a newer schema version than the archive or
+// a data file or the config file was created or changed after the archive was
+// taken; files deleted since are restored.
+// The current data is backed up first; the history repository and the backups
+// directory are left untouched.
+func (b *BackupStore) Restore(name string, supportedVersion int, force bool) (*BackupRestoreReport, error) {
+	if !backupNameRegex.MatchString(name) {
+		return nil, fmt.Errorf("invalid backup name: %s", name)
+	}
+
+	path := filepath.Join(b.pathBuilder.BackupsDir(), name)
+	backup, err := b.info(path)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := readBackupManifest(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if backup.SchemaVersion > supportedVersion {
+		return nil, fmt.Errorf("backup schema version %d is newer than supported version %d", backup.SchemaVersion, supportedVersion)
+	}
+
+	currentVersion, err := ReadSchemaVersion(b.rootPath())
+	if err != nil {
+		return nil, err
+	}
+
+	if !force {
+		if currentVersion > backup.SchemaVersion {
+			return nil, fmt.Errorf("data directory schema version %d is newer than backup version %d (use force to overwrite)", currentVersion, backup.SchemaVersion)
+		}
+
+		changed, err := b.newerFile(manifest)
+		if err != nil {
+			return nil, err
+		}
+		if changed != "" {
+			return nil, fmt.Errorf("%s changed after the backup was taken (use force to overwrite)", changed)
+		}
+	}
+
+	safety, err := b.Create(BackupReasonPreRestore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to back up current data before restore: %w", err)
+	}
+
+	if err := b.clearDataRoot(); err != nil {
+		return nil, err
+	}
+
+	files, configRestored, err := b.extract(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore %s (current data saved in %s): %w", name, safety.Name, err)
+	}
+
+	return &BackupRestoreReport{
+		Backup:         *backup,
+		SafetyBackup:   safety.Name,
+		FromVersion:    backup.SchemaVersion,
+		Files:          files,
+		ConfigRestored: configRestored,
+	}, nil
+}
+
+// writeArchive writes the manifest, the data files and the config file to a
+// gzipped tarball. The config file can hold calendar credentials, so archives
+// are only readable by their owner.
+func (b *BackupStore) writeArchive(path string, manifest BackupManifest, files []string) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create backup archive: %w", err)
+	}
+	defer file.Close()
+
+	gz := gzip.NewWriter(file)
+	tw := tar.NewWriter(gz)
+
+	manifestData, err := serialization.SerializeYaml(manifest)
+	if err != nil {
+		return fmt.Errorf("failed to serialize backup manifest: %w", err)
+	}
+	if err := writeTarEntry(tw, backupManifestName, manifestData, 0644, manifest.Created); err != nil {
+		return err
+	}
+
+	root := b.rootPath()
+	for _, rel := range files {
+		if err := addTarFile(tw, filepath.Join(root, rel), backupDataDir+"/"+filepath.ToSlash(rel)); err != nil {
+			return err
+		}
+	}
+
+	if manifest.HasConfig {
+		if err := addTarFile(tw, b.configPath, backupConfigFile); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to finish backup archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("failed to finish backup archive: %w", err)
+	}
+
+	return file.Sync()
+}
+
+// extract unpacks the data and config entries of an archive
+func (b *BackupStore) extract(path string) (int, bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, false, err
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to read backup archive: %w", err)
+	}
+	defer gz.Close()
+
+	root := b.rootPath()
+	files := 0
+	configRestored := false
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return files, configRestored, fmt.Errorf("failed to read backup archive: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		var target string
+		switch {
+		case header.Name == backupConfigFile:
+			if b.configPath == "" {
+				continue
+			}
+			target = b.configPath
+			configRestored = true
+		case strings.HasPrefix(header.Name, backupDataDir+"/"):
+			rel := filepath.FromSlash(strings.TrimPrefix(header.Name, backupDataDir+"/"))
+			target = filepath.Join(root, rel)
+			// Never write outside the data root
+			if !strings.HasPrefix(target, filepath.Clean(root)+string(filepath.Separator)) {
+				return files, configRestored, fmt.Errorf("backup entry escapes data directory: %s", header.Name)
+			}
+			files++
+		default:
+			continue
+		}
+
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return files, configRestored, fmt.Errorf("failed to read %s from backup: %w", header.Name, err)
+		}
+		if err := filesystem.SafeWrite(target, data, fs.FileMode(header.Mode).Perm()); err != nil {
+			return files, configRestored, err
+		}
+	}
+
+	return files, configRestored, nil
+}
+
+// info reads the manifest of an archive
+func (b *BackupStore) info(path string) (*BackupInfo, error) {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("backup not found: %s", filepath.Base(path))
+	}
+
+	manifest, err := readBackupManifest(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BackupInfo{
+		Name:          filepath.Base(path),
+		Path:          path,
+		Created:       manifest.Created,
+		Reason:        manifest.Reason,
+		SchemaVersion: manifest.SchemaVersion,
+		Size:          stat.Size(),
+	}, nil
+}
+
+// dataFiles lists the regular files of the data root that belong in a backup
+func (b *BackupStore) dataFiles() ([]string, error) {
+	root := b.rootPath()
+	files := make([]string, 0)
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if b.isExcluded(rel) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if d.Type().IsRegular() && !strings.HasSuffix(rel, ".tmp") {
+			files = append(files, rel)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan data directory: %w", err)
+	}
+
+	return files, nil
+}
+
+// newerFile returns a data file, relative to the data root, or the config
+// file that was written after an archive was taken and differs from the
+// archived version, or "" when there is none. Restoring would overwrite those
+// changes; files deleted since are simply restored. Files the daemon rewrites
+// on its own are not compared.
+func (b *BackupStore) newerFile(manifest *BackupManifest) (string, error) {
+	files, err := b.dataFiles()
+	if err != nil {
+		return "", err
+	}
+
+	for _, rel := range files {
+		key := filepath.ToSlash(rel)
+		if b.isDaemonStateFile(key) {
+			continue
+		}
+		newer, err := newerThanArchive(filepath.Join(b.rootPath(), rel), manifest.Created, manifest.Checksums[key], manifest.Checksums != nil)
+		if err != nil {
+			return "", err
+		}
+		if newer {
+			return key, nil
+		}
+	}
+
+	if manifest.ConfigSum != "" && b.configPath != "" {
+		if exists, _ := filesystem.Exists(b.configPath); exists {
+			newer, err := newerThanArchive(b.configPath, manifest.Created, manifest.ConfigSum, true)
+			if err != nil {
+				return "", err
+			}
+			if newer {
+				return b.configPath, nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// newerThanArchive reports whether a file was modified after created and, when
+// the archive has checksums, no longer matches archived ("" if it was not archived)
+func newerThanArchive(path string, created time.Time, archived string, checksummed bool) (bool, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, fmt.Errorf("failed to stat %s: %w", path, err)
+	}
+	if !info.ModTime().After(created) {
+		return false, nil
+	}
+	// Archives without checksums fall back to modification times
+	if !checksummed || archived == "" {
+		return true, nil
+	}
+	current, err := fileChecksum(path)
+	if err != nil {
+		return false, err
+	}
+	return current != archived, nil
+}
+
+// isDaemonStateFile reports whether a slash-separated path relative to the
+// data root is rewritten by the daemon without user changes: the schema file,
+// whose version is compared separately, and the calendar sync state
+func (b *BackupStore) isDaemonStateFile(rel string) bool {
+	syncFile, err := filepath.Rel(b.rootPath(), b.pathBuilder.GlobalCalendarSyncFile())
+	return rel == schemaFile || (err == nil && rel == filepath.ToSlash(syncFile))
+}
+
+// fileChecksum returns the hex SHA-256 of a file
+func fileChecksum(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// clearDataRoot removes everything a backup restores, keeping backups/ and the history repository
+func (b *BackupStore) clearDataRoot() error {
+	root := b.rootPath()
+
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read data directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		if b.isExcluded(entry.Name()) {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(root, entry.Name())); err != nil {
+			return fmt.Errorf("failed to clear %s: %w", entry.Name(), err)
+		}
+	}
+
+	return nil
+}
+
+// isExcluded reports whether a top-level path is left out of backups
+func (b *BackupStore) isExcluded(rel string) bool {
+	return rel == backupsDir || rel == historyRepoDir
+}
+
+func (b *BackupStore) rootPath() string {
+	return b.pathBuilder.RootPath()
+}
+
+// readBackupManifest reads the manifest entry at the start of an archive
+func readBackupManifest(path string) (*BackupManifest, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup %s: %w", filepath.Base(path), err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	header, err := tr.Next()
+	if err != nil || header.Name != backupManifestName {
+		return nil, fmt.Errorf("backup %s has no manifest", filepath.Base(path))
+	}
+
+	data, err := io.ReadAll(tr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest of %s: %w", filepath.Base(path), err)
+	}
+
+	var manifest BackupManifest
+	if err := serialization.ParseYaml(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest of %s: %w", filepath.Base(path), err)
+	}
+
+	return &manifest, nil
+}
+
+func addTarFile(tw *tar.Writer, path, name string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat %s: %w", path, err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	return writeTarEntry(tw, name, data, info.Mode().Perm(), info.ModTime())
+}
+
+func writeTarEntry(tw *tar.Writer, name string, data []byte, mode fs.FileMode, modTime time.Time) error {
+	header := &tar.Header{
+		Name:     name,
+		Mode:     int64(mode),
+		Size:     int64(len(data)),
+		ModTime:  modTime,
+		Typeflag: tar.TypeReg,
+	}
+
+	if err := tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("failed to write %s to backup: %w", name, err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("failed to write %s to backup: %w", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,373 @@
+package filesystem
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"mkanban/internal/infrastructure/serialization"
+)
+
+const (
+	testTaskFile    = "projects/work/boards/tracker/columns/to-do/tasks/TRK-001-fix-bug/task.md"
+	testNoteFile    = "projects/work/notes/standup.md"
+	testTimeLogFile = "projects/work/time/logs/2026-03.yml"
+)
+
+// newTestBackupStore creates a data root holding one task file and a config
+// file next to it
+func newTestBackupStore(t *testing.T) (*BackupStore, string, string) {
+	t.Helper()
+	dir := t.TempDir()
+	root := filepath.Join(dir, "data")
+	configPath := filepath.Join(dir, "config.yaml")
+
+	writeTestFile(t, root, testTaskFile, "# Fix bug\n")
+	if err := WriteSchemaVersion(root, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(configPath, []byte("theme: dark\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	return NewBackupStore(root, configPath), root, configPath
+}
+
+// readTestFile returns the contents of a file below root
+func readTestFile(t *testing.T, root, rel string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(rel)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestBackupCreateAndRestore(t *testing.T) {
+	store, root, configPath := newTestBackupStore(t)
+
+	backup, err := store.Create("Before Cleanup")
+	if err != nil {
+		t.Fatalf("backup failed: %v", err)
+	}
+	if backup.Reason != "before-cleanup" {
+		t.Errorf("expected the reason to be slugged, got %q", backup.Reason)
+	}
+	if backup.SchemaVersion != 1 {
+		t.Errorf("expected schema version 1, got %d", backup.SchemaVersion)
+	}
+
+	info, err := os.Stat(backup.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("expected the archive to be readable by its owner only, got %v", info.Mode().Perm())
+	}
+
+	// Rewriting the schema file and the calendar sync state and touching a
+	// task without changing it do not count as changes
+	if err := WriteSchemaVersion(root, 1); err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, root, "global/calendar_sync.yml", "last_sync: now\n")
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(filepath.Join(root, filepath.FromSlash(testTaskFile)), later, later); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := store.Restore(backup.Name, 1, false)
+	if err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+	if !report.ConfigRestored {
+		t.Error("expected the config file to be restored")
+	}
+	if report.SafetyBackup == "" {
+		t.Error("expected the current data to be backed up before restoring")
+	}
+	if got := readTestFile(t, filepath.Dir(configPath), "config.yaml"); got != "theme: dark\n" {
+		t.Errorf("expected the archived config, got %q", got)
+	}
+	if got := readTestFile(t, root, testTaskFile); got != "# Fix bug\n" {
+		t.Errorf("expected the archived task, got %q", got)
+	}
+	assertNotExists(t, root, "global/calendar_sync.yml")
+
+	backups, err := store.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 2 {
+		t.Errorf("expected the backup and the safety backup to be listed, got %d", len(backups))
+	}
+}
+
+func TestBackupRestoreRefusesChangedBoardFiles(t *testing.T) {
+	store, root, _ := newTestBackupStore(t)
+
+	backup, err := store.Create(BackupReasonManual)
+	if err != nil {
+		t.Fatalf("backup failed: %v", err)
+	}
+
+	writeTestFile(t, root, testTaskFile, "# Fix bug\n\nNew notes\n")
+	markNewer(t, root, testTaskFile)
+
+	if _, err := store.Restore(backup.Name, 1, false); err == nil || !strings.Contains(err.Error(), "changed after the backup") {
+		t.Fatalf("expected the changed task to block the restore, got %v", err)
+	}
+	if got := readTestFile(t, root, testTaskFile); got != "# Fix bug\n\nNew notes\n" {
+		t.Errorf("expected the refused restore to leave the task alone, got %q", got)
+	}
+
+	if _, err := store.Restore(backup.Name, 1, true); err != nil {
+		t.Fatalf("forced restore failed: %v", err)
+	}
+	if got := readTestFile(t, root, testTaskFile); got != "# Fix bug\n" {
+		t.Errorf("expected the archived task after a forced restore, got %q", got)
+	}
+}
+
+func TestBackupRestoreRefusesNewerData(t *testing.T) {
+	tests := []struct {
+		name    string
+		changed string
+		change  func(t *testing.T, root, configPath string)
+	}{
+		{
+			name:    "edited note",
+			changed: testNoteFile,
+			change: func(t *testing.T, root, _ string) {
+				writeTestFile(t, root, testNoteFile, "# Standup\n\nBlocked on review\n")
+				markNewer(t, root, testNoteFile)
+			},
+		},
+		{
+			name:    "edited time log",
+			changed: testTimeLogFile,
+			change: func(t *testing.T, root, _ string) {
+				writeTestFile(t, root, testTimeLogFile, "- task: TRK-001\n  minutes: 30\n- task: TRK-001\n  minutes: 45\n")
+				markNewer(t, root, testTimeLogFile)
+			},
+		},
+		{
+			name:    "created file",
+			changed: "projects/work/notes/retro.md",
+			change: func(t *testing.T, root, _ string) {
+				writeTestFile(t, root, "projects/work/notes/retro.md", "# Retro\n")
+				markNewer(t, root, "projects/work/notes/retro.md")
+			},
+		},
+		{
+			name: "edited config",
+			change: func(t *testing.T, _, configPath string) {
+				if err := os.WriteFile(configPath, []byte("theme: light\n"), 0644); err != nil {
+					t.Fatal(err)
+				}
+				markNewer(t, filepath.Dir(configPath), filepath.Base(configPath))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, root, configPath := newTestBackupStore(t)
+			writeTestFile(t, root, testNoteFile, "# Standup\n")
+			writeTestFile(t, root, testTimeLogFile, "- task: TRK-001\n  minutes: 30\n")
+
+			backup, err := store.Create(BackupReasonManual)
+			if err != nil {
+				t.Fatalf("backup failed: %v", err)
+			}
+
+			tt.change(t, root, configPath)
+			changed := tt.changed
+			if changed == "" {
+				changed = configPath
+			}
+
+			_, err = store.Restore(backup.Name, 1, false)
+			if err == nil || !strings.Contains(err.Error(), changed+" changed after the backup") {
+				t.Fatalf("expected %s to block the restore, got %v", changed, err)
+			}
+			backups, err := store.List()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(backups) != 1 {
+				t.Errorf("expected a refused restore not to take a safety backup, got %d archives", len(backups))
+			}
+
+			if _, err := store.Restore(backup.Name, 1, true); err != nil {
+				t.Fatalf("forced restore failed: %v", err)
+			}
+			if got := readTestFile(t, root, testNoteFile); got != "# Standup\n" {
+				t.Errorf("expected the archived note after a forced restore, got %q", got)
+			}
+			if got := readTestFile(t, filepath.Dir(configPath), "config.yaml"); got != "theme: dark\n" {
+				t.Errorf("expected the archived config after a forced restore, got %q", got)
+			}
+			assertNotExists(t, root, "projects/work/notes/retro.md")
+		})
+	}
+}
+
+func TestBackupRestoreOverDeletedAndUnchangedFiles(t *testing.T) {
+	store, root, _ := newTestBackupStore(t)
+	writeTestFile(t, root, testNoteFile, "# Standup\n")
+
+	backup, err := store.Create(BackupReasonManual)
+	if err != nil {
+		t.Fatalf("backup failed: %v", err)
+	}
+
+	// The task was deleted by accident and the note saved again unchanged
+	if err := os.RemoveAll(filepath.Join(root, filepath.FromSlash(filepath.Dir(testTaskFile)))); err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, root, testNoteFile, "# Standup\n")
+	markNewer(t, root, testNoteFile)
+
+	if _, err := store.Restore(backup.Name, 1, false); err != nil {
+		t.Fatalf("expected the restore to go ahead without force, got %v", err)
+	}
+	if got := readTestFile(t, root, testTaskFile); got != "# Fix bug\n" {
+		t.Errorf("expected the deleted task to be restored, got %q", got)
+	}
+}
+
+func TestBackupRestoreChecksSchemaVersions(t *testing.T) {
+	store, root, _ := newTestBackupStore(t)
+
+	backup, err := store.Create(BackupReasonManual)
+	if err != nil {
+		t.Fatalf("backup failed: %v", err)
+	}
+
+	if _, err := store.Restore(backup.Name, 0, true); err == nil {
+		t.Error("expected an archive newer than the supported version to be rejected")
+	}
+
+	if err := WriteSchemaVersion(root, 2); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Restore(backup.Name, 2, false); err == nil {
+		t.Error("expected data newer than the archive to block the restore")
+	}
+
+	if _, err := store.Restore("../outside.tar.gz", 2, true); err == nil {
+		t.Error("expected an invalid backup name to be rejected")
+	}
+}
+
+func TestBackupRestoreRejectsEntriesOutsideDataRoot(t *testing.T) {
+	store, root, _ := newTestBackupStore(t)
+
+	name := "mkanban-20260101-120000-manual.tar.gz"
+	writeTestArchive(t, store, name, BackupManifest{Created: time.Now()}, map[string]string{
+		backupDataDir + "/../escaped.txt": "outside\n",
+	})
+
+	if _, err := store.Restore(name, 1, true); err == nil || !strings.Contains(err.Error(), "escapes data directory") {
+		t.Fatalf("expected the escaping entry to be rejected, got %v", err)
+	}
+	assertNotExists(t, filepath.Dir(root), "escaped.txt")
+}
+
+func TestBackupPrune(t *testing.T) {
+	store, _, _ := newTestBackupStore(t)
+
+	// Three archives today, one yesterday and one in each of the two weeks before
+	now := time.Date(2026, 3, 18, 12, 0, 0, 0, time.Local)
+	created := []time.Time{
+		now,
+		now.Add(-time.Hour),
+		now.Add(-2 * time.Hour),
+		now.AddDate(0, 0, -1),
+		now.AddDate(0, 0, -7),
+		now.AddDate(0, 0, -14),
+	}
+	names := make([]string, len(created))
+	for i, at := range created {
+		names[i] = fmt.Sprintf("%s%s-%s%s", backupArchivePrefix, at.Format(backupTimeFormat), BackupReasonScheduled, backupArchiveExt)
+		writeTestArchive(t, store, names[i], BackupManifest{Created: at, Reason: BackupReasonScheduled}, nil)
+	}
+
+	removed, err := store.Prune(BackupRetention{KeepLast: 1, KeepDaily: 2, KeepWeekly: 2})
+	if err != nil {
+		t.Fatalf("prune failed: %v", err)
+	}
+
+	// KeepLast keeps names[0], which is also today's daily and this week's
+	// weekly; KeepDaily adds yesterday and KeepWeekly last week
+	want := map[string]bool{names[1]: true, names[2]: true, names[5]: true}
+	if len(removed) != len(want) {
+		t.Fatalf("expected %d archives removed, got %v", len(want), removed)
+	}
+	for _, name := range removed {
+		if !want[name] {
+			t.Errorf("did not expect %s to be removed", name)
+		}
+	}
+
+	backups, err := store.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 3 || backups[0].Name != names[0] {
+		t.Errorf("expected the 3 kept archives newest first, got %v", backups)
+	}
+}
+
+// writeTestArchive writes an archive with a manifest and the given entries to
+// the backups directory
+func writeTestArchive(t *testing.T, store *BackupStore, name string, manifest BackupManifest, entries map[string]string) {
+	t.Helper()
+	if err := os.MkdirAll(store.pathBuilder.BackupsDir(), 0755); err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.Create(filepath.Join(store.pathBuilder.BackupsDir(), name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	gz := gzip.NewWriter(file)
+	tw := tar.NewWriter(gz)
+
+	manifestData, err := serialization.SerializeYaml(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := writeTarEntry(tw, backupManifestName, manifestData, 0644, manifest.Created); err != nil {
+		t.Fatal(err)
+	}
+	for entryName, content := range entries {
+		if err := writeTarEntry(tw, entryName, []byte(content), 0644, manifest.Created); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// markNewer sets the modification time of a file a minute ahead, so it is
+// newer than any archive taken before regardless of timestamp granularity
+func markNewer(t *testing.T, root, rel string) {
+	t.Helper()
+	future := time.Now().Add(time.Minute)
+	if err := os.Chtimes(filepath.Join(root, filepath.FromSlash(rel)), future, future); err != nil {
+		t.Fatal(err)
+	}
+}
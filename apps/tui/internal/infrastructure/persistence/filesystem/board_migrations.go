@@ -0,0 +1,350 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"mkanban/internal/domain/valueobject"
+	"mkanban/internal/infrastructure/persistence/mapper"
+	"mkanban/internal/infrastructure/serialization"
+	"mkanban/pkg/filesystem"
+	"mkanban/pkg/slug"
+)
+
+// Board storage schema versions
+const (
+	SchemaVersionColumnsSubdirectory = 1
+	SchemaVersionColumnMetadataSplit = 2
+	SchemaVersionTasksSubdirectory   = 3
+	SchemaVersionBoardMetadataSplit  = 4
+)
+
+const (
+	columnsSubdir = "columns"
+	tasksSubdir   = "tasks"
+)
+
+// registerBoardMigrations registers the board layout migrations that used to run ad hoc
+func (m *Migrator) registerBoardMigrations() {
+	m.Register(MigrationStep{
+		Version:     SchemaVersionColumnsSubdirectory,
+		Name:        "columns-subdirectory",
+		Description: "Move column folders from the board root into columns/",
+		Apply:       m.forEachBoard(m.migrateColumnsToSubdirectory),
+	})
+	m.Register(MigrationStep{
+		Version:     SchemaVersionColumnMetadataSplit,
+		Name:        "column-metadata-split",
+		Description: "Split column.md frontmatter into metadata.yml and normalize column folder names",
+		Apply:       m.forEachBoard(m.migrateColumnsToNewFormat),
+	})
+	m.Register(MigrationStep{
+		Version:     SchemaVersionTasksSubdirectory,
+		Name:        "tasks-subdirectory",
+		Description: "Move task folders from the column root into tasks/",
+		Apply:       m.forEachBoard(m.migrateTasksToSubdirectory),
+	})
+	m.Register(MigrationStep{
+		Version:     SchemaVersionBoardMetadataSplit,
+		Name:        "board-metadata-split",
+		Description: "Split board.md frontmatter into metadata.yml",
+		Apply:       m.forEachBoard(m.migrateBoardMetadata),
+	})
+}
+
+// columnDirs returns the column folders of a board, looking in the legacy
+// location (directly under the board) when columns/ does not exist yet
+func (m *Migrator) columnDirs(boardID string) ([]string, error) {
+	boardDir, err := m.pathBuilder.BoardDir(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	columnsDir := filepath.Join(boardDir, columnsSubdir)
+	exists, err := filesystem.Exists(columnsDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check columns directory: %w", err)
+	}
+
+	if exists {
+		entries, err := os.ReadDir(columnsDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read columns directory: %w", err)
+		}
+
+		dirs := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			if entry.IsDir() {
+				dirs = append(dirs, filepath.Join(columnsDir, entry.Name()))
+			}
+		}
+		return dirs, nil
+	}
+
+	return m.legacyColumnDirs(boardDir)
+}
+
+// legacyColumnDirs returns folders directly under the board that look like columns
+func (m *Migrator) legacyColumnDirs(boardDir string) ([]string, error) {
+	entries, err := os.ReadDir(boardDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read board directory: %w", err)
+	}
+
+	dirs := make([]string, 0)
+	for _, entry := range entries {
+		if !entry.IsDir() || entry.Name() == columnsSubdir {
+			continue
+		}
+
+		columnDir := filepath.Join(boardDir, entry.Name())
+		hasColumnMd, _ := filesystem.Exists(filepath.Join(columnDir, columnContentFile))
+		hasMetadataYaml, _ := filesystem.Exists(filepath.Join(columnDir, columnMetadataYamlFile))
+
+		if hasColumnMd || hasMetadataYaml {
+			dirs = append(dirs, columnDir)
+		}
+	}
+
+	return dirs, nil
+}
+
+// migrateColumnsToSubdirectory moves columns from the board root to columns/
+func (m *Migrator) migrateColumnsToSubdirectory(ctx context.Context, boardID string, dryRun bool) ([]string, error) {
+	boardDir, err := m.pathBuilder.BoardDir(boardID)
+	if err != nil {
+		return nil, err
+	}
+	columnsDir := filepath.Join(boardDir, columnsSubdir)
+
+	// If columns/ already exists, this board is already migrated
+	if exists, err := filesystem.Exists(columnsDir); err != nil {
+		return nil, fmt.Errorf("failed to check columns directory: %w", err)
+	} else if exists {
+		return nil, nil
+	}
+
+	columnsToMigrate, err := m.legacyColumnDirs(boardDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// Boards without columns get an empty columns/ too, so they can be loaded
+	changes := make([]string, 0, len(columnsToMigrate)+1)
+	if len(columnsToMigrate) == 0 {
+		changes = append(changes, fmt.Sprintf("create %s", m.relPath(columnsDir)))
+	}
+	if !dryRun {
+		if err := filesystem.EnsureDir(columnsDir, 0755); err != nil {
+			return nil, fmt.Errorf("failed to create columns directory: %w", err)
+		}
+	}
+
+	for _, oldPath := range columnsToMigrate {
+		newPath := filepath.Join(columnsDir, filepath.Base(oldPath))
+		changes = append(changes, fmt.Sprintf("move %s -> %s", m.relPath(oldPath), m.relPath(newPath)))
+
+		if dryRun {
+			continue
+		}
+
+		if err := os.Rename(oldPath, newPath); err != nil {
+			return changes, fmt.Errorf("failed to move column %s: %w", filepath.Base(oldPath), err)
+		}
+	}
+
+	return changes, nil
+}
+
+// migrateColumnsToNewFormat converts column.md frontmatter into metadata.yml plus a
+// titled column.md, renaming column folders to their normalized slug
+func (m *Migrator) migrateColumnsToNewFormat(ctx context.Context, boardID string, dryRun bool) ([]string, error) {
+	columnDirs, err := m.columnDirs(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]string, 0)
+	for _, columnDir := range columnDirs {
+		// Columns that already have metadata.yml are in the new format
+		if exists, _ := filesystem.Exists(filepath.Join(columnDir, columnMetadataYamlFile)); exists {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(columnDir, columnContentFile))
+		if err != nil {
+			continue
+		}
+
+		doc, err := serialization.ParseFrontmatter(data)
+		if err != nil {
+			// Not frontmatter, nothing to convert
+			continue
+		}
+
+		folderName := filepath.Base(columnDir)
+		normalizedName := slug.Generate(folderName)
+
+		if normalizedName != folderName {
+			newColumnDir := filepath.Join(filepath.Dir(columnDir), normalizedName)
+			changes = append(changes, fmt.Sprintf("rename %s -> %s", m.relPath(columnDir), m.relPath(newColumnDir)))
+
+			if !dryRun {
+				if err := os.Rename(columnDir, newColumnDir); err != nil {
+					return changes, fmt.Errorf("failed to rename column folder %s to %s: %w", folderName, normalizedName, err)
+				}
+			}
+			columnDir = newColumnDir
+		}
+
+		changes = append(changes, fmt.Sprintf("split %s into %s", m.relPath(filepath.Join(columnDir, columnContentFile)), columnMetadataYamlFile))
+		if dryRun {
+			continue
+		}
+
+		displayName := doc.GetString("display_name")
+		if displayName == "" {
+			displayName = folderName
+		}
+
+		storage := mapper.ColumnStorage{
+			Order:    doc.GetInt("order"),
+			WIPLimit: doc.GetInt("wip_limit"),
+			Color:    doc.GetString("color"),
+		}
+
+		yamlData, err := serialization.SerializeYaml(storage)
+		if err != nil {
+			return changes, fmt.Errorf("failed to serialize column metadata: %w", err)
+		}
+		if err := filesystem.SafeWrite(filepath.Join(columnDir, columnMetadataYamlFile), yamlData, 0644); err != nil {
+			return changes, fmt.Errorf("failed to write metadata.yml: %w", err)
+		}
+
+		markdownContent := serialization.SerializeMarkdownWithTitle(displayName, doc.GetString("description"))
+		if err := filesystem.SafeWrite(filepath.Join(columnDir, columnContentFile), markdownContent, 0644); err != nil {
+			return changes, fmt.Errorf("failed to write column.md: %w", err)
+		}
+	}
+
+	return changes, nil
+}
+
+// migrateTasksToSubdirectory moves task folders from the column root to tasks/
+func (m *Migrator) migrateTasksToSubdirectory(ctx context.Context, boardID string, dryRun bool) ([]string, error) {
+	columnDirs, err := m.columnDirs(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]string, 0)
+	for _, columnDir := range columnDirs {
+		tasksDir := filepath.Join(columnDir, tasksSubdir)
+
+		// If tasks/ already exists, this column is already migrated
+		if exists, err := filesystem.Exists(tasksDir); err != nil {
+			return changes, fmt.Errorf("failed to check tasks directory for column %s: %w", filepath.Base(columnDir), err)
+		} else if exists {
+			continue
+		}
+
+		entries, err := os.ReadDir(columnDir)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			if !entry.IsDir() || !isTaskDirectory(entry.Name()) {
+				continue
+			}
+
+			oldPath := filepath.Join(columnDir, entry.Name())
+			newPath := filepath.Join(tasksDir, entry.Name())
+			changes = append(changes, fmt.Sprintf("move %s -> %s", m.relPath(oldPath), m.relPath(newPath)))
+
+			if dryRun {
+				continue
+			}
+
+			if err := filesystem.EnsureDir(tasksDir, 0755); err != nil {
+				return changes, fmt.Errorf("failed to create tasks directory for column %s: %w", filepath.Base(columnDir), err)
+			}
+			if err := os.Rename(oldPath, newPath); err != nil {
+				return changes, fmt.Errorf("failed to move task %s in column %s: %w", entry.Name(), filepath.Base(columnDir), err)
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+// migrateBoardMetadata converts a legacy board.md with frontmatter into metadata.yml
+// plus a titled board.md
+func (m *Migrator) migrateBoardMetadata(ctx context.Context, boardID string, dryRun bool) ([]string, error) {
+	metadataYamlPath, err := m.pathBuilder.BoardMetadataYaml(boardID)
+	if err != nil {
+		return nil, err
+	}
+	contentPath, err := m.pathBuilder.BoardContent(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if exists, _ := filesystem.Exists(metadataYamlPath); exists {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(contentPath)
+	if err != nil {
+		return nil, nil
+	}
+
+	doc, err := serialization.ParseFrontmatter(data)
+	if err != nil {
+		return nil, nil
+	}
+
+	changes := []string{fmt.Sprintf("split %s into %s", m.relPath(contentPath), boardMetadataYamlFile)}
+	if dryRun {
+		return changes, nil
+	}
+
+	board, err := mapper.BoardFromLegacyStorage(doc, boardID)
+	if err != nil {
+		return changes, err
+	}
+	if board.ProjectID() == "" {
+		if projectSlug, _, err := valueobject.ParseBoardID(boardID); err == nil {
+			board.SetProjectID(projectSlug)
+		}
+	}
+
+	metadata, err := mapper.BoardMetadataToStorage(board)
+	if err != nil {
+		return changes, err
+	}
+	yamlData, err := serialization.SerializeYaml(metadata)
+	if err != nil {
+		return changes, fmt.Errorf("failed to serialize board metadata: %w", err)
+	}
+	if err := filesystem.SafeWrite(metadataYamlPath, yamlData, 0644); err != nil {
+		return changes, fmt.Errorf("failed to write metadata.yml: %w", err)
+	}
+	markdown, err := mapper.BoardContentToMarkdown(board)
+	if err != nil {
+		return changes, err
+	}
+	if err := filesystem.SafeWrite(contentPath, markdown, 0644); err != nil {
+		return changes, fmt.Errorf("failed to write board.md: %w", err)
+	}
+
+	return changes, nil
+}
+
+// isTaskDirectory checks if a directory name matches the task folder format (PREFIX-NUMBER-slug)
+func isTaskDirectory(name string) bool {
+	_, err := valueobject.ParseTaskID(name)
+	return err == nil
+}
@@ -0,0 +1,119 @@
+package filesystem
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/valueobject"
+)
+
+// addRoundTripTask adds a task to a column of a board
+func addRoundTripTask(t *testing.T, board *entity.Board, column *entity.Column, title string) *entity.Task {
+	t.Helper()
+	taskID, err := board.GenerateNextTaskID(title)
+	if err != nil {
+		t.Fatal(err)
+	}
+	task, err := entity.NewTask(taskID, title, "", valueobject.PriorityMedium, valueobject.StatusTodo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := column.AddTask(task); err != nil {
+		t.Fatal(err)
+	}
+	return task
+}
+
+// findTaskMetadata finds the metadata file of a task folder under a data root
+func findTaskMetadata(t *testing.T, root, folder string) string {
+	t.Helper()
+	matches, err := filepath.Glob(filepath.Join(root, "projects", "work", "boards", "tracker", "columns", "*", "tasks", folder, "metadata.yml"))
+	if err != nil || len(matches) != 1 {
+		t.Fatalf("expected one metadata file for %s, got %v (%v)", folder, matches, err)
+	}
+	return matches[0]
+}
+
+func TestBoardRoundTripKeepsParents(t *testing.T) {
+	ctx := context.Background()
+	root := newIntegrityRoot(t)
+	repo := NewBoardRepository(root)
+
+	board, err := entity.NewBoard("work/tracker", "Tracker", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	column, err := entity.NewColumn("To Do", "", 0, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := board.AddColumn(column); err != nil {
+		t.Fatal(err)
+	}
+	parent := addRoundTripTask(t, board, column, "parent")
+	child := addRoundTripTask(t, board, column, "child")
+	crossBoard := addRoundTripTask(t, board, column, "cross-board")
+	if err := board.SetTaskParent(child.ID(), parent.ID()); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Save(ctx, board); err != nil {
+		t.Fatal(err)
+	}
+
+	// Nest a task under a task of another board, as an older version allowed
+	crossBoardFile := findTaskMetadata(t, root, crossBoard.ID().String())
+	data, err := os.ReadFile(crossBoardFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(crossBoardFile, append(data, []byte("parent_id: API-001\n")...), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := repo.FindByID(ctx, "work/tracker")
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	task, _, err := loaded.FindTask(child.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if task.ParentID() == nil || task.ParentID().String() != parent.ID().String() {
+		t.Errorf("expected the parent on the board to be resolved to %s, got %v", parent.ID(), task.ParentID())
+	}
+
+	if err := repo.Save(ctx, loaded); err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+	if got := readFile(t, findTaskMetadata(t, root, child.ID().String())); !strings.Contains(got, "parent_id: "+parent.ID().ShortID()+"\n") {
+		t.Errorf("expected the parent on the board to be written back, got %q", got)
+	}
+	if got := readFile(t, crossBoardFile); !strings.Contains(got, "parent_id: API-001\n") {
+		t.Errorf("expected the parent on another board to be written back unchanged, got %q", got)
+	}
+
+	// Making the task top-level drops the parent it could not resolve
+	if err := loaded.SetTaskParent(crossBoard.ID(), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Save(ctx, loaded); err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+	if got := readFile(t, crossBoardFile); strings.Contains(got, "parent_id") {
+		t.Errorf("expected the parent to be dropped, got %q", got)
+	}
+}
+
+// readFile reads a file by its absolute path
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
@@ -0,0 +1,71 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/infrastructure/persistence/mapper"
+)
+
+const boardTemplatesDir = "boards"
+
+// BoardTemplateRepositoryImpl implements BoardTemplateRepository with one YAML
+// file per template in the global templates/boards/ folder
+type BoardTemplateRepositoryImpl struct {
+	files templateDir
+}
+
+// NewBoardTemplateRepository creates a new filesystem-based board template repository
+func NewBoardTemplateRepository(rootPath string) repository.BoardTemplateRepository {
+	return &BoardTemplateRepositoryImpl{
+		files: newTemplateDir(rootPath, boardTemplatesDir),
+	}
+}
+
+// Save creates or replaces a template file
+func (r *BoardTemplateRepositoryImpl) Save(ctx context.Context, template *entity.BoardTemplate) error {
+	data, err := mapper.BoardTemplateToStorage(template)
+	if err != nil {
+		return fmt.Errorf("failed to serialize template: %w", err)
+	}
+	return r.files.write(template.Name(), data)
+}
+
+// FindByName retrieves a template by name
+func (r *BoardTemplateRepositoryImpl) FindByName(ctx context.Context, name string) (*entity.BoardTemplate, error) {
+	data, err := r.files.read(name)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := mapper.BoardTemplateFromStorage(name, data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template %s: %w", name, err)
+	}
+	return template, nil
+}
+
+// FindAll retrieves all templates, skipping files that are not valid templates
+func (r *BoardTemplateRepositoryImpl) FindAll(ctx context.Context) ([]*entity.BoardTemplate, error) {
+	names, err := r.files.names()
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]*entity.BoardTemplate, 0, len(names))
+	for _, name := range names {
+		template, err := r.FindByName(ctx, name)
+		if err != nil {
+			continue
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+// Delete removes a template file
+func (r *BoardTemplateRepositoryImpl) Delete(ctx context.Context, name string) error {
+	return r.files.remove(name)
+}
@@ -0,0 +1,97 @@
+package filesystem
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"mkanban/internal/infrastructure/serialization"
+	"mkanban/pkg/filesystem"
+)
+
+// CalendarSyncRecord is the calendar sync state kept between daemon runs
+type CalendarSyncRecord struct {
+	Provider  string                   `yaml:"provider"` // mappings only hold for the provider that made them
+	LastSync  time.Time                `yaml:"last_sync,omitempty"`
+	Events    map[string]string        `yaml:"events"` // calendar event ID -> task ID
+	Conflicts []CalendarConflictRecord `yaml:"conflicts,omitempty"`
+}
+
+// CalendarConflictRecord is a sync conflict awaiting a resolution, with the
+// versions of the meeting in the calendar and on the board
+type CalendarConflictRecord struct {
+	EventID     string              `yaml:"event_id"`
+	TaskID      string              `yaml:"task_id"`
+	BoardID     string              `yaml:"board_id"`
+	Description string              `yaml:"description"`
+	Fields      []string            `yaml:"fields"`
+	DetectedAt  time.Time           `yaml:"detected_at"`
+	Event       CalendarEventRecord `yaml:"event"`
+	Task        CalendarEventRecord `yaml:"task"`
+}
+
+// CalendarEventRecord is one version of a conflicting meeting
+type CalendarEventRecord struct {
+	UID         string    `yaml:"uid,omitempty"`
+	Title       string    `yaml:"title"`
+	Description string    `yaml:"description,omitempty"`
+	Start       time.Time `yaml:"start"`
+	End         time.Time `yaml:"end"`
+	AllDay      bool      `yaml:"all_day,omitempty"`
+	Location    string    `yaml:"location,omitempty"`
+	Attendees   []string  `yaml:"attendees,omitempty"`
+	MeetingLink string    `yaml:"meeting_link,omitempty"`
+	Updated     time.Time `yaml:"updated,omitempty"`
+}
+
+// CalendarSyncStore keeps the calendar sync state in global/calendar_sync.yml
+type CalendarSyncStore struct {
+	pathBuilder *ProjectPathBuilder
+}
+
+// NewCalendarSyncStore creates a calendar sync state store for a data root
+func NewCalendarSyncStore(rootPath string) *CalendarSyncStore {
+	return &CalendarSyncStore{
+		pathBuilder: NewProjectPathBuilder(rootPath),
+	}
+}
+
+// Load reads the sync state, an empty record when nothing was synced yet
+func (s *CalendarSyncStore) Load() (*CalendarSyncRecord, error) {
+	record := &CalendarSyncRecord{Events: make(map[string]string)}
+
+	data, err := os.ReadFile(s.pathBuilder.GlobalCalendarSyncFile())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return record, nil
+		}
+		return nil, fmt.Errorf("failed to read calendar sync state: %w", err)
+	}
+
+	if err := serialization.ParseYaml(data, record); err != nil {
+		return nil, fmt.Errorf("invalid calendar sync state: %w", err)
+	}
+	if record.Events == nil {
+		record.Events = make(map[string]string)
+	}
+	return record, nil
+}
+
+// Save replaces the sync state
+func (s *CalendarSyncStore) Save(record *CalendarSyncRecord) error {
+	path := s.pathBuilder.GlobalCalendarSyncFile()
+	if err := filesystem.EnsureDir(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create calendar sync directory: %w", err)
+	}
+
+	data, err := serialization.SerializeYaml(record)
+	if err != nil {
+		return fmt.Errorf("failed to serialize calendar sync state: %w", err)
+	}
+
+	if err := filesystem.SafeWrite(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write calendar sync state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,635 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"mkanban/internal/domain/valueobject"
+	"mkanban/internal/infrastructure/persistence/mapper"
+	"mkanban/internal/infrastructure/serialization"
+	"mkanban/pkg/filesystem"
+)
+
+// IssueSeverity describes how serious an integrity issue is
+type IssueSeverity string
+
+const (
+	IssueSeverityError   IssueSeverity = "error"
+	IssueSeverityWarning IssueSeverity = "warning"
+	IssueSeverityInfo    IssueSeverity = "info"
+)
+
+// Integrity issue codes
+const (
+	IssuePendingMigrations     = "pending_migrations"
+	IssueMissingProjectMeta    = "missing_project_metadata"
+	IssueMissingBoardMeta      = "missing_board_metadata"
+	IssueMissingColumnsDir     = "missing_columns_dir"
+	IssueOrphanedColumnFolder  = "orphaned_column_folder"
+	IssueInvalidTaskFolder     = "invalid_task_folder"
+	IssueIncompleteTask        = "incomplete_task"
+	IssueDuplicateTaskNumber   = "duplicate_task_number"
+	IssueTaskPrefixMismatch    = "task_prefix_mismatch"
+	IssueStaleTaskCounter      = "stale_task_counter"
+	IssueDanglingParent        = "dangling_parent"
+	IssueCrossBoardParent      = "cross_board_parent"
+	IssueDanglingNoteLink      = "dangling_note_link"
+	IssueUnreadableNote        = "unreadable_note"
+	IssueUnknownNoteProject    = "unknown_note_project"
+	IssueUnknownTimeLogTask    = "unknown_time_log_task"
+	IssueStaleRunningTimer     = "stale_running_timer"
+	IssueUnreadableTimeLog     = "unreadable_time_log"
+	IssueUnreadableAction      = "unreadable_action"
+	IssueUnknownActionScope    = "unknown_action_scope"
+	IssueUnknownActionScopeRef = "unknown_action_scope_ref"
+)
+
+// staleTimerThreshold is how long a timer may run before it is reported as forgotten
+const staleTimerThreshold = 24 * time.Hour
+
+// IntegrityIssue describes a single inconsistency found in the data root
+type IntegrityIssue struct {
+	Severity IssueSeverity
+	Code     string
+	Path     string
+	Message  string
+	Fixable  bool
+	Fixed    bool
+}
+
+// IntegrityReport is the result of an integrity check
+type IntegrityReport struct {
+	Fix    bool
+	Issues []IntegrityIssue
+}
+
+// IntegrityChecker walks the data root looking for inconsistencies between
+// projects, boards, notes, time logs and actions
+type IntegrityChecker struct {
+	pathBuilder *PathBuilder
+}
+
+// NewIntegrityChecker creates a new integrity checker for the given data root
+func NewIntegrityChecker(rootPath string) *IntegrityChecker {
+	return &IntegrityChecker{
+		pathBuilder: NewPathBuilder(rootPath),
+	}
+}
+
+// integrityScan holds the state collected while walking the data root
+type integrityScan struct {
+	fix             bool
+	report          *IntegrityReport
+	boards          map[string]bool
+	tasks           map[string]bool     // full and short task IDs
+	taskBoards      map[string][]string // short task ID -> boards holding it
+	unreadableTasks map[string]bool     // boardID -> board has task folders that could not be read
+	missingParents  []missingParent
+	projectIDs      map[string]bool
+}
+
+// missingParent is a task whose parent is not on the task's board
+type missingParent struct {
+	boardID  string
+	taskPath string
+	parentID string
+}
+
+// Check walks the data root and reports every inconsistency it finds.
+// With fix set, issues that can be repaired without losing data are repaired.
+func (c *IntegrityChecker) Check(ctx context.Context, fix bool) (*IntegrityReport, error) {
+	scan := &integrityScan{
+		fix:             fix,
+		report:          &IntegrityReport{Fix: fix, Issues: make([]IntegrityIssue, 0)},
+		boards:          make(map[string]bool),
+		tasks:           make(map[string]bool),
+		taskBoards:      make(map[string][]string),
+		unreadableTasks: make(map[string]bool),
+		projectIDs:      make(map[string]bool),
+	}
+
+	c.checkSchema(scan)
+
+	projectsRoot := c.pathBuilder.projectPathBuilder.ProjectsRoot()
+	projectEntries, err := os.ReadDir(projectsRoot)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read projects directory: %w", err)
+	}
+
+	for _, entry := range projectEntries {
+		if entry.IsDir() && !strings.HasPrefix(entry.Name(), ".") {
+			c.checkProject(scan, entry.Name())
+		}
+	}
+
+	// Parents, notes, time logs and actions reference tasks on any board, so
+	// they are checked once every board has been read
+	c.checkParents(scan)
+	for _, entry := range projectEntries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		c.checkNotes(scan, c.pathBuilder.projectPathBuilder.ProjectNotesDir(entry.Name()))
+		c.checkTimeLogs(scan, c.pathBuilder.projectPathBuilder.ProjectTimeLogsDir(entry.Name()))
+	}
+	c.checkNotes(scan, c.pathBuilder.projectPathBuilder.GlobalNotesDir())
+	c.checkActions(scan)
+
+	return scan.report, nil
+}
+
+func (c *IntegrityChecker) checkSchema(scan *integrityScan) {
+	version, err := ReadSchemaVersion(c.rootPath())
+	if err != nil {
+		c.addIssue(scan, IssueSeverityError, IssuePendingMigrations, c.pathBuilder.projectPathBuilder.SchemaFile(), err.Error(), false, nil)
+		return
+	}
+
+	latest := NewMigrator(c.rootPath(), nil).LatestVersion()
+	if version < latest {
+		c.addIssue(scan, IssueSeverityWarning, IssuePendingMigrations, c.pathBuilder.projectPathBuilder.SchemaFile(),
+			fmt.Sprintf("schema version %d is behind %d; run migrate", version, latest), false, nil)
+	}
+}
+
+func (c *IntegrityChecker) checkProject(scan *integrityScan, projectSlug string) {
+	metadataPath := c.pathBuilder.projectPathBuilder.ProjectMetadata(projectSlug)
+	data, err := os.ReadFile(metadataPath)
+	if err != nil {
+		c.addIssue(scan, IssueSeverityWarning, IssueMissingProjectMeta, c.pathBuilder.projectPathBuilder.ProjectDir(projectSlug),
+			"project folder has no project.md", false, nil)
+	} else if doc, err := serialization.ParseFrontmatter(data); err != nil || doc.GetString("id") == "" {
+		c.addIssue(scan, IssueSeverityError, IssueMissingProjectMeta, metadataPath,
+			"project.md has no readable id", false, nil)
+	} else {
+		scan.projectIDs[doc.GetString("id")] = true
+	}
+	scan.projectIDs[projectSlug] = true
+
+	boardEntries, err := os.ReadDir(c.pathBuilder.projectPathBuilder.ProjectBoardsDir(projectSlug))
+	if err != nil {
+		return
+	}
+
+	for _, entry := range boardEntries {
+		if !entry.IsDir() {
+			continue
+		}
+		boardID, err := valueobject.BuildBoardID(projectSlug, entry.Name())
+		if err != nil {
+			continue
+		}
+		c.checkBoard(scan, boardID)
+	}
+}
+
+// boardTask is a task folder as found on disk
+type boardTask struct {
+	path    string
+	id      *valueobject.TaskID
+	storage mapper.TaskStorage
+}
+
+func (c *IntegrityChecker) checkBoard(scan *integrityScan, boardID string) {
+	scan.boards[boardID] = true
+
+	boardDir, err := c.pathBuilder.BoardDir(boardID)
+	if err != nil {
+		return
+	}
+
+	metadataPath, _ := c.pathBuilder.BoardMetadataYaml(boardID)
+	var metadata map[string]interface{}
+	data, err := os.ReadFile(metadataPath)
+	if err == nil {
+		err = serialization.ParseYaml(data, &metadata)
+	}
+	if err != nil {
+		c.addIssue(scan, IssueSeverityError, IssueMissingBoardMeta, boardDir,
+			fmt.Sprintf("board metadata is unreadable: %v", err), false, nil)
+		return
+	}
+	metaDoc := &serialization.FrontmatterDocument{Frontmatter: metadata}
+
+	columnsDir := filepath.Join(boardDir, columnsSubdir)
+	columnEntries, err := os.ReadDir(columnsDir)
+	if err != nil {
+		c.addIssue(scan, IssueSeverityError, IssueMissingColumnsDir, columnsDir,
+			"board has no columns directory and cannot be loaded", true, func() error {
+				return filesystem.EnsureDir(columnsDir, 0755)
+			})
+		return
+	}
+
+	tasks := make([]boardTask, 0)
+	maxOrder := -1
+	orphanedColumns := make([]string, 0)
+
+	for _, columnEntry := range columnEntries {
+		if !columnEntry.IsDir() {
+			continue
+		}
+		columnDir := filepath.Join(columnsDir, columnEntry.Name())
+
+		columnMetaData, err := os.ReadFile(filepath.Join(columnDir, columnMetadataYamlFile))
+		if err != nil {
+			orphanedColumns = append(orphanedColumns, columnDir)
+		} else {
+			var columnMeta map[string]interface{}
+			if err := serialization.ParseYaml(columnMetaData, &columnMeta); err == nil {
+				order := (&serialization.FrontmatterDocument{Frontmatter: columnMeta}).GetInt("order")
+				if order > maxOrder {
+					maxOrder = order
+				}
+			}
+		}
+
+		tasks = append(tasks, c.scanColumnTasks(scan, boardID, columnDir)...)
+	}
+
+	// Orphaned columns are re-attached after every existing column
+	for _, columnDir := range orphanedColumns {
+		maxOrder++
+		order := maxOrder
+		dir := columnDir
+		c.addIssue(scan, IssueSeverityWarning, IssueOrphanedColumnFolder, dir,
+			"column folder has no metadata.yml and is hidden from the board", true, func() error {
+				return c.restoreColumnMetadata(dir, order)
+			})
+	}
+
+	c.checkBoardTasks(scan, boardID, metaDoc, metadataPath, metadata, tasks)
+}
+
+// scanColumnTasks reads every task folder of a column
+func (c *IntegrityChecker) scanColumnTasks(scan *integrityScan, boardID, columnDir string) []boardTask {
+	taskEntries, err := os.ReadDir(filepath.Join(columnDir, tasksSubdir))
+	if err != nil {
+		return nil
+	}
+
+	tasks := make([]boardTask, 0, len(taskEntries))
+	for _, taskEntry := range taskEntries {
+		if !taskEntry.IsDir() {
+			continue
+		}
+		taskDir := filepath.Join(columnDir, tasksSubdir, taskEntry.Name())
+
+		taskID, err := valueobject.ParseTaskID(taskEntry.Name())
+		if err != nil {
+			scan.unreadableTasks[boardID] = true
+			c.addIssue(scan, IssueSeverityWarning, IssueInvalidTaskFolder, taskDir,
+				"folder name is not a valid task ID", false, nil)
+			continue
+		}
+
+		var storage mapper.TaskStorage
+		metaData, metaErr := os.ReadFile(filepath.Join(taskDir, taskMetadataYamlFile))
+		if metaErr == nil {
+			metaErr = serialization.ParseYaml(metaData, &storage)
+		}
+		_, contentErr := os.Stat(filepath.Join(taskDir, taskMetadataFile))
+		if metaErr != nil || contentErr != nil {
+			scan.unreadableTasks[boardID] = true
+			c.addIssue(scan, IssueSeverityError, IssueIncompleteTask, taskDir,
+				"task folder is missing a readable metadata.yml or task.md", false, nil)
+			continue
+		}
+
+		tasks = append(tasks, boardTask{path: taskDir, id: taskID, storage: storage})
+		scan.tasks[taskID.String()] = true
+		scan.tasks[taskID.ShortID()] = true
+		scan.taskBoards[taskID.ShortID()] = append(scan.taskBoards[taskID.ShortID()], boardID)
+	}
+
+	return tasks
+}
+
+func (c *IntegrityChecker) checkBoardTasks(
+	scan *integrityScan,
+	boardID string,
+	metaDoc *serialization.FrontmatterDocument,
+	metadataPath string,
+	metadata map[string]interface{},
+	tasks []boardTask,
+) {
+	prefix := metaDoc.GetString("prefix")
+	shortIDs := make(map[string]bool, len(tasks))
+	byNumber := make(map[string][]string)
+	maxNumber := 0
+
+	for _, task := range tasks {
+		shortIDs[task.id.ShortID()] = true
+		byNumber[task.id.ShortID()] = append(byNumber[task.id.ShortID()], task.path)
+		if task.id.Number() > maxNumber {
+			maxNumber = task.id.Number()
+		}
+
+		if prefix != "" && task.id.Prefix() != prefix {
+			c.addIssue(scan, IssueSeverityWarning, IssueTaskPrefixMismatch, task.path,
+				fmt.Sprintf("task prefix %s does not match board prefix %s", task.id.Prefix(), prefix), false, nil)
+		}
+	}
+
+	duplicates := make([]string, 0)
+	for shortID, paths := range byNumber {
+		if len(paths) > 1 {
+			duplicates = append(duplicates, shortID)
+		}
+	}
+	sort.Strings(duplicates)
+	for _, shortID := range duplicates {
+		for _, path := range byNumber[shortID] {
+			c.addIssue(scan, IssueSeverityError, IssueDuplicateTaskNumber, path,
+				fmt.Sprintf("task number %s is used by %d tasks", shortID, len(byNumber[shortID])), false, nil)
+		}
+	}
+
+	if next := metaDoc.GetInt("next_task_num"); next <= maxNumber {
+		c.addIssue(scan, IssueSeverityError, IssueStaleTaskCounter, metadataPath,
+			fmt.Sprintf("next_task_num is %d but task %d already exists", next, maxNumber), true, func() error {
+				metadata["next_task_num"] = maxNumber + 1
+				return writeYamlFile(metadataPath, metadata)
+			})
+	}
+
+	for _, task := range tasks {
+		parentID := task.storage.ParentID
+		if parentID == "" || shortIDs[parentID] {
+			continue
+		}
+		if parsed, err := valueobject.ParseTaskID(parentID); err == nil && shortIDs[parsed.ShortID()] {
+			continue
+		}
+		scan.missingParents = append(scan.missingParents, missingParent{
+			boardID:  boardID,
+			taskPath: task.path,
+			parentID: parentID,
+		})
+	}
+}
+
+// checkParents reports tasks whose parent is not on their board. Only parents
+// that exist nowhere are dropped by a fix: a parent on another board is the
+// user's to move, and one on a board with unreadable task folders may be
+// among them.
+func (c *IntegrityChecker) checkParents(scan *integrityScan) {
+	for _, missing := range scan.missingParents {
+		shortID := missing.parentID
+		if parsed, err := valueobject.ParseTaskID(shortID); err == nil {
+			shortID = parsed.ShortID()
+		}
+
+		if boards := scan.taskBoards[shortID]; len(boards) > 0 {
+			c.addIssue(scan, IssueSeverityWarning, IssueCrossBoardParent, missing.taskPath,
+				fmt.Sprintf("parent task %s is on board %s, not %s; subtasks must be on their parent's board",
+					missing.parentID, strings.Join(boards, ", "), missing.boardID), false, nil)
+			continue
+		}
+
+		if scan.unreadableTasks[missing.boardID] {
+			c.addIssue(scan, IssueSeverityWarning, IssueDanglingParent, missing.taskPath,
+				fmt.Sprintf("parent task %s was not found on board %s, which has unreadable task folders", missing.parentID, missing.boardID), false, nil)
+			continue
+		}
+
+		taskPath := missing.taskPath
+		c.addIssue(scan, IssueSeverityWarning, IssueDanglingParent, taskPath,
+			fmt.Sprintf("parent task %s does not exist on any board", missing.parentID), true, func() error {
+				return updateYamlFile(filepath.Join(taskPath, taskMetadataYamlFile), func(doc map[string]interface{}) {
+					delete(doc, "parent_id")
+				})
+			})
+	}
+}
+
+func (c *IntegrityChecker) checkNotes(scan *integrityScan, notesDir string) {
+	_ = filepath.WalkDir(notesDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() || d.Name() != noteMetadataFile {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		var storage mapper.NoteStorage
+		if err == nil {
+			err = serialization.ParseYaml(data, &storage)
+		}
+		if err != nil || storage.ID == "" {
+			c.addIssue(scan, IssueSeverityError, IssueUnreadableNote, filepath.Dir(path),
+				"note metadata is unreadable", false, nil)
+			return nil
+		}
+
+		if storage.ProjectID != "" && !scan.projectIDs[storage.ProjectID] {
+			c.addIssue(scan, IssueSeverityWarning, IssueUnknownNoteProject, filepath.Dir(path),
+				fmt.Sprintf("note belongs to unknown project %s", storage.ProjectID), false, nil)
+		}
+
+		kept := make([]string, 0, len(storage.LinkedTasks))
+		for _, taskID := range storage.LinkedTasks {
+			if c.taskExists(scan, taskID) {
+				kept = append(kept, taskID)
+			}
+		}
+		if len(kept) == len(storage.LinkedTasks) {
+			return nil
+		}
+
+		// A linked task may be one of the task folders that could not be read
+		if len(scan.unreadableTasks) > 0 {
+			c.addIssue(scan, IssueSeverityWarning, IssueDanglingNoteLink, filepath.Dir(path),
+				fmt.Sprintf("note links %d task(s) that were not found while some task folders are unreadable", len(storage.LinkedTasks)-len(kept)), false, nil)
+			return nil
+		}
+
+		metadataPath := path
+		c.addIssue(scan, IssueSeverityWarning, IssueDanglingNoteLink, filepath.Dir(path),
+			fmt.Sprintf("note links %d task(s) that no longer exist", len(storage.LinkedTasks)-len(kept)), true, func() error {
+				return updateYamlFile(metadataPath, func(doc map[string]interface{}) {
+					if len(kept) == 0 {
+						delete(doc, "linked_tasks")
+					} else {
+						doc["linked_tasks"] = kept
+					}
+				})
+			})
+		return nil
+	})
+}
+
+func (c *IntegrityChecker) checkTimeLogs(scan *integrityScan, logsDir string) {
+	files, err := os.ReadDir(logsDir)
+	if err != nil {
+		return
+	}
+
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".yml") {
+			continue
+		}
+		logPath := filepath.Join(logsDir, file.Name())
+
+		data, err := os.ReadFile(logPath)
+		var storage mapper.MonthlyTimeLogStorage
+		if err == nil {
+			err = serialization.ParseYaml(data, &storage)
+		}
+		if err != nil {
+			c.addIssue(scan, IssueSeverityError, IssueUnreadableTimeLog, logPath,
+				fmt.Sprintf("time log file is unreadable: %v", err), false, nil)
+			continue
+		}
+
+		for _, log := range storage.Logs {
+			if log.TaskID != "" && !c.taskExists(scan, log.TaskID) {
+				c.addIssue(scan, IssueSeverityWarning, IssueUnknownTimeLogTask, logPath,
+					fmt.Sprintf("time log %s references unknown task %s", log.ID, log.TaskID), false, nil)
+			}
+			if log.EndTime == nil && time.Since(log.StartTime) > staleTimerThreshold {
+				c.addIssue(scan, IssueSeverityInfo, IssueStaleRunningTimer, logPath,
+					fmt.Sprintf("time log %s has been running since %s", log.ID, log.StartTime.Format(time.RFC3339)), false, nil)
+			}
+		}
+	}
+}
+
+func (c *IntegrityChecker) checkActions(scan *integrityScan) {
+	actionsDir := filepath.Join(c.rootPath(), "actions")
+	files, err := os.ReadDir(actionsDir)
+	if err != nil {
+		return
+	}
+
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".yml") {
+			continue
+		}
+		actionPath := filepath.Join(actionsDir, file.Name())
+
+		data, err := os.ReadFile(actionPath)
+		var doc map[string]interface{}
+		if err == nil {
+			err = serialization.ParseYaml(data, &doc)
+		}
+		if err != nil {
+			c.addIssue(scan, IssueSeverityError, IssueUnreadableAction, actionPath,
+				fmt.Sprintf("action file is unreadable: %v", err), false, nil)
+			continue
+		}
+
+		actionDoc := &serialization.FrontmatterDocument{Frontmatter: doc}
+		scope := valueobject.ActionScope(actionDoc.GetString("scope"))
+		scopeID := actionDoc.GetString("scope_id")
+
+		if !scope.IsValid() {
+			c.addIssue(scan, IssueSeverityError, IssueUnknownActionScope, actionPath,
+				fmt.Sprintf("action has unknown scope %q", scope), false, nil)
+			continue
+		}
+
+		switch scope {
+		case valueobject.ActionScopeBoard:
+			if !scan.boards[scopeID] {
+				c.addIssue(scan, IssueSeverityWarning, IssueUnknownActionScopeRef, actionPath,
+					fmt.Sprintf("action targets unknown board %s", scopeID), false, nil)
+			}
+		case valueobject.ActionScopeTask:
+			if !c.taskExists(scan, scopeID) {
+				c.addIssue(scan, IssueSeverityWarning, IssueUnknownActionScopeRef, actionPath,
+					fmt.Sprintf("action targets unknown task %s", scopeID), false, nil)
+			}
+		}
+	}
+}
+
+// taskExists reports whether a full or short task ID was found on any board
+func (c *IntegrityChecker) taskExists(scan *integrityScan, taskID string) bool {
+	if scan.tasks[taskID] {
+		return true
+	}
+	if parsed, err := valueobject.ParseTaskID(taskID); err == nil {
+		return scan.tasks[parsed.ShortID()]
+	}
+	return false
+}
+
+// addIssue records an issue and, when fixing is enabled, applies its fix
+func (c *IntegrityChecker) addIssue(scan *integrityScan, severity IssueSeverity, code, path, message string, fixable bool, fix func() error) {
+	issue := IntegrityIssue{
+		Severity: severity,
+		Code:     code,
+		Path:     c.relPath(path),
+		Message:  message,
+		Fixable:  fixable && fix != nil,
+	}
+
+	if scan.fix && issue.Fixable {
+		if err := fix(); err != nil {
+			issue.Message = fmt.Sprintf("%s (fix failed: %v)", issue.Message, err)
+		} else {
+			issue.Fixed = true
+		}
+	}
+
+	scan.report.Issues = append(scan.report.Issues, issue)
+}
+
+// restoreColumnMetadata writes a default metadata.yml (and column.md if missing)
+// so an orphaned column folder loads again
+func (c *IntegrityChecker) restoreColumnMetadata(columnDir string, order int) error {
+	storage := mapper.ColumnStorage{Order: order}
+	data, err := serialization.SerializeYaml(storage)
+	if err != nil {
+		return err
+	}
+	if err := filesystem.SafeWrite(filepath.Join(columnDir, columnMetadataYamlFile), data, 0644); err != nil {
+		return err
+	}
+
+	contentPath := filepath.Join(columnDir, columnContentFile)
+	if exists, _ := filesystem.Exists(contentPath); !exists {
+		return filesystem.SafeWrite(contentPath, serialization.SerializeMarkdownWithTitle(filepath.Base(columnDir), ""), 0644)
+	}
+	return nil
+}
+
+// updateYamlFile rewrites a YAML file through a generic map so unknown fields survive
+func updateYamlFile(path string, update func(doc map[string]interface{})) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var doc map[string]interface{}
+	if err := serialization.ParseYaml(data, &doc); err != nil {
+		return err
+	}
+
+	update(doc)
+	return writeYamlFile(path, doc)
+}
+
+func writeYamlFile(path string, doc map[string]interface{}) error {
+	data, err := serialization.SerializeYaml(doc)
+	if err != nil {
+		return err
+	}
+	return filesystem.SafeWrite(path, data, 0644)
+}
+
+func (c *IntegrityChecker) relPath(path string) string {
+	rel, err := filepath.Rel(c.rootPath(), path)
+	if err != nil {
+		return path
+	}
+	return rel
+}
+
+func (c *IntegrityChecker) rootPath() string {
+	return c.pathBuilder.projectPathBuilder.RootPath()
+}
@@ -0,0 +1,189 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const (
+	integrityTrackerDir = "projects/work/boards/tracker"
+	integrityAPIDir     = "projects/work/boards/api"
+	integrityNoteFile   = "projects/work/notes/standup/metadata.yml"
+)
+
+// newIntegrityRoot creates a data root at the latest schema version with a
+// work project
+func newIntegrityRoot(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	if err := WriteSchemaVersion(root, NewMigrator(root, nil).LatestVersion()); err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, root, "projects/work/project.md", "---\nid: work\n---\n# Work\n")
+	return root
+}
+
+// writeIntegrityBoard writes a board with a To Do column
+func writeIntegrityBoard(t *testing.T, root, boardDir, prefix string, nextTaskNum int) {
+	t.Helper()
+	writeTestFile(t, root, boardDir+"/metadata.yml", fmt.Sprintf("prefix: %s\nnext_task_num: %d\n", prefix, nextTaskNum))
+	writeTestFile(t, root, boardDir+"/board.md", "# Board\n")
+	writeTestFile(t, root, boardDir+"/columns/to-do/metadata.yml", "order: 0\n")
+	writeTestFile(t, root, boardDir+"/columns/to-do/column.md", "# To Do\n")
+}
+
+// writeIntegrityTask writes a task folder to the To Do column of a board,
+// nested under parentID if it is not empty
+func writeIntegrityTask(t *testing.T, root, boardDir, folder, parentID string) string {
+	t.Helper()
+	taskDir := boardDir + "/columns/to-do/tasks/" + folder
+	metadata := "id: " + folder + "\n"
+	if parentID != "" {
+		metadata += "parent_id: " + parentID + "\n"
+	}
+	writeTestFile(t, root, taskDir+"/metadata.yml", metadata)
+	writeTestFile(t, root, taskDir+"/task.md", "# "+folder+"\n")
+	return taskDir
+}
+
+// issuesWithCode returns the issues of a report with the given code
+func issuesWithCode(report *IntegrityReport, code string) []IntegrityIssue {
+	issues := make([]IntegrityIssue, 0)
+	for _, issue := range report.Issues {
+		if issue.Code == code {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+func TestIntegrityCheckWithoutFixChangesNothing(t *testing.T) {
+	root := newIntegrityRoot(t)
+	writeIntegrityBoard(t, root, integrityTrackerDir, "TRK", 1)
+	writeIntegrityTask(t, root, integrityTrackerDir, "TRK-003-fix-bug", "TRK-009")
+
+	report, err := NewIntegrityChecker(root).Check(context.Background(), false)
+	if err != nil {
+		t.Fatalf("check failed: %v", err)
+	}
+
+	for _, code := range []string{IssueStaleTaskCounter, IssueDanglingParent} {
+		issues := issuesWithCode(report, code)
+		if len(issues) != 1 || !issues[0].Fixable || issues[0].Fixed {
+			t.Errorf("expected one fixable, unfixed %s issue, got %+v", code, issues)
+		}
+	}
+	if got := readTestFile(t, root, integrityTrackerDir+"/metadata.yml"); !strings.Contains(got, "next_task_num: 1") {
+		t.Errorf("expected the board metadata to be left alone, got %q", got)
+	}
+	if got := readTestFile(t, root, integrityTrackerDir+"/columns/to-do/tasks/TRK-003-fix-bug/metadata.yml"); !strings.Contains(got, "parent_id: TRK-009") {
+		t.Errorf("expected the task metadata to be left alone, got %q", got)
+	}
+}
+
+func TestIntegrityFix(t *testing.T) {
+	root := newIntegrityRoot(t)
+
+	writeIntegrityBoard(t, root, integrityTrackerDir, "TRK", 2)
+	writeIntegrityTask(t, root, integrityTrackerDir, "TRK-001-parent", "")
+	writeIntegrityTask(t, root, integrityTrackerDir, "TRK-002-child", "TRK-001")
+	orphan := writeIntegrityTask(t, root, integrityTrackerDir, "TRK-003-orphan", "TRK-009")
+	crossBoard := writeIntegrityTask(t, root, integrityTrackerDir, "TRK-004-cross-board", "API-001")
+	// A column folder without metadata, next to the To Do column
+	writeTestFile(t, root, integrityTrackerDir+"/columns/review/column.md", "# Review\n")
+
+	writeIntegrityBoard(t, root, integrityAPIDir, "API", 2)
+	writeIntegrityTask(t, root, integrityAPIDir, "API-001-endpoint", "")
+
+	// A board without a columns directory
+	writeTestFile(t, root, "projects/work/boards/empty/metadata.yml", "prefix: EMP\nnext_task_num: 1\n")
+
+	writeTestFile(t, root, integrityNoteFile, "id: standup\nproject_id: work\ntitle: Standup\nlinked_tasks:\n  - TRK-001\n  - TRK-042\n")
+
+	report, err := NewIntegrityChecker(root).Check(context.Background(), true)
+	if err != nil {
+		t.Fatalf("check failed: %v", err)
+	}
+
+	for _, code := range []string{IssueStaleTaskCounter, IssueDanglingParent, IssueOrphanedColumnFolder, IssueMissingColumnsDir, IssueDanglingNoteLink} {
+		issues := issuesWithCode(report, code)
+		if len(issues) != 1 || !issues[0].Fixed {
+			t.Errorf("expected one fixed %s issue, got %+v", code, issues)
+		}
+	}
+
+	// The counter moves past the highest task number
+	if got := readTestFile(t, root, integrityTrackerDir+"/metadata.yml"); !strings.Contains(got, "next_task_num: 5") || !strings.Contains(got, "prefix: TRK") {
+		t.Errorf("expected next_task_num 5 with the other fields kept, got %q", got)
+	}
+
+	// Only the parent that exists nowhere is dropped
+	if got := readTestFile(t, root, orphan+"/metadata.yml"); strings.Contains(got, "parent_id") || !strings.Contains(got, "id: TRK-003-orphan") {
+		t.Errorf("expected the missing parent to be dropped and the rest kept, got %q", got)
+	}
+	if got := readTestFile(t, root, integrityTrackerDir+"/columns/to-do/tasks/TRK-002-child/metadata.yml"); !strings.Contains(got, "parent_id: TRK-001") {
+		t.Errorf("expected a parent on the same board to be kept, got %q", got)
+	}
+	crossBoardIssues := issuesWithCode(report, IssueCrossBoardParent)
+	if len(crossBoardIssues) != 1 || crossBoardIssues[0].Fixable {
+		t.Errorf("expected the parent on another board to be reported without a fix, got %+v", crossBoardIssues)
+	}
+	if got := readTestFile(t, root, crossBoard+"/metadata.yml"); !strings.Contains(got, "parent_id: API-001") {
+		t.Errorf("expected a parent on another board to be kept, got %q", got)
+	}
+
+	// The orphaned column is re-attached after To Do
+	if got := readTestFile(t, root, integrityTrackerDir+"/columns/review/metadata.yml"); !strings.Contains(got, "order: 1") {
+		t.Errorf("expected the orphaned column to get order 1, got %q", got)
+	}
+	assertExists(t, root, "projects/work/boards/empty/columns")
+
+	// Links to tasks that still exist survive
+	if got := readTestFile(t, root, integrityNoteFile); !strings.Contains(got, "TRK-001") || strings.Contains(got, "TRK-042") {
+		t.Errorf("expected only the link to the missing task to be dropped, got %q", got)
+	}
+
+	// Everything fixable was fixed
+	report, err = NewIntegrityChecker(root).Check(context.Background(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, issue := range report.Issues {
+		if issue.Fixable {
+			t.Errorf("expected no fixable issues left, got %+v", issue)
+		}
+	}
+}
+
+func TestIntegrityFixKeepsReferencesToUnreadableTasks(t *testing.T) {
+	root := newIntegrityRoot(t)
+	writeIntegrityBoard(t, root, integrityTrackerDir, "TRK", 3)
+
+	// The parent has lost its task.md, so it cannot be read
+	writeTestFile(t, root, integrityTrackerDir+"/columns/to-do/tasks/TRK-001-parent/metadata.yml", "id: TRK-001-parent\n")
+	child := writeIntegrityTask(t, root, integrityTrackerDir, "TRK-002-child", "TRK-001")
+	writeTestFile(t, root, integrityNoteFile, "id: standup\nproject_id: work\ntitle: Standup\nlinked_tasks:\n  - TRK-001\n")
+
+	report, err := NewIntegrityChecker(root).Check(context.Background(), true)
+	if err != nil {
+		t.Fatalf("check failed: %v", err)
+	}
+
+	if issues := issuesWithCode(report, IssueIncompleteTask); len(issues) != 1 {
+		t.Errorf("expected the unreadable task to be reported, got %+v", issues)
+	}
+	for _, code := range []string{IssueDanglingParent, IssueDanglingNoteLink} {
+		issues := issuesWithCode(report, code)
+		if len(issues) != 1 || issues[0].Fixable || issues[0].Fixed {
+			t.Errorf("expected one %s issue without a fix, got %+v", code, issues)
+		}
+	}
+	if got := readTestFile(t, root, child+"/metadata.yml"); !strings.Contains(got, "parent_id: TRK-001") {
+		t.Errorf("expected the parent to be kept, got %q", got)
+	}
+	if got := readTestFile(t, root, integrityNoteFile); !strings.Contains(got, "TRK-001") {
+		t.Errorf("expected the note link to be kept, got %q", got)
+	}
+}
@@ -0,0 +1,248 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"mkanban/internal/domain/valueobject"
+)
+
+// MigrationStep is a single ordered change to the on-disk storage layout.
+// Apply must be idempotent; with dryRun set it only reports what it would change.
+type MigrationStep struct {
+	Version     int
+	Name        string
+	Description string
+	Apply       func(ctx context.Context, dryRun bool) ([]string, error)
+}
+
+// MigrationStepReport describes the outcome of a single migration step
+type MigrationStepReport struct {
+	Version     int
+	Name        string
+	Description string
+	Changes     []string
+}
+
+// MigrationReport describes a migration run (or a dry run of one)
+type MigrationReport struct {
+	DryRun      bool
+	FromVersion int
+	ToVersion   int
+	BackupPath  string
+	Steps       []MigrationStepReport
+}
+
+// Migrator runs registered storage migrations against a data root
+type Migrator struct {
+	pathBuilder *PathBuilder
+	backups     *BackupStore
+	steps       []MigrationStep
+}
+
+// NewMigrator creates a migrator with all known storage migrations registered.
+// The data root is archived to backups before any migration changes it.
+func NewMigrator(rootPath string, backups *BackupStore) *Migrator {
+	m := &Migrator{
+		pathBuilder: NewPathBuilder(rootPath),
+		backups:     backups,
+	}
+	m.registerBoardMigrations()
+	return m
+}
+
+// Register adds a migration step to the registry, keeping steps ordered by version
+func (m *Migrator) Register(step MigrationStep) {
+	m.steps = append(m.steps, step)
+	sort.SliceStable(m.steps, func(i, j int) bool {
+		return m.steps[i].Version < m.steps[j].Version
+	})
+}
+
+// LatestVersion returns the schema version this build writes
+func (m *Migrator) LatestVersion() int {
+	if len(m.steps) == 0 {
+		return 0
+	}
+	return m.steps[len(m.steps)-1].Version
+}
+
+// CurrentVersion returns the schema version recorded in the data root
+func (m *Migrator) CurrentVersion() (int, error) {
+	return ReadSchemaVersion(m.rootPath())
+}
+
+// Pending returns the steps that have not yet been applied to the data root
+func (m *Migrator) Pending() ([]MigrationStep, error) {
+	current, err := m.CurrentVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	if current > m.LatestVersion() {
+		return nil, fmt.Errorf("data root schema version %d is newer than supported version %d", current, m.LatestVersion())
+	}
+
+	pending := make([]MigrationStep, 0)
+	for _, step := range m.steps {
+		if step.Version > current {
+			pending = append(pending, step)
+		}
+	}
+
+	return pending, nil
+}
+
+// Run applies all pending migrations in order. The data root is backed up before
+// the first change is made, and the schema version is recorded after each step so
+// an interrupted run resumes where it stopped. With dryRun set nothing is written.
+func (m *Migrator) Run(ctx context.Context, dryRun bool) (*MigrationReport, error) {
+	current, err := m.CurrentVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := m.Pending()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &MigrationReport{
+		DryRun:      dryRun,
+		FromVersion: current,
+		ToVersion:   m.LatestVersion(),
+		Steps:       make([]MigrationStepReport, 0, len(pending)),
+	}
+
+	// Always plan first so we know whether a backup is needed
+	plan := make([]MigrationStepReport, 0, len(pending))
+	hasChanges := false
+	for _, step := range pending {
+		changes, err := step.Apply(ctx, true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to plan migration %d (%s): %w", step.Version, step.Name, err)
+		}
+		if len(changes) > 0 {
+			hasChanges = true
+		}
+		plan = append(plan, MigrationStepReport{
+			Version:     step.Version,
+			Name:        step.Name,
+			Description: step.Description,
+			Changes:     changes,
+		})
+	}
+
+	if dryRun {
+		report.Steps = plan
+		return report, nil
+	}
+
+	if len(pending) == 0 {
+		return report, nil
+	}
+
+	if hasChanges {
+		backup, err := m.backups.Create(fmt.Sprintf("pre-migration-v%d", current))
+		if err != nil {
+			return nil, fmt.Errorf("failed to back up data root before migration: %w", err)
+		}
+		report.BackupPath = backup.Path
+	}
+
+	for _, step := range pending {
+		changes, err := step.Apply(ctx, false)
+		if err != nil {
+			return report, fmt.Errorf("migration %d (%s) failed: %w", step.Version, step.Name, err)
+		}
+
+		report.Steps = append(report.Steps, MigrationStepReport{
+			Version:     step.Version,
+			Name:        step.Name,
+			Description: step.Description,
+			Changes:     changes,
+		})
+
+		if err := WriteSchemaVersion(m.rootPath(), step.Version); err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// boardIDs lists the IDs of every board directory in the data root
+func (m *Migrator) boardIDs() ([]string, error) {
+	projectsRoot := m.pathBuilder.projectPathBuilder.ProjectsRoot()
+
+	projectEntries, err := os.ReadDir(projectsRoot)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read projects directory: %w", err)
+	}
+
+	ids := make([]string, 0)
+	for _, projectEntry := range projectEntries {
+		if !projectEntry.IsDir() {
+			continue
+		}
+
+		boardEntries, err := os.ReadDir(m.pathBuilder.projectPathBuilder.ProjectBoardsDir(projectEntry.Name()))
+		if err != nil {
+			continue
+		}
+
+		for _, boardEntry := range boardEntries {
+			if !boardEntry.IsDir() {
+				continue
+			}
+
+			boardID, err := valueobject.BuildBoardID(projectEntry.Name(), boardEntry.Name())
+			if err != nil {
+				continue
+			}
+			ids = append(ids, boardID)
+		}
+	}
+
+	return ids, nil
+}
+
+// forEachBoard adapts a per-board migration into a root-wide migration step
+func (m *Migrator) forEachBoard(fn func(ctx context.Context, boardID string, dryRun bool) ([]string, error)) func(ctx context.Context, dryRun bool) ([]string, error) {
+	return func(ctx context.Context, dryRun bool) ([]string, error) {
+		boardIDs, err := m.boardIDs()
+		if err != nil {
+			return nil, err
+		}
+
+		changes := make([]string, 0)
+		for _, boardID := range boardIDs {
+			boardChanges, err := fn(ctx, boardID, dryRun)
+			if err != nil {
+				return changes, fmt.Errorf("board %s: %w", boardID, err)
+			}
+			changes = append(changes, boardChanges...)
+		}
+
+		return changes, nil
+	}
+}
+
+// relPath renders a path relative to the data root for reports
+func (m *Migrator) relPath(path string) string {
+	rel, err := filepath.Rel(m.rootPath(), path)
+	if err != nil {
+		return path
+	}
+	return rel
+}
+
+func (m *Migrator) rootPath() string {
+	return m.pathBuilder.projectPathBuilder.RootPath()
+}
@@ -0,0 +1,234 @@
+package filesystem
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"mkanban/internal/domain/entity"
+)
+
+// writeTestFile writes a file below root, creating its directories
+func writeTestFile(t *testing.T, root, rel, content string) {
+	t.Helper()
+	path := filepath.Join(root, filepath.FromSlash(rel))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// assertExists fails the test unless rel exists below root
+func assertExists(t *testing.T, root, rel string) {
+	t.Helper()
+	if _, err := os.Stat(filepath.Join(root, filepath.FromSlash(rel))); err != nil {
+		t.Errorf("expected %s to exist: %v", rel, err)
+	}
+}
+
+// assertNotExists fails the test if rel exists below root
+func assertNotExists(t *testing.T, root, rel string) {
+	t.Helper()
+	if _, err := os.Stat(filepath.Join(root, filepath.FromSlash(rel))); !os.IsNotExist(err) {
+		t.Errorf("expected %s not to exist, got %v", rel, err)
+	}
+}
+
+// writeLegacyBoard lays out a board the way it was stored before schema v1:
+// frontmatter in board.md and column.md, columns at the board root and tasks
+// at the column root
+func writeLegacyBoard(t *testing.T, root string) string {
+	t.Helper()
+	boardDir := "projects/work/boards/tracker"
+	writeTestFile(t, root, boardDir+"/board.md", "---\ndescription: Legacy board\nnext_task_num: 2\n---\n")
+	writeTestFile(t, root, boardDir+"/To Do/column.md", "---\norder: 1\nwip_limit: 4\ndisplay_name: To Do\n---\n")
+	writeTestFile(t, root, boardDir+"/To Do/TRK-001-fix-bug/task.md", "# Fix bug\n")
+	return boardDir
+}
+
+func TestMigratorDryRunChangesNothing(t *testing.T) {
+	root := t.TempDir()
+	boardDir := writeLegacyBoard(t, root)
+	migrator := NewMigrator(root, NewBackupStore(root, ""))
+
+	report, err := migrator.Run(context.Background(), true)
+	if err != nil {
+		t.Fatalf("dry run failed: %v", err)
+	}
+
+	if report.FromVersion != 0 || report.ToVersion != SchemaVersionBoardMetadataSplit {
+		t.Errorf("expected a plan from 0 to %d, got %d to %d", SchemaVersionBoardMetadataSplit, report.FromVersion, report.ToVersion)
+	}
+	if len(report.Steps) != 4 {
+		t.Fatalf("expected 4 planned steps, got %d", len(report.Steps))
+	}
+	if len(report.Steps[0].Changes) == 0 {
+		t.Error("expected the columns step to plan a move")
+	}
+	if report.BackupPath != "" {
+		t.Errorf("expected no backup on a dry run, got %s", report.BackupPath)
+	}
+
+	version, err := ReadSchemaVersion(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != 0 {
+		t.Errorf("expected the schema version to stay 0, got %d", version)
+	}
+	assertExists(t, root, boardDir+"/To Do/TRK-001-fix-bug/task.md")
+	assertNotExists(t, root, boardDir+"/columns")
+	assertNotExists(t, root, backupsDir)
+}
+
+func TestMigratorRunMigratesLegacyBoard(t *testing.T) {
+	root := t.TempDir()
+	boardDir := writeLegacyBoard(t, root)
+	migrator := NewMigrator(root, NewBackupStore(root, ""))
+
+	report, err := migrator.Run(context.Background(), false)
+	if err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	if report.BackupPath == "" {
+		t.Error("expected the data root to be backed up before migrating")
+	}
+	if len(report.Steps) != 4 {
+		t.Errorf("expected 4 applied steps, got %d", len(report.Steps))
+	}
+
+	version, err := ReadSchemaVersion(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != migrator.LatestVersion() {
+		t.Errorf("expected schema version %d, got %d", migrator.LatestVersion(), version)
+	}
+
+	// v1 moves the column, v2 normalizes its folder and splits its metadata,
+	// v3 moves the task, v4 splits the board metadata
+	columnDir := boardDir + "/columns/to-do"
+	assertNotExists(t, root, boardDir+"/To Do")
+	assertExists(t, root, columnDir+"/"+columnMetadataYamlFile)
+	assertExists(t, root, columnDir+"/tasks/TRK-001-fix-bug/task.md")
+	assertNotExists(t, root, columnDir+"/TRK-001-fix-bug")
+	assertExists(t, root, boardDir+"/"+boardMetadataYamlFile)
+
+	board, err := NewBoardRepository(root).FindByID(context.Background(), "work/tracker")
+	if err != nil {
+		t.Fatalf("failed to load migrated board: %v", err)
+	}
+	if board.Description() != "Legacy board" {
+		t.Errorf("expected the board description to survive, got %q", board.Description())
+	}
+	column, err := board.GetColumn("to-do")
+	if err != nil {
+		t.Fatalf("expected the migrated column: %v", err)
+	}
+	if column.WIPLimit() != 4 {
+		t.Errorf("expected WIP limit 4, got %d", column.WIPLimit())
+	}
+
+	// Everything is applied, so a second run has nothing to do
+	again, err := migrator.Run(context.Background(), false)
+	if err != nil {
+		t.Fatalf("second run failed: %v", err)
+	}
+	if len(again.Steps) != 0 || again.BackupPath != "" {
+		t.Errorf("expected a no-op second run, got %d steps and backup %q", len(again.Steps), again.BackupPath)
+	}
+}
+
+func TestMigratorResumesFromRecordedVersion(t *testing.T) {
+	root := t.TempDir()
+	boardDir := writeLegacyBoard(t, root)
+	if err := WriteSchemaVersion(root, SchemaVersionTasksSubdirectory); err != nil {
+		t.Fatal(err)
+	}
+	migrator := NewMigrator(root, NewBackupStore(root, ""))
+
+	pending, err := migrator.Pending()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 1 || pending[0].Version != SchemaVersionBoardMetadataSplit {
+		t.Fatalf("expected only the board metadata step to be pending, got %v", pending)
+	}
+
+	if _, err := migrator.Run(context.Background(), false); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	assertExists(t, root, boardDir+"/"+boardMetadataYamlFile)
+	// Steps before the recorded version are not run again
+	assertExists(t, root, boardDir+"/To Do/TRK-001-fix-bug/task.md")
+}
+
+func TestMigratorRejectsNewerSchema(t *testing.T) {
+	root := t.TempDir()
+	migrator := NewMigrator(root, NewBackupStore(root, ""))
+	if err := WriteSchemaVersion(root, migrator.LatestVersion()+1); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := migrator.Run(context.Background(), false); err == nil {
+		t.Fatal("expected a data root from a newer version to be rejected")
+	}
+}
+
+func TestMigrateColumnsToSubdirectoryCreatesColumnsForEmptyBoard(t *testing.T) {
+	root := t.TempDir()
+	boardDir := "projects/work/boards/empty"
+	writeTestFile(t, root, boardDir+"/board.md", "---\ndescription: Empty\n---\n")
+	migrator := NewMigrator(root, NewBackupStore(root, ""))
+
+	changes, err := migrator.migrateColumnsToSubdirectory(context.Background(), "work/empty", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 {
+		t.Errorf("expected the dry run to report creating columns/, got %v", changes)
+	}
+	assertNotExists(t, root, boardDir+"/columns")
+
+	if _, err := migrator.migrateColumnsToSubdirectory(context.Background(), "work/empty", false); err != nil {
+		t.Fatal(err)
+	}
+	assertExists(t, root, boardDir+"/columns")
+
+	changes, err = migrator.migrateColumnsToSubdirectory(context.Background(), "work/empty", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("expected a migrated board to be left alone, got %v", changes)
+	}
+}
+
+func TestFindByIDLoadsBoardWithoutColumnsDirectory(t *testing.T) {
+	root := t.TempDir()
+	ctx := context.Background()
+	repo := NewBoardRepository(root)
+
+	board, err := entity.NewBoard("work/empty", "Empty", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Save(ctx, board); err != nil {
+		t.Fatal(err)
+	}
+	assertExists(t, root, "projects/work/boards/empty/columns")
+
+	if err := os.Remove(filepath.Join(root, "projects/work/boards/empty/columns")); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := repo.FindByID(ctx, "work/empty")
+	if err != nil {
+		t.Fatalf("expected a board without columns/ to load: %v", err)
+	}
+	if len(loaded.Columns()) != 0 {
+		t.Errorf("expected no columns, got %d", len(loaded.Columns()))
+	}
+}
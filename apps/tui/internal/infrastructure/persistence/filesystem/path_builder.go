@@ -13,6 +13,8 @@ const (
 	columnContentFile      = "column.md"
 	taskMetadataFile       = "task.md"
 	taskMetadataYamlFile   = "metadata.yml"
+	taskAttachmentsDir     = "attachments"
+	taskActivityYamlFile   = "activity.yml"
 )
 
 // PathBuilder constructs filesystem paths for board entities
@@ -107,3 +109,21 @@ func (pb *PathBuilder) TaskMetadataYaml(boardID string, columnName string, taskF
 	}
 	return filepath.Join(taskDir, taskMetadataYamlFile), nil
 }
+
+// TaskAttachmentsDir returns the directory holding a task's attachment files
+func (pb *PathBuilder) TaskAttachmentsDir(boardID string, columnName string, taskFolderName string) (string, error) {
+	taskDir, err := pb.TaskDir(boardID, columnName, taskFolderName)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(taskDir, taskAttachmentsDir), nil
+}
+
+// TaskActivityYaml returns the path to a task's activity.yml file
+func (pb *PathBuilder) TaskActivityYaml(boardID string, columnName string, taskFolderName string) (string, error) {
+	taskDir, err := pb.TaskDir(boardID, columnName, taskFolderName)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(taskDir, taskActivityYamlFile), nil
+}
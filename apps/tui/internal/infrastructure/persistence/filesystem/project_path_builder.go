@@ -9,6 +9,14 @@ const (
 	notesDir          = "notes"
 	timeDir           = "time"
 	timeLogsDir       = "logs"
+	schemaFile        = "schema.yml"
+	backupsDir        = "backups"
+	trashDir          = ".trash"
+	templatesDir      = "templates"
+	tagsFile          = "tags.yml"
+	sprintsDir        = "sprints"
+	scheduleFile      = "schedule.yml"
+	calendarSyncFile  = "calendar_sync.yml"
 )
 
 type ProjectPathBuilder struct {
@@ -51,6 +59,30 @@ func (pb *ProjectPathBuilder) TimeLogFile(projectSlug string, yearMonth string)
 	return filepath.Join(pb.ProjectTimeLogsDir(projectSlug), yearMonth+".yml")
 }
 
+func (pb *ProjectPathBuilder) ProjectTrashDir(projectSlug string) string {
+	return filepath.Join(pb.ProjectDir(projectSlug), trashDir)
+}
+
+func (pb *ProjectPathBuilder) ProjectTemplatesDir(projectSlug string) string {
+	return filepath.Join(pb.ProjectDir(projectSlug), templatesDir)
+}
+
+func (pb *ProjectPathBuilder) ProjectTagsFile(projectSlug string) string {
+	return filepath.Join(pb.ProjectDir(projectSlug), tagsFile)
+}
+
+func (pb *ProjectPathBuilder) ProjectSprintsDir(projectSlug string) string {
+	return filepath.Join(pb.ProjectDir(projectSlug), sprintsDir)
+}
+
+func (pb *ProjectPathBuilder) SprintFile(projectSlug string, sprintID string) string {
+	return filepath.Join(pb.ProjectSprintsDir(projectSlug), sprintID+".yml")
+}
+
+func (pb *ProjectPathBuilder) ProjectScheduleFile(projectSlug string) string {
+	return filepath.Join(pb.ProjectDir(projectSlug), scheduleFile)
+}
+
 func (pb *ProjectPathBuilder) GlobalDir() string {
 	return filepath.Join(pb.rootPath, "global")
 }
@@ -59,6 +91,34 @@ func (pb *ProjectPathBuilder) GlobalNotesDir() string {
 	return filepath.Join(pb.GlobalDir(), notesDir)
 }
 
+func (pb *ProjectPathBuilder) GlobalTrashDir() string {
+	return filepath.Join(pb.GlobalDir(), trashDir)
+}
+
+func (pb *ProjectPathBuilder) GlobalTemplatesDir() string {
+	return filepath.Join(pb.GlobalDir(), templatesDir)
+}
+
 func (pb *ProjectPathBuilder) GlobalTimeDir() string {
 	return filepath.Join(pb.GlobalDir(), timeDir)
 }
+
+func (pb *ProjectPathBuilder) GlobalScheduleFile() string {
+	return filepath.Join(pb.GlobalDir(), scheduleFile)
+}
+
+func (pb *ProjectPathBuilder) GlobalCalendarSyncFile() string {
+	return filepath.Join(pb.GlobalDir(), calendarSyncFile)
+}
+
+func (pb *ProjectPathBuilder) RootPath() string {
+	return pb.rootPath
+}
+
+func (pb *ProjectPathBuilder) SchemaFile() string {
+	return filepath.Join(pb.rootPath, schemaFile)
+}
+
+func (pb *ProjectPathBuilder) BackupsDir() string {
+	return filepath.Join(pb.rootPath, backupsDir)
+}
@@ -0,0 +1,71 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/infrastructure/persistence/mapper"
+)
+
+const projectTemplatesDir = "projects"
+
+// ProjectTemplateRepositoryImpl implements ProjectTemplateRepository with one YAML
+// file per template in the global templates/projects/ folder
+type ProjectTemplateRepositoryImpl struct {
+	files templateDir
+}
+
+// NewProjectTemplateRepository creates a new filesystem-based project template repository
+func NewProjectTemplateRepository(rootPath string) repository.ProjectTemplateRepository {
+	return &ProjectTemplateRepositoryImpl{
+		files: newTemplateDir(rootPath, projectTemplatesDir),
+	}
+}
+
+// Save creates or replaces a template file
+func (r *ProjectTemplateRepositoryImpl) Save(ctx context.Context, template *entity.ProjectTemplate) error {
+	data, err := mapper.ProjectTemplateToStorage(template)
+	if err != nil {
+		return fmt.Errorf("failed to serialize template: %w", err)
+	}
+	return r.files.write(template.Name(), data)
+}
+
+// FindByName retrieves a template by name
+func (r *ProjectTemplateRepositoryImpl) FindByName(ctx context.Context, name string) (*entity.ProjectTemplate, error) {
+	data, err := r.files.read(name)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := mapper.ProjectTemplateFromStorage(name, data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template %s: %w", name, err)
+	}
+	return template, nil
+}
+
+// FindAll retrieves all templates, skipping files that are not valid templates
+func (r *ProjectTemplateRepositoryImpl) FindAll(ctx context.Context) ([]*entity.ProjectTemplate, error) {
+	names, err := r.files.names()
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]*entity.ProjectTemplate, 0, len(names))
+	for _, name := range names {
+		template, err := r.FindByName(ctx, name)
+		if err != nil {
+			continue
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+// Delete removes a template file
+func (r *ProjectTemplateRepositoryImpl) Delete(ctx context.Context, name string) error {
+	return r.files.remove(name)
+}
@@ -0,0 +1,52 @@
+package filesystem
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"mkanban/internal/infrastructure/serialization"
+	"mkanban/pkg/filesystem"
+)
+
+// SchemaStorage represents the storage schema marker (schema.yml) in the data root
+type SchemaStorage struct {
+	Version  int       `yaml:"version"`
+	Migrated time.Time `yaml:"migrated"`
+}
+
+// ReadSchemaVersion returns the schema version recorded in the data root.
+// A data root without schema.yml predates versioning and reports version 0.
+func ReadSchemaVersion(rootPath string) (int, error) {
+	data, err := os.ReadFile(NewProjectPathBuilder(rootPath).SchemaFile())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to read schema.yml: %w", err)
+	}
+
+	var storage SchemaStorage
+	if err := serialization.ParseYaml(data, &storage); err != nil {
+		return 0, fmt.Errorf("failed to parse schema.yml: %w", err)
+	}
+
+	return storage.Version, nil
+}
+
+// WriteSchemaVersion records the schema version in the data root
+func WriteSchemaVersion(rootPath string, version int) error {
+	data, err := serialization.SerializeYaml(SchemaStorage{
+		Version:  version,
+		Migrated: time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to serialize schema.yml: %w", err)
+	}
+
+	if err := filesystem.SafeWrite(NewProjectPathBuilder(rootPath).SchemaFile(), data, 0644); err != nil {
+		return fmt.Errorf("failed to write schema.yml: %w", err)
+	}
+
+	return nil
+}
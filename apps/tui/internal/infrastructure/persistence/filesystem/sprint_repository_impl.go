@@ -0,0 +1,110 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/infrastructure/persistence/mapper"
+	"mkanban/pkg/filesystem"
+)
+
+const sprintExtension = ".yml"
+
+// SprintRepositoryImpl implements SprintRepository with one YAML file per
+// sprint in sprints/ of a project
+type SprintRepositoryImpl struct {
+	pathBuilder *ProjectPathBuilder
+}
+
+// NewSprintRepository creates a new filesystem-based sprint repository
+func NewSprintRepository(rootPath string) repository.SprintRepository {
+	return &SprintRepositoryImpl{
+		pathBuilder: NewProjectPathBuilder(rootPath),
+	}
+}
+
+// Save creates or replaces a sprint file
+func (r *SprintRepositoryImpl) Save(ctx context.Context, sprint *entity.Sprint) error {
+	if _, err := os.Stat(r.pathBuilder.ProjectDir(sprint.ProjectID())); err != nil {
+		if os.IsNotExist(err) {
+			return entity.ErrProjectNotFound
+		}
+		return err
+	}
+
+	if err := filesystem.EnsureDir(r.pathBuilder.ProjectSprintsDir(sprint.ProjectID()), 0755); err != nil {
+		return fmt.Errorf("failed to create sprints directory: %w", err)
+	}
+
+	data, err := mapper.SprintToStorage(sprint)
+	if err != nil {
+		return fmt.Errorf("failed to serialize sprint: %w", err)
+	}
+
+	if err := filesystem.SafeWrite(r.pathBuilder.SprintFile(sprint.ProjectID(), sprint.ID()), data, 0644); err != nil {
+		return fmt.Errorf("failed to write sprint: %w", err)
+	}
+	return nil
+}
+
+// FindByID reads a sprint file
+func (r *SprintRepositoryImpl) FindByID(ctx context.Context, projectID string, sprintID string) (*entity.Sprint, error) {
+	if sprintID == "" || strings.ContainsAny(sprintID, `/\`) {
+		return nil, entity.ErrSprintNotFound
+	}
+
+	data, err := os.ReadFile(r.pathBuilder.SprintFile(projectID, sprintID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, entity.ErrSprintNotFound
+		}
+		return nil, fmt.Errorf("failed to read sprint: %w", err)
+	}
+
+	sprint, err := mapper.SprintFromStorage(projectID, data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sprint %s: %w", sprintID, err)
+	}
+	return sprint, nil
+}
+
+// FindByProject reads every sprint file of a project
+func (r *SprintRepositoryImpl) FindByProject(ctx context.Context, projectID string) ([]*entity.Sprint, error) {
+	if _, err := os.Stat(r.pathBuilder.ProjectDir(projectID)); err != nil {
+		if os.IsNotExist(err) {
+			return nil, entity.ErrProjectNotFound
+		}
+		return nil, err
+	}
+
+	sprints := make([]*entity.Sprint, 0)
+	entries, err := os.ReadDir(r.pathBuilder.ProjectSprintsDir(projectID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return sprints, nil
+		}
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), sprintExtension) {
+			continue
+		}
+		sprint, err := r.FindByID(ctx, projectID, strings.TrimSuffix(entry.Name(), sprintExtension))
+		if err != nil {
+			// Skip files that are not valid sprints
+			continue
+		}
+		sprints = append(sprints, sprint)
+	}
+
+	sort.Slice(sprints, func(i, j int) bool {
+		return sprints[i].StartDate().Before(sprints[j].StartDate())
+	})
+	return sprints, nil
+}
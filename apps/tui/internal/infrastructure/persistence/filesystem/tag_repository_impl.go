@@ -0,0 +1,68 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/infrastructure/persistence/mapper"
+	"mkanban/pkg/filesystem"
+)
+
+// TagRepositoryImpl implements TagRepository with one tags.yml file per project
+type TagRepositoryImpl struct {
+	pathBuilder *ProjectPathBuilder
+}
+
+// NewTagRepository creates a new filesystem-based tag repository
+func NewTagRepository(rootPath string) repository.TagRepository {
+	return &TagRepositoryImpl{
+		pathBuilder: NewProjectPathBuilder(rootPath),
+	}
+}
+
+// FindByProject reads the tag registry of a project; a missing file is an empty registry
+func (r *TagRepositoryImpl) FindByProject(ctx context.Context, projectID string) (*entity.TagRegistry, error) {
+	if _, err := os.Stat(r.pathBuilder.ProjectDir(projectID)); err != nil {
+		if os.IsNotExist(err) {
+			return nil, entity.ErrProjectNotFound
+		}
+		return nil, err
+	}
+
+	data, err := os.ReadFile(r.pathBuilder.ProjectTagsFile(projectID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return entity.NewTagRegistry(projectID), nil
+		}
+		return nil, fmt.Errorf("failed to read tag registry: %w", err)
+	}
+
+	registry, err := mapper.TagRegistryFromStorage(projectID, data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tag registry: %w", err)
+	}
+	return registry, nil
+}
+
+// Save writes the tag registry file of a project
+func (r *TagRepositoryImpl) Save(ctx context.Context, registry *entity.TagRegistry) error {
+	if _, err := os.Stat(r.pathBuilder.ProjectDir(registry.ProjectID())); err != nil {
+		if os.IsNotExist(err) {
+			return entity.ErrProjectNotFound
+		}
+		return err
+	}
+
+	data, err := mapper.TagRegistryToStorage(registry)
+	if err != nil {
+		return fmt.Errorf("failed to serialize tag registry: %w", err)
+	}
+
+	if err := filesystem.SafeWrite(r.pathBuilder.ProjectTagsFile(registry.ProjectID()), data, 0644); err != nil {
+		return fmt.Errorf("failed to write tag registry: %w", err)
+	}
+	return nil
+}
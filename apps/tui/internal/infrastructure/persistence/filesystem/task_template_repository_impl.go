@@ -0,0 +1,166 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/infrastructure/persistence/mapper"
+	"mkanban/pkg/filesystem"
+)
+
+const (
+	taskTemplatesDir      = "tasks"
+	taskTemplateExtension = ".md"
+)
+
+// TaskTemplateRepositoryImpl implements TaskTemplateRepository with one markdown
+// file per template in templates/tasks/ of a project, or of the global folder
+type TaskTemplateRepositoryImpl struct {
+	pathBuilder *ProjectPathBuilder
+}
+
+// NewTaskTemplateRepository creates a new filesystem-based task template repository
+func NewTaskTemplateRepository(rootPath string) repository.TaskTemplateRepository {
+	return &TaskTemplateRepositoryImpl{
+		pathBuilder: NewProjectPathBuilder(rootPath),
+	}
+}
+
+// Save creates or replaces a template file
+func (r *TaskTemplateRepositoryImpl) Save(ctx context.Context, template *entity.TaskTemplate) error {
+	dir := r.templatesDir(template.ProjectID())
+	if err := filesystem.EnsureDir(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create templates directory: %w", err)
+	}
+
+	data, err := mapper.TaskTemplateToStorage(template)
+	if err != nil {
+		return fmt.Errorf("failed to serialize template: %w", err)
+	}
+
+	path := filepath.Join(dir, template.Name()+taskTemplateExtension)
+	if err := filesystem.SafeWrite(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write template: %w", err)
+	}
+
+	return nil
+}
+
+// FindByName retrieves a template, looking in the project before the global folder
+func (r *TaskTemplateRepositoryImpl) FindByName(ctx context.Context, projectID string, name string) (*entity.TaskTemplate, error) {
+	if !entity.IsValidTemplateName(name) {
+		return nil, entity.ErrInvalidTemplateName
+	}
+
+	scopes := []string{""}
+	if projectID != "" {
+		scopes = []string{projectID, ""}
+	}
+
+	for _, scope := range scopes {
+		template, err := r.load(scope, name)
+		if err == nil {
+			return template, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+
+	return nil, entity.ErrTemplateNotFound
+}
+
+// FindAll retrieves the project templates plus the global templates they do not shadow
+func (r *TaskTemplateRepositoryImpl) FindAll(ctx context.Context, projectID string) ([]*entity.TaskTemplate, error) {
+	byName := make(map[string]*entity.TaskTemplate)
+
+	if err := r.loadAll("", byName); err != nil {
+		return nil, err
+	}
+	if projectID != "" {
+		if err := r.loadAll(projectID, byName); err != nil {
+			return nil, err
+		}
+	}
+
+	templates := make([]*entity.TaskTemplate, 0, len(byName))
+	for _, template := range byName {
+		templates = append(templates, template)
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name() < templates[j].Name()
+	})
+
+	return templates, nil
+}
+
+// Delete removes a template file from exactly the given scope
+func (r *TaskTemplateRepositoryImpl) Delete(ctx context.Context, projectID string, name string) error {
+	if !entity.IsValidTemplateName(name) {
+		return entity.ErrInvalidTemplateName
+	}
+
+	path := filepath.Join(r.templatesDir(projectID), name+taskTemplateExtension)
+	if err := os.Remove(path); err != nil {
+		if os.IsNotExist(err) {
+			return entity.ErrTemplateNotFound
+		}
+		return fmt.Errorf("failed to delete template: %w", err)
+	}
+	return nil
+}
+
+// loadAll loads every template of a scope into byName, replacing earlier entries
+func (r *TaskTemplateRepositoryImpl) loadAll(projectID string, byName map[string]*entity.TaskTemplate) error {
+	entries, err := os.ReadDir(r.templatesDir(projectID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), taskTemplateExtension) {
+			continue
+		}
+		name := strings.TrimSuffix(entry.Name(), taskTemplateExtension)
+		template, err := r.load(projectID, name)
+		if err != nil {
+			// Skip files that are not valid templates
+			continue
+		}
+		byName[name] = template
+	}
+
+	return nil
+}
+
+// load reads one template file; a missing file is reported as os.ErrNotExist
+func (r *TaskTemplateRepositoryImpl) load(projectID string, name string) (*entity.TaskTemplate, error) {
+	path := filepath.Join(r.templatesDir(projectID), name+taskTemplateExtension)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := mapper.TaskTemplateFromStorage(name, projectID, data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template %s: %w", name, err)
+	}
+	return template, nil
+}
+
+// templatesDir returns the task templates folder of a project, or the global one
+func (r *TaskTemplateRepositoryImpl) templatesDir(projectID string) string {
+	if projectID == "" {
+		return filepath.Join(r.pathBuilder.GlobalTemplatesDir(), taskTemplatesDir)
+	}
+	return filepath.Join(r.pathBuilder.ProjectTemplatesDir(projectID), taskTemplatesDir)
+}
@@ -0,0 +1,94 @@
+package filesystem
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/pkg/filesystem"
+)
+
+const templateFileExtension = ".yml"
+
+// templateDir is a folder of global <name>.yml template files of one kind
+type templateDir struct {
+	dir string
+}
+
+// newTemplateDir creates a templateDir for a subfolder of the global templates folder
+func newTemplateDir(rootPath string, kind string) templateDir {
+	return templateDir{
+		dir: filepath.Join(NewProjectPathBuilder(rootPath).GlobalTemplatesDir(), kind),
+	}
+}
+
+// write creates or replaces a template file
+func (d templateDir) write(name string, data []byte) error {
+	if err := filesystem.EnsureDir(d.dir, 0755); err != nil {
+		return fmt.Errorf("failed to create templates directory: %w", err)
+	}
+	if err := filesystem.SafeWrite(d.path(name), data, 0644); err != nil {
+		return fmt.Errorf("failed to write template: %w", err)
+	}
+	return nil
+}
+
+// read returns the content of a template file, or ErrTemplateNotFound
+func (d templateDir) read(name string) ([]byte, error) {
+	if !entity.IsValidTemplateName(name) {
+		return nil, entity.ErrInvalidTemplateName
+	}
+
+	data, err := os.ReadFile(d.path(name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, entity.ErrTemplateNotFound
+		}
+		return nil, fmt.Errorf("failed to read template: %w", err)
+	}
+	return data, nil
+}
+
+// remove deletes a template file, or returns ErrTemplateNotFound
+func (d templateDir) remove(name string) error {
+	if !entity.IsValidTemplateName(name) {
+		return entity.ErrInvalidTemplateName
+	}
+
+	if err := os.Remove(d.path(name)); err != nil {
+		if os.IsNotExist(err) {
+			return entity.ErrTemplateNotFound
+		}
+		return fmt.Errorf("failed to delete template: %w", err)
+	}
+	return nil
+}
+
+// names returns the names of all template files, sorted
+func (d templateDir) names() ([]string, error) {
+	entries, err := os.ReadDir(d.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), templateFileExtension) {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(entry.Name(), templateFileExtension))
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// path returns the file path of a template
+func (d templateDir) path(name string) string {
+	return filepath.Join(d.dir, name+templateFileExtension)
+}
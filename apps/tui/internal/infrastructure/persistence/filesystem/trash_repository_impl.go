@@ -0,0 +1,468 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/valueobject"
+	"mkanban/internal/infrastructure/persistence/mapper"
+	"mkanban/internal/infrastructure/serialization"
+	"mkanban/pkg/filesystem"
+)
+
+const (
+	trashItemFile     = "item.yml"
+	trashContentDir   = "content"
+	trashTasksSubdir  = "tasks"
+	trashColumnSubdir = "column"
+	trashNoteSubdir   = "note"
+)
+
+// TrashRepositoryImpl implements TrashRepository by moving folders into a per-project .trash directory.
+// Each item lives in .trash/<id>/ with an item.yml describing where it came from and a content/
+// directory holding the moved folders untouched, so a restore is a plain move back.
+type TrashRepositoryImpl struct {
+	pathBuilder *PathBuilder
+	notes       *NoteRepositoryImpl
+}
+
+// NewTrashRepository creates a new filesystem-based trash repository
+func NewTrashRepository(rootPath string) repository.TrashRepository {
+	return &TrashRepositoryImpl{
+		pathBuilder: NewPathBuilder(rootPath),
+		notes:       NewNoteRepository(rootPath).(*NoteRepositoryImpl),
+	}
+}
+
+// TrashTasks moves task folders of a board into the trash as a single item
+func (r *TrashRepositoryImpl) TrashTasks(ctx context.Context, boardID string, title string, tasks []entity.TrashedTask) (*entity.TrashItem, error) {
+	if len(tasks) == 0 {
+		return nil, entity.ErrTaskNotFound
+	}
+
+	projectSlug, _, err := valueobject.ParseBoardID(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := entity.NewTrashItem(uuid.New().String(), entity.TrashItemTask, title, tasks[0].TaskID)
+	if err != nil {
+		return nil, err
+	}
+	item.SetLocation(projectSlug, boardID, tasks[0].Column)
+
+	itemDir := r.itemDir(item)
+	tasksDir := filepath.Join(itemDir, trashContentDir, trashTasksSubdir)
+	if err := filesystem.EnsureDir(tasksDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create trash directory: %w", err)
+	}
+
+	var moved []move
+	for _, task := range tasks {
+		taskDir, err := r.pathBuilder.TaskDir(boardID, task.Column, task.TaskID)
+		if err != nil {
+			r.rollback(itemDir, moved)
+			return nil, err
+		}
+
+		dst := filepath.Join(tasksDir, task.TaskID)
+		if err := os.Rename(taskDir, dst); err != nil {
+			r.rollback(itemDir, moved)
+			return nil, fmt.Errorf("failed to move task %s to trash: %w", task.TaskID, err)
+		}
+		moved = append(moved, move{from: taskDir, to: dst})
+		item.AddTask(task.TaskID, task.Column)
+	}
+
+	boardDir, _ := r.pathBuilder.BoardDir(boardID)
+	if err := r.saveItem(item, r.relPath(boardDir)); err != nil {
+		r.rollback(itemDir, moved)
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// TrashColumn moves a column folder, including its tasks, into the trash
+func (r *TrashRepositoryImpl) TrashColumn(ctx context.Context, boardID string, column *entity.Column) (*entity.TrashItem, error) {
+	projectSlug, _, err := valueobject.ParseBoardID(boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	columnDir, err := r.pathBuilder.ColumnDir(boardID, column.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := entity.NewTrashItem(uuid.New().String(), entity.TrashItemColumn, column.DisplayName(), column.Name())
+	if err != nil {
+		return nil, err
+	}
+	item.SetLocation(projectSlug, boardID, column.Name())
+
+	itemDir := r.itemDir(item)
+	contentDir := filepath.Join(itemDir, trashContentDir)
+	if err := filesystem.EnsureDir(filepath.Join(contentDir, trashTasksSubdir), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create trash directory: %w", err)
+	}
+
+	// Tasks are kept apart from the column folder so they can be restored one by one
+	// into a column of the same name that was recreated in the meantime
+	var moved []move
+	columnTasksDir := filepath.Join(columnDir, tasksSubdir)
+	entries, err := os.ReadDir(columnTasksDir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		src := filepath.Join(columnTasksDir, entry.Name())
+		dst := filepath.Join(contentDir, trashTasksSubdir, entry.Name())
+		if err := os.Rename(src, dst); err != nil {
+			r.rollback(itemDir, moved)
+			return nil, fmt.Errorf("failed to move task %s to trash: %w", entry.Name(), err)
+		}
+		moved = append(moved, move{from: src, to: dst})
+		item.AddTask(entry.Name(), column.Name())
+	}
+
+	_ = os.Remove(columnTasksDir)
+	dst := filepath.Join(contentDir, trashColumnSubdir)
+	if err := os.Rename(columnDir, dst); err != nil {
+		r.rollback(itemDir, moved)
+		return nil, fmt.Errorf("failed to move column %s to trash: %w", column.Name(), err)
+	}
+	moved = append(moved, move{from: columnDir, to: dst})
+
+	if err := r.saveItem(item, r.relPath(columnDir)); err != nil {
+		r.rollback(itemDir, moved)
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// TrashNote moves a note folder into the trash
+func (r *TrashRepositoryImpl) TrashNote(ctx context.Context, note *entity.Note) (*entity.TrashItem, error) {
+	noteDir, err := r.findNoteDir(note)
+	if err != nil {
+		return nil, err
+	}
+
+	projectSlug := ""
+	if note.ProjectID() != "" {
+		projectSlug = r.notes.getProjectSlugSync(note.ProjectID())
+		if projectSlug == "" {
+			projectSlug = note.ProjectID()
+		}
+	}
+
+	item, err := entity.NewTrashItem(uuid.New().String(), entity.TrashItemNote, note.Title(), note.ID())
+	if err != nil {
+		return nil, err
+	}
+	item.SetLocation(projectSlug, "", "")
+
+	itemDir := r.itemDir(item)
+	contentDir := filepath.Join(itemDir, trashContentDir)
+	if err := filesystem.EnsureDir(contentDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create trash directory: %w", err)
+	}
+
+	dst := filepath.Join(contentDir, trashNoteSubdir)
+	if err := os.Rename(noteDir, dst); err != nil {
+		_ = os.RemoveAll(itemDir)
+		return nil, fmt.Errorf("failed to move note to trash: %w", err)
+	}
+
+	if err := r.saveItem(item, r.relPath(noteDir)); err != nil {
+		r.rollback(itemDir, []move{{from: noteDir, to: dst}})
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// FindByID retrieves a trash item by its ID or a unique ID prefix
+func (r *TrashRepositoryImpl) FindByID(ctx context.Context, id string) (*entity.TrashItem, error) {
+	if id == "" {
+		return nil, entity.ErrInvalidTrashItemID
+	}
+
+	items, err := r.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *entity.TrashItem
+	for _, item := range items {
+		if item.ID() == id {
+			return item, nil
+		}
+		if strings.HasPrefix(item.ID(), id) {
+			if found != nil {
+				return nil, entity.ErrAmbiguousTrashItemID
+			}
+			found = item
+		}
+	}
+
+	if found == nil {
+		return nil, entity.ErrTrashItemNotFound
+	}
+	return found, nil
+}
+
+// FindAll retrieves all trash items, most recently deleted first
+func (r *TrashRepositoryImpl) FindAll(ctx context.Context) ([]*entity.TrashItem, error) {
+	trashDirs := []string{r.projectPaths().GlobalTrashDir()}
+
+	projectEntries, err := os.ReadDir(r.projectPaths().ProjectsRoot())
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	for _, entry := range projectEntries {
+		if entry.IsDir() {
+			trashDirs = append(trashDirs, r.projectPaths().ProjectTrashDir(entry.Name()))
+		}
+	}
+
+	items := make([]*entity.TrashItem, 0)
+	for _, trashDir := range trashDirs {
+		entries, err := os.ReadDir(trashDir)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+
+			storage, err := r.loadItem(filepath.Join(trashDir, entry.Name()))
+			if err != nil {
+				// Skip items that can't be loaded
+				continue
+			}
+
+			item, err := mapper.TrashItemFromStorage(storage)
+			if err != nil {
+				continue
+			}
+			items = append(items, item)
+		}
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].DeletedAt().After(items[j].DeletedAt())
+	})
+
+	return items, nil
+}
+
+// RestoreColumn moves a trashed column folder, without its tasks, back onto the board
+func (r *TrashRepositoryImpl) RestoreColumn(ctx context.Context, item *entity.TrashItem) error {
+	columnDir, err := r.pathBuilder.ColumnDir(item.BoardID(), item.ColumnName())
+	if err != nil {
+		return err
+	}
+
+	exists, err := filesystem.Exists(columnDir)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return entity.ErrColumnAlreadyExists
+	}
+
+	if err := filesystem.EnsureDir(filepath.Dir(columnDir), 0755); err != nil {
+		return err
+	}
+
+	src := filepath.Join(r.itemDir(item), trashContentDir, trashColumnSubdir)
+	if err := os.Rename(src, columnDir); err != nil {
+		return fmt.Errorf("failed to restore column %s: %w", item.ColumnName(), err)
+	}
+
+	return nil
+}
+
+// RestoreTask moves one trashed task into a column under the given ID
+func (r *TrashRepositoryImpl) RestoreTask(
+	ctx context.Context,
+	item *entity.TrashItem,
+	trashedTaskID string,
+	columnName string,
+	taskID *valueobject.TaskID,
+	renamed map[string]string,
+) error {
+	taskDir, err := r.pathBuilder.TaskDir(item.BoardID(), columnName, taskID.String())
+	if err != nil {
+		return err
+	}
+
+	exists, err := filesystem.Exists(taskDir)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return entity.ErrTaskAlreadyExists
+	}
+
+	if err := filesystem.EnsureDir(filepath.Dir(taskDir), 0755); err != nil {
+		return err
+	}
+
+	src := filepath.Join(r.itemDir(item), trashContentDir, trashTasksSubdir, trashedTaskID)
+	if err := os.Rename(src, taskDir); err != nil {
+		return fmt.Errorf("failed to restore task %s: %w", trashedTaskID, err)
+	}
+
+	if len(renamed) == 0 {
+		return nil
+	}
+
+	// The folder name carries the new ID; metadata keeps short IDs for the task and its parent
+	return updateYamlFile(filepath.Join(taskDir, taskMetadataYamlFile), func(doc map[string]interface{}) {
+		if id, ok := doc["id"].(string); ok {
+			if newID, ok := renamed[id]; ok {
+				doc["id"] = newID
+			}
+		}
+		if parentID, ok := doc["parent_id"].(string); ok {
+			if newID, ok := renamed[parentID]; ok {
+				doc["parent_id"] = newID
+			}
+		}
+	})
+}
+
+// RestoreNote moves a trashed note folder back to where it was deleted from
+func (r *TrashRepositoryImpl) RestoreNote(ctx context.Context, item *entity.TrashItem) error {
+	itemDir := r.itemDir(item)
+	storage, err := r.loadItem(itemDir)
+	if err != nil {
+		return err
+	}
+
+	noteDir := filepath.Join(r.projectPaths().RootPath(), storage.OriginalPath)
+	exists, err := filesystem.Exists(noteDir)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("note folder %s already exists", storage.OriginalPath)
+	}
+
+	if err := filesystem.EnsureDir(filepath.Dir(noteDir), 0755); err != nil {
+		return err
+	}
+
+	src := filepath.Join(itemDir, trashContentDir, trashNoteSubdir)
+	if err := os.Rename(src, noteDir); err != nil {
+		return fmt.Errorf("failed to restore note: %w", err)
+	}
+
+	return nil
+}
+
+// Delete permanently removes a trash item
+func (r *TrashRepositoryImpl) Delete(ctx context.Context, id string) error {
+	item, err := r.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	return filesystem.RemoveDir(r.itemDir(item))
+}
+
+// move records a folder moved into the trash so a failed operation can put it back
+type move struct {
+	from string
+	to   string
+}
+
+func (r *TrashRepositoryImpl) rollback(itemDir string, moved []move) {
+	for i := len(moved) - 1; i >= 0; i-- {
+		_ = os.Rename(moved[i].to, moved[i].from)
+	}
+	_ = os.RemoveAll(itemDir)
+}
+
+func (r *TrashRepositoryImpl) saveItem(item *entity.TrashItem, originalPath string) error {
+	storage := mapper.TrashItemToStorage(item, originalPath)
+	data, err := serialization.SerializeYaml(storage)
+	if err != nil {
+		return fmt.Errorf("failed to serialize trash item: %w", err)
+	}
+
+	path := filepath.Join(r.itemDir(item), trashItemFile)
+	if err := filesystem.SafeWrite(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write trash item: %w", err)
+	}
+	return nil
+}
+
+func (r *TrashRepositoryImpl) loadItem(itemDir string) (*mapper.TrashItemStorage, error) {
+	data, err := os.ReadFile(filepath.Join(itemDir, trashItemFile))
+	if err != nil {
+		return nil, err
+	}
+
+	var storage mapper.TrashItemStorage
+	if err := serialization.ParseYaml(data, &storage); err != nil {
+		return nil, fmt.Errorf("failed to parse trash item: %w", err)
+	}
+	return &storage, nil
+}
+
+func (r *TrashRepositoryImpl) itemDir(item *entity.TrashItem) string {
+	if item.ProjectSlug() == "" {
+		return filepath.Join(r.projectPaths().GlobalTrashDir(), item.ID())
+	}
+	return filepath.Join(r.projectPaths().ProjectTrashDir(item.ProjectSlug()), item.ID())
+}
+
+// findNoteDir locates a note folder, falling back to a scan of its date folder
+// because the folder name keeps the slug of the title the note was created with
+func (r *TrashRepositoryImpl) findNoteDir(note *entity.Note) (string, error) {
+	noteDir := r.notes.getNoteDir(note)
+	if exists, _ := filesystem.Exists(noteDir); exists {
+		return noteDir, nil
+	}
+
+	dateDir := filepath.Dir(noteDir)
+	entries, err := os.ReadDir(dateDir)
+	if err != nil {
+		return "", entity.ErrNoteNotFound
+	}
+	for _, entry := range entries {
+		if entry.IsDir() && strings.HasPrefix(entry.Name(), note.ID()[:8]+"-") {
+			return filepath.Join(dateDir, entry.Name()), nil
+		}
+	}
+
+	return "", entity.ErrNoteNotFound
+}
+
+func (r *TrashRepositoryImpl) relPath(path string) string {
+	rel, err := filepath.Rel(r.projectPaths().RootPath(), path)
+	if err != nil {
+		return path
+	}
+	return rel
+}
+
+func (r *TrashRepositoryImpl) projectPaths() *ProjectPathBuilder {
+	return r.pathBuilder.projectPathBuilder
+}
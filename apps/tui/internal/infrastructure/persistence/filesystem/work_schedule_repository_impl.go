@@ -0,0 +1,78 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/infrastructure/persistence/mapper"
+	"mkanban/pkg/filesystem"
+)
+
+// WorkScheduleRepositoryImpl implements WorkScheduleRepository with the default
+// schedule in global/schedule.yml and overrides in schedule.yml of a project
+type WorkScheduleRepositoryImpl struct {
+	pathBuilder *ProjectPathBuilder
+}
+
+// NewWorkScheduleRepository creates a new filesystem-based work schedule repository
+func NewWorkScheduleRepository(rootPath string) repository.WorkScheduleRepository {
+	return &WorkScheduleRepositoryImpl{
+		pathBuilder: NewProjectPathBuilder(rootPath),
+	}
+}
+
+// Save creates or replaces a schedule file
+func (r *WorkScheduleRepositoryImpl) Save(ctx context.Context, projectID string, schedule *entity.WorkSchedule) error {
+	if projectID != "" {
+		if _, err := os.Stat(r.pathBuilder.ProjectDir(projectID)); err != nil {
+			if os.IsNotExist(err) {
+				return entity.ErrProjectNotFound
+			}
+			return err
+		}
+	}
+
+	path := r.scheduleFile(projectID)
+	if err := filesystem.EnsureDir(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create schedule directory: %w", err)
+	}
+
+	data, err := mapper.WorkScheduleToStorage(schedule)
+	if err != nil {
+		return fmt.Errorf("failed to serialize work schedule: %w", err)
+	}
+
+	if err := filesystem.SafeWrite(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write work schedule: %w", err)
+	}
+	return nil
+}
+
+// Find reads a schedule file
+func (r *WorkScheduleRepositoryImpl) Find(ctx context.Context, projectID string) (*entity.WorkSchedule, error) {
+	data, err := os.ReadFile(r.scheduleFile(projectID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, entity.ErrWorkScheduleNotFound
+		}
+		return nil, fmt.Errorf("failed to read work schedule: %w", err)
+	}
+
+	schedule, err := mapper.WorkScheduleFromStorage(projectID, data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid work schedule: %w", err)
+	}
+	return schedule, nil
+}
+
+// scheduleFile returns the schedule file of a project, or the default one
+func (r *WorkScheduleRepositoryImpl) scheduleFile(projectID string) string {
+	if projectID == "" {
+		return r.pathBuilder.GlobalScheduleFile()
+	}
+	return r.pathBuilder.ProjectScheduleFile(projectID)
+}
@@ -0,0 +1,54 @@
+package mapper
+
+import (
+	"fmt"
+	"mkanban/internal/domain/entity"
+	"time"
+)
+
+type ActivityEntryStorage struct {
+	ID        string            `yaml:"id"`
+	Type      string            `yaml:"type"`
+	Timestamp time.Time         `yaml:"timestamp"`
+	Author    string            `yaml:"author,omitempty"`
+	Message   string            `yaml:"message"`
+	Details   map[string]string `yaml:"details,omitempty"`
+}
+
+type ActivityLogStorage struct {
+	Entries []*ActivityEntryStorage `yaml:"entries"`
+}
+
+func ActivityEntryToStorage(entry *entity.ActivityEntry) *ActivityEntryStorage {
+	storage := &ActivityEntryStorage{
+		ID:        entry.ID(),
+		Type:      string(entry.Type()),
+		Timestamp: entry.Timestamp(),
+		Author:    entry.Author(),
+		Message:   entry.Message(),
+	}
+
+	if details := entry.Details(); len(details) > 0 {
+		storage.Details = details
+	}
+
+	return storage
+}
+
+func ActivityEntryFromStorage(storage *ActivityEntryStorage) (*entity.ActivityEntry, error) {
+	if storage.ID == "" {
+		return nil, fmt.Errorf("missing activity entry ID")
+	}
+
+	entry, err := entity.NewActivityEntry(storage.ID, entity.ActivityType(storage.Type), storage.Message, storage.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	entry.SetAuthor(storage.Author)
+	for key, value := range storage.Details {
+		entry.SetDetail(key, value)
+	}
+
+	return entry, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"mkanban/internal/domain/entity"
 	"mkanban/internal/infrastructure/serialization"
+	"strings"
 	"time"
 )
 
@@ -32,9 +33,79 @@ func BoardMetadataToStorage(board *entity.Board) (map[string]interface{}, error)
 	return metadata, nil
 }
 
-// BoardContentToMarkdown converts a Board entity to markdown content (board.md)
-func BoardContentToMarkdown(board *entity.Board) []byte {
-	return serialization.SerializeMarkdownWithTitle(board.Name(), board.Description())
+// FieldDefinitionStorage represents a custom field in the board.md frontmatter
+type FieldDefinitionStorage struct {
+	Name        string   `yaml:"name"`
+	Type        string   `yaml:"type"`
+	Description string   `yaml:"description,omitempty"`
+	Options     []string `yaml:"options,omitempty"`
+	Required    bool     `yaml:"required,omitempty"`
+}
+
+// BoardContentToMarkdown converts a Board entity to markdown content (board.md).
+// A board with custom fields keeps its field schema in the frontmatter.
+func BoardContentToMarkdown(board *entity.Board) ([]byte, error) {
+	markdown := serialization.SerializeMarkdownWithTitle(board.Name(), board.Description())
+
+	fields := board.Fields()
+	if len(fields) == 0 {
+		return markdown, nil
+	}
+
+	frontmatter := map[string]interface{}{
+		"fields": fieldDefinitionsToStorage(fields),
+	}
+	return serialization.SerializeFrontmatter(frontmatter, strings.TrimRight(string(markdown), "\n"))
+}
+
+// BoardFieldsFromStorage reads the custom field schema from the board.md frontmatter
+func BoardFieldsFromStorage(contentDoc *serialization.FrontmatterDocument) ([]entity.FieldDefinition, error) {
+	raw, ok := contentDoc.Frontmatter["fields"]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+
+	// Round-trip through YAML to decode the generic frontmatter into typed storage
+	data, err := serialization.SerializeYaml(raw)
+	if err != nil {
+		return nil, err
+	}
+	var storage []FieldDefinitionStorage
+	if err := serialization.ParseYaml(data, &storage); err != nil {
+		return nil, fmt.Errorf("invalid field schema: %w", err)
+	}
+
+	return fieldDefinitionsFromStorage(storage), nil
+}
+
+// fieldDefinitionsToStorage converts a field schema to its storage format
+func fieldDefinitionsToStorage(fields []entity.FieldDefinition) []FieldDefinitionStorage {
+	storage := make([]FieldDefinitionStorage, 0, len(fields))
+	for _, field := range fields {
+		storage = append(storage, FieldDefinitionStorage{
+			Name:        field.Name,
+			Type:        string(field.Type),
+			Description: field.Description,
+			Options:     field.Options,
+			Required:    field.Required,
+		})
+	}
+	return storage
+}
+
+// fieldDefinitionsFromStorage converts a stored field schema to field definitions
+func fieldDefinitionsFromStorage(storage []FieldDefinitionStorage) []entity.FieldDefinition {
+	fields := make([]entity.FieldDefinition, 0, len(storage))
+	for _, field := range storage {
+		fields = append(fields, entity.FieldDefinition{
+			Name:        field.Name,
+			Type:        entity.FieldType(field.Type),
+			Description: field.Description,
+			Options:     field.Options,
+			Required:    field.Required,
+		})
+	}
+	return fields
 }
 
 // BoardFromStorage converts storage format to Board entity (new split format)
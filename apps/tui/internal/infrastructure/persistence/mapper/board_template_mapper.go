@@ -0,0 +1,207 @@
+package mapper
+
+import (
+	"fmt"
+	"time"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/valueobject"
+	"mkanban/internal/infrastructure/serialization"
+)
+
+// BoardTemplateStorage represents a board template file (<name>.yml)
+type BoardTemplateStorage struct {
+	Description string                   `yaml:"description,omitempty"`
+	Columns     []ColumnBlueprintStorage `yaml:"columns"`
+	Fields      []FieldDefinitionStorage `yaml:"fields,omitempty"`
+	Tasks       []TaskBlueprintStorage   `yaml:"tasks,omitempty"`
+	Actions     []ActionBlueprintStorage `yaml:"actions,omitempty"`
+}
+
+// ColumnBlueprintStorage represents a column of a board template
+type ColumnBlueprintStorage struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+	Order       int    `yaml:"order"`
+	WIPLimit    int    `yaml:"wip_limit,omitempty"`
+	Color       string `yaml:"color,omitempty"`
+}
+
+// TaskBlueprintStorage represents a seed task of a board template
+type TaskBlueprintStorage struct {
+	Title       string            `yaml:"title"`
+	Description string            `yaml:"description,omitempty"`
+	Column      string            `yaml:"column,omitempty"`
+	Priority    string            `yaml:"priority,omitempty"`
+	Tags        []string          `yaml:"tags,omitempty"`
+	Fields      map[string]string `yaml:"fields,omitempty"`
+}
+
+// ActionBlueprintStorage represents an action of a board template
+type ActionBlueprintStorage struct {
+	Name        string               `yaml:"name"`
+	Description string               `yaml:"description,omitempty"`
+	Trigger     ActionTriggerStorage `yaml:"trigger"`
+	Type        string               `yaml:"type"`
+	Title       string               `yaml:"title,omitempty"`
+	Message     string               `yaml:"message,omitempty"`
+	ScriptPath  string               `yaml:"script_path,omitempty"`
+	Column      string               `yaml:"column,omitempty"`
+	AddTags     []string             `yaml:"add_tags,omitempty"`
+	RemoveTags  []string             `yaml:"remove_tags,omitempty"`
+}
+
+// ActionTriggerStorage represents the trigger of a board template action:
+// an event, or a schedule type with an offset or cron expression
+type ActionTriggerStorage struct {
+	Event    string `yaml:"event,omitempty"`
+	Schedule string `yaml:"schedule,omitempty"`
+	Offset   string `yaml:"offset,omitempty"` // duration string like "-24h"
+	Cron     string `yaml:"cron,omitempty"`
+}
+
+// BoardTemplateToStorage converts a BoardTemplate to the content of its template file
+func BoardTemplateToStorage(template *entity.BoardTemplate) ([]byte, error) {
+	storage := BoardTemplateStorage{
+		Description: template.Description(),
+		Columns:     make([]ColumnBlueprintStorage, 0),
+	}
+
+	for _, column := range template.Columns() {
+		storage.Columns = append(storage.Columns, ColumnBlueprintStorage{
+			Name:        column.Name,
+			Description: column.Description,
+			Order:       column.Order,
+			WIPLimit:    column.WIPLimit,
+			Color:       column.Color,
+		})
+	}
+
+	if fields := template.Fields(); len(fields) > 0 {
+		storage.Fields = fieldDefinitionsToStorage(fields)
+	}
+
+	for _, task := range template.Tasks() {
+		taskStorage := TaskBlueprintStorage{
+			Title:       task.Title,
+			Description: task.Description,
+			Column:      task.Column,
+			Tags:        task.Tags,
+			Fields:      task.Fields,
+		}
+		if task.Priority != valueobject.PriorityNone {
+			taskStorage.Priority = task.Priority.String()
+		}
+		storage.Tasks = append(storage.Tasks, taskStorage)
+	}
+
+	for _, action := range template.Actions() {
+		actionStorage := ActionBlueprintStorage{
+			Name:        action.Name,
+			Description: action.Description,
+			Trigger: ActionTriggerStorage{
+				Event:    string(action.Event),
+				Schedule: string(action.ScheduleType),
+				Cron:     action.Cron,
+			},
+			Type:       string(action.Type),
+			Title:      action.Title,
+			Message:    action.Message,
+			ScriptPath: action.ScriptPath,
+			Column:     action.Column,
+			AddTags:    action.AddTags,
+			RemoveTags: action.RemoveTags,
+		}
+		if action.ScheduleType == valueobject.ScheduleTypeRelativeDueDate ||
+			action.ScheduleType == valueobject.ScheduleTypeRelativeCreation {
+			actionStorage.Trigger.Offset = action.Offset.String()
+		}
+		storage.Actions = append(storage.Actions, actionStorage)
+	}
+
+	return serialization.SerializeYaml(storage)
+}
+
+// BoardTemplateFromStorage converts the content of a template file to a BoardTemplate
+func BoardTemplateFromStorage(name string, data []byte) (*entity.BoardTemplate, error) {
+	var storage BoardTemplateStorage
+	if err := serialization.ParseYaml(data, &storage); err != nil {
+		return nil, err
+	}
+
+	template, err := entity.NewBoardTemplate(name, storage.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make([]entity.ColumnBlueprint, 0, len(storage.Columns))
+	for _, column := range storage.Columns {
+		columns = append(columns, entity.ColumnBlueprint{
+			Name:        column.Name,
+			Description: column.Description,
+			Order:       column.Order,
+			WIPLimit:    column.WIPLimit,
+			Color:       column.Color,
+		})
+	}
+	if err := template.SetColumns(columns); err != nil {
+		return nil, err
+	}
+
+	if err := template.SetFields(fieldDefinitionsFromStorage(storage.Fields)); err != nil {
+		return nil, err
+	}
+
+	tasks := make([]entity.TaskBlueprint, 0, len(storage.Tasks))
+	for _, task := range storage.Tasks {
+		priority := valueobject.PriorityNone
+		if task.Priority != "" {
+			priority, err = valueobject.ParsePriority(task.Priority)
+			if err != nil {
+				return nil, fmt.Errorf("task %q: %w", task.Title, err)
+			}
+		}
+		tasks = append(tasks, entity.TaskBlueprint{
+			Title:       task.Title,
+			Description: task.Description,
+			Column:      task.Column,
+			Priority:    priority,
+			Tags:        task.Tags,
+			Fields:      task.Fields,
+		})
+	}
+	if err := template.SetTasks(tasks); err != nil {
+		return nil, err
+	}
+
+	actions := make([]entity.ActionBlueprint, 0, len(storage.Actions))
+	for _, action := range storage.Actions {
+		var offset time.Duration
+		if action.Trigger.Offset != "" {
+			offset, err = time.ParseDuration(action.Trigger.Offset)
+			if err != nil {
+				return nil, fmt.Errorf("action %q: invalid offset: %w", action.Name, err)
+			}
+		}
+		actions = append(actions, entity.ActionBlueprint{
+			Name:         action.Name,
+			Description:  action.Description,
+			Event:        valueobject.EventType(action.Trigger.Event),
+			ScheduleType: valueobject.ScheduleType(action.Trigger.Schedule),
+			Offset:       offset,
+			Cron:         action.Trigger.Cron,
+			Type:         entity.ActionTypeEnum(action.Type),
+			Title:        action.Title,
+			Message:      action.Message,
+			ScriptPath:   action.ScriptPath,
+			Column:       action.Column,
+			AddTags:      action.AddTags,
+			RemoveTags:   action.RemoveTags,
+		})
+	}
+	if err := template.SetActions(actions); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
@@ -56,33 +56,3 @@ func ColumnFromStorage(metadataDoc *serialization.FrontmatterDocument, name stri
 
 	return column, nil
 }
-
-// ColumnFromLegacyStorage converts old frontmatter format to Column entity (backward compatibility)
-func ColumnFromLegacyStorage(doc *serialization.FrontmatterDocument, name string) (*entity.Column, error) {
-	displayName := doc.GetString("display_name")
-	if displayName == "" {
-		// Fallback for old format: use folder name as display name
-		displayName = name
-	}
-	description := doc.GetString("description")
-	order := doc.GetInt("order")
-	wipLimit := doc.GetInt("wip_limit")
-
-	var color *valueobject.Color
-	colorStr := doc.GetString("color")
-	if colorStr != "" {
-		var err error
-		color, err = valueobject.NewColor(colorStr)
-		if err != nil {
-			// If color is invalid, just use nil
-			color = nil
-		}
-	}
-
-	column, err := entity.NewColumnWithDisplayName(name, displayName, description, order, wipLimit, color)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create column: %w", err)
-	}
-
-	return column, nil
-}
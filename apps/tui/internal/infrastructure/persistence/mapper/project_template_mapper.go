@@ -0,0 +1,95 @@
+package mapper
+
+import (
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/infrastructure/serialization"
+)
+
+// ProjectTemplateStorage represents a project template file (<name>.yml)
+type ProjectTemplateStorage struct {
+	Description string                  `yaml:"description,omitempty"`
+	Boards      []BoardBlueprintStorage `yaml:"boards"`
+	Notes       []NoteBlueprintStorage  `yaml:"notes,omitempty"`
+}
+
+// BoardBlueprintStorage represents a board of a project template
+type BoardBlueprintStorage struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+	Template    string `yaml:"template,omitempty"`
+}
+
+// NoteBlueprintStorage represents a note of a project template
+type NoteBlueprintStorage struct {
+	Title   string   `yaml:"title"`
+	Type    string   `yaml:"type,omitempty"`
+	Content string   `yaml:"content,omitempty"`
+	Tags    []string `yaml:"tags,omitempty"`
+}
+
+// ProjectTemplateToStorage converts a ProjectTemplate to the content of its template file
+func ProjectTemplateToStorage(template *entity.ProjectTemplate) ([]byte, error) {
+	storage := ProjectTemplateStorage{
+		Description: template.Description(),
+		Boards:      make([]BoardBlueprintStorage, 0),
+	}
+
+	for _, board := range template.Boards() {
+		storage.Boards = append(storage.Boards, BoardBlueprintStorage{
+			Name:        board.Name,
+			Description: board.Description,
+			Template:    board.Template,
+		})
+	}
+
+	for _, note := range template.Notes() {
+		storage.Notes = append(storage.Notes, NoteBlueprintStorage{
+			Title:   note.Title,
+			Type:    string(note.Type),
+			Content: note.Content,
+			Tags:    note.Tags,
+		})
+	}
+
+	return serialization.SerializeYaml(storage)
+}
+
+// ProjectTemplateFromStorage converts the content of a template file to a ProjectTemplate
+func ProjectTemplateFromStorage(name string, data []byte) (*entity.ProjectTemplate, error) {
+	var storage ProjectTemplateStorage
+	if err := serialization.ParseYaml(data, &storage); err != nil {
+		return nil, err
+	}
+
+	template, err := entity.NewProjectTemplate(name, storage.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	boards := make([]entity.BoardBlueprint, 0, len(storage.Boards))
+	for _, board := range storage.Boards {
+		boards = append(boards, entity.BoardBlueprint{
+			Name:        board.Name,
+			Description: board.Description,
+			Template:    board.Template,
+		})
+	}
+	if err := template.SetBoards(boards); err != nil {
+		return nil, err
+	}
+
+	notes := make([]entity.NoteBlueprint, 0, len(storage.Notes))
+	for _, note := range storage.Notes {
+		notes = append(notes, entity.NoteBlueprint{
+			Title:   note.Title,
+			Type:    entity.NoteType(note.Type),
+			Content: note.Content,
+			Tags:    note.Tags,
+		})
+	}
+	if err := template.SetNotes(notes); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
@@ -0,0 +1,124 @@
+package mapper
+
+import (
+	"time"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/infrastructure/serialization"
+)
+
+// SprintStorage represents a sprint file (sprints/<id>.yml)
+type SprintStorage struct {
+	Name      string                `yaml:"name"`
+	Goal      string                `yaml:"goal,omitempty"`
+	StartDate time.Time             `yaml:"start_date"`
+	EndDate   time.Time             `yaml:"end_date"`
+	Tasks     []SprintTaskStorage   `yaml:"tasks"`
+	Summary   *SprintSummaryStorage `yaml:"summary,omitempty"`
+}
+
+// SprintTaskStorage represents a task planned in a sprint
+type SprintTaskStorage struct {
+	BoardID string    `yaml:"board_id"`
+	TaskID  string    `yaml:"task_id"`
+	AddedAt time.Time `yaml:"added_at"`
+}
+
+// SprintSummaryStorage represents the outcome of a closed sprint
+type SprintSummaryStorage struct {
+	ClosedAt      time.Time           `yaml:"closed_at"`
+	Committed     int                 `yaml:"committed"`
+	CommittedDone int                 `yaml:"committed_done"`
+	Added         int                 `yaml:"added"`
+	AddedDone     int                 `yaml:"added_done"`
+	RolledOver    []SprintTaskStorage `yaml:"rolled_over,omitempty"`
+	RolledOverTo  string              `yaml:"rolled_over_to,omitempty"`
+	TrackedTime   int64               `yaml:"tracked_seconds"`
+}
+
+// SprintToStorage converts a Sprint to the content of its sprint file
+func SprintToStorage(sprint *entity.Sprint) ([]byte, error) {
+	storage := SprintStorage{
+		Name:      sprint.Name(),
+		Goal:      sprint.Goal(),
+		StartDate: sprint.StartDate(),
+		EndDate:   sprint.EndDate(),
+		Tasks:     sprintTasksToStorage(sprint.Tasks()),
+	}
+
+	if summary := sprint.Summary(); summary != nil {
+		storage.Summary = &SprintSummaryStorage{
+			ClosedAt:      summary.ClosedAt,
+			Committed:     summary.Committed,
+			CommittedDone: summary.CommittedDone,
+			Added:         summary.Added,
+			AddedDone:     summary.AddedDone,
+			RolledOver:    sprintTasksToStorage(summary.RolledOver),
+			RolledOverTo:  summary.RolledOverTo,
+			TrackedTime:   int64(summary.TrackedTime.Seconds()),
+		}
+	}
+
+	return serialization.SerializeYaml(storage)
+}
+
+// SprintFromStorage converts the content of a sprint file to a Sprint
+func SprintFromStorage(projectID string, data []byte) (*entity.Sprint, error) {
+	var storage SprintStorage
+	if err := serialization.ParseYaml(data, &storage); err != nil {
+		return nil, err
+	}
+
+	sprint, err := entity.NewSprint(projectID, storage.Name, storage.Goal, storage.StartDate, storage.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, task := range sprintTasksFromStorage(storage.Tasks) {
+		if _, err := sprint.AddTask(task); err != nil {
+			return nil, err
+		}
+	}
+
+	if storage.Summary != nil {
+		summary := entity.SprintSummary{
+			ClosedAt:      storage.Summary.ClosedAt,
+			Committed:     storage.Summary.Committed,
+			CommittedDone: storage.Summary.CommittedDone,
+			Added:         storage.Summary.Added,
+			AddedDone:     storage.Summary.AddedDone,
+			RolledOver:    sprintTasksFromStorage(storage.Summary.RolledOver),
+			RolledOverTo:  storage.Summary.RolledOverTo,
+			TrackedTime:   time.Duration(storage.Summary.TrackedTime) * time.Second,
+		}
+		if err := sprint.Close(summary); err != nil {
+			return nil, err
+		}
+	}
+
+	return sprint, nil
+}
+
+func sprintTasksToStorage(tasks []entity.SprintTask) []SprintTaskStorage {
+	storage := make([]SprintTaskStorage, 0, len(tasks))
+	for _, task := range tasks {
+		storage = append(storage, SprintTaskStorage{
+			BoardID: task.BoardID,
+			TaskID:  task.TaskID,
+			AddedAt: task.AddedAt,
+		})
+	}
+	return storage
+}
+
+func sprintTasksFromStorage(storage []SprintTaskStorage) []entity.SprintTask {
+	tasks := make([]entity.SprintTask, 0, len(storage))
+	for _, task := range storage {
+		tasks = append(tasks, entity.SprintTask{
+			BoardID: task.BoardID,
+			TaskID:  task.TaskID,
+			AddedAt: task.AddedAt,
+		})
+	}
+	return tasks
+}
@@ -0,0 +1,75 @@
+package mapper
+
+import (
+	"fmt"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/valueobject"
+	"mkanban/internal/infrastructure/serialization"
+)
+
+// TagRegistryStorage represents the tag registry file of a project (tags.yml)
+type TagRegistryStorage struct {
+	Tags []TagStorage `yaml:"tags"`
+}
+
+// TagStorage represents a registered tag
+type TagStorage struct {
+	Name        string   `yaml:"name"`
+	Color       string   `yaml:"color,omitempty"`
+	Description string   `yaml:"description,omitempty"`
+	Aliases     []string `yaml:"aliases,omitempty"`
+}
+
+// TagRegistryToStorage converts a TagRegistry to the content of its registry file
+func TagRegistryToStorage(registry *entity.TagRegistry) ([]byte, error) {
+	storage := TagRegistryStorage{
+		Tags: make([]TagStorage, 0),
+	}
+
+	for _, tag := range registry.Tags() {
+		tagStorage := TagStorage{
+			Name:        tag.Name(),
+			Description: tag.Description(),
+			Aliases:     tag.Aliases(),
+		}
+		if tag.Color() != nil {
+			tagStorage.Color = tag.Color().String()
+		}
+		storage.Tags = append(storage.Tags, tagStorage)
+	}
+
+	return serialization.SerializeYaml(storage)
+}
+
+// TagRegistryFromStorage converts the content of a registry file to a TagRegistry
+func TagRegistryFromStorage(projectID string, data []byte) (*entity.TagRegistry, error) {
+	var storage TagRegistryStorage
+	if err := serialization.ParseYaml(data, &storage); err != nil {
+		return nil, err
+	}
+
+	registry := entity.NewTagRegistry(projectID)
+	for _, tagStorage := range storage.Tags {
+		var color *valueobject.Color
+		if tagStorage.Color != "" {
+			parsed, err := valueobject.NewColor(tagStorage.Color)
+			if err != nil {
+				return nil, fmt.Errorf("tag %q: %w", tagStorage.Name, err)
+			}
+			color = parsed
+		}
+
+		tag, err := entity.NewTag(tagStorage.Name, tagStorage.Description, color)
+		if err != nil {
+			return nil, err
+		}
+		tag.SetAliases(tagStorage.Aliases)
+
+		if err := registry.Set(tag); err != nil {
+			return nil, fmt.Errorf("tag %q: %w", tagStorage.Name, err)
+		}
+	}
+
+	return registry, nil
+}
@@ -5,6 +5,7 @@ import (
 	"mkanban/internal/domain/entity"
 	"mkanban/internal/domain/valueobject"
 	"mkanban/internal/infrastructure/serialization"
+	"strings"
 	"time"
 )
 
@@ -14,22 +15,82 @@ type GitMetadata struct {
 	IsCurrentBranch string `yaml:"is_current_branch,omitempty"`
 }
 
+// ParentShortIDMetadataKey holds the stored parent short ID of a task until
+// it is resolved to the full ID of the parent. Parents that are not on the
+// task's board stay unresolved and are written back unchanged.
+const ParentShortIDMetadataKey = entity.UnresolvedParentMetadataKey
+
 // TaskStorage represents task storage format
 type TaskStorage struct {
-	ID            string         `yaml:"id"`
-	ParentID      string         `yaml:"parent_id,omitempty"`
-	Created       time.Time      `yaml:"created"`
-	Modified      time.Time      `yaml:"modified"`
-	DueDate       *time.Time     `yaml:"due_date,omitempty"`
-	CompletedDate *time.Time     `yaml:"completed_date,omitempty"`
-	Priority      string         `yaml:"priority"`
-	Status        string         `yaml:"status"`
-	Tags          []string       `yaml:"tags,omitempty"`
-	Git           *GitMetadata   `yaml:"git,omitempty"`
-	ScheduledDate *time.Time     `yaml:"scheduled_date,omitempty"`
-	ScheduledTime *time.Time     `yaml:"scheduled_time,omitempty"`
-	TimeBlock     *time.Duration `yaml:"time_block,omitempty"`
-	TaskType      string         `yaml:"task_type,omitempty"`
+	ID            string               `yaml:"id"`
+	ParentID      string               `yaml:"parent_id,omitempty"`
+	Created       time.Time            `yaml:"created"`
+	Modified      time.Time            `yaml:"modified"`
+	DueDate       *time.Time           `yaml:"due_date,omitempty"`
+	CompletedDate *time.Time           `yaml:"completed_date,omitempty"`
+	Priority      string               `yaml:"priority"`
+	Status        string               `yaml:"status"`
+	Tags          []string             `yaml:"tags,omitempty"`
+	Git           *GitMetadata         `yaml:"git,omitempty"`
+	ScheduledDate *time.Time           `yaml:"scheduled_date,omitempty"`
+	ScheduledTime *time.Time           `yaml:"scheduled_time,omitempty"`
+	TimeBlock     *time.Duration       `yaml:"time_block,omitempty"`
+	EstimatedTime *time.Duration       `yaml:"estimated_time,omitempty"`
+	TaskType      string               `yaml:"task_type,omitempty"`
+	Meeting       *MeetingStorage      `yaml:"meeting,omitempty"`
+	Recurrence    *RecurrenceStorage   `yaml:"recurrence,omitempty"`
+	Attachments   []AttachmentStorage  `yaml:"attachments,omitempty"`
+	Fields        map[string]string    `yaml:"fields,omitempty"`
+	LinkedNotes   []string             `yaml:"linked_notes,omitempty"`
+	Milestone     *MilestoneStorage    `yaml:"milestone,omitempty"`
+	ColumnHistory []ColumnVisitStorage `yaml:"column_history,omitempty"`
+}
+
+// ColumnVisitStorage represents a stay of a task in a column
+type ColumnVisitStorage struct {
+	Column    string     `yaml:"column"`
+	EnteredAt time.Time  `yaml:"entered_at"`
+	ExitedAt  *time.Time `yaml:"exited_at,omitempty"`
+}
+
+// MeetingStorage represents the details of a meeting task
+type MeetingStorage struct {
+	Attendees     []string `yaml:"attendees,omitempty"`
+	Location      string   `yaml:"location,omitempty"`
+	MeetingURL    string   `yaml:"meeting_url,omitempty"`
+	GoogleEventID string   `yaml:"google_event_id,omitempty"`
+	ICalUID       string   `yaml:"ical_uid,omitempty"`
+}
+
+// RecurrenceStorage represents how a scheduled task repeats
+type RecurrenceStorage struct {
+	Frequency  string     `yaml:"frequency"`
+	Interval   int        `yaml:"interval,omitempty"`
+	DaysOfWeek []string   `yaml:"days_of_week,omitempty"`
+	DayOfMonth int        `yaml:"day_of_month,omitempty"`
+	EndDate    *time.Time `yaml:"end_date,omitempty"`
+	Count      int        `yaml:"count,omitempty"`
+}
+
+// MilestoneStorage represents the tasks tracked by a milestone task
+type MilestoneStorage struct {
+	Members []MilestoneMemberStorage `yaml:"members,omitempty"`
+	AtRisk  bool                     `yaml:"at_risk,omitempty"`
+}
+
+// MilestoneMemberStorage represents a task tracked by a milestone
+type MilestoneMemberStorage struct {
+	BoardID string `yaml:"board_id"`
+	TaskID  string `yaml:"task_id"`
+}
+
+// AttachmentStorage represents an attachment entry in task metadata;
+// the file itself lives in the attachments/ folder of the task
+type AttachmentStorage struct {
+	Name     string    `yaml:"name"`
+	Size     int64     `yaml:"size"`
+	MimeType string    `yaml:"mime_type,omitempty"`
+	AddedAt  time.Time `yaml:"added_at"`
 }
 
 // TaskToStorage converts a Task entity to storage format
@@ -47,15 +108,77 @@ func TaskToStorage(task *entity.Task) (*TaskStorage, []byte, error) {
 		ScheduledDate: task.ScheduledDate(),
 		ScheduledTime: task.ScheduledTime(),
 		TimeBlock:     task.TimeBlock(),
+		EstimatedTime: task.EstimatedTime(),
 	}
 
 	if task.TaskType() != entity.TaskTypeRegular {
 		storage.TaskType = string(task.TaskType())
 	}
 
-	// Store parent ID if this is a subtask
+	if meeting := task.MeetingData(); meeting != nil {
+		storage.Meeting = &MeetingStorage{
+			Attendees:     meeting.Attendees,
+			Location:      meeting.Location,
+			MeetingURL:    meeting.MeetingURL,
+			GoogleEventID: meeting.GoogleEventID,
+			ICalUID:       meeting.ICalUID,
+		}
+	}
+
+	if rule := task.Recurrence(); rule != nil {
+		storage.Recurrence = &RecurrenceStorage{
+			Frequency:  string(rule.Frequency()),
+			Interval:   rule.Interval(),
+			DayOfMonth: rule.DayOfMonth(),
+			EndDate:    rule.EndDate(),
+			Count:      rule.Count(),
+		}
+		for _, day := range rule.DaysOfWeek() {
+			storage.Recurrence.DaysOfWeek = append(storage.Recurrence.DaysOfWeek, strings.ToLower(day.String()))
+		}
+	}
+
+	for _, attachment := range task.Attachments() {
+		storage.Attachments = append(storage.Attachments, AttachmentStorage{
+			Name:     attachment.Name,
+			Size:     attachment.Size,
+			MimeType: attachment.MimeType,
+			AddedAt:  attachment.AddedAt,
+		})
+	}
+
+	if fields := task.Fields(); len(fields) > 0 {
+		storage.Fields = fields
+	}
+
+	if notes := task.LinkedNotes(); len(notes) > 0 {
+		storage.LinkedNotes = notes
+	}
+
+	if task.IsMilestone() {
+		storage.Milestone = &MilestoneStorage{AtRisk: task.MilestoneAtRisk()}
+		for _, member := range task.MilestoneMembers() {
+			storage.Milestone.Members = append(storage.Milestone.Members, MilestoneMemberStorage{
+				BoardID: member.BoardID,
+				TaskID:  member.TaskID,
+			})
+		}
+	}
+
+	for _, visit := range task.ColumnHistory() {
+		storage.ColumnHistory = append(storage.ColumnHistory, ColumnVisitStorage{
+			Column:    visit.Column,
+			EnteredAt: visit.EnteredAt,
+			ExitedAt:  visit.ExitedAt,
+		})
+	}
+
+	// Store parent ID if this is a subtask. A parent that could not be
+	// resolved on the board is written back as it was read.
 	if task.ParentID() != nil {
 		storage.ParentID = task.ParentID().ShortID()
+	} else if shortID, ok := task.GetMetadata(ParentShortIDMetadataKey); ok {
+		storage.ParentID = shortID
 	}
 
 	// Extract git metadata if present
@@ -122,9 +245,6 @@ func TaskFromStorage(metadata *TaskStorage, markdownContent []byte, taskID *valu
 	}
 
 	// Parse optional dates
-	if metadata.DueDate != nil {
-		_ = task.SetDueDate(*metadata.DueDate)
-	}
 	if metadata.ScheduledDate != nil {
 		task.SetScheduledDate(*metadata.ScheduledDate)
 	}
@@ -134,20 +254,82 @@ func TaskFromStorage(metadata *TaskStorage, markdownContent []byte, taskID *valu
 	if metadata.TimeBlock != nil {
 		task.SetTimeBlock(*metadata.TimeBlock)
 	}
+	if metadata.EstimatedTime != nil {
+		task.SetEstimatedTime(*metadata.EstimatedTime)
+	}
 	if metadata.TaskType != "" {
 		task.SetTaskType(entity.TaskType(metadata.TaskType))
 	}
+	if metadata.Meeting != nil {
+		task.SetMeetingData(&entity.MeetingData{
+			Attendees:     metadata.Meeting.Attendees,
+			Location:      metadata.Meeting.Location,
+			MeetingURL:    metadata.Meeting.MeetingURL,
+			GoogleEventID: metadata.Meeting.GoogleEventID,
+			ICalUID:       metadata.Meeting.ICalUID,
+		})
+	}
+	if metadata.Recurrence != nil {
+		rule, err := recurrenceFromStorage(metadata.Recurrence)
+		if err != nil {
+			return nil, err
+		}
+		task.SetRecurrence(rule)
+	}
 
 	// Parse tags
 	for _, tag := range metadata.Tags {
 		task.AddTag(tag)
 	}
 
-	// Parse parent ID if present
+	for _, attachment := range metadata.Attachments {
+		task.AddAttachment(entity.Attachment{
+			Name:     attachment.Name,
+			Size:     attachment.Size,
+			MimeType: attachment.MimeType,
+			AddedAt:  attachment.AddedAt,
+		})
+	}
+
+	// Field values are validated against the board schema when they change
+	if len(metadata.Fields) > 0 {
+		task.SetFields(metadata.Fields)
+	}
+
+	for _, noteID := range metadata.LinkedNotes {
+		task.AddLinkedNote(noteID)
+	}
+
+	if metadata.Milestone != nil {
+		for _, member := range metadata.Milestone.Members {
+			_, _ = task.AddMilestoneMember(entity.MilestoneMember{
+				BoardID: member.BoardID,
+				TaskID:  member.TaskID,
+			})
+		}
+		task.SetMilestoneAtRisk(metadata.Milestone.AtRisk)
+	}
+
+	if len(metadata.ColumnHistory) > 0 {
+		history := make([]entity.ColumnVisit, 0, len(metadata.ColumnHistory))
+		for _, visit := range metadata.ColumnHistory {
+			history = append(history, entity.ColumnVisit{
+				Column:    visit.Column,
+				EnteredAt: visit.EnteredAt,
+				ExitedAt:  visit.ExitedAt,
+			})
+		}
+		task.SetColumnHistory(history)
+	}
+
+	// Parse parent ID if present. Metadata only keeps the short ID of the
+	// parent, which the board repository resolves once all tasks are loaded.
 	if metadata.ParentID != "" {
 		parentID, err := valueobject.ParseTaskID(metadata.ParentID)
 		if err == nil {
 			task.SetParentID(parentID)
+		} else {
+			task.SetMetadata(ParentShortIDMetadataKey, metadata.ParentID)
 		}
 	}
 
@@ -161,5 +343,38 @@ func TaskFromStorage(metadata *TaskStorage, markdownContent []byte, taskID *valu
 		}
 	}
 
+	// Restore the stored dates last, the setters above touch the modification time
+	task.RestoreDates(metadata.Created, metadata.Modified, metadata.DueDate, metadata.CompletedDate)
+
 	return task, nil
 }
+
+// recurrenceFromStorage converts a stored recurrence to a RecurrenceRule
+func recurrenceFromStorage(storage *RecurrenceStorage) (*valueobject.RecurrenceRule, error) {
+	rule, err := valueobject.NewRecurrenceRule(valueobject.RecurrenceFrequency(storage.Frequency), storage.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	days := make([]time.Weekday, 0, len(storage.DaysOfWeek))
+	for _, name := range storage.DaysOfWeek {
+		found := false
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			if strings.EqualFold(day.String(), name) {
+				days = append(days, day)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("invalid recurrence weekday: %s", name)
+		}
+	}
+	rule.SetDaysOfWeek(days)
+	rule.SetDayOfMonth(storage.DayOfMonth)
+	if storage.EndDate != nil {
+		rule.SetEndDate(*storage.EndDate)
+	}
+	rule.SetCount(storage.Count)
+	return rule, nil
+}
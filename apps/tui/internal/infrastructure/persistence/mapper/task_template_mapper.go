@@ -0,0 +1,97 @@
+package mapper
+
+import (
+	"fmt"
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/valueobject"
+	"mkanban/internal/infrastructure/serialization"
+	"strings"
+)
+
+// TaskTemplateStorage represents the frontmatter of a task template file
+// (<name>.md); the title and description are the markdown below it
+type TaskTemplateStorage struct {
+	Priority  string            `yaml:"priority,omitempty"`
+	Column    string            `yaml:"column,omitempty"`
+	Tags      []string          `yaml:"tags,omitempty"`
+	Fields    map[string]string `yaml:"fields,omitempty"`
+	Variables map[string]string `yaml:"variables,omitempty"`
+}
+
+// TaskTemplateToStorage converts a TaskTemplate to the content of its template file
+func TaskTemplateToStorage(template *entity.TaskTemplate) ([]byte, error) {
+	storage := TaskTemplateStorage{
+		Column: template.Column(),
+		Tags:   template.Tags(),
+	}
+	if template.Priority() != valueobject.PriorityNone {
+		storage.Priority = template.Priority().String()
+	}
+	if fields := template.Fields(); len(fields) > 0 {
+		storage.Fields = fields
+	}
+	if variables := template.Variables(); len(variables) > 0 {
+		storage.Variables = variables
+	}
+
+	// Round-trip through YAML so the frontmatter keeps the storage field names
+	data, err := serialization.SerializeYaml(storage)
+	if err != nil {
+		return nil, err
+	}
+	var frontmatter map[string]interface{}
+	if err := serialization.ParseYaml(data, &frontmatter); err != nil {
+		return nil, err
+	}
+
+	markdown := serialization.SerializeMarkdownWithTitle(template.Title(), template.Description())
+	return serialization.SerializeFrontmatter(frontmatter, strings.TrimRight(string(markdown), "\n"))
+}
+
+// TaskTemplateFromStorage converts the content of a template file to a TaskTemplate
+func TaskTemplateFromStorage(name string, projectID string, data []byte) (*entity.TaskTemplate, error) {
+	doc, err := serialization.ParseFrontmatter(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var storage TaskTemplateStorage
+	if len(doc.Frontmatter) > 0 {
+		frontmatter, err := serialization.SerializeYaml(doc.Frontmatter)
+		if err != nil {
+			return nil, err
+		}
+		if err := serialization.ParseYaml(frontmatter, &storage); err != nil {
+			return nil, fmt.Errorf("invalid template frontmatter: %w", err)
+		}
+	}
+
+	markdown, err := serialization.ParseMarkdownWithTitle([]byte(doc.Content))
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := entity.NewTaskTemplate(name, markdown.Title, markdown.Content)
+	if err != nil {
+		return nil, err
+	}
+	template.SetProjectID(projectID)
+
+	if storage.Priority != "" {
+		priority, err := valueobject.ParsePriority(storage.Priority)
+		if err != nil {
+			return nil, fmt.Errorf("invalid priority: %w", err)
+		}
+		if err := template.SetPriority(priority); err != nil {
+			return nil, err
+		}
+	}
+	template.SetColumn(storage.Column)
+	template.SetTags(storage.Tags)
+	template.SetFields(storage.Fields)
+	for variable, defaultValue := range storage.Variables {
+		template.SetVariable(variable, defaultValue)
+	}
+
+	return template, nil
+}
@@ -0,0 +1,64 @@
+package mapper
+
+import (
+	"fmt"
+	"mkanban/internal/domain/entity"
+	"time"
+)
+
+type TrashItemStorage struct {
+	ID           string               `yaml:"id"`
+	Type         string               `yaml:"type"`
+	Title        string               `yaml:"title"`
+	OriginalID   string               `yaml:"original_id"`
+	Project      string               `yaml:"project,omitempty"`
+	BoardID      string               `yaml:"board_id,omitempty"`
+	Column       string               `yaml:"column,omitempty"`
+	OriginalPath string               `yaml:"original_path"`
+	Tasks        []TrashedTaskStorage `yaml:"tasks,omitempty"`
+	DeletedAt    time.Time            `yaml:"deleted_at"`
+}
+
+type TrashedTaskStorage struct {
+	ID     string `yaml:"id"`
+	Column string `yaml:"column"`
+}
+
+func TrashItemToStorage(item *entity.TrashItem, originalPath string) *TrashItemStorage {
+	storage := &TrashItemStorage{
+		ID:           item.ID(),
+		Type:         string(item.Type()),
+		Title:        item.Title(),
+		OriginalID:   item.OriginalID(),
+		Project:      item.ProjectSlug(),
+		BoardID:      item.BoardID(),
+		Column:       item.ColumnName(),
+		OriginalPath: originalPath,
+		DeletedAt:    item.DeletedAt(),
+	}
+
+	for _, task := range item.Tasks() {
+		storage.Tasks = append(storage.Tasks, TrashedTaskStorage{ID: task.TaskID, Column: task.Column})
+	}
+
+	return storage
+}
+
+func TrashItemFromStorage(storage *TrashItemStorage) (*entity.TrashItem, error) {
+	if storage.ID == "" {
+		return nil, fmt.Errorf("missing trash item ID")
+	}
+
+	item, err := entity.NewTrashItem(storage.ID, entity.TrashItemType(storage.Type), storage.Title, storage.OriginalID)
+	if err != nil {
+		return nil, err
+	}
+
+	item.SetLocation(storage.Project, storage.BoardID, storage.Column)
+	for _, task := range storage.Tasks {
+		item.AddTask(task.ID, task.Column)
+	}
+	item.SetDeletedAt(storage.DeletedAt)
+
+	return item, nil
+}
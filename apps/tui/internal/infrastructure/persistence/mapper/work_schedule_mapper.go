@@ -0,0 +1,172 @@
+package mapper
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/infrastructure/serialization"
+)
+
+// WorkScheduleStorage represents a schedule file (schedule.yml)
+type WorkScheduleStorage struct {
+	Name       string                        `yaml:"name"`
+	Timezone   string                        `yaml:"timezone,omitempty"`
+	Days       map[string]DayScheduleStorage `yaml:"days"` // keyed by lower-case weekday name
+	Exceptions []ScheduleExceptionStorage    `yaml:"exceptions,omitempty"`
+	Created    time.Time                     `yaml:"created"`
+	Modified   time.Time                     `yaml:"modified"`
+}
+
+// DayScheduleStorage represents the working hours of a weekday
+type DayScheduleStorage struct {
+	Enabled    bool   `yaml:"enabled"`
+	Start      string `yaml:"start,omitempty"` // HH:MM
+	End        string `yaml:"end,omitempty"`
+	BreakStart string `yaml:"break_start,omitempty"`
+	BreakEnd   string `yaml:"break_end,omitempty"`
+}
+
+// ScheduleExceptionStorage represents a holiday, time off or changed working
+// hours on one date
+type ScheduleExceptionStorage struct {
+	Date        string `yaml:"date"` // YYYY-MM-DD
+	Type        string `yaml:"type"`
+	Description string `yaml:"description,omitempty"`
+	Start       string `yaml:"start,omitempty"`
+	End         string `yaml:"end,omitempty"`
+}
+
+// WorkScheduleToStorage converts a WorkSchedule to the content of its schedule file
+func WorkScheduleToStorage(schedule *entity.WorkSchedule) ([]byte, error) {
+	storage := WorkScheduleStorage{
+		Name:     schedule.Name(),
+		Timezone: schedule.Timezone(),
+		Days:     make(map[string]DayScheduleStorage),
+		Created:  schedule.CreatedAt(),
+		Modified: schedule.ModifiedAt(),
+	}
+
+	for day := entity.WeekdaySunday; day <= entity.WeekdaySaturday; day++ {
+		daySchedule := schedule.GetDaySchedule(day)
+		dayStorage := DayScheduleStorage{Enabled: daySchedule.Enabled()}
+		if daySchedule.Enabled() {
+			dayStorage.Start = daySchedule.StartTime().String()
+			dayStorage.End = daySchedule.EndTime().String()
+			if daySchedule.BreakStart() != nil && daySchedule.BreakEnd() != nil {
+				dayStorage.BreakStart = daySchedule.BreakStart().String()
+				dayStorage.BreakEnd = daySchedule.BreakEnd().String()
+			}
+		}
+		storage.Days[strings.ToLower(day.String())] = dayStorage
+	}
+
+	for _, exception := range schedule.GetExceptions() {
+		exceptionStorage := ScheduleExceptionStorage{
+			Date:        exception.Date.Format("2006-01-02"),
+			Type:        string(exception.Type),
+			Description: exception.Description,
+		}
+		if exception.StartTime != nil {
+			exceptionStorage.Start = exception.StartTime.String()
+		}
+		if exception.EndTime != nil {
+			exceptionStorage.End = exception.EndTime.String()
+		}
+		storage.Exceptions = append(storage.Exceptions, exceptionStorage)
+	}
+
+	return serialization.SerializeYaml(storage)
+}
+
+// WorkScheduleFromStorage converts the content of a schedule file to a
+// WorkSchedule of a project, or to the default schedule for an empty projectID
+func WorkScheduleFromStorage(projectID string, data []byte) (*entity.WorkSchedule, error) {
+	var storage WorkScheduleStorage
+	if err := serialization.ParseYaml(data, &storage); err != nil {
+		return nil, err
+	}
+
+	id := projectID
+	if id == "" {
+		id = entity.DefaultWorkScheduleID
+	}
+	schedule, err := entity.NewWorkSchedule(id, storage.Name)
+	if err != nil {
+		return nil, err
+	}
+	schedule.SetDefault(projectID == "")
+	if storage.Timezone != "" {
+		schedule.SetTimezone(storage.Timezone)
+	}
+
+	for name, dayStorage := range storage.Days {
+		day, err := entity.ParseWeekday(name)
+		if err != nil {
+			return nil, err
+		}
+		daySchedule, err := dayScheduleFromStorage(dayStorage)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		schedule.SetDaySchedule(day, daySchedule)
+	}
+
+	for _, exceptionStorage := range storage.Exceptions {
+		date, err := time.ParseInLocation("2006-01-02", exceptionStorage.Date, schedule.Location())
+		if err != nil {
+			return nil, fmt.Errorf("invalid exception date %q: %w", exceptionStorage.Date, entity.ErrInvalidDate)
+		}
+		exception := entity.ScheduleException{
+			Date:        date,
+			Type:        entity.ExceptionType(exceptionStorage.Type),
+			Description: exceptionStorage.Description,
+		}
+		if exception.StartTime, err = optionalTimeOfDay(exceptionStorage.Start); err != nil {
+			return nil, err
+		}
+		if exception.EndTime, err = optionalTimeOfDay(exceptionStorage.End); err != nil {
+			return nil, err
+		}
+		schedule.AddException(exception)
+	}
+
+	schedule.RestoreTimestamps(storage.Created, storage.Modified)
+	return schedule, nil
+}
+
+func dayScheduleFromStorage(storage DayScheduleStorage) (*entity.DaySchedule, error) {
+	if !storage.Enabled {
+		return entity.NewDayOff(), nil
+	}
+
+	start, err := entity.ParseTimeOfDay(storage.Start)
+	if err != nil {
+		return nil, err
+	}
+	end, err := entity.ParseTimeOfDay(storage.End)
+	if err != nil {
+		return nil, err
+	}
+	breakStart, err := optionalTimeOfDay(storage.BreakStart)
+	if err != nil {
+		return nil, err
+	}
+	breakEnd, err := optionalTimeOfDay(storage.BreakEnd)
+	if err != nil {
+		return nil, err
+	}
+	return entity.NewDaySchedule(start, end, breakStart, breakEnd)
+}
+
+func optionalTimeOfDay(value string) (*entity.TimeOfDay, error) {
+	if value == "" {
+		return nil, nil
+	}
+	timeOfDay, err := entity.ParseTimeOfDay(value)
+	if err != nil {
+		return nil, err
+	}
+	return &timeOfDay, nil
+}
@@ -2,21 +2,25 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"mkanban/internal/domain/entity"
 	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/service"
 	"mkanban/internal/domain/valueobject"
-	"mkanban/internal/infrastructure/external"
 )
 
 type CalendarSyncService struct {
-	calendarClient *external.GoogleCalendarClient
+	provider       service.CalendarProvider
 	boardRepo      repository.BoardRepository
 	projectRepo    repository.ProjectRepository
 	syncState      *SyncState
+	dataLock       sync.Locker // held while tasks are read or saved, never across calendar requests
 	mu             sync.RWMutex
 }
 
@@ -25,6 +29,7 @@ type SyncState struct {
 	SyncedEvents   map[string]string // calendarEventID -> taskID
 	SyncedTasks    map[string]string // taskID -> calendarEventID
 	ConflictPolicy ConflictPolicy
+	Conflicts      map[string]SyncConflict // calendarEventID -> conflict awaiting a resolution
 }
 
 type ConflictPolicy string
@@ -45,29 +50,64 @@ type SyncResult struct {
 	Conflicts       []SyncConflict
 	Errors          []error
 	LastSyncTime    time.Time
+	ChangedBoards   []string            // boards tasks were created or updated on
+	CreatedTasks    map[string][]string // IDs of the tasks created from events, by board
 }
 
 type SyncConflict struct {
 	EventID     string
 	TaskID      string
+	BoardID     string
 	EventTitle  string
 	TaskTitle   string
 	Description string
+	Fields      []ConflictField       // fields the two versions disagree on
+	Event       service.CalendarEvent // calendar version when the conflict was found
+	Task        service.CalendarEvent // task version when the conflict was found
+	DetectedAt  time.Time
 }
 
+// ConflictField is a field of a meeting that conflicting versions are merged by
+type ConflictField string
+
+const (
+	ConflictFieldTitle       ConflictField = "title"
+	ConflictFieldDescription ConflictField = "description"
+	ConflictFieldTime        ConflictField = "time"
+	ConflictFieldLocation    ConflictField = "location"
+)
+
+// ConflictFields lists the fields conflicting versions are merged by
+var ConflictFields = []ConflictField{
+	ConflictFieldTitle,
+	ConflictFieldDescription,
+	ConflictFieldTime,
+	ConflictFieldLocation,
+}
+
+// ConflictSide is the version a field of a resolved conflict is taken from
+type ConflictSide string
+
+const (
+	ConflictSideCalendar ConflictSide = "calendar"
+	ConflictSideTask     ConflictSide = "task"
+)
+
 func NewCalendarSyncService(
-	calendarClient *external.GoogleCalendarClient,
+	provider service.CalendarProvider,
 	boardRepo repository.BoardRepository,
 	projectRepo repository.ProjectRepository,
 ) *CalendarSyncService {
 	return &CalendarSyncService{
-		calendarClient: calendarClient,
+		provider:       provider,
 		boardRepo:      boardRepo,
 		projectRepo:    projectRepo,
+		dataLock:       noopLocker{},
 		syncState: &SyncState{
 			SyncedEvents:   make(map[string]string),
 			SyncedTasks:    make(map[string]string),
 			ConflictPolicy: ConflictPolicyNewerWins,
+			Conflicts:      make(map[string]SyncConflict),
 		},
 	}
 }
@@ -78,6 +118,20 @@ func (s *CalendarSyncService) SetConflictPolicy(policy ConflictPolicy) {
 	s.syncState.ConflictPolicy = policy
 }
 
+// SetDataLock sets the lock that keeps other writers out while synced tasks
+// are read and saved. Calendar requests are made without holding it.
+func (s *CalendarSyncService) SetDataLock(lock sync.Locker) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dataLock = lock
+}
+
+// noopLocker is the data lock of a service that is the only writer of its tasks
+type noopLocker struct{}
+
+func (noopLocker) Lock()   {}
+func (noopLocker) Unlock() {}
+
 func (s *CalendarSyncService) SyncAll(ctx context.Context) (*SyncResult, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -93,6 +147,8 @@ func (s *CalendarSyncService) SyncAll(ctx context.Context) (*SyncResult, error)
 		result.TasksCreated = pullResult.TasksCreated
 		result.TasksUpdated = pullResult.TasksUpdated
 		result.Conflicts = append(result.Conflicts, pullResult.Conflicts...)
+		result.ChangedBoards = pullResult.ChangedBoards
+		result.CreatedTasks = pullResult.CreatedTasks
 	}
 
 	pushResult, err := s.pushToCalendar(ctx)
@@ -112,7 +168,12 @@ func (s *CalendarSyncService) SyncAll(ctx context.Context) (*SyncResult, error)
 func (s *CalendarSyncService) PullFromCalendar(ctx context.Context) (*SyncResult, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	return s.pullFromCalendar(ctx)
+
+	result, err := s.pullFromCalendar(ctx)
+	if err == nil {
+		s.syncState.LastSyncTime = result.LastSyncTime
+	}
+	return result, err
 }
 
 func (s *CalendarSyncService) pullFromCalendar(ctx context.Context) (*SyncResult, error) {
@@ -124,11 +185,14 @@ func (s *CalendarSyncService) pullFromCalendar(ctx context.Context) (*SyncResult
 	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
 	end := start.AddDate(0, 1, 0)
 
-	events, err := s.calendarClient.GetEvents(ctx, start, end)
+	events, err := s.provider.ListEvents(ctx, start, end)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch calendar events: %w", err)
 	}
 
+	s.dataLock.Lock()
+	defer s.dataLock.Unlock()
+
 	boards, err := s.boardRepo.FindAll(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch boards: %w", err)
@@ -150,15 +214,52 @@ func (s *CalendarSyncService) pullFromCalendar(ctx context.Context) (*SyncResult
 		return result, nil
 	}
 
+	var createdTasks []string
 	for _, event := range events {
 		if taskID, exists := s.syncState.SyncedEvents[event.ID]; exists {
+			if _, pending := s.syncState.Conflicts[event.ID]; pending {
+				// Left alone until the conflict is resolved
+				continue
+			}
 			task := s.findTaskByID(meetingBoard, taskID)
-			if task != nil {
-				if s.eventNewerThanTask(event, task) {
-					s.updateTaskFromEvent(task, event)
-					result.TasksUpdated++
+			if task == nil {
+				continue
+			}
+			taskVersion := s.createEventFromTask(task)
+			fields := conflictingFields(event, taskVersion)
+			if len(fields) == 0 {
+				continue
+			}
+
+			pull := s.eventNewerThanTask(event, task)
+			if s.changedOnBothSides(event, task) {
+				switch s.syncState.ConflictPolicy {
+				case ConflictPolicyAskUser:
+					conflict := SyncConflict{
+						EventID:     event.ID,
+						TaskID:      taskID,
+						BoardID:     meetingBoard.ID(),
+						EventTitle:  event.Title,
+						TaskTitle:   task.Title(),
+						Description: "event and task both changed since the last sync",
+						Fields:      fields,
+						Event:       event,
+						Task:        taskVersion,
+						DetectedAt:  time.Now(),
+					}
+					s.syncState.Conflicts[event.ID] = conflict
+					result.Conflicts = append(result.Conflicts, conflict)
+					continue
+				case ConflictPolicyCalendarWins:
+					pull = true
+				case ConflictPolicyTaskWins:
+					pull = false
 				}
 			}
+			if pull {
+				s.updateTaskFromEvent(task, event)
+				result.TasksUpdated++
+			}
 			continue
 		}
 
@@ -172,7 +273,18 @@ func (s *CalendarSyncService) pullFromCalendar(ctx context.Context) (*SyncResult
 			s.syncState.SyncedEvents[event.ID] = task.ID().String()
 			s.syncState.SyncedTasks[task.ID().String()] = event.ID
 			result.TasksCreated++
+			createdTasks = append(createdTasks, task.ID().String())
+		}
+	}
+
+	if result.TasksCreated > 0 || result.TasksUpdated > 0 {
+		if err := s.boardRepo.Save(ctx, meetingBoard); err != nil {
+			return nil, fmt.Errorf("failed to save board: %w", err)
 		}
+		result.ChangedBoards = append(result.ChangedBoards, meetingBoard.ID())
+	}
+	if len(createdTasks) > 0 {
+		result.CreatedTasks = map[string][]string{meetingBoard.ID(): createdTasks}
 	}
 
 	return result, nil
@@ -181,7 +293,20 @@ func (s *CalendarSyncService) pullFromCalendar(ctx context.Context) (*SyncResult
 func (s *CalendarSyncService) PushToCalendar(ctx context.Context) (*SyncResult, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	return s.pushToCalendar(ctx)
+
+	result, err := s.pushToCalendar(ctx)
+	if err == nil {
+		s.syncState.LastSyncTime = result.LastSyncTime
+	}
+	return result, err
+}
+
+// pendingPush is a meeting to write to the calendar, with the event it is
+// synced with when it has one
+type pendingPush struct {
+	taskID  string
+	eventID string
+	event   service.CalendarEvent
 }
 
 func (s *CalendarSyncService) pushToCalendar(ctx context.Context) (*SyncResult, error) {
@@ -189,11 +314,48 @@ func (s *CalendarSyncService) pushToCalendar(ctx context.Context) (*SyncResult,
 		LastSyncTime: time.Now(),
 	}
 
+	pending, err := s.pendingPushes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The calendar is written without the data lock, the events were taken
+	// from the tasks while it was held
+	for _, push := range pending {
+		if push.eventID != "" {
+			push.event.ID = push.eventID
+			if err := s.provider.UpdateEvent(ctx, push.event); err != nil {
+				result.Errors = append(result.Errors, err)
+			} else {
+				result.EventsUpdated++
+			}
+			continue
+		}
+
+		created, err := s.provider.CreateEvent(ctx, push.event)
+		if err != nil {
+			result.Errors = append(result.Errors, err)
+		} else {
+			s.syncState.SyncedEvents[created.ID] = push.taskID
+			s.syncState.SyncedTasks[push.taskID] = created.ID
+			result.EventsCreated++
+		}
+	}
+
+	return result, nil
+}
+
+// pendingPushes returns the meetings created or changed since the last sync
+func (s *CalendarSyncService) pendingPushes(ctx context.Context) ([]pendingPush, error) {
+	s.dataLock.Lock()
+	defer s.dataLock.Unlock()
+
 	boards, err := s.boardRepo.FindAll(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch boards: %w", err)
 	}
 
+	var pending []pendingPush
 	for _, board := range boards {
 		for _, col := range board.Columns() {
 			for _, task := range col.Tasks() {
@@ -206,30 +368,24 @@ func (s *CalendarSyncService) pushToCalendar(ctx context.Context) (*SyncResult,
 				}
 
 				eventID, exists := s.syncState.SyncedTasks[task.ID().String()]
-				if exists {
-					event := s.createEventFromTask(task)
-					event.ID = eventID
-					if err := s.calendarClient.UpdateEvent(ctx, event); err != nil {
-						result.Errors = append(result.Errors, err)
-					} else {
-						result.EventsUpdated++
-					}
-				} else {
-					event := s.createEventFromTask(task)
-					created, err := s.calendarClient.CreateEvent(ctx, event)
-					if err != nil {
-						result.Errors = append(result.Errors, err)
-					} else {
-						s.syncState.SyncedEvents[created.ID] = task.ID().String()
-						s.syncState.SyncedTasks[task.ID().String()] = created.ID
-						result.EventsCreated++
-					}
+				if exists && !task.ModifiedAt().After(s.syncState.LastSyncTime) {
+					// Unchanged since the last sync
+					continue
+				}
+				if _, pending := s.syncState.Conflicts[eventID]; exists && pending {
+					// Left alone until the conflict is resolved
+					continue
 				}
+				pending = append(pending, pendingPush{
+					taskID:  task.ID().String(),
+					eventID: eventID,
+					event:   s.createEventFromTask(task),
+				})
 			}
 		}
 	}
 
-	return result, nil
+	return pending, nil
 }
 
 func (s *CalendarSyncService) findTaskByID(board *entity.Board, taskID string) *entity.Task {
@@ -254,11 +410,70 @@ func (s *CalendarSyncService) findTaskByCalendarID(board *entity.Board, calendar
 	return nil
 }
 
-func (s *CalendarSyncService) eventNewerThanTask(event external.CalendarEvent, task *entity.Task) bool {
-	return event.StartTime.After(task.ModifiedAt())
+func (s *CalendarSyncService) eventNewerThanTask(event service.CalendarEvent, task *entity.Task) bool {
+	// Fall back to the start time for events without a modification time
+	if event.Updated.IsZero() {
+		return event.StartTime.After(task.ModifiedAt())
+	}
+	return event.Updated.After(task.ModifiedAt())
+}
+
+// changedOnBothSides reports whether an event and its task were both edited
+// since the last sync
+func (s *CalendarSyncService) changedOnBothSides(event service.CalendarEvent, task *entity.Task) bool {
+	lastSync := s.syncState.LastSyncTime
+	if lastSync.IsZero() || event.Updated.IsZero() {
+		return false
+	}
+	return event.Updated.After(lastSync) && task.ModifiedAt().After(lastSync)
+}
+
+// conflictingFields returns the fields two versions of a meeting disagree on
+func conflictingFields(a, b service.CalendarEvent) []ConflictField {
+	fields := make([]ConflictField, 0)
+	if a.Title != b.Title {
+		fields = append(fields, ConflictFieldTitle)
+	}
+	if strings.TrimSpace(a.Description) != strings.TrimSpace(b.Description) {
+		fields = append(fields, ConflictFieldDescription)
+	}
+	if !sameEventTime(a, b) {
+		fields = append(fields, ConflictFieldTime)
+	}
+	if a.Location != b.Location {
+		fields = append(fields, ConflictFieldLocation)
+	}
+	return fields
 }
 
-func (s *CalendarSyncService) updateTaskFromEvent(task *entity.Task, event external.CalendarEvent) {
+// sameEventTime compares the times of two versions of a meeting, only by
+// day when either lasts all day since tasks carry no end for those
+func sameEventTime(a, b service.CalendarEvent) bool {
+	if a.IsAllDay || b.IsAllDay {
+		ay, am, ad := a.StartTime.Date()
+		by, bm, bd := b.StartTime.Date()
+		return ay == by && am == bm && ad == bd
+	}
+	return a.StartTime.Equal(b.StartTime) && a.EndTime.Equal(b.EndTime)
+}
+
+// copyConflictField sets a field of dst to its value in src
+func copyConflictField(dst *service.CalendarEvent, src service.CalendarEvent, field ConflictField) {
+	switch field {
+	case ConflictFieldTitle:
+		dst.Title = src.Title
+	case ConflictFieldDescription:
+		dst.Description = src.Description
+	case ConflictFieldTime:
+		dst.StartTime = src.StartTime
+		dst.EndTime = src.EndTime
+		dst.IsAllDay = src.IsAllDay
+	case ConflictFieldLocation:
+		dst.Location = src.Location
+	}
+}
+
+func (s *CalendarSyncService) updateTaskFromEvent(task *entity.Task, event service.CalendarEvent) {
 	task.UpdateTitle(event.Title)
 	task.UpdateDescription(event.Description)
 	task.SetScheduledDate(event.StartTime)
@@ -275,7 +490,7 @@ func (s *CalendarSyncService) updateTaskFromEvent(task *entity.Task, event exter
 	}
 }
 
-func (s *CalendarSyncService) createTaskFromEvent(board *entity.Board, event external.CalendarEvent) *entity.Task {
+func (s *CalendarSyncService) createTaskFromEvent(board *entity.Board, event service.CalendarEvent) *entity.Task {
 	var todoColumn *entity.Column
 	todoColumn, _ = board.GetColumn("To Do")
 	if todoColumn == nil {
@@ -331,8 +546,8 @@ func (s *CalendarSyncService) createTaskFromEvent(board *entity.Board, event ext
 	return task
 }
 
-func (s *CalendarSyncService) createEventFromTask(task *entity.Task) external.CalendarEvent {
-	event := external.CalendarEvent{
+func (s *CalendarSyncService) createEventFromTask(task *entity.Task) service.CalendarEvent {
+	event := service.CalendarEvent{
 		Title:       task.Title(),
 		Description: task.Description(),
 	}
@@ -361,6 +576,161 @@ func (s *CalendarSyncService) createEventFromTask(task *entity.Task) external.Ca
 	return event
 }
 
+// Snapshot returns a copy of the sync state for persisting
+func (s *CalendarSyncService) Snapshot() SyncState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	state := SyncState{
+		LastSyncTime:   s.syncState.LastSyncTime,
+		SyncedEvents:   make(map[string]string, len(s.syncState.SyncedEvents)),
+		SyncedTasks:    make(map[string]string, len(s.syncState.SyncedTasks)),
+		ConflictPolicy: s.syncState.ConflictPolicy,
+		Conflicts:      make(map[string]SyncConflict, len(s.syncState.Conflicts)),
+	}
+	for eventID, taskID := range s.syncState.SyncedEvents {
+		state.SyncedEvents[eventID] = taskID
+	}
+	for taskID, eventID := range s.syncState.SyncedTasks {
+		state.SyncedTasks[taskID] = eventID
+	}
+	for eventID, conflict := range s.syncState.Conflicts {
+		state.Conflicts[eventID] = conflict
+	}
+	return state
+}
+
+// Restore replaces the event-task mappings, pending conflicts and last sync
+// time with persisted ones, keeping the conflict policy
+func (s *CalendarSyncService) Restore(lastSync time.Time, syncedEvents map[string]string, conflicts []SyncConflict) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.syncState.LastSyncTime = lastSync
+	s.syncState.SyncedEvents = make(map[string]string, len(syncedEvents))
+	s.syncState.SyncedTasks = make(map[string]string, len(syncedEvents))
+	for eventID, taskID := range syncedEvents {
+		s.syncState.SyncedEvents[eventID] = taskID
+		s.syncState.SyncedTasks[taskID] = eventID
+	}
+	s.syncState.Conflicts = make(map[string]SyncConflict, len(conflicts))
+	for _, conflict := range conflicts {
+		s.syncState.Conflicts[conflict.EventID] = conflict
+	}
+}
+
+// ResolveConflict settles a pending conflict. keep takes every field from one
+// side; an empty keep merges, taking each conflicting field from the side
+// chosen for it in fields. The merged version is written to whichever of the
+// event and the task differs from it.
+func (s *CalendarSyncService) ResolveConflict(ctx context.Context, eventID string, keep ConflictSide, fields map[ConflictField]ConflictSide) (*SyncResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	conflict, exists := s.syncState.Conflicts[eventID]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", entity.ErrCalendarConflictNotFound, eventID)
+	}
+
+	// Start from the calendar version to keep what tasks don't carry, like attendees
+	merged := conflict.Event
+	for _, field := range ConflictFields {
+		side := keep
+		if keep == "" {
+			side = fields[field]
+			if side == "" && !containsConflictField(conflict.Fields, field) {
+				continue
+			}
+		}
+		switch side {
+		case ConflictSideCalendar:
+		case ConflictSideTask:
+			copyConflictField(&merged, conflict.Task, field)
+		default:
+			return nil, fmt.Errorf("%w: choose calendar or task for %s", entity.ErrInvalidConflictChoice, field)
+		}
+	}
+
+	result := &SyncResult{
+		LastSyncTime: time.Now(),
+	}
+
+	if len(conflictingFields(merged, conflict.Event)) > 0 {
+		err := s.provider.UpdateEvent(ctx, merged)
+		switch {
+		case errors.Is(err, entity.ErrCalendarEventNotFound):
+			// Gone from the calendar, the task is pushed as a new event
+			delete(s.syncState.SyncedEvents, conflict.EventID)
+			delete(s.syncState.SyncedTasks, conflict.TaskID)
+		case err != nil:
+			return nil, err
+		default:
+			result.EventsUpdated++
+		}
+	}
+
+	if len(conflictingFields(merged, conflict.Task)) > 0 {
+		if err := s.applyToTask(ctx, conflict, merged); err != nil {
+			return nil, err
+		}
+		result.TasksUpdated++
+		result.ChangedBoards = append(result.ChangedBoards, conflict.BoardID)
+	}
+
+	delete(s.syncState.Conflicts, eventID)
+	return result, nil
+}
+
+// applyToTask writes the merged version of a conflict to its task
+func (s *CalendarSyncService) applyToTask(ctx context.Context, conflict SyncConflict, merged service.CalendarEvent) error {
+	s.dataLock.Lock()
+	defer s.dataLock.Unlock()
+
+	board, err := s.boardRepo.FindByID(ctx, conflict.BoardID)
+	if err != nil {
+		return fmt.Errorf("failed to load board: %w", err)
+	}
+	task := s.findTaskByID(board, conflict.TaskID)
+	if task == nil {
+		return fmt.Errorf("%w: %s", entity.ErrTaskNotFound, conflict.TaskID)
+	}
+
+	s.updateTaskFromEvent(task, merged)
+	if err := s.boardRepo.Save(ctx, board); err != nil {
+		return fmt.Errorf("failed to save board: %w", err)
+	}
+	return nil
+}
+
+// Conflicts returns the conflicts awaiting a resolution, oldest first
+func (s *CalendarSyncService) Conflicts() []SyncConflict {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	conflicts := make([]SyncConflict, 0, len(s.syncState.Conflicts))
+	for _, conflict := range s.syncState.Conflicts {
+		conflicts = append(conflicts, conflict)
+	}
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].DetectedAt.Before(conflicts[j].DetectedAt)
+	})
+	return conflicts
+}
+
+func containsConflictField(fields []ConflictField, field ConflictField) bool {
+	for _, f := range fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// ProviderName returns the name of the calendar provider synced with
+func (s *CalendarSyncService) ProviderName() string {
+	return s.provider.Name()
+}
+
 func (s *CalendarSyncService) GetLastSyncTime() time.Time {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
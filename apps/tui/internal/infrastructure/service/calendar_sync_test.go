@@ -0,0 +1,369 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"mkanban/internal/domain/entity"
+	"mkanban/internal/domain/repository"
+	"mkanban/internal/domain/service"
+	"mkanban/internal/domain/valueobject"
+	"mkanban/internal/infrastructure/persistence/filesystem"
+)
+
+// lockCheckingProvider is a calendar that fails the test when a request is
+// made while the data lock is held
+type lockCheckingProvider struct {
+	t        *testing.T
+	dataLock *sync.Mutex
+	events   []service.CalendarEvent
+	created  int
+	updated  []service.CalendarEvent
+}
+
+func (p *lockCheckingProvider) checkUnlocked(request string) {
+	p.t.Helper()
+	if !p.dataLock.TryLock() {
+		p.t.Errorf("%s was called with the data lock held", request)
+		return
+	}
+	p.dataLock.Unlock()
+}
+
+func (p *lockCheckingProvider) Name() string { return "stand-in" }
+
+func (p *lockCheckingProvider) ListEvents(ctx context.Context, start, end time.Time) ([]service.CalendarEvent, error) {
+	p.checkUnlocked("ListEvents")
+	return p.events, nil
+}
+
+func (p *lockCheckingProvider) CreateEvent(ctx context.Context, event service.CalendarEvent) (*service.CalendarEvent, error) {
+	p.checkUnlocked("CreateEvent")
+	p.created++
+	event.ID = "created-" + event.Title
+	return &event, nil
+}
+
+func (p *lockCheckingProvider) UpdateEvent(ctx context.Context, event service.CalendarEvent) error {
+	p.checkUnlocked("UpdateEvent")
+	p.updated = append(p.updated, event)
+	return nil
+}
+
+func (p *lockCheckingProvider) DeleteEvent(ctx context.Context, eventID string) error {
+	p.checkUnlocked("DeleteEvent")
+	return nil
+}
+
+func (p *lockCheckingProvider) Changes(ctx context.Context, syncToken string) (*service.CalendarChanges, error) {
+	p.checkUnlocked("Changes")
+	return &service.CalendarChanges{}, nil
+}
+
+func TestSyncAllMakesCalendarRequestsWithoutDataLock(t *testing.T) {
+	ctx := context.Background()
+	boardRepo := filesystem.NewBoardRepository(t.TempDir())
+
+	board, err := entity.NewBoard("work/meetings", "Meetings", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	column, err := entity.NewColumn("To Do", "", 0, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := board.AddColumn(column); err != nil {
+		t.Fatal(err)
+	}
+	taskID, err := board.GenerateNextTaskID("review")
+	if err != nil {
+		t.Fatal(err)
+	}
+	task, err := entity.NewTask(taskID, "Review", "", valueobject.PriorityMedium, valueobject.StatusTodo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	task.SetTaskType(entity.TaskTypeMeeting)
+	task.SetScheduledDate(time.Now().Add(24 * time.Hour))
+	if err := column.AddTask(task); err != nil {
+		t.Fatal(err)
+	}
+	if err := boardRepo.Save(ctx, board); err != nil {
+		t.Fatal(err)
+	}
+
+	var dataLock sync.Mutex
+	start := time.Now().Add(48 * time.Hour)
+	provider := &lockCheckingProvider{
+		t:        t,
+		dataLock: &dataLock,
+		events: []service.CalendarEvent{{
+			ID:        "standup",
+			Title:     "Standup",
+			StartTime: start,
+			EndTime:   start.Add(15 * time.Minute),
+		}},
+	}
+
+	syncService := NewCalendarSyncService(provider, boardRepo, nil)
+	syncService.SetDataLock(&dataLock)
+
+	result, err := syncService.SyncAll(ctx)
+	if err != nil {
+		t.Fatalf("sync failed: %v", err)
+	}
+	if len(result.Errors) > 0 {
+		t.Fatalf("sync reported errors: %v", result.Errors)
+	}
+	if result.TasksCreated != 1 {
+		t.Errorf("expected 1 task created from the calendar, got %d", result.TasksCreated)
+	}
+	if ids := result.CreatedTasks[board.ID()]; len(ids) != 1 {
+		t.Errorf("expected the created task to be reported for %s, got %v", board.ID(), result.CreatedTasks)
+	}
+	if provider.created != 1 {
+		t.Errorf("expected the meeting to be pushed as 1 new event, got %d", provider.created)
+	}
+	if !dataLock.TryLock() {
+		t.Fatal("data lock still held after the sync")
+	}
+	dataLock.Unlock()
+}
+
+// conflictTestStart is when the synced meeting starts on the board
+var conflictTestStart = time.Date(2026, 11, 2, 10, 0, 0, 0, time.Local)
+
+// newConflictTestSync sets up a meeting synced with the standup event and
+// edited on both sides since the last sync: the event was renamed and moved
+// an hour later, the task was renamed. It returns the sync service, the
+// calendar and the board the meeting is on.
+func newConflictTestSync(t *testing.T) (*CalendarSyncService, *lockCheckingProvider, repository.BoardRepository, *entity.Board, string) {
+	t.Helper()
+	ctx := context.Background()
+	boardRepo := filesystem.NewBoardRepository(t.TempDir())
+
+	board, err := entity.NewBoard("work/meetings", "Meetings", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	column, err := entity.NewColumn("To Do", "", 0, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := board.AddColumn(column); err != nil {
+		t.Fatal(err)
+	}
+	taskID, err := board.GenerateNextTaskID("standup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	task, err := entity.NewTask(taskID, "Standup (board)", "", valueobject.PriorityMedium, valueobject.StatusTodo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	task.SetTaskType(entity.TaskTypeMeeting)
+	task.SetScheduledDate(conflictTestStart)
+	task.SetScheduledTime(conflictTestStart)
+	task.SetTimeBlock(30 * time.Minute)
+	if err := column.AddTask(task); err != nil {
+		t.Fatal(err)
+	}
+	if err := boardRepo.Save(ctx, board); err != nil {
+		t.Fatal(err)
+	}
+
+	var dataLock sync.Mutex
+	moved := conflictTestStart.Add(time.Hour)
+	provider := &lockCheckingProvider{
+		t:        t,
+		dataLock: &dataLock,
+		events: []service.CalendarEvent{{
+			ID:        "standup",
+			Title:     "Standup (calendar)",
+			StartTime: moved,
+			EndTime:   moved.Add(30 * time.Minute),
+			Updated:   time.Now(),
+		}},
+	}
+
+	syncService := NewCalendarSyncService(provider, boardRepo, nil)
+	syncService.SetDataLock(&dataLock)
+	syncService.SetConflictPolicy(ConflictPolicyAskUser)
+	syncService.Restore(time.Now().Add(-time.Hour), map[string]string{"standup": taskID.String()}, nil)
+
+	return syncService, provider, boardRepo, board, taskID.String()
+}
+
+// loadConflictTestTask reads the synced meeting back from the board
+func loadConflictTestTask(t *testing.T, boardRepo repository.BoardRepository, board *entity.Board, taskID string) *entity.Task {
+	t.Helper()
+	loaded, err := boardRepo.FindByID(context.Background(), board.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, column := range loaded.Columns() {
+		for _, task := range column.Tasks() {
+			if task.ID().String() == taskID {
+				return task
+			}
+		}
+	}
+	t.Fatalf("task %s not found on %s", taskID, board.ID())
+	return nil
+}
+
+func TestAskUserQueuesConflictAndSkipsThePairUntilResolved(t *testing.T) {
+	ctx := context.Background()
+	syncService, provider, boardRepo, board, taskID := newConflictTestSync(t)
+
+	result, err := syncService.SyncAll(ctx)
+	if err != nil {
+		t.Fatalf("sync failed: %v", err)
+	}
+	if len(result.Conflicts) != 1 {
+		t.Fatalf("expected the meeting changed on both sides to be queued, got %+v", result.Conflicts)
+	}
+	conflict := result.Conflicts[0]
+	if conflict.EventID != "standup" || conflict.TaskID != taskID || conflict.BoardID != board.ID() {
+		t.Errorf("expected the conflict to name the event, task and board, got %+v", conflict)
+	}
+	if len(conflict.Fields) != 2 || conflict.Fields[0] != ConflictFieldTitle || conflict.Fields[1] != ConflictFieldTime {
+		t.Errorf("expected the title and time to conflict, got %v", conflict.Fields)
+	}
+	if result.TasksUpdated != 0 || len(provider.updated) != 0 {
+		t.Errorf("expected neither side to be written, got %d task and %d event updates", result.TasksUpdated, len(provider.updated))
+	}
+	if got := loadConflictTestTask(t, boardRepo, board, taskID).Title(); got != "Standup (board)" {
+		t.Errorf("expected the task to keep its title, got %q", got)
+	}
+
+	// Edited again on the board, the pair is still left alone
+	loaded, err := boardRepo.FindByID(ctx, board.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, task := range loaded.Columns()[0].Tasks() {
+		task.UpdateTitle("Standup (board, again)")
+	}
+	if err := boardRepo.Save(ctx, loaded); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err = syncService.SyncAll(ctx)
+	if err != nil {
+		t.Fatalf("second sync failed: %v", err)
+	}
+	if len(result.Conflicts) != 0 || result.TasksUpdated != 0 || len(provider.updated) != 0 || provider.created != 0 {
+		t.Errorf("expected the conflicted pair to be skipped, got %+v and %d event updates", result, len(provider.updated))
+	}
+	pending := syncService.Conflicts()
+	if len(pending) != 1 || !pending[0].DetectedAt.Equal(conflict.DetectedAt) {
+		t.Errorf("expected the first conflict to stay queued, got %+v", pending)
+	}
+}
+
+func TestResolveConflict(t *testing.T) {
+	moved := conflictTestStart.Add(time.Hour)
+
+	tests := []struct {
+		name   string
+		keep   ConflictSide
+		fields map[ConflictField]ConflictSide
+		// the title and start both sides have after resolving, with whether
+		// each was written
+		wantTitle    string
+		wantStart    time.Time
+		eventUpdated bool
+		taskUpdated  bool
+	}{
+		{
+			name:        "keep calendar",
+			keep:        ConflictSideCalendar,
+			wantTitle:   "Standup (calendar)",
+			wantStart:   moved,
+			taskUpdated: true,
+		},
+		{
+			name:         "keep task",
+			keep:         ConflictSideTask,
+			wantTitle:    "Standup (board)",
+			wantStart:    conflictTestStart,
+			eventUpdated: true,
+		},
+		{
+			name:         "merge",
+			fields:       map[ConflictField]ConflictSide{ConflictFieldTitle: ConflictSideTask, ConflictFieldTime: ConflictSideCalendar},
+			wantTitle:    "Standup (board)",
+			wantStart:    moved,
+			eventUpdated: true,
+			taskUpdated:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			syncService, provider, boardRepo, board, taskID := newConflictTestSync(t)
+			if _, err := syncService.SyncAll(ctx); err != nil {
+				t.Fatal(err)
+			}
+
+			result, err := syncService.ResolveConflict(ctx, "standup", tt.keep, tt.fields)
+			if err != nil {
+				t.Fatalf("resolve failed: %v", err)
+			}
+
+			if tt.eventUpdated {
+				if len(provider.updated) != 1 || result.EventsUpdated != 1 {
+					t.Fatalf("expected the event to be updated once, got %d", len(provider.updated))
+				}
+				event := provider.updated[0]
+				if event.ID != "standup" || event.Title != tt.wantTitle || !event.StartTime.Equal(tt.wantStart) {
+					t.Errorf("expected the event to become %q at %v, got %q at %v", tt.wantTitle, tt.wantStart, event.Title, event.StartTime)
+				}
+			} else if len(provider.updated) != 0 {
+				t.Errorf("expected the event to be left alone, got %+v", provider.updated)
+			}
+
+			task := loadConflictTestTask(t, boardRepo, board, taskID)
+			if task.Title() != tt.wantTitle || task.ScheduledTime() == nil || !task.ScheduledTime().Equal(tt.wantStart) {
+				t.Errorf("expected the task to become %q at %v, got %q at %v", tt.wantTitle, tt.wantStart, task.Title(), task.ScheduledTime())
+			}
+			if tt.taskUpdated != (result.TasksUpdated == 1) {
+				t.Errorf("expected task written: %v, got %d task updates", tt.taskUpdated, result.TasksUpdated)
+			}
+			if tt.taskUpdated && (len(result.ChangedBoards) != 1 || result.ChangedBoards[0] != board.ID()) {
+				t.Errorf("expected %s to be reported changed, got %v", board.ID(), result.ChangedBoards)
+			}
+
+			if pending := syncService.Conflicts(); len(pending) != 0 {
+				t.Errorf("expected the conflict to be settled, got %+v", pending)
+			}
+			if _, err := syncService.ResolveConflict(ctx, "standup", tt.keep, tt.fields); !errors.Is(err, entity.ErrCalendarConflictNotFound) {
+				t.Errorf("expected a settled conflict to be gone, got %v", err)
+			}
+		})
+	}
+}
+
+func TestResolveConflictRequiresASideForEveryConflictingField(t *testing.T) {
+	ctx := context.Background()
+	syncService, provider, _, _, _ := newConflictTestSync(t)
+	if _, err := syncService.SyncAll(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// The time conflicts too but is not chosen
+	fields := map[ConflictField]ConflictSide{ConflictFieldTitle: ConflictSideTask}
+	if _, err := syncService.ResolveConflict(ctx, "standup", "", fields); !errors.Is(err, entity.ErrInvalidConflictChoice) {
+		t.Fatalf("expected an invalid choice error, got %v", err)
+	}
+	if len(provider.updated) != 0 {
+		t.Errorf("expected nothing to be written, got %+v", provider.updated)
+	}
+	if pending := syncService.Conflicts(); len(pending) != 1 {
+		t.Errorf("expected the conflict to stay queued, got %+v", pending)
+	}
+}
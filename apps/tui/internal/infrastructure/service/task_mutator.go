@@ -76,6 +76,6 @@ func (s *TaskMutatorService) CreateTask(boardID string, columnName string, taskE
 		Tags:        taskEntity.Tags(),
 	}
 
-	_, err := s.createTaskUseCase.Execute(ctx, boardID, createReq)
+	_, err := s.createTaskUseCase.ExecuteForAutomation(ctx, boardID, createReq)
 	return err
 }
@@ -41,11 +41,7 @@ func main() {
 	var boardID string
 	if len(boards) == 0 {
 		// Create default board
-		board, err := container.CreateBoardUseCase.Execute(ctx, struct {
-			ProjectID   string `json:"project_id"`
-			Name        string `json:"name"`
-			Description string `json:"description"`
-		}{
+		board, err := container.CreateBoardUseCase.Execute(ctx, dto.CreateBoardRequest{
 			ProjectID:   slug.Generate("Test Project"),
 			Name:        "Test Board",
 			Description: "Board with test data",
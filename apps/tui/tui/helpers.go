@@ -87,8 +87,9 @@ func formatDueDate(dueDate *time.Time, isOverdue bool, cfg *config.Config) (stri
 	return fmt.Sprintf("%s📅 %s (%s)", prefix, dateStr, relativeTime), color
 }
 
-// formatTags formats tags with icon and handles truncation
-func formatTags(tags []string, maxWidth int) string {
+// formatTags formats tags with icon and handles truncation; tags with a
+// color in the project's tag registry are drawn in that color
+func formatTags(tags []string, maxWidth int, colors map[string]string) string {
 	if len(tags) == 0 {
 		return ""
 	}
@@ -110,7 +111,7 @@ func formatTags(tags []string, maxWidth int) string {
 			if i == 0 {
 				// At least show part of the first tag
 				truncated := tag[:min(len(tag), availableWidth-3)] + "..."
-				displayTags = append(displayTags, truncated)
+				displayTags = append(displayTags, renderTag(tag, truncated, colors))
 			} else {
 				// Show how many more tags there are
 				remaining := len(tags) - i
@@ -118,13 +119,25 @@ func formatTags(tags []string, maxWidth int) string {
 			}
 			break
 		}
-		displayTags = append(displayTags, tag)
+		displayTags = append(displayTags, renderTag(tag, tag, colors))
 		currentLength += tagLen
 	}
 
 	return prefix + strings.Join(displayTags, "  ")
 }
 
+// renderTag draws the text shown for a tag in its registry color, if it has one.
+// Other tags keep the tag style color, which a colored tag before them would reset.
+func renderTag(tag string, text string, colors map[string]string) string {
+	if len(colors) == 0 {
+		return text
+	}
+	if color, ok := colors[tag]; ok {
+		return lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Render(text)
+	}
+	return lipgloss.NewStyle().Foreground(style.TagStyle.GetForeground()).Render(text)
+}
+
 // truncateDescription extracts and truncates the description preview
 func truncateDescription(desc string, maxLen int) string {
 	if desc == "" {
@@ -148,7 +161,7 @@ func truncateDescription(desc string, maxLen int) string {
 }
 
 // renderTaskCard renders a complete task card with all components
-func renderTaskCard(task dto.TaskDTO, width int, isSelected bool, cfg *config.Config) string {
+func renderTaskCard(task dto.TaskDTO, width int, isSelected bool, cfg *config.Config, tagColors map[string]string) string {
 	var lines []string
 
 	// Calculate content width (account for padding and borders)
@@ -188,7 +201,7 @@ func renderTaskCard(task dto.TaskDTO, width int, isSelected bool, cfg *config.Co
 
 	// Line 3: Tags (if exist)
 	if len(task.Tags) > 0 {
-		tagsStr := formatTags(task.Tags, contentWidth)
+		tagsStr := formatTags(task.Tags, contentWidth, tagColors)
 		if tagsStr != "" {
 			tagLine := style.TagStyle.
 				Width(contentWidth).
@@ -212,6 +225,14 @@ func renderTaskCard(task dto.TaskDTO, width int, isSelected bool, cfg *config.Co
 		}
 	}
 
+	// Line 5: Attachment count (if any)
+	if task.AttachmentCount > 0 {
+		attachmentLine := style.DescriptionStyle.
+			Width(contentWidth).
+			Render(fmt.Sprintf("📎 %d", task.AttachmentCount))
+		lines = append(lines, attachmentLine)
+	}
+
 	// Join all lines with small spacing
 	cardContent := strings.Join(lines, "\n")
 
@@ -223,6 +244,232 @@ func renderTaskCard(task dto.TaskDTO, width int, isSelected bool, cfg *config.Co
 	return style.TaskCardStyle.Width(width).Render(cardContent)
 }
 
+// formatCustomFields lists a task's custom field values in board schema order
+func formatCustomFields(values map[string]string, fields []dto.FieldDefinitionDTO) string {
+	var parts []string
+	for _, field := range fields {
+		if value, ok := values[field.Name]; ok {
+			parts = append(parts, fmt.Sprintf("%s: %s", field.Name, value))
+		}
+	}
+	return strings.Join(parts, "  •  ")
+}
+
+// getActivityIcon returns an icon for an activity entry type
+func getActivityIcon(activityType string) string {
+	switch activityType {
+	case "created":
+		return "✨"
+	case "moved":
+		return "➜"
+	case "completed":
+		return "✅"
+	case "time_logged":
+		return "⏱"
+	case "commit_linked":
+		return "🔗"
+	case "comment":
+		return "💬"
+	default:
+		return "•"
+	}
+}
+
+// formatActivityEntry renders a timeline line; comments keep their full text and wrap
+func formatActivityEntry(entry dto.ActivityEntryDTO, width int) string {
+	timestamp := style.DescriptionStyle.Render(entry.Timestamp.Local().Format("Jan 02 15:04"))
+	prefix := timestamp + " " + getActivityIcon(entry.Type) + " "
+
+	author := ""
+	if entry.Author != "" {
+		author = lipgloss.NewStyle().Bold(true).Render(entry.Author) + " "
+	}
+	available := width - lipgloss.Width(prefix) - lipgloss.Width(author)
+
+	if entry.Type == "comment" {
+		return lipgloss.JoinHorizontal(lipgloss.Top, prefix+author, lipgloss.NewStyle().Width(available).Render(entry.Message))
+	}
+
+	message := entry.Message
+	if available > 3 && len(message) > available {
+		message = message[:available-3] + "..."
+	}
+	return prefix + author + message
+}
+
+// formatAgendaDuration renders a duration in hours and minutes, e.g. 1h 30m
+func formatAgendaDuration(d time.Duration) string {
+	hours := int(d.Hours())
+	minutes := int(d.Minutes()) % 60
+	switch {
+	case hours == 0:
+		return fmt.Sprintf("%dm", minutes)
+	case minutes == 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	}
+}
+
+// renderAgendaTimeline draws the working hours of a day in quarter hours:
+// busy time as full cells, free time as shaded cells and breaks as blanks
+func renderAgendaTimeline(day dto.AgendaDayDTO, width int) string {
+	start, end := *day.WorkingStart, *day.WorkingEnd
+	label := start.Format("15:04") + " "
+	cells := int(end.Sub(start) / (15 * time.Minute))
+	if available := width - 2*lipgloss.Width(label); cells > available {
+		cells = available
+	}
+	if cells < 1 {
+		return ""
+	}
+	step := end.Sub(start) / time.Duration(cells)
+
+	overlaps := func(slots []dto.TimeSlotDTO, from, to time.Time) bool {
+		for _, slot := range slots {
+			if slot.Start.Before(to) && slot.End.After(from) {
+				return true
+			}
+		}
+		return false
+	}
+
+	now := time.Now()
+	var strip strings.Builder
+	for i := 0; i < cells; i++ {
+		from := start.Add(time.Duration(i) * step)
+		to := from.Add(step)
+		switch {
+		case !now.Before(from) && now.Before(to):
+			strip.WriteString(style.OverdueStyle.Render("│"))
+		case overlaps(day.Busy, from, to):
+			strip.WriteString("█")
+		case overlaps(day.Free, from, to):
+			strip.WriteString(style.DescriptionStyle.Render("░"))
+		default:
+			strip.WriteString(" ")
+		}
+	}
+	return style.DescriptionStyle.Render(label) + strip.String() + style.DescriptionStyle.Render(" "+end.Format("15:04"))
+}
+
+// formatAgendaRow renders a task or free slot line of the agenda view
+func formatAgendaRow(row agendaRow, width int, cfg *config.Config) string {
+	if row.free != nil {
+		return style.DescriptionStyle.Render(fmt.Sprintf("%s–%s  ░ free %s",
+			row.free.Start.Format("15:04"), row.free.End.Format("15:04"),
+			formatAgendaDuration(row.free.End.Sub(row.free.Start))))
+	}
+
+	item := row.item
+	when := "all day    "
+	switch {
+	case row.day == nil:
+		when = item.Start.Format("Jan 02     ")
+	case item.Kind == "due" && !item.AllDay:
+		when = "due " + item.Start.Format("15:04  ")
+	case !item.AllDay:
+		when = item.Start.Format("15:04") + "–" + item.End.Format("15:04")
+	}
+
+	icon := getPriorityIcon(item.Priority)
+	if item.Kind == "meeting" {
+		icon = "👥"
+	}
+	marks := ""
+	if item.Recurrence != "" {
+		marks += " ↻"
+	}
+	if item.Kind == "due" || item.Kind == "scheduled" {
+		marks += " (" + item.Kind + ")"
+	}
+
+	prefix := when + "  " + icon + " "
+	title := item.Title
+	available := width - lipgloss.Width(prefix) - lipgloss.Width(marks)
+	if available > 3 && len(title) > available {
+		title = title[:available-3] + "..."
+	}
+
+	titleStyle := lipgloss.NewStyle().Foreground(getPriorityColor(item.Priority, cfg))
+	switch {
+	case item.Done:
+		titleStyle = style.DescriptionStyle.Strikethrough(true)
+	case row.day == nil:
+		titleStyle = style.OverdueStyle
+	}
+	return prefix + titleStyle.Render(title) + style.DescriptionStyle.Render(marks)
+}
+
+// conflictFields are the fields of a meeting, in display order, that a
+// calendar conflict is merged by
+var conflictFields = []string{"title", "description", "time", "location"}
+
+// conflictLabelWidth is the width of the field names of the conflict prompt
+const conflictLabelWidth = 13
+
+// formatConflictValue formats a field of one version of a conflicting meeting
+func formatConflictValue(version dto.CalendarEventVersionDTO, field string) string {
+	switch field {
+	case "title":
+		return version.Title
+	case "description":
+		return version.Description
+	case "time":
+		start := version.Start.Local()
+		if version.AllDay {
+			return start.Format("Mon Jan 02") + " (all day)"
+		}
+		return start.Format("Mon Jan 02 15:04") + "–" + version.End.Local().Format("15:04")
+	case "location":
+		return version.Location
+	}
+	return ""
+}
+
+// fitConflictValue puts a value on one line of at most width characters
+func fitConflictValue(value string, width int) string {
+	value = strings.Join(strings.Fields(value), " ")
+	if value == "" {
+		return "—"
+	}
+	runes := []rune(value)
+	if width > 3 && len(runes) > width {
+		return string(runes[:width-3]) + "..."
+	}
+	return value
+}
+
+// formatConflictHeader formats the column titles of the conflict prompt
+func formatConflictHeader(valueWidth int) string {
+	return fmt.Sprintf("  %-*s%-*s  %s", conflictLabelWidth, "", valueWidth, "Calendar", "Task")
+}
+
+// formatConflictRow formats a field of a conflict with both versions side by
+// side, marking fields that differ and, while merging, the side chosen
+func formatConflictRow(conflict dto.CalendarSyncConflictDTO, field string, valueWidth int, merge map[string]string) string {
+	marker := "  "
+	for _, f := range conflict.Fields {
+		if f == field {
+			marker = "! "
+		}
+	}
+
+	calendar := fitConflictValue(formatConflictValue(conflict.Event, field), valueWidth)
+	task := fitConflictValue(formatConflictValue(conflict.Task, field), valueWidth)
+	if side, ok := merge[field]; ok {
+		calendar = fitConflictValue(formatConflictValue(conflict.Event, field), valueWidth-2)
+		task = fitConflictValue(formatConflictValue(conflict.Task, field), valueWidth-2)
+		if side == "calendar" {
+			calendar, task = "● "+calendar, "○ "+task
+		} else {
+			calendar, task = "○ "+calendar, "● "+task
+		}
+	}
+
+	return fmt.Sprintf("%s%-*s%-*s  %s", marker, conflictLabelWidth, field, valueWidth, calendar, task)
+}
+
 // min returns the minimum of two integers
 func min(a, b int) int {
 	if a < b {
@@ -8,14 +8,30 @@ import (
 )
 
 type keyMap struct {
-	Up     key.Binding
-	Down   key.Binding
-	Left   key.Binding
-	Right  key.Binding
-	Move   key.Binding
-	Add    key.Binding
-	Delete key.Binding
-	Quit   key.Binding
+	Up        key.Binding
+	Down      key.Binding
+	Left      key.Binding
+	Right     key.Binding
+	Move      key.Binding
+	Add       key.Binding
+	Delete    key.Binding
+	Details   key.Binding
+	Agenda    key.Binding
+	Conflicts key.Binding
+	Close     key.Binding
+	Quit      key.Binding
+
+	// Agenda view
+	AgendaWeek key.Binding
+	Schedule   key.Binding
+	Reschedule key.Binding
+	Timer      key.Binding
+
+	// Calendar conflict prompt
+	KeepCalendar key.Binding
+	KeepTask     key.Binding
+	Merge        key.Binding
+	Apply        key.Binding
 }
 
 var keys keyMap
@@ -24,6 +40,19 @@ var keys keyMap
 func InitKeybindings(cfg *config.Config) {
 	kb := cfg.Keybindings
 
+	// Configs written before the task detail view existed have no details key
+	if len(kb.Details) == 0 {
+		kb.Details = []string{"i"}
+	}
+	// Likewise for the agenda key
+	if len(kb.Agenda) == 0 {
+		kb.Agenda = []string{"c"}
+	}
+	// Likewise for the calendar conflicts key
+	if len(kb.Conflicts) == 0 {
+		kb.Conflicts = []string{"x"}
+	}
+
 	keys = keyMap{
 		Up: key.NewBinding(
 			key.WithKeys(kb.Up...),
@@ -53,10 +82,58 @@ func InitKeybindings(cfg *config.Config) {
 			key.WithKeys(kb.Delete...),
 			key.WithHelp(formatKeysHelp(kb.Delete), "delete task"),
 		),
+		Details: key.NewBinding(
+			key.WithKeys(kb.Details...),
+			key.WithHelp(formatKeysHelp(kb.Details), "task details"),
+		),
+		Agenda: key.NewBinding(
+			key.WithKeys(kb.Agenda...),
+			key.WithHelp(formatKeysHelp(kb.Agenda), "agenda"),
+		),
+		Conflicts: key.NewBinding(
+			key.WithKeys(kb.Conflicts...),
+			key.WithHelp(formatKeysHelp(kb.Conflicts), "calendar conflicts"),
+		),
+		Close: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "close"),
+		),
 		Quit: key.NewBinding(
 			key.WithKeys(kb.Quit...),
 			key.WithHelp(formatKeysHelp(kb.Quit), "quit"),
 		),
+		AgendaWeek: key.NewBinding(
+			key.WithKeys("w"),
+			key.WithHelp("w", "day/week"),
+		),
+		Schedule: key.NewBinding(
+			key.WithKeys("s"),
+			key.WithHelp("s", "schedule task"),
+		),
+		Reschedule: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "reschedule"),
+		),
+		Timer: key.NewBinding(
+			key.WithKeys("t"),
+			key.WithHelp("t", "start timer"),
+		),
+		KeepCalendar: key.NewBinding(
+			key.WithKeys("c"),
+			key.WithHelp("c", "keep calendar"),
+		),
+		KeepTask: key.NewBinding(
+			key.WithKeys("t"),
+			key.WithHelp("t", "keep task"),
+		),
+		Merge: key.NewBinding(
+			key.WithKeys("m"),
+			key.WithHelp("m", "merge by field"),
+		),
+		Apply: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "apply merge"),
+		),
 	}
 }
 
@@ -21,7 +21,21 @@ type Model struct {
 	horizontalScrollOffset int   // horizontal scroll offset for columns
 	width                  int
 	height                 int
-	lastBoardID            string // track the last board ID to detect changes
+	lastBoardID            string                        // track the last board ID to detect changes
+	detail                 *dto.TaskActivityDTO          // timeline of the task shown in the detail view, nil when closed
+	detailScroll           int                           // first timeline entry shown in the detail view
+	tagColors              map[string]string             // colors of the project's registered tags, by tag name
+	agenda                 *dto.AgendaDTO                // days shown in the agenda view, nil when closed
+	agendaFrom             time.Time                     // first day shown in the agenda view
+	agendaWeek             bool                          // the agenda view shows a week rather than a day
+	agendaRow              int                           // selected row of the agenda view
+	agendaStatus           string                        // outcome of the last action taken in the agenda view
+	conflicts              []dto.CalendarSyncConflictDTO // calendar sync conflicts awaiting a resolution
+	conflictOpen           bool                          // the conflict prompt is shown
+	conflictIndex          int                           // conflict shown in the prompt
+	conflictMerge          map[string]string             // side chosen for each conflicting field while merging, nil otherwise
+	conflictField          int                           // selected conflicting field while merging
+	conflictStatus         string                        // outcome of the last resolution
 }
 
 // BoardUpdateMsg is a message sent when the board is updated
@@ -29,6 +43,26 @@ type BoardUpdateMsg struct {
 	board *dto.BoardDTO
 }
 
+// taskActivityMsg is sent when the timeline of the task in the detail view is loaded
+type taskActivityMsg struct {
+	activity *dto.TaskActivityDTO
+}
+
+// agendaMsg is sent when the days shown in the agenda view are reloaded
+type agendaMsg struct {
+	agenda *dto.AgendaDTO
+}
+
+// conflictsMsg is sent when the calendar sync conflicts awaiting a resolution are loaded
+type conflictsMsg struct {
+	conflicts []dto.CalendarSyncConflictDTO
+}
+
+// tagColorsMsg is sent when the tag colors of the board's project are loaded
+type tagColorsMsg struct {
+	colors map[string]string
+}
+
 // NotificationMsg is a message sent when a notification is received
 type NotificationMsg struct {
 	notification *daemon.Notification
@@ -67,6 +101,8 @@ func (m Model) Init() tea.Cmd {
 	return tea.Batch(
 		m.subscribeToBoard(),
 		m.waitForNotification(),
+		m.loadTagColors(),
+		m.loadConflicts(),
 	)
 }
 
@@ -106,6 +142,85 @@ func (m Model) currentTask() *dto.TaskDTO {
 	return &m.board.Columns[m.focusedColumn].Tasks[m.focusedTask]
 }
 
+// Helper to find a task on the board by its full ID
+func (m Model) findTask(taskID string) *dto.TaskDTO {
+	for i := range m.board.Columns {
+		for j := range m.board.Columns[i].Tasks {
+			if m.board.Columns[i].Tasks[j].ID == taskID {
+				return &m.board.Columns[i].Tasks[j]
+			}
+		}
+	}
+	return nil
+}
+
+// Helper to get the conflict shown in the conflict prompt
+func (m Model) currentConflict() *dto.CalendarSyncConflictDTO {
+	if m.conflictIndex < 0 || m.conflictIndex >= len(m.conflicts) {
+		return nil
+	}
+	return &m.conflicts[m.conflictIndex]
+}
+
+// agendaRow is a line of the agenda view: a day header, a task, or a free
+// slot of working time
+type agendaRow struct {
+	header string             // day or section title, set on header rows only
+	day    *dto.AgendaDayDTO  // day of the row, nil in the overdue section
+	item   *dto.AgendaItemDTO // task on the agenda
+	free   *dto.TimeSlotDTO   // free working time
+}
+
+// agendaMinFreeSlot is the shortest free slot listed in the agenda view
+const agendaMinFreeSlot = 15 * time.Minute
+
+// Helper to list the rows of the agenda view: overdue tasks first, then each
+// day with its tasks and free slots in time order
+func (m Model) agendaRows() []agendaRow {
+	var rows []agendaRow
+	if m.agenda == nil {
+		return rows
+	}
+
+	if len(m.agenda.Overdue) > 0 {
+		rows = append(rows, agendaRow{header: "Overdue"})
+		for i := range m.agenda.Overdue {
+			rows = append(rows, agendaRow{item: &m.agenda.Overdue[i]})
+		}
+	}
+
+	for d := range m.agenda.Days {
+		day := &m.agenda.Days[d]
+		rows = append(rows, agendaRow{header: day.Date.Format("Monday, Jan 02"), day: day})
+
+		free := 0
+		for i := range day.Items {
+			item := &day.Items[i]
+			for ; free < len(day.Free) && !item.AllDay && !day.Free[free].Start.After(item.Start); free++ {
+				if day.Free[free].End.Sub(day.Free[free].Start) >= agendaMinFreeSlot {
+					rows = append(rows, agendaRow{day: day, free: &day.Free[free]})
+				}
+			}
+			rows = append(rows, agendaRow{day: day, item: item})
+		}
+		for ; free < len(day.Free); free++ {
+			if day.Free[free].End.Sub(day.Free[free].Start) >= agendaMinFreeSlot {
+				rows = append(rows, agendaRow{day: day, free: &day.Free[free]})
+			}
+		}
+	}
+	return rows
+}
+
+// Helper to get the selected row of the agenda view
+func (m Model) currentAgendaRow() *agendaRow {
+	rows := m.agendaRows()
+	if m.agendaRow < 0 || m.agendaRow >= len(rows) || rows[m.agendaRow].header != "" {
+		return nil
+	}
+	return &rows[m.agendaRow]
+}
+
 // Helper to get scroll offset for current column
 func (m Model) currentScrollOffset() int {
 	if m.focusedColumn < 0 || m.focusedColumn >= len(m.scrollOffsets) {
@@ -2,7 +2,9 @@ package tui
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
@@ -56,13 +58,24 @@ func checkBoardChange(m Model) tea.Cmd {
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case NotificationMsg:
+		// Keep an open agenda current, any change may move its items
+		var agendaCmd tea.Cmd
+		if m.agenda != nil {
+			agendaCmd = m.loadAgenda()
+		}
 		// Handle real-time update notification
 		if msg.notification.Type == "board_updated" || msg.notification.Type == "task_moved" {
 			// Reload the board
-			return m, m.reloadBoard()
+			return m, tea.Batch(agendaCmd, m.reloadBoard())
+		}
+		if msg.notification.Type == daemon.NotificationTagsUpdated {
+			return m, tea.Batch(agendaCmd, m.loadTagColors(), m.waitForNotification())
+		}
+		if msg.notification.Type == daemon.NotificationCalendarConflicts {
+			return m, tea.Batch(agendaCmd, m.loadConflicts(), m.waitForNotification())
 		}
 		// Continue waiting for next notification
-		return m, m.waitForNotification()
+		return m, tea.Batch(agendaCmd, m.waitForNotification())
 
 	case BoardUpdateMsg:
 		// Board has been reloaded
@@ -72,8 +85,35 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.scrollOffsets = make([]int, len(m.board.Columns))
 		}
 		m.clampTaskFocus()
+		// Keep an open timeline current, the change may have added entries to it
+		if m.detail != nil {
+			return m, tea.Batch(m.waitForNotification(), m.loadTaskActivity(m.detail.TaskID))
+		}
 		// Continue waiting for next notification
 		return m, m.waitForNotification()
+
+	case tagColorsMsg:
+		m.tagColors = msg.colors
+		return m, nil
+
+	case conflictsMsg:
+		m.conflicts = msg.conflicts
+		m.clampConflictIndex()
+		return m, nil
+
+	case agendaMsg:
+		if m.agenda != nil {
+			m.agenda = msg.agenda
+			m.clampAgendaRow()
+		}
+		return m, nil
+
+	case taskActivityMsg:
+		if m.detail != nil && msg.activity.TaskID == m.detail.TaskID {
+			m.detail = msg.activity
+		}
+		return m, nil
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
@@ -82,6 +122,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
+		if m.conflictOpen {
+			return m.updateConflicts(msg)
+		}
+		if m.detail != nil {
+			return m.updateDetail(msg)
+		}
+		if m.agenda != nil {
+			return m.updateAgenda(msg)
+		}
+
 		switch {
 		case key.Matches(msg, keys.Quit):
 			return m, tea.Quit
@@ -106,12 +156,520 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		case key.Matches(msg, keys.Delete):
 			m.deleteTask()
+
+		case key.Matches(msg, keys.Details):
+			m.openTaskDetail()
+
+		case key.Matches(msg, keys.Agenda):
+			m.openAgenda()
+
+		case key.Matches(msg, keys.Conflicts):
+			m.openConflicts()
+		}
+	}
+
+	return m, nil
+}
+
+// updateDetail handles keys while the task detail view is open
+func (m Model) updateDetail(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, keys.Quit):
+		return m, tea.Quit
+
+	case key.Matches(msg, keys.Details), key.Matches(msg, keys.Close):
+		m.detail = nil
+		m.detailScroll = 0
+
+	case key.Matches(msg, keys.Up):
+		if m.detailScroll > 0 {
+			m.detailScroll--
+		}
+
+	case key.Matches(msg, keys.Down):
+		if m.detailScroll < len(m.detail.Entries)-1 {
+			m.detailScroll++
 		}
 	}
 
 	return m, nil
 }
 
+// openTaskDetail shows the focused task with its activity timeline
+func (m *Model) openTaskDetail() {
+	task := m.currentTask()
+	if task == nil {
+		return
+	}
+
+	ctx := context.Background()
+	activity, err := m.daemonClient.GetTaskActivity(ctx, m.board.ID, task.ID)
+	if err != nil {
+		// Still show the task, the timeline just stays empty
+		activity = &dto.TaskActivityDTO{TaskID: task.ID, BoardID: m.board.ID}
+	}
+
+	m.detail = activity
+	m.detailScroll = 0
+}
+
+// loadTaskActivity reloads the timeline of a task from the daemon
+func (m Model) loadTaskActivity(taskID string) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		activity, err := m.daemonClient.GetTaskActivity(ctx, m.board.ID, taskID)
+		if err != nil {
+			return nil
+		}
+		return taskActivityMsg{activity: activity}
+	}
+}
+
+// updateAgenda handles keys while the agenda view is open
+func (m Model) updateAgenda(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, keys.Quit):
+		return m, tea.Quit
+
+	case key.Matches(msg, keys.Agenda), key.Matches(msg, keys.Close):
+		m.agenda = nil
+		m.agendaStatus = ""
+		return m, m.reloadBoard()
+
+	case key.Matches(msg, keys.Up):
+		m.moveAgendaRow(-1)
+
+	case key.Matches(msg, keys.Down):
+		m.moveAgendaRow(1)
+
+	case key.Matches(msg, keys.Left):
+		m.shiftAgenda(-1)
+
+	case key.Matches(msg, keys.Right):
+		m.shiftAgenda(1)
+
+	case key.Matches(msg, keys.AgendaWeek):
+		m.agendaWeek = !m.agendaWeek
+		if m.agendaWeek {
+			m.agendaFrom = startOfWeek(m.agendaFrom)
+		} else if today := startOfDay(time.Now()); !today.Before(m.agendaFrom) && today.Before(m.agendaFrom.AddDate(0, 0, 7)) {
+			m.agendaFrom = today
+		}
+		m.agendaRow = 0
+		m.refreshAgenda()
+
+	case key.Matches(msg, keys.Schedule):
+		m.scheduleIntoFreeSlot()
+
+	case key.Matches(msg, keys.Reschedule):
+		m.rescheduleBlock()
+
+	case key.Matches(msg, keys.Timer):
+		m.startAgendaTimer()
+	}
+
+	return m, nil
+}
+
+// openAgenda shows the agenda of the board's project, starting today
+func (m *Model) openAgenda() {
+	m.agendaFrom = startOfDay(time.Now())
+	if m.agendaWeek {
+		m.agendaFrom = startOfWeek(m.agendaFrom)
+	}
+	m.agenda = &dto.AgendaDTO{}
+	m.agendaRow = 0
+	m.agendaStatus = ""
+	m.refreshAgenda()
+}
+
+// fetchAgenda gets the days shown in the agenda view from the daemon
+func (m Model) fetchAgenda() (*dto.AgendaDTO, error) {
+	to := m.agendaFrom
+	if m.agendaWeek {
+		to = to.AddDate(0, 0, 6)
+	}
+
+	ctx := context.Background()
+	return m.daemonClient.GetAgenda(ctx, m.board.ProjectID, m.agendaFrom.Format("2006-01-02"), to.Format("2006-01-02"))
+}
+
+// refreshAgenda reloads the agenda view right away, keeping the selection in range
+func (m *Model) refreshAgenda() {
+	agenda, err := m.fetchAgenda()
+	if err != nil {
+		// Keep showing the last agenda, the status line tells why it is stale
+		m.agendaStatus = err.Error()
+		return
+	}
+
+	m.agenda = agenda
+	m.clampAgendaRow()
+}
+
+// loadAgenda reloads the agenda view from the daemon
+func (m Model) loadAgenda() tea.Cmd {
+	return func() tea.Msg {
+		agenda, err := m.fetchAgenda()
+		if err != nil {
+			return nil
+		}
+		return agendaMsg{agenda: agenda}
+	}
+}
+
+// shiftAgenda moves the agenda view by a day or, in week mode, by a week
+func (m *Model) shiftAgenda(direction int) {
+	days := direction
+	if m.agendaWeek {
+		days *= 7
+	}
+	m.agendaFrom = m.agendaFrom.AddDate(0, 0, days)
+	m.agendaRow = 0
+	m.agendaStatus = ""
+	m.refreshAgenda()
+}
+
+// moveAgendaRow selects the next row of the agenda view in a direction, skipping headers
+func (m *Model) moveAgendaRow(direction int) {
+	rows := m.agendaRows()
+	for i := m.agendaRow + direction; i >= 0 && i < len(rows); i += direction {
+		if rows[i].header == "" {
+			m.agendaRow = i
+			return
+		}
+	}
+}
+
+// clampAgendaRow ensures the agenda selection is on a row that is not a header
+func (m *Model) clampAgendaRow() {
+	rows := m.agendaRows()
+	if m.agendaRow >= len(rows) {
+		m.agendaRow = len(rows) - 1
+	}
+	if m.agendaRow < 0 {
+		m.agendaRow = 0
+	}
+	if m.agendaRow < len(rows) && rows[m.agendaRow].header != "" {
+		m.moveAgendaRow(1)
+	}
+	if m.agendaRow < len(rows) && rows[m.agendaRow].header != "" {
+		m.moveAgendaRow(-1)
+	}
+}
+
+// selectAgendaItem selects the agenda row of a task starting at a time, if shown
+func (m *Model) selectAgendaItem(taskID string, start time.Time) {
+	for i, row := range m.agendaRows() {
+		if row.item != nil && row.day != nil && row.item.TaskID == taskID && row.item.Start.Equal(start) {
+			m.agendaRow = i
+			return
+		}
+	}
+}
+
+// scheduleIntoFreeSlot blocks time for the task focused on the board at the
+// start of the selected free slot
+func (m *Model) scheduleIntoFreeSlot() {
+	row := m.currentAgendaRow()
+	if row == nil || row.free == nil {
+		m.agendaStatus = "select a free slot to schedule into"
+		return
+	}
+	task := m.currentTask()
+	if task == nil {
+		m.agendaStatus = "focus a task on the board to schedule it"
+		return
+	}
+
+	// Block the task's own time block or estimate, an hour without either
+	duration := time.Hour
+	if task.TimeBlock != nil && *task.TimeBlock > 0 {
+		duration = *task.TimeBlock
+	} else if task.EstimatedTime != nil && *task.EstimatedTime > 0 {
+		duration = *task.EstimatedTime
+	}
+	if available := row.free.End.Sub(row.free.Start); duration > available {
+		m.agendaStatus = fmt.Sprintf("%s needs %s, the slot has %s", task.ShortID, formatAgendaDuration(duration), formatAgendaDuration(available))
+		return
+	}
+
+	start := row.free.Start.Format(time.RFC3339)
+	length := duration.String()
+	payload := daemon.ScheduleTaskPayload{
+		TaskID:   task.ID,
+		Date:     row.free.Start.Format("2006-01-02"),
+		Start:    &start,
+		Duration: &length,
+	}
+
+	ctx := context.Background()
+	if err := m.daemonClient.ScheduleTask(ctx, payload); err != nil {
+		m.agendaStatus = err.Error()
+		return
+	}
+
+	m.agendaStatus = fmt.Sprintf("scheduled %s at %s", task.ShortID, row.free.Start.Format("Mon 15:04"))
+	m.refreshAgenda()
+	m.selectAgendaItem(task.ID, row.free.Start)
+}
+
+// rescheduleBlock moves the selected time block to the next free slot it
+// fits in, later than where it is now
+func (m *Model) rescheduleBlock() {
+	row := m.currentAgendaRow()
+	if row == nil || row.item == nil || row.item.AllDay || (row.item.Kind != "block" && row.item.Kind != "meeting") {
+		m.agendaStatus = "select a time block to reschedule"
+		return
+	}
+	item := row.item
+	if item.Occurrence {
+		m.agendaStatus = "occurrences of a recurring task move with the first one"
+		return
+	}
+
+	duration := item.End.Sub(item.Start)
+	var slot *dto.TimeSlotDTO
+	for d := range m.agenda.Days {
+		for i := range m.agenda.Days[d].Free {
+			free := &m.agenda.Days[d].Free[i]
+			if free.Start.After(item.Start) && free.Start.After(time.Now()) && free.End.Sub(free.Start) >= duration {
+				slot = free
+				break
+			}
+		}
+		if slot != nil {
+			break
+		}
+	}
+	if slot == nil {
+		m.agendaStatus = fmt.Sprintf("no later free slot of %s in view", formatAgendaDuration(duration))
+		return
+	}
+
+	start := slot.Start.Format(time.RFC3339)
+	payload := daemon.RescheduleTaskPayload{
+		TaskID: item.TaskID,
+		Start:  &start,
+	}
+
+	ctx := context.Background()
+	if err := m.daemonClient.RescheduleTask(ctx, payload); err != nil {
+		m.agendaStatus = err.Error()
+		return
+	}
+
+	m.agendaStatus = fmt.Sprintf("moved %s to %s", item.ShortID, slot.Start.Format("Mon 15:04"))
+	taskID, movedTo := item.TaskID, slot.Start
+	m.refreshAgenda()
+	m.selectAgendaItem(taskID, movedTo)
+}
+
+// startAgendaTimer starts tracking time on the task of the selected row
+func (m *Model) startAgendaTimer() {
+	row := m.currentAgendaRow()
+	if row == nil || row.item == nil {
+		m.agendaStatus = "select a task to start a timer on"
+		return
+	}
+
+	ctx := context.Background()
+	if err := m.daemonClient.StartTimer(ctx, row.item.ProjectID, row.item.TaskID, row.item.Title); err != nil {
+		m.agendaStatus = err.Error()
+		return
+	}
+
+	m.agendaStatus = fmt.Sprintf("timer started on %s", row.item.ShortID)
+}
+
+// startOfDay returns midnight of t's day
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// startOfWeek returns midnight of the Monday of t's week
+func startOfWeek(t time.Time) time.Time {
+	return startOfDay(t).AddDate(0, 0, -((int(t.Weekday()) + 6) % 7))
+}
+
+// updateConflicts handles keys while the calendar conflict prompt is open
+func (m Model) updateConflicts(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.conflictMerge != nil {
+		return m.updateConflictMerge(msg)
+	}
+
+	switch {
+	case key.Matches(msg, keys.Quit):
+		return m, tea.Quit
+
+	case key.Matches(msg, keys.Conflicts), key.Matches(msg, keys.Close):
+		m.conflictOpen = false
+		m.conflictStatus = ""
+
+	case key.Matches(msg, keys.Left), key.Matches(msg, keys.Up):
+		if m.conflictIndex > 0 {
+			m.conflictIndex--
+		}
+
+	case key.Matches(msg, keys.Right), key.Matches(msg, keys.Down):
+		if m.conflictIndex < len(m.conflicts)-1 {
+			m.conflictIndex++
+		}
+
+	case key.Matches(msg, keys.KeepCalendar):
+		m.resolveConflict("keep_calendar", nil)
+
+	case key.Matches(msg, keys.KeepTask):
+		m.resolveConflict("keep_task", nil)
+
+	case key.Matches(msg, keys.Merge):
+		m.startConflictMerge()
+	}
+
+	return m, nil
+}
+
+// updateConflictMerge handles keys while a side is chosen for each
+// conflicting field of the conflict in the prompt
+func (m Model) updateConflictMerge(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	conflict := m.currentConflict()
+	if conflict == nil {
+		m.conflictMerge = nil
+		return m, nil
+	}
+	field := conflict.Fields[m.conflictField]
+
+	switch {
+	case key.Matches(msg, keys.Quit):
+		return m, tea.Quit
+
+	case key.Matches(msg, keys.Close):
+		m.conflictMerge = nil
+
+	case key.Matches(msg, keys.Up):
+		if m.conflictField > 0 {
+			m.conflictField--
+		}
+
+	case key.Matches(msg, keys.Down):
+		if m.conflictField < len(conflict.Fields)-1 {
+			m.conflictField++
+		}
+
+	case key.Matches(msg, keys.Left), key.Matches(msg, keys.KeepCalendar):
+		m.conflictMerge[field] = "calendar"
+
+	case key.Matches(msg, keys.Right), key.Matches(msg, keys.KeepTask):
+		m.conflictMerge[field] = "task"
+
+	case key.Matches(msg, keys.Apply):
+		m.resolveConflict("merge", m.conflictMerge)
+	}
+
+	return m, nil
+}
+
+// openConflicts shows the prompt for the calendar sync conflicts awaiting a resolution
+func (m *Model) openConflicts() {
+	m.conflictOpen = true
+	m.conflictIndex = 0
+	m.conflictMerge = nil
+	m.conflictStatus = ""
+
+	ctx := context.Background()
+	conflicts, err := m.daemonClient.ListCalendarConflicts(ctx)
+	if err != nil {
+		// Keep the conflicts known so far, the status line tells why
+		m.conflictStatus = err.Error()
+		return
+	}
+	m.conflicts = conflicts
+}
+
+// startConflictMerge starts choosing a side for each conflicting field,
+// the calendar's to begin with
+func (m *Model) startConflictMerge() {
+	conflict := m.currentConflict()
+	if conflict == nil || len(conflict.Fields) == 0 {
+		return
+	}
+
+	m.conflictMerge = make(map[string]string, len(conflict.Fields))
+	for _, field := range conflict.Fields {
+		m.conflictMerge[field] = "calendar"
+	}
+	m.conflictField = 0
+	m.conflictStatus = ""
+}
+
+// resolveConflict settles the conflict shown in the prompt
+func (m *Model) resolveConflict(resolution string, fields map[string]string) {
+	conflict := m.currentConflict()
+	if conflict == nil {
+		return
+	}
+
+	ctx := context.Background()
+	if _, err := m.daemonClient.ResolveCalendarConflict(ctx, conflict.EventID, resolution, fields); err != nil {
+		m.conflictStatus = err.Error()
+		return
+	}
+
+	m.conflictStatus = fmt.Sprintf("resolved %q", conflict.TaskTitle)
+	m.conflictMerge = nil
+	m.conflicts = append(m.conflicts[:m.conflictIndex:m.conflictIndex], m.conflicts[m.conflictIndex+1:]...)
+	m.clampConflictIndex()
+}
+
+// clampConflictIndex keeps the conflict shown in the prompt in range
+func (m *Model) clampConflictIndex() {
+	if m.conflictIndex >= len(m.conflicts) {
+		m.conflictIndex = len(m.conflicts) - 1
+	}
+	if m.conflictIndex < 0 {
+		m.conflictIndex = 0
+	}
+	if m.currentConflict() == nil {
+		m.conflictMerge = nil
+	}
+}
+
+// loadConflicts loads the calendar sync conflicts awaiting a resolution
+func (m Model) loadConflicts() tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		conflicts, err := m.daemonClient.ListCalendarConflicts(ctx)
+		if err != nil {
+			return nil
+		}
+		return conflictsMsg{conflicts: conflicts}
+	}
+}
+
+// loadTagColors loads the colors of the registered tags of the board's project
+func (m Model) loadTagColors() tea.Cmd {
+	return func() tea.Msg {
+		if m.board.ProjectID == "" {
+			return nil
+		}
+
+		ctx := context.Background()
+		tags, err := m.daemonClient.ListTags(ctx, m.board.ProjectID)
+		if err != nil {
+			// Tags are drawn in the default tag style
+			return nil
+		}
+
+		colors := make(map[string]string)
+		for _, tag := range tags {
+			if tag.Color != "" {
+				colors[tag.Name] = tag.Color
+			}
+		}
+		return tagColorsMsg{colors: colors}
+	}
+}
+
 // moveLeft moves focus to the left column
 func (m *Model) moveLeft() {
 	if m.focusedColumn > 0 {
@@ -305,8 +863,28 @@ func (m *Model) deleteTask() {
 		return
 	}
 
-	// For now, just skip delete as we need to implement DeleteTaskUseCase
-	// TODO: Implement DeleteTaskUseCase and call it here
+	// Get the current task
+	task := m.board.Columns[m.focusedColumn].Tasks[m.focusedTask]
+
+	// The daemon moves the task and its subtasks to the trash
+	ctx := context.Background()
+	if _, err := m.daemonClient.DeleteTask(ctx, m.board.ID, task.ID); err != nil {
+		// Handle error (for now, just return)
+		return
+	}
+
+	// Reload the board to get updated state
+	updatedBoard, err := m.daemonClient.GetBoard(ctx, m.board.ID)
+	if err != nil {
+		return
+	}
+
+	m.board = updatedBoard
+
+	// Ensure scroll offsets array matches board columns
+	if len(m.scrollOffsets) != len(m.board.Columns) {
+		m.scrollOffsets = make([]int, len(m.board.Columns))
+	}
 
 	// Adjust focus
 	m.clampTaskFocus()
@@ -3,6 +3,7 @@ package tui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"mkanban/internal/application/dto"
@@ -15,6 +16,16 @@ func (m Model) View() string {
 		return "Loading..."
 	}
 
+	if m.conflictOpen {
+		return m.renderConflicts()
+	}
+	if m.detail != nil {
+		return m.renderTaskDetail()
+	}
+	if m.agenda != nil {
+		return m.renderAgenda()
+	}
+
 	// Calculate column width - account for borders, padding, and spacing
 	totalColumns := len(m.board.Columns)
 	if totalColumns == 0 {
@@ -155,6 +166,13 @@ func (m Model) View() string {
 		scrollInfo := fmt.Sprintf("Columns: %d-%d of %d", startCol+1, endCol, totalColumns)
 		help = help + "\n" + style.HelpStyle.Faint(true).Render(scrollInfo)
 	}
+	if len(m.conflicts) > 0 {
+		conflicts := "1 calendar conflict awaits"
+		if len(m.conflicts) > 1 {
+			conflicts = fmt.Sprintf("%d calendar conflicts await", len(m.conflicts))
+		}
+		help = help + "\n" + style.OverdueStyle.Render(fmt.Sprintf("%s a resolution  •  %s (resolve)", conflicts, keys.Conflicts.Help().Key))
+	}
 
 	// Apply same left margin to help text
 	if leftMargin > 0 {
@@ -215,7 +233,7 @@ func (m Model) renderColumn(col dto.ColumnDTO, colIndex int, width int, viewport
 		isSelected := isFocused && i == m.focusedTask
 
 		// Render task card with all components
-		taskCard := renderTaskCard(task, width, isSelected, m.config)
+		taskCard := renderTaskCard(task, width, isSelected, m.config, m.tagColors)
 		tasks = append(tasks, taskCard)
 	}
 
@@ -246,11 +264,216 @@ func (m Model) renderColumn(col dto.ColumnDTO, colIndex int, width int, viewport
 	return style.ColumnStyle.Height(m.height - 6).Render(content)
 }
 
+// renderTaskDetail renders the task shown in the detail view with its activity timeline, newest entry first
+func (m Model) renderTaskDetail() string {
+	// Frame overhead: borders (2 chars) + horizontal padding (2*2 = 4 chars)
+	width := m.width - 6
+	if width < 20 {
+		width = 20
+	}
+
+	var lines []string
+
+	task := m.findTask(m.detail.TaskID)
+	if task != nil {
+		priorityColor := getPriorityColor(task.Priority, m.config)
+		lines = append(lines, lipgloss.NewStyle().Foreground(priorityColor).Bold(true).Render(getPriorityIcon(task.Priority))+" "+
+			lipgloss.NewStyle().Bold(true).Render(task.Title))
+		lines = append(lines, style.DescriptionStyle.Render(fmt.Sprintf("%s  •  %s  •  %s", task.ShortID, m.detail.Column, task.Status)))
+
+		if task.Description != "" {
+			lines = append(lines, "", style.DescriptionStyle.Width(width).MaxHeight(6).Render(task.Description))
+		}
+		if len(task.Tags) > 0 {
+			lines = append(lines, style.TagStyle.Render(formatTags(task.Tags, width, m.tagColors)))
+		}
+		if task.DueDate != nil {
+			dueDateStr, dueDateColor := formatDueDate(task.DueDate, task.IsOverdue, m.config)
+			lines = append(lines, style.DueDateStyle.Foreground(dueDateColor).Render(dueDateStr))
+		}
+		if len(task.Fields) > 0 && m.board != nil {
+			lines = append(lines, style.DescriptionStyle.Width(width).Render(formatCustomFields(task.Fields, m.board.Fields)))
+		}
+	} else {
+		lines = append(lines, lipgloss.NewStyle().Bold(true).Render(m.detail.TaskID))
+	}
+
+	lines = append(lines, "", style.ColumnTitleStyle.Render("Activity"))
+
+	// Whatever is left below the header holds the timeline
+	timelineHeight := m.height - len(lines) - 6
+	if timelineHeight < 1 {
+		timelineHeight = 1
+	}
+
+	var timeline []string
+	entries := m.detail.Entries
+	for i := len(entries) - 1 - m.detailScroll; i >= 0; i-- {
+		timeline = append(timeline, formatActivityEntry(entries[i], width))
+	}
+	if len(timeline) == 0 {
+		timeline = append(timeline, style.DescriptionStyle.Render("(no activity yet)"))
+	}
+
+	lines = append(lines, lipgloss.NewStyle().MaxHeight(timelineHeight).Render(strings.Join(timeline, "\n")))
+
+	content := strings.Join(lines, "\n")
+	help := style.HelpStyle.Render("↑/k,↓/j (scroll)  •  i/esc (close)  •  q (quit)")
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		style.FocusedColumnStyle.Height(m.height-4).Render(content),
+		help,
+	)
+}
+
+// renderAgenda renders the agenda view: overdue tasks, then each day with a
+// timeline of its working hours and its tasks and free slots in time order
+func (m Model) renderAgenda() string {
+	// Frame overhead: borders (2 chars) + horizontal padding (2*2 = 4 chars)
+	width := m.width - 6
+	if width < 20 {
+		width = 20
+	}
+
+	title := "Agenda  " + m.agendaFrom.Format("Mon Jan 02")
+	if m.agendaWeek {
+		title = fmt.Sprintf("Agenda  week of %s – %s", m.agendaFrom.Format("Jan 02"), m.agendaFrom.AddDate(0, 0, 6).Format("Jan 02"))
+	}
+	if m.board != nil && m.board.ProjectID != "" {
+		title += "  •  " + m.board.ProjectID
+	}
+
+	var lines []string
+	selectedLine := 0
+	for i, row := range m.agendaRows() {
+		if i == m.agendaRow {
+			selectedLine = len(lines)
+		}
+		switch {
+		case row.header != "" && row.day == nil:
+			lines = append(lines, "", style.OverdueStyle.Render(row.header))
+		case row.header != "":
+			summary := "day off"
+			if row.day.WorkingStart != nil {
+				summary = fmt.Sprintf("%s free  •  %s busy",
+					formatAgendaDuration(time.Duration(row.day.FreeMinutes)*time.Minute),
+					formatAgendaDuration(time.Duration(row.day.BusyMinutes)*time.Minute))
+			}
+			lines = append(lines, "", style.ColumnTitleStyle.Render(row.header)+"  "+style.DescriptionStyle.Render(summary))
+			if row.day.WorkingStart != nil {
+				lines = append(lines, renderAgendaTimeline(*row.day, width))
+			}
+		default:
+			line := formatAgendaRow(row, width, m.config)
+			if i == m.agendaRow {
+				line = style.SelectedTaskStyle.Width(width).Render(line)
+			}
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) == 0 {
+		lines = append(lines, "", style.DescriptionStyle.Render("(nothing scheduled)"))
+	}
+
+	// Scroll so the selected row stays in view below the title
+	height := m.height - 7
+	if height < 1 {
+		height = 1
+	}
+	start := 0
+	if selectedLine >= height {
+		start = selectedLine - height + 1
+	}
+	end := start + height
+	if end > len(lines) {
+		end = len(lines)
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left,
+		lipgloss.NewStyle().Bold(true).Render(title),
+		strings.Join(lines[start:end], "\n"),
+	)
+
+	helpText := []string{
+		"↑/k,↓/j (select)  ←/h,→/l (previous/next)  w (day/week)",
+		"s (schedule focused task)  r (reschedule)  t (start timer)  c/esc (close)  q (quit)",
+	}
+	help := style.HelpStyle.Render(strings.Join(helpText, "  •  "))
+	if m.agendaStatus != "" {
+		help += "\n" + style.DescriptionStyle.Render(m.agendaStatus)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		style.FocusedColumnStyle.Height(m.height-5).Render(content),
+		help,
+	)
+}
+
+// renderConflicts renders the prompt for calendar sync conflicts, one
+// conflict at a time with the calendar and task versions side by side
+func (m Model) renderConflicts() string {
+	// Frame overhead: borders (2 chars) + horizontal padding (2*2 = 4 chars)
+	width := m.width - 6
+	if width < 20 {
+		width = 20
+	}
+
+	title := "Calendar conflicts"
+	var lines []string
+	conflict := m.currentConflict()
+	if conflict == nil {
+		lines = append(lines, "", style.DescriptionStyle.Render("(no conflicts)"))
+	} else {
+		title = fmt.Sprintf("Calendar conflict %d of %d", m.conflictIndex+1, len(m.conflicts))
+		lines = append(lines,
+			"",
+			style.ColumnTitleStyle.Render(conflict.TaskTitle)+"  "+style.DescriptionStyle.Render(conflict.Description),
+			style.DescriptionStyle.Render("found "+conflict.DetectedAt.Local().Format("Mon Jan 02 15:04")),
+			"",
+		)
+
+		valueWidth := (width - conflictLabelWidth - 4) / 2
+		lines = append(lines, style.ColumnTitleStyle.Render(formatConflictHeader(valueWidth)))
+		for _, field := range conflictFields {
+			line := formatConflictRow(*conflict, field, valueWidth, m.conflictMerge)
+			if m.conflictMerge != nil && field == conflict.Fields[m.conflictField] {
+				line = style.SelectedTaskStyle.Width(width).Render(line)
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left,
+		lipgloss.NewStyle().Bold(true).Render(title),
+		strings.Join(lines, "\n"),
+	)
+
+	helpText := []string{
+		"←/h,→/l (previous/next)",
+		"c (keep calendar)  t (keep task)  m (merge by field)  x/esc (close)  q (quit)",
+	}
+	if m.conflictMerge != nil {
+		helpText = []string{
+			"↑/k,↓/j (field)",
+			"←/h,c (calendar)  →/l,t (task)  enter (apply merge)  esc (cancel)",
+		}
+	}
+	help := style.HelpStyle.Render(strings.Join(helpText, "  •  "))
+	if m.conflictStatus != "" {
+		help += "\n" + style.DescriptionStyle.Render(m.conflictStatus)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		style.FocusedColumnStyle.Height(m.height-5).Render(content),
+		help,
+	)
+}
+
 // renderHelp renders the help text at the bottom
 func (m Model) renderHelp() string {
 	helpText := []string{
 		"Navigation: ←/h,→/l (columns)  ↑/k,↓/j (tasks)",
-		"Actions: a (add)  d (delete)  m/enter (move)  q (quit)",
+		"Actions: a (add)  d (delete)  m/enter (move)  i (details)  c (agenda)  q (quit)",
 	}
 
 	return style.HelpStyle.Render(strings.Join(helpText, "  •  "))